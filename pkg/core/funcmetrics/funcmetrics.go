@@ -0,0 +1,245 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package funcmetrics records the duration and calls of instrumented functions
+// whose rules ask for metrics. The generated trampolines call Start on entry
+// and the returned function on exit, so that arbitrary functions are measured
+// without creating spans. Functions of metrics-only rules, i.e. rules without
+// hooks, skip the trampolines and call Record on exit instead, which allocates
+// nothing once the function has been measured, so that extremely hot functions
+// can be measured as well.
+package funcmetrics
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/hook"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/meter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/overhead"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const func_call_duration = "function.call.duration"
+
+const func_calls = "function.calls"
+
+// Instruments that rules may choose from, both of them are recorded by default
+const (
+	Histogram = "histogram"
+	Counter   = "counter"
+)
+
+type funcInstruments struct {
+	duration metric.Float64Histogram
+	calls    metric.Int64Counter
+}
+
+// funcKey identifies a measured function
+type funcKey struct {
+	rule      string
+	namespace string
+	function  string
+}
+
+// recorder holds everything needed to record a call of a measured function,
+// so that recording allocates nothing
+type recorder struct {
+	histogram  bool
+	counter    bool
+	set        attribute.Set
+	recordOpts []metric.RecordOption
+	addOpts    []metric.AddOption
+	// Options of calls returning errors, keyed by the reflect.Type of errors
+	errOpts sync.Map
+}
+
+var (
+	mu          sync.Mutex
+	instruments atomic.Pointer[funcInstruments]
+	// Static attributes of rules, the value is []attribute.KeyValue
+	ruleAttrs sync.Map
+	// Instruments chosen by rules, the value is []string
+	ruleInstruments sync.Map
+	// Recorders of measured functions, replaced as a whole under mu when a new
+	// function is measured, so that lookups take no lock
+	recorders atomic.Pointer[map[funcKey]*recorder]
+)
+
+// Register attaches static attributes to the metrics of the given rule, the
+// attributes are given as key-value pairs, e.g. Register(rule, "team", "pay")
+func Register(rule string, kv ...string) {
+	attrs := make([]attribute.KeyValue, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		attrs = append(attrs, attribute.String(kv[i], kv[i+1]))
+	}
+	ruleAttrs.Store(rule, attrs)
+}
+
+// SetInstruments chooses the instruments recorded for the given rule, each of
+// them is either Histogram or Counter, e.g. SetInstruments(rule, Counter)
+func SetInstruments(rule string, kinds ...string) {
+	ruleInstruments.Store(rule, kinds)
+}
+
+func getInstruments() *funcInstruments {
+	if inst := instruments.Load(); inst != nil {
+		return inst
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if inst := instruments.Load(); inst != nil {
+		return inst
+	}
+	// The meter is not ready until the otel setup is done, functions called
+	// before that are not measured
+	m := meter.GetMeter()
+	if m == nil {
+		return nil
+	}
+	d, err := m.Float64Histogram(func_call_duration,
+		metric.WithUnit("ms"),
+		metric.WithDescription("Duration of instrumented function calls."))
+	if err != nil {
+		return nil
+	}
+	c, err := m.Int64Counter(func_calls,
+		metric.WithUnit("{call}"),
+		metric.WithDescription("Number of instrumented function calls."))
+	if err != nil {
+		return nil
+	}
+	inst := &funcInstruments{duration: d, calls: c}
+	instruments.Store(inst)
+	return inst
+}
+
+func newRecorder(key funcKey) *recorder {
+	attrs := []attribute.KeyValue{
+		semconv.CodeNamespace(key.namespace),
+		semconv.CodeFunctionName(key.function),
+	}
+	if static, ok := ruleAttrs.Load(key.rule); ok {
+		attrs = append(attrs, static.([]attribute.KeyValue)...)
+	}
+	set := attribute.NewSet(attrs...)
+	r := &recorder{
+		histogram:  true,
+		counter:    true,
+		set:        set,
+		recordOpts: []metric.RecordOption{metric.WithAttributeSet(set)},
+		addOpts:    []metric.AddOption{metric.WithAttributeSet(set)},
+	}
+	if kinds, ok := ruleInstruments.Load(key.rule); ok {
+		r.histogram, r.counter = false, false
+		for _, kind := range kinds.([]string) {
+			switch kind {
+			case Histogram:
+				r.histogram = true
+			case Counter:
+				r.counter = true
+			}
+		}
+	}
+	return r
+}
+
+func getRecorder(rule, namespace, function string) *recorder {
+	key := funcKey{rule: rule, namespace: namespace, function: function}
+	if m := recorders.Load(); m != nil {
+		if r, ok := (*m)[key]; ok {
+			return r
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	old := recorders.Load()
+	if old != nil {
+		if r, ok := (*old)[key]; ok {
+			return r
+		}
+	}
+	m := make(map[funcKey]*recorder)
+	if old != nil {
+		for k, v := range *old {
+			m[k] = v
+		}
+	}
+	r := newRecorder(key)
+	m[key] = r
+	recorders.Store(&m)
+	return r
+}
+
+// recordOptions returns the options of the call returning err, the error type
+// is recorded for the duration only, so that the calls are counted as a whole
+func (r *recorder) recordOptions(err error) []metric.RecordOption {
+	if err == nil {
+		return r.recordOpts
+	}
+	t := reflect.TypeOf(err)
+	if opts, ok := r.errOpts.Load(t); ok {
+		return opts.([]metric.RecordOption)
+	}
+	attrs := append(r.set.ToSlice(),
+		semconv.ErrorTypeKey.String(fmt.Sprintf("%T", err)))
+	opts := []metric.RecordOption{
+		metric.WithAttributeSet(attribute.NewSet(attrs...)),
+	}
+	r.errOpts.Store(t, opts)
+	return opts
+}
+
+func (r *recorder) record(inst *funcInstruments, elapsed int64, err error) {
+	ctx := context.Background()
+	if r.histogram {
+		inst.duration.Record(ctx, float64(elapsed)/1e6, r.recordOptions(err)...)
+	}
+	if r.counter {
+		inst.calls.Add(ctx, 1, r.addOpts...)
+	}
+}
+
+// Start starts measuring a call of the function matched by the given rule, the
+// returned function should be called with the error returned by the function,
+// if any, when the call ends. It returns nil if metrics are not available.
+func Start(rule, namespace, function string) func(error) {
+	inst := getInstruments()
+	if inst == nil {
+		return nil
+	}
+	start := overhead.Now()
+	return func(err error) {
+		r := getRecorder(rule, namespace, function)
+		r.record(inst, overhead.Now()-start, err)
+	}
+}
+
+// Record records a call of the function matched by the given metrics-only
+// rule, which started at start, i.e. the value of overhead.Now() when the call
+// started, and returned err. It is called by the instrumented function itself
+// on exit, the call is not recorded if the rule is disabled at runtime.
+func Record(rule, namespace, function string, start int64, err error) {
+	inst := getInstruments()
+	if inst == nil || !hook.IsEnabled(rule) {
+		return
+	}
+	r := getRecorder(rule, namespace, function)
+	r.record(inst, overhead.Now()-start, err)
+}
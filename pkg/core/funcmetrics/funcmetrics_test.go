@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcmetrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/hook"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/meter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/overhead"
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+func TestStartWithoutMeter(t *testing.T) {
+	assert.Nil(t, Start("test.nometer", "test", "Foo"))
+}
+
+func TestStartRecordsMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	meter.SetMeter(provider.Meter("test"))
+
+	Register("test.metrics", "team", "payment")
+	done := Start("test.metrics", "example.com/foo", "Foo")
+	assert.NotNil(t, done)
+	done(nil)
+	done = Start("test.metrics", "example.com/foo", "Foo")
+	done(errors.New("boom"))
+
+	rm := metricdata.ResourceMetrics{}
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Len(t, rm.ScopeMetrics, 1)
+	found := map[string]bool{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		found[m.Name] = true
+		switch data := m.Data.(type) {
+		case metricdata.Histogram[float64]:
+			assert.Len(t, data.DataPoints, 2)
+			for _, point := range data.DataPoints {
+				assert.Equal(t, uint64(1), point.Count)
+				v, ok := point.Attributes.Value(semconv.CodeNamespaceKey)
+				assert.True(t, ok)
+				assert.Equal(t, "example.com/foo", v.AsString())
+				v, ok = point.Attributes.Value("team")
+				assert.True(t, ok)
+				assert.Equal(t, "payment", v.AsString())
+			}
+		case metricdata.Sum[int64]:
+			assert.Len(t, data.DataPoints, 1)
+			assert.Equal(t, int64(2), data.DataPoints[0].Value)
+			v, ok := data.DataPoints[0].Attributes.Value(semconv.CodeFunctionNameKey)
+			assert.True(t, ok)
+			assert.Equal(t, "Foo", v.AsString())
+		default:
+			t.Fatalf("unexpected metric %s", m.Name)
+		}
+	}
+	assert.True(t, found[func_call_duration])
+	assert.True(t, found[func_calls])
+}
+
+func collect(t *testing.T, reader sdkmetric.Reader) map[string]metricdata.Aggregation {
+	rm := metricdata.ResourceMetrics{}
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	found := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			found[m.Name] = m.Data
+		}
+	}
+	return found
+}
+
+func TestRecordMetricsOnly(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	meter.SetMeter(provider.Meter("test"))
+	instruments.Store(nil)
+
+	// Only the chosen instrument is recorded
+	SetInstruments("test.counter", Counter)
+	Record("test.counter", "example.com/foo", "Bar", overhead.Now(), nil)
+	Record("test.counter", "example.com/foo", "Bar", overhead.Now(), errors.New("boom"))
+	found := collect(t, reader)
+	assert.NotContains(t, found, func_call_duration)
+	sum, ok := found[func_calls].(metricdata.Sum[int64])
+	assert.True(t, ok)
+	assert.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(2), sum.DataPoints[0].Value)
+
+	// Disabled rules are not recorded
+	hook.Disable("test.counter")
+	defer hook.Enable("test.counter")
+	Record("test.counter", "example.com/foo", "Bar", overhead.Now(), nil)
+	sum = collect(t, reader)[func_calls].(metricdata.Sum[int64])
+	assert.Equal(t, int64(2), sum.DataPoints[0].Value)
+}
+
+func TestRecordAllocatesNothing(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	meter.SetMeter(provider.Meter("test"))
+	instruments.Store(nil)
+
+	err := errors.New("boom")
+	Record("test.allocs", "example.com/foo", "Baz", overhead.Now(), nil)
+	Record("test.allocs", "example.com/foo", "Baz", overhead.Now(), err)
+	allocs := testing.AllocsPerRun(100, func() {
+		Record("test.allocs", "example.com/foo", "Baz", overhead.Now(), nil)
+		Record("test.allocs", "example.com/foo", "Baz", overhead.Now(), err)
+	})
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkRecord(b *testing.B) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	meter.SetMeter(provider.Meter("test"))
+	instruments.Store(nil)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Record("test.bench", "example.com/foo", "Qux", overhead.Now(), nil)
+	}
+}
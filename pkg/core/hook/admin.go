@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hook
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// Handler returns the admin endpoint of the registry. GET lists all known hooks
+// along with their enablement, POST switches the hook designated by the "name"
+// query parameter according to the "enabled" query parameter, e.g.
+//
+//	curl -X POST "localhost:9465/hooks?name=redigo.onBeforeDialContext&enabled=false"
+func Handler() http.Handler {
+	return http.HandlerFunc(serveHooks)
+}
+
+func serveHooks(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost, http.MethodPut:
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			http.Error(w, "missing hook name", http.StatusBadRequest)
+			return
+		}
+		enabled, err := strconv.ParseBool(r.URL.Query().Get("enabled"))
+		if err != nil {
+			http.Error(w, "invalid enabled value", http.StatusBadRequest)
+			return
+		}
+		if enabled {
+			Enable(name)
+		} else {
+			Disable(name)
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(List())
+}
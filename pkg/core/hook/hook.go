@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hook maintains the runtime switches of instrumentation hooks. Every
+// generated trampoline holds the switch of its rule, which is resolved once
+// during initialization, and loads it before calling into the hook, so that a
+// misbehaving instrumentation point can be disabled without rebuilding the
+// application.
+package hook
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+)
+
+// Comma-separated rule names whose hooks are disabled at startup
+const disabledHooksEnv = "OTEL_INSTRUMENTATION_DISABLED_HOOKS"
+
+// The hook of one rule is disabled at startup if the environment variable
+// named after the rule is set to false, see EnvName
+const (
+	enabledEnvPrefix = "OTEL_INSTRUMENTATION_"
+	enabledEnvSuffix = "_ENABLED"
+)
+
+// Registry of hook switches, the value is *atomic.Bool indicating whether the
+// hook is disabled. Note that the registry may be queried before this package
+// is initialized, the zero value of sync.Map is ready to use.
+var switches sync.Map
+
+// Whether the environment can be consulted, hooks of package initializers may
+// run before this package, or even the os package, is initialized
+var ready atomic.Bool
+
+func init() {
+	for _, name := range strings.Split(os.Getenv(disabledHooksEnv), ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			Disable(name)
+		}
+	}
+	ready.Store(true)
+}
+
+// EnvName returns the environment variable that switches the hook of the rule,
+// letters and digits of the name are upper-cased and others are replaced by
+// underscores, e.g. OTEL_INSTRUMENTATION_REDIGO_ONBEFOREDIALCONTEXT_ENABLED
+// for rule "redigo.onBeforeDialContext"
+func EnvName(name string) string {
+	var sb strings.Builder
+	sb.WriteString(enabledEnvPrefix)
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(unicode.ToUpper(r))
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	sb.WriteString(enabledEnvSuffix)
+	return sb.String()
+}
+
+func lookup(name string) *atomic.Bool {
+	if flag, ok := switches.Load(name); ok {
+		return flag.(*atomic.Bool)
+	}
+	disabled := new(atomic.Bool)
+	if value, ok := os.LookupEnv(EnvName(name)); ok {
+		if enabled, err := strconv.ParseBool(value); err == nil && !enabled {
+			disabled.Store(true)
+		}
+	}
+	flag, _ := switches.LoadOrStore(name, disabled)
+	return flag.(*atomic.Bool)
+}
+
+// Register makes the hook known to the registry, it's enabled unless it was
+// explicitly disabled before, either by the environment or by Disable
+func Register(name string) {
+	lookup(name)
+}
+
+// Switch returns the switch of the hook of the given rule, it reports whether
+// the hook is disabled. Trampolines resolve it once and only load it on every
+// invocation of the instrumented function, so it must not be replaced
+func Switch(name string) *atomic.Bool {
+	return lookup(name)
+}
+
+// IsEnabled reports whether the hook of the given rule is enabled
+func IsEnabled(name string) bool {
+	flag, ok := switches.Load(name)
+	if !ok {
+		// The environment is consulted once the hook is first seen, hooks are
+		// enabled until it can be
+		if !ready.Load() {
+			return true
+		}
+		return !lookup(name).Load()
+	}
+	return !flag.(*atomic.Bool).Load()
+}
+
+// Enable enables the hook of the given rule
+func Enable(name string) {
+	lookup(name).Store(false)
+}
+
+// Disable disables the hook of the given rule, the instrumented function
+// behaves as if it is not instrumented until the hook is enabled again
+func Disable(name string) {
+	lookup(name).Store(true)
+}
+
+// List returns the names of all known hooks along with their enablement
+func List() map[string]bool {
+	hooks := make(map[string]bool)
+	switches.Range(func(key, value any) bool {
+		hooks[key.(string)] = !value.(*atomic.Bool).Load()
+		return true
+	})
+	return hooks
+}
+
+// Names returns the sorted names of all known hooks
+func Names() []string {
+	names := make([]string, 0)
+	switches.Range(func(key, _ any) bool {
+		names = append(names, key.(string))
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownHookIsEnabled(t *testing.T) {
+	assert.True(t, IsEnabled("test.unknown"))
+}
+
+func TestSwitchHook(t *testing.T) {
+	Register("test.switch")
+	assert.True(t, IsEnabled("test.switch"))
+	Disable("test.switch")
+	assert.False(t, IsEnabled("test.switch"))
+	assert.False(t, List()["test.switch"])
+	Enable("test.switch")
+	assert.True(t, IsEnabled("test.switch"))
+	assert.Contains(t, Names(), "test.switch")
+}
+
+func TestSwitchIsShared(t *testing.T) {
+	flag := Switch("test.shared")
+	assert.Same(t, flag, Switch("test.shared"))
+	assert.False(t, flag.Load())
+	Disable("test.shared")
+	assert.True(t, flag.Load())
+	Enable("test.shared")
+	assert.False(t, flag.Load())
+}
+
+func TestRegisterKeepsDisabled(t *testing.T) {
+	Disable("test.keep")
+	Register("test.keep")
+	assert.False(t, IsEnabled("test.keep"))
+}
+
+func TestEnvName(t *testing.T) {
+	assert.Equal(t, "OTEL_INSTRUMENTATION_REDIGO_ONBEFOREDIALCONTEXT_ENABLED",
+		EnvName("redigo.onBeforeDialContext"))
+	assert.Equal(t, "OTEL_INSTRUMENTATION_NET_HTTP_GET_ENABLED",
+		EnvName("net/http.Get"))
+}
+
+func TestDisableHookByEnv(t *testing.T) {
+	t.Setenv(EnvName("test.env"), "false")
+	t.Setenv(EnvName("test.envtrue"), "true")
+	assert.False(t, IsEnabled("test.env"))
+	assert.True(t, IsEnabled("test.envtrue"))
+	// The environment is evaluated once, the hook can be enabled afterwards
+	Enable("test.env")
+	assert.True(t, IsEnabled("test.env"))
+
+	t.Setenv(EnvName("test.envregister"), "FALSE")
+	Register("test.envregister")
+	assert.False(t, IsEnabled("test.envregister"))
+}
+
+func TestHookBeforeInit(t *testing.T) {
+	t.Setenv(EnvName("test.early"), "false")
+	ready.Store(false)
+	assert.True(t, IsEnabled("test.early"))
+	ready.Store(true)
+	// The hook is not settled until the environment is consulted
+	assert.False(t, IsEnabled("test.early"))
+}
+
+func TestAdminHandler(t *testing.T) {
+	Register("test.admin")
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"?name=test.admin&enabled=false", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	assert.False(t, IsEnabled("test.admin"))
+
+	resp, err = http.Get(server.URL)
+	assert.NoError(t, err)
+	hooks := map[string]bool{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&hooks))
+	resp.Body.Close()
+	enabled, ok := hooks["test.admin"]
+	assert.True(t, ok)
+	assert.False(t, enabled)
+
+	resp, err = http.Post(server.URL+"?name=test.admin&enabled=maybe", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	resp, err = http.Post(server.URL+"?enabled=true", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+}
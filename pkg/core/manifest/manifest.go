@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package manifest holds the manifest of instrumentation compiled into the
+// binary, i.e. which rules are applied to which packages at what versions. It
+// is generated at build time and can be retrieved at runtime by Get, or from
+// the binary file by "otel verify".
+package manifest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Markers that wrap the embedded manifest, they must be kept in sync with the
+// tool, which searches the binary for them
+const (
+	beginMarker = "\x00otel-manifest-begin:"
+	endMarker   = ":otel-manifest-end\x00"
+)
+
+// Manifest lists the rules compiled into the binary
+type Manifest struct {
+	ToolVersion string  `json:"tool"`
+	Entries     []Entry `json:"rules"`
+}
+
+// Entry is one rule applied to one target, i.e. a function, a struct field or
+// a file of the instrumented package
+type Entry struct {
+	Rule    string `json:"rule,omitempty"`
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Target  string `json:"target"`
+	Version string `json:"version,omitempty"`
+}
+
+var (
+	raw    string
+	once   sync.Once
+	parsed *Manifest
+)
+
+// Register is called by the generated code to register the embedded manifest
+func Register(manifest string) {
+	raw = manifest
+}
+
+// Get returns the manifest of the binary, or nil if the binary is not built by
+// the tool
+func Get() *Manifest {
+	once.Do(func() {
+		content := strings.TrimSuffix(strings.TrimPrefix(raw, beginMarker),
+			endMarker)
+		m := &Manifest{}
+		if json.Unmarshal([]byte(content), m) == nil {
+			parsed = m
+		}
+	})
+	return parsed
+}
+
+// Handler returns the admin endpoint that serves the manifest as JSON
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		m := Get()
+		if m == nil {
+			http.Error(w, "no manifest", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(m)
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetManifest(t *testing.T) {
+	Register(beginMarker + `{"tool":"1.0.0","rules":[{"rule":"nethttp.client","kind":"func","package":"net/http","target":"net/http.(*Client).Do"}]}` + endMarker)
+	once, parsed = sync.Once{}, nil
+
+	m := Get()
+	assert.NotNil(t, m)
+	assert.Equal(t, "1.0.0", m.ToolVersion)
+	assert.Equal(t, []Entry{{Rule: "nethttp.client", Kind: "func",
+		Package: "net/http", Target: "net/http.(*Client).Do"}}, m.Entries)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/manifest", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var served Manifest
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+	assert.Equal(t, *m, served)
+}
+
+func TestNoManifest(t *testing.T) {
+	Register("")
+	once, parsed = sync.Once{}, nil
+
+	assert.Nil(t, Get())
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/manifest", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
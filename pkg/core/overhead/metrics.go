@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overhead
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	hook_calls    = "otel.instrumentation.hook.calls"
+	hook_duration = "otel.instrumentation.hook.duration"
+	hook_panics   = "otel.instrumentation.hook.panics"
+	spans_dropped = "otel.instrumentation.spans.dropped"
+)
+
+const rule_key = attribute.Key("otel.instrumentation.rule")
+
+// InitMetrics exports the overhead of all rules as observable counters of the
+// given meter, they are collected whenever the meter provider is read
+func InitMetrics(m metric.Meter) error {
+	calls, err := m.Int64ObservableCounter(hook_calls,
+		metric.WithUnit("{call}"),
+		metric.WithDescription("Number of invocations of instrumentation hooks."))
+	if err != nil {
+		return err
+	}
+	duration, err := m.Float64ObservableCounter(hook_duration,
+		metric.WithUnit("s"),
+		metric.WithDescription("Time spent in instrumentation hooks."))
+	if err != nil {
+		return err
+	}
+	panics, err := m.Int64ObservableCounter(hook_panics,
+		metric.WithUnit("{call}"),
+		metric.WithDescription("Number of instrumentation hooks that panicked."))
+	if err != nil {
+		return err
+	}
+	dropped, err := m.Int64ObservableCounter(spans_dropped,
+		metric.WithUnit("{span}"),
+		metric.WithDescription("Number of spans started by instrumentations that were not recorded."))
+	if err != nil {
+		return err
+	}
+	_, err = m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for rule, s := range List() {
+			attrs := metric.WithAttributes(rule_key.String(rule))
+			o.ObserveInt64(calls, s.Calls, attrs)
+			o.ObserveFloat64(duration, float64(s.Nanos)/1e9, attrs)
+			o.ObserveInt64(panics, s.Panics, attrs)
+			o.ObserveInt64(dropped, s.Dropped, attrs)
+		}
+		return nil
+	}, calls, duration, panics, dropped)
+	return err
+}
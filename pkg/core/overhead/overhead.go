@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package overhead accounts the overhead of instrumentation per rule. Every
+// generated trampoline records the invocations of its hooks, the time spent in
+// them and the hook invocations that panicked, and every instrumenter records
+// the spans it started that were dropped, so that observed latency regressions
+// can be attributed to specific instrumentations.
+//
+// Accounting is disabled by default, the trampolines then only load an atomic
+// flag per hook invocation and read no clock.
+package overhead
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const overhead_enabled = "OTEL_INSTRUMENTATION_OVERHEAD_ENABLED"
+
+// Stats is the accumulated overhead of the hooks of one rule, or of the spans
+// of one instrumentation scope
+type Stats struct {
+	// Number of invocations of the instrumented function that called the hooks
+	Calls int64 `json:"calls"`
+	// Cumulative time spent in the hooks, in nanoseconds
+	Nanos int64 `json:"nanos"`
+	// Number of hook invocations that panicked, their telemetry is lost
+	Panics int64 `json:"panics"`
+	// Number of spans started by the instrumentation scope that were not
+	// recorded, e.g. sampled out, they are accounted to the scope rather than
+	// the rule
+	Dropped int64 `json:"dropped"`
+}
+
+// Counters is the overhead of one rule or instrumentation scope. They are
+// resolved once, e.g. by the trampolines during initialization, rather than
+// looked up by name on every invocation.
+type Counters struct {
+	calls   atomic.Int64
+	nanos   atomic.Int64
+	panics  atomic.Int64
+	dropped atomic.Int64
+}
+
+// Counters of rules and scopes, the value is *Counters. Like the hook registry,
+// the zero value is ready to use before this package is initialized.
+var rules sync.Map
+
+// Whether the overhead is accounted, see Enable
+var enabled atomic.Bool
+
+// The reference point of Now, time.Since reads the monotonic clock
+var epoch = time.Now()
+
+func init() {
+	if os.Getenv(overhead_enabled) == "true" {
+		Enable()
+	}
+}
+
+// Enable starts accounting the overhead, e.g. once it is exported
+func Enable() {
+	enabled.Store(true)
+}
+
+// Enabled returns whether the overhead is accounted
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Counter returns the counters of the given rule or instrumentation scope
+func Counter(name string) *Counters {
+	if c, ok := rules.Load(name); ok {
+		return c.(*Counters)
+	}
+	c, _ := rules.LoadOrStore(name, new(Counters))
+	return c.(*Counters)
+}
+
+// Now returns a monotonic timestamp in nanoseconds
+func Now() int64 {
+	return int64(time.Since(epoch))
+}
+
+// Start returns the timestamp the trampoline passes to Record after calling
+// the hook, it's zero if the overhead is not accounted
+func (c *Counters) Start() int64 {
+	if !enabled.Load() {
+		return 0
+	}
+	return Now()
+}
+
+// Record accounts the time elapsed since start to the hooks of the rule, the
+// invocation is counted as well if call is true. A rule with both onEnter and
+// onExit hooks records twice per invocation but counts only once.
+func (c *Counters) Record(start int64, call bool) {
+	if start == 0 {
+		return
+	}
+	c.nanos.Add(Now() - start)
+	if call {
+		c.calls.Add(1)
+	}
+}
+
+// Drop accounts a span of the instrumentation scope that was not recorded
+func (c *Counters) Drop() {
+	if enabled.Load() {
+		c.dropped.Add(1)
+	}
+}
+
+// Panic accounts a hook invocation of the given rule that panicked, it's rare
+// enough to look up the rule by name
+func Panic(rule string) {
+	if enabled.Load() {
+		Counter(rule).panics.Add(1)
+	}
+}
+
+// Get returns the overhead of the given rule
+func Get(rule string) Stats {
+	c, ok := rules.Load(rule)
+	if !ok {
+		return Stats{}
+	}
+	return c.(*Counters).stats()
+}
+
+func (c *Counters) stats() Stats {
+	return Stats{
+		Calls:   c.calls.Load(),
+		Nanos:   c.nanos.Load(),
+		Panics:  c.panics.Load(),
+		Dropped: c.dropped.Load(),
+	}
+}
+
+// List returns the overhead of all rules and instrumentation scopes that have
+// been resolved
+func List() map[string]Stats {
+	list := make(map[string]Stats)
+	rules.Range(func(key, value any) bool {
+		list[key.(string)] = value.(*Counters).stats()
+		return true
+	})
+	return list
+}
+
+// Dump writes the overhead of all rules and scopes to w as a table, they are
+// sorted by the time spent in their hooks in descending order
+func Dump(w io.Writer) {
+	list := List()
+	names := make([]string, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if list[names[i]].Nanos != list[names[j]].Nanos {
+			return list[names[i]].Nanos > list[names[j]].Nanos
+		}
+		return names[i] < names[j]
+	})
+	fmt.Fprintf(w, "%-48s %12s %14s %12s %8s %8s\n",
+		"RULE", "CALLS", "TOTAL", "AVG", "PANICS", "DROPPED")
+	for _, name := range names {
+		s := list[name]
+		avg := time.Duration(0)
+		if s.Calls > 0 {
+			avg = time.Duration(s.Nanos / s.Calls)
+		}
+		fmt.Fprintf(w, "%-48s %12d %14s %12s %8d %8d\n",
+			name, s.Calls, time.Duration(s.Nanos), avg, s.Panics, s.Dropped)
+	}
+}
+
+// Handler returns the admin endpoint that serves the overhead of all rules as
+// JSON, e.g.
+//
+//	curl "localhost:9465/overhead"
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(List())
+	})
+}
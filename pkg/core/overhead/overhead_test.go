@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overhead
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMain(m *testing.M) {
+	Enable()
+	os.Exit(m.Run())
+}
+
+func TestRecord(t *testing.T) {
+	c := Counter("test.record")
+	assert.Same(t, c, Counter("test.record"))
+	c.Record(c.Start(), true)
+	// The onExit hook of the same invocation is not counted again
+	c.Record(c.Start(), false)
+	Panic("test.record")
+	c.Drop()
+	s := Get("test.record")
+	assert.Equal(t, int64(1), s.Calls)
+	assert.Equal(t, int64(1), s.Panics)
+	assert.Equal(t, int64(1), s.Dropped)
+	assert.GreaterOrEqual(t, s.Nanos, int64(0))
+	assert.Equal(t, Stats{}, Get("test.unknown"))
+}
+
+func TestRecordDisabled(t *testing.T) {
+	enabled.Store(false)
+	defer Enable()
+	c := Counter("test.disabled")
+	start := c.Start()
+	assert.Equal(t, int64(0), start)
+	c.Record(start, true)
+	Panic("test.disabled")
+	c.Drop()
+	assert.Equal(t, Stats{}, Get("test.disabled"))
+}
+
+func TestDump(t *testing.T) {
+	c := Counter("test.dump")
+	c.Record(c.Start(), true)
+	var sb strings.Builder
+	Dump(&sb)
+	assert.Contains(t, sb.String(), "RULE")
+	assert.Contains(t, sb.String(), "test.dump")
+}
+
+func TestAdminHandler(t *testing.T) {
+	c := Counter("test.admin")
+	c.Record(c.Start(), true)
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	list := map[string]Stats{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+	resp.Body.Close()
+	assert.Equal(t, int64(1), list["test.admin"].Calls)
+
+	resp, err = http.Post(server.URL, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestInitMetrics(t *testing.T) {
+	c := Counter("test.metrics")
+	c.Record(c.Start(), true)
+	reader := metric.NewManualReader()
+	provider := metric.NewMeterProvider(metric.WithReader(reader))
+	assert.NoError(t, InitMetrics(provider.Meter("test")))
+
+	rm := metricdata.ResourceMetrics{}
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	found := false
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != hook_calls {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				if v, ok := dp.Attributes.Value(rule_key); ok &&
+					v.AsString() == "test.metrics" {
+					found = dp.Value == 1
+				}
+			}
+		}
+	}
+	assert.True(t, found)
+}
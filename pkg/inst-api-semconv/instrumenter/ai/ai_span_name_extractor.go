@@ -14,6 +14,8 @@
 
 package ai
 
+// AISpanNameExtractor names the span "{gen_ai.operation.name}", or "unknown"
+// if the operation is not available.
 type AISpanNameExtractor[REQUEST any, RESPONSE any] struct {
 	Getter CommonAttrsGetter[REQUEST, RESPONSE]
 }
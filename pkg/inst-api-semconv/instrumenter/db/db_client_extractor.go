@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
@@ -126,5 +127,16 @@ func (d *DbClientAttrsExtractor[REQUEST, RESPONSE, GETTER]) GetSpanKey() attribu
 	return utils.DB_CLIENT_KEY
 }
 
-// TODO: sanitize sql
+// statementLiteralRegexp matches quoted strings, with quotes escaped by
+// doubling them or by a backslash, and numbers that are not part of a name.
+var statementLiteralRegexp = regexp.MustCompile(`'(?:[^'\\]|\\.|'')*'|"(?:[^"\\]|\\.|"")*"|(^|[^\w$])\d+(?:\.\d+)?\b`)
+
+// SanitizeStatement replaces the string and numeric literals of a statement
+// with "?", so that the values embedded in it are not recorded as db.query.text.
+// Double-quoted text is replaced as well, since MySQL and Cypher use it for
+// strings, while bind parameters such as ? and $name are kept as they are.
+func SanitizeStatement(statement string) string {
+	return statementLiteralRegexp.ReplaceAllString(statement, "${1}?")
+}
+
 // TODO: batch sql
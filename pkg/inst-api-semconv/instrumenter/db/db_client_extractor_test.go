@@ -134,3 +134,17 @@ func TestDbClientExtractorWithFilter(t *testing.T) {
 		panic("attribute should be test")
 	}
 }
+
+func TestSanitizeStatement(t *testing.T) {
+	for statement, expected := range map[string]string{
+		"SELECT * FROM users WHERE id = 1":                             "SELECT * FROM users WHERE id = ?",
+		"SELECT * FROM users WHERE name = 'it''s' AND age > 2.5":       "SELECT * FROM users WHERE name = ? AND age > ?",
+		"INSERT INTO t2 (a, b) VALUES ('x\\'y', '')":                   "INSERT INTO t2 (a, b) VALUES (?, ?)",
+		"MATCH (p:Person {name: \"Tom\"}) WHERE p.age = $age RETURN p": "MATCH (p:Person {name: ?}) WHERE p.age = $age RETURN p",
+		"SELECT * FROM users WHERE id = ? AND v2 = $1":                 "SELECT * FROM users WHERE id = ? AND v2 = $1",
+	} {
+		if actual := SanitizeStatement(statement); actual != expected {
+			t.Fatalf("expected %q to be sanitized to %q, got %q", statement, expected, actual)
+		}
+	}
+}
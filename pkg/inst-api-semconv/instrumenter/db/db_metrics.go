@@ -29,6 +29,8 @@ import (
 
 const db_client_request_duration = "db.client.request.duration"
 
+const db_client_connection_wait_time = "db.client.connection.wait_time"
+
 type DbClientMetric struct {
 	key                   attribute.Key
 	clientRequestDuration metric.Float64Histogram
@@ -45,6 +47,8 @@ var dbMetricsConv = map[attribute.Key]bool{
 
 var globalMeter metric.Meter
 
+var clientConnectionWaitTime metric.Float64Histogram
+
 // InitDbMetrics so we need to make sure the otel_setup is executed before all the init() function
 // related to issue Dbs://github.com/alibaba/opentelemetry-go-auto-instrumentation/issues/48
 func InitDbMetrics(m metric.Meter) {
@@ -126,3 +130,37 @@ func (h DbClientMetric) OnAfterEnd(context context.Context, endAttributes []attr
 		h.clientRequestDuration.Record(context, float64(endTime.Sub(startTime).Milliseconds()), metric.WithAttributeSet(attribute.NewSet(metricsAttrs[0:n]...)))
 	}
 }
+
+func newDbClientConnectionWaitTimeMeasures(meter metric.Meter) (metric.Float64Histogram, error) {
+	if meter == nil {
+		return nil, errors.New("nil meter")
+	}
+	d, err := meter.Float64Histogram(db_client_connection_wait_time,
+		metric.WithUnit("s"),
+		metric.WithDescription("The time it took to obtain an open connection from the pool."))
+	if err != nil {
+		return d, errors.New(fmt.Sprintf("failed to create db.client.connection.wait_time histogram, %v", err))
+	}
+	return d, nil
+}
+
+// RecordDbClientConnectionWaitTime records how long a client waited for a
+// connection of the pool, which is usually named after the server address.
+func RecordDbClientConnectionWaitTime(ctx context.Context, system, poolName string, wait time.Duration) {
+	mu.Lock()
+	if clientConnectionWaitTime == nil && globalMeter != nil {
+		var err error
+		clientConnectionWaitTime, err = newDbClientConnectionWaitTimeMeasures(globalMeter)
+		if err != nil {
+			log.Printf("failed to create clientConnectionWaitTime, err is %v\n", err)
+		}
+	}
+	histogram := clientConnectionWaitTime
+	mu.Unlock()
+	if histogram == nil {
+		return
+	}
+	histogram.Record(ctx, wait.Seconds(), metric.WithAttributes(
+		semconv.DBSystemNameKey.String(system),
+		semconv.DBClientConnectionPoolName(poolName)))
+}
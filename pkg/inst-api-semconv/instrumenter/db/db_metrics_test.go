@@ -137,3 +137,22 @@ func TestNilMeter(t *testing.T) {
 		panic(err)
 	}
 }
+
+func TestDbClientConnectionWaitTime(t *testing.T) {
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	InitDbMetrics(mp.Meter("test-meter"))
+	clientConnectionWaitTime = nil
+	ctx := context.Background()
+	RecordDbClientConnectionWaitTime(ctx, "mongodb", "127.0.0.1:27017", 5*time.Millisecond)
+	rm := &metricdata.ResourceMetrics{}
+	reader.Collect(ctx, rm)
+	if rm.ScopeMetrics[0].Metrics[0].Name != "db.client.connection.wait_time" {
+		panic("wrong metrics name, " + rm.ScopeMetrics[0].Metrics[0].Name)
+	}
+	histogram := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+	poolName, _ := histogram.DataPoints[0].Attributes.Value(semconv.DBClientConnectionPoolNameKey)
+	if poolName.AsString() != "127.0.0.1:27017" {
+		panic("wrong pool name, " + poolName.AsString())
+	}
+}
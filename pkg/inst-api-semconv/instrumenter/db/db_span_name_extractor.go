@@ -14,6 +14,8 @@
 
 package db
 
+// DBSpanNameExtractor names the span "{db.operation.name} {db.collection.name}",
+// falling back to "{db.operation.name}", "{db.system.name}" and "DB".
 type DBSpanNameExtractor[REQUEST any] struct {
 	Getter DbClientAttrsGetter[REQUEST]
 }
@@ -136,7 +136,10 @@ func (h *HttpServerAttrsExtractor[REQUEST, RESPONSE, GETTER1, GETTER2, GETTER3])
 	if ok && span.IsRecording() {
 		route := h.Base.HttpGetter.GetHttpRoute(request)
 		if !strings.Contains(localRootSpan.Name(), route) {
-			route = localRootSpan.Name()
+			// routers rename the span to the route, optionally prefixed
+			// with the method
+			method := h.Base.HttpGetter.GetRequestMethod(request)
+			route = strings.TrimPrefix(localRootSpan.Name(), method+" ")
 		}
 		attributes = append(attributes, attribute.KeyValue{
 			Key:   semconv.HTTPRouteKey,
@@ -347,6 +347,24 @@ func TestHttpServerExtractorEnd(t *testing.T) {
 	}
 }
 
+func TestHttpServerExtractorRouteFromSpanName(t *testing.T) {
+	httpServerExtractor := HttpServerAttrsExtractor[testRequest, testResponse, httpServerAttrsGetter, networkAttrsGetter, urlAttrsGetter]{
+		Base:             HttpCommonAttrsExtractor[testRequest, testResponse, httpServerAttrsGetter, networkAttrsGetter]{},
+		NetworkExtractor: net.NetworkAttrsExtractor[testRequest, testResponse, networkAttrsGetter]{},
+		UrlExtractor:     net.UrlAttrsExtractor[testRequest, testResponse, urlAttrsGetter]{},
+	}
+	for name, route := range map[string]string{
+		"GET /users/{id}": "/users/{id}",
+		"/users/{id}":     "/users/{id}",
+	} {
+		ctx := trace.ContextWithSpan(context.Background(), &testReadOnlySpan{isRecording: true, name: name})
+		attrs, _ := httpServerExtractor.OnEnd(nil, ctx, testRequest{}, testResponse{}, nil)
+		if attrs[12].Key != semconv.HTTPRouteKey || attrs[12].Value.AsString() != route {
+			t.Fatalf("httproute of span %q should be %s, got %s", name, route, attrs[12].Value.AsString())
+		}
+	}
+}
+
 func TestHttpServerExtractorWithFilter(t *testing.T) {
 	httpServerExtractor := HttpServerAttrsExtractor[testRequest, testResponse, httpServerAttrsGetter, networkAttrsGetter, urlAttrsGetter]{
 		Base:             HttpCommonAttrsExtractor[testRequest, testResponse, httpServerAttrsGetter, networkAttrsGetter]{},
@@ -14,6 +14,8 @@
 
 package http
 
+// HttpClientSpanNameExtractor names the span "{http.request.method}", or
+// "HTTP" if the method is not available.
 type HttpClientSpanNameExtractor[REQUEST any, RESPONSE any] struct {
 	Getter HttpClientAttrsGetter[REQUEST, RESPONSE]
 }
@@ -26,6 +28,9 @@ func (h *HttpClientSpanNameExtractor[REQUEST, RESPONSE]) Extract(request REQUEST
 	return method
 }
 
+// HttpServerSpanNameExtractor names the span
+// "{http.request.method} {http.route}", falling back to "{http.request.method}"
+// and "HTTP".
 type HttpServerSpanNameExtractor[REQUEST any, RESPONSE any] struct {
 	Getter HttpServerAttrsGetter[REQUEST, RESPONSE]
 }
@@ -39,9 +39,13 @@ func (ts *testSpan) SetAttributes(kv ...attribute.KeyValue) {
 type testReadOnlySpan struct {
 	sdktrace.ReadWriteSpan
 	isRecording bool
+	name        string
 }
 
 func (t *testReadOnlySpan) Name() string {
+	if t.name != "" {
+		return t.name
+	}
 	return "http-route"
 }
 
@@ -16,6 +16,9 @@ package message
 
 const temp_destination_name = "(temporary)"
 
+// MessageSpanNameExtractor names the span
+// "{messaging.destination.name} {messaging.operation.type}", the destination
+// is "(temporary)" for temporary ones and "unknown" if not available.
 type MessageSpanNameExtractor[REQUEST any, RESPONSE any] struct {
 	Getter        MessageAttrsGetter[REQUEST, RESPONSE]
 	OperationName MessageOperation
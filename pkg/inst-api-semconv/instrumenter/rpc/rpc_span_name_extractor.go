@@ -14,6 +14,8 @@
 
 package rpc
 
+// RpcSpanNameExtractor names the span "{rpc.service}/{rpc.method}", or
+// "RPC request" if either of them is not available.
 type RpcSpanNameExtractor[REQUEST any] struct {
 	Getter RpcAttrsGetter[REQUEST]
 }
@@ -16,6 +16,7 @@ package instrumenter
 
 import (
 	"context"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/overhead"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -44,6 +45,7 @@ type InternalInstrumenter[REQUEST any, RESPONSE any] struct {
 	spanSuppressor       SpanSuppressor
 	tracer               trace.Tracer
 	instVersion          string
+	overhead             *overhead.Counters
 }
 
 type PropagatingToDownstreamInstrumenter[REQUEST any, RESPONSE any] struct {
@@ -106,6 +108,9 @@ func (i *InternalInstrumenter[REQUEST, RESPONSE]) doStart(parentContext context.
 	spanKind := i.spanKindExtractor.Extract(request)
 	options = append(options, trace.WithSpanKind(spanKind), trace.WithTimestamp(timestamp))
 	newCtx, span := i.tracer.Start(parentContext, spanName, options...)
+	if i.overhead != nil && !span.IsRecording() {
+		i.overhead.Drop()
+	}
 	attrs := make([]attribute.KeyValue, 0, 20)
 	// extract span attrs
 	for _, extractor := range i.attributesExtractors {
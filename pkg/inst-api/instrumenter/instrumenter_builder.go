@@ -15,6 +15,7 @@
 package instrumenter
 
 import (
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/overhead"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
@@ -116,6 +117,7 @@ func (b *Builder[REQUEST, RESPONSE]) BuildInstrumenter() *InternalInstrumenter[R
 		spanSuppressor:       b.buildSpanSuppressor(),
 		tracer:               tracer,
 		instVersion:          b.InstVersion,
+		overhead:             b.buildOverhead(),
 	}
 }
 
@@ -131,6 +133,7 @@ func (b *Builder[REQUEST, RESPONSE]) BuildInstrumenterWithTracer(tracer trace.Tr
 		spanSuppressor:       b.buildSpanSuppressor(),
 		tracer:               tracer,
 		instVersion:          b.InstVersion,
+		overhead:             b.buildOverhead(),
 	}
 }
 
@@ -151,6 +154,7 @@ func (b *Builder[REQUEST, RESPONSE]) BuildPropagatingToDownstreamInstrumenter(ca
 			spanSuppressor:       b.buildSpanSuppressor(),
 			tracer:               tracer,
 			instVersion:          b.InstVersion,
+			overhead:             b.buildOverhead(),
 		},
 		carrierGetter: carrierGetter,
 		prop:          prop,
@@ -173,12 +177,22 @@ func (b *Builder[REQUEST, RESPONSE]) BuildPropagatingFromUpstreamInstrumenter(ca
 			spanSuppressor:       b.buildSpanSuppressor(),
 			tracer:               tracer,
 			instVersion:          b.InstVersion,
+			overhead:             b.buildOverhead(),
 		},
 		carrierGetter: carrierGetter,
 		prop:          prop,
 	}
 }
 
+// buildOverhead resolves the counters of the instrumentation scope, which
+// account the spans that were not recorded
+func (b *Builder[REQUEST, RESPONSE]) buildOverhead() *overhead.Counters {
+	if b.Scope.Name == "" {
+		return nil
+	}
+	return overhead.Counter(b.Scope.Name)
+}
+
 func (b *Builder[REQUEST, RESPONSE]) buildSpanSuppressor() SpanSuppressor {
 	spanSuppressorStrategy := getSpanSuppressionStrategyFromEnv()
 	kvs := make(map[attribute.Key]bool)
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/overhead"
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
@@ -291,6 +292,22 @@ func TestInstrumentationScope(t *testing.T) {
 	}
 }
 
+func TestDroppedSpans(t *testing.T) {
+	overhead.Enable()
+	builder := Builder[testRequest, testResponse]{}
+	builder.Init().SetSpanNameExtractor(testNameExtractor{}).
+		SetSpanKindExtractor(&AlwaysClientExtractor[testRequest]{}).
+		SetInstrumentationScope(instrumentation.Scope{Name: "test.dropped"})
+	originalTP := otel.GetTracerProvider()
+	traceProvider := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.NeverSample()))
+	otel.SetTracerProvider(traceProvider)
+	defer otel.SetTracerProvider(originalTP)
+	instrumenter := builder.BuildInstrumenter()
+	ctx := instrumenter.Start(context.Background(), testRequest{})
+	instrumenter.End(ctx, testRequest{}, testResponse{}, nil)
+	assert.Equal(t, int64(1), overhead.Get("test.dropped").Dropped)
+}
+
 func TestSpanTimestamps(t *testing.T) {
 	// The `startTime` and `endTime` of the generated span
 	// must exactly match those in the input params of inst-api entry func.
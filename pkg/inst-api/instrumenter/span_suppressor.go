@@ -43,13 +43,14 @@ var scopeKey = map[string]attribute.Key{
 	utils.TRPCGO_SERVER_SCOPE_NAME: utils.RPC_SERVER_KEY,
 
 	// database
-	utils.DATABASE_SQL_SCOPE_NAME: utils.DB_CLIENT_KEY,
-	utils.GO_REDIS_V9_SCOPE_NAME:  utils.DB_CLIENT_KEY,
-	utils.GO_REDIS_V8_SCOPE_NAME:  utils.DB_CLIENT_KEY,
-	utils.REDIGO_SCOPE_NAME:       utils.DB_CLIENT_KEY,
-	utils.MONGO_SCOPE_NAME:        utils.DB_CLIENT_KEY,
-	utils.GORM_SCOPE_NAME:         utils.DB_CLIENT_KEY,
-	utils.GOPG_SCOPE_NAME:         utils.DB_CLIENT_KEY,
+	utils.DATABASE_SQL_SCOPE_NAME:        utils.DB_CLIENT_KEY,
+	utils.DATABASE_SQL_DRIVER_SCOPE_NAME: utils.DB_CLIENT_KEY,
+	utils.GO_REDIS_V9_SCOPE_NAME:         utils.DB_CLIENT_KEY,
+	utils.GO_REDIS_V8_SCOPE_NAME:         utils.DB_CLIENT_KEY,
+	utils.REDIGO_SCOPE_NAME:              utils.DB_CLIENT_KEY,
+	utils.MONGO_SCOPE_NAME:               utils.DB_CLIENT_KEY,
+	utils.GORM_SCOPE_NAME:                utils.DB_CLIENT_KEY,
+	utils.GOPG_SCOPE_NAME:                utils.DB_CLIENT_KEY,
 }
 
 var kindKey = map[string]trace.SpanKind{
@@ -75,13 +76,14 @@ var kindKey = map[string]trace.SpanKind{
 	utils.KITEX_SERVER_SCOPE_NAME: trace.SpanKindServer,
 
 	// database
-	utils.DATABASE_SQL_SCOPE_NAME: trace.SpanKindClient,
-	utils.GO_REDIS_V9_SCOPE_NAME:  trace.SpanKindClient,
-	utils.GO_REDIS_V8_SCOPE_NAME:  trace.SpanKindClient,
-	utils.REDIGO_SCOPE_NAME:       trace.SpanKindClient,
-	utils.MONGO_SCOPE_NAME:        trace.SpanKindClient,
-	utils.GORM_SCOPE_NAME:         trace.SpanKindClient,
-	utils.GOPG_SCOPE_NAME:         trace.SpanKindClient,
+	utils.DATABASE_SQL_SCOPE_NAME:        trace.SpanKindClient,
+	utils.DATABASE_SQL_DRIVER_SCOPE_NAME: trace.SpanKindClient,
+	utils.GO_REDIS_V9_SCOPE_NAME:         trace.SpanKindClient,
+	utils.GO_REDIS_V8_SCOPE_NAME:         trace.SpanKindClient,
+	utils.REDIGO_SCOPE_NAME:              trace.SpanKindClient,
+	utils.MONGO_SCOPE_NAME:               trace.SpanKindClient,
+	utils.GORM_SCOPE_NAME:                trace.SpanKindClient,
+	utils.GOPG_SCOPE_NAME:                trace.SpanKindClient,
 }
 
 type SpanSuppressor interface {
@@ -15,6 +15,7 @@
 package utils
 
 const DATABASE_SQL_SCOPE_NAME = "pkg/rules/databasesql/setup.go"
+const DATABASE_SQL_DRIVER_SCOPE_NAME = "pkg/rules/databasesql/driver_setup.go"
 const DUBBO_CLIENT_SCOPE_NAME = "pkg/rules/dubbo/dubbo_client_setup.go"
 const DUBBO_SERVER_SCOPE_NAME = "pkg/rules/dubbo/dubbo_server_setup.go"
 const FAST_HTTP_CLIENT_SCOPE_NAME = "pkg/rules/fasthttp/fasthttp_client_setup.go"
@@ -46,3 +47,77 @@ const MCP_SCOPE_NAME = "pkg/rules/mcp/setup.go"
 const KAFKAGO_PRODUCER_SCOPE_NAME = "pkg/rules/segmentio-kafka-go/kafka_producer_setup.go"
 const KAFKAGO_CONSUMER_SCOPE_NAME = "pkg/rules/segmentio-kafka-go/kafka_consumer_setup.go"
 const GOPG_SCOPE_NAME = "pkg/rules/gopg/setup.go"
+const GO_NET_SCOPE_NAME = "pkg/rules/gonet/setup.go"
+const GO_OS_SCOPE_NAME = "pkg/rules/goos/setup.go"
+const GO_TLS_SCOPE_NAME = "pkg/rules/gotls/setup.go"
+const GO_JSON_SCOPE_NAME = "pkg/rules/gojson/setup.go"
+const ANNOTATION_SCOPE_NAME = "pkg/rules/annotation/setup.go"
+const BEEGO_ORM_SCOPE_NAME = "pkg/rules/beego/beego_orm_setup.go"
+const GOFRAME_GDB_SCOPE_NAME = "pkg/rules/goframe/goframe_gdb_setup.go"
+const GORILLA_WEBSOCKET_SCOPE_NAME = "pkg/rules/gorilla-websocket/websocket_setup.go"
+const CODER_WEBSOCKET_SCOPE_NAME = "pkg/rules/coder-websocket/websocket_setup.go"
+const CONNECT_CLIENT_SCOPE_NAME = "pkg/rules/connect/connect_client_setup.go"
+const CONNECT_SERVER_SCOPE_NAME = "pkg/rules/connect/connect_server_setup.go"
+const TWIRP_CLIENT_SCOPE_NAME = "pkg/rules/twirp/twirp_client_setup.go"
+const TWIRP_SERVER_SCOPE_NAME = "pkg/rules/twirp/twirp_server_setup.go"
+const GQLGEN_SCOPE_NAME = "pkg/rules/gqlgen/gqlgen_setup.go"
+const GRAPHQL_GO_SCOPE_NAME = "pkg/rules/graphql-go/graphql_setup.go"
+const GORM_TRANSACTION_SCOPE_NAME = "pkg/rules/gorm/gorm_transaction_setup.go"
+const GORM_PRELOAD_SCOPE_NAME = "pkg/rules/gorm/gorm_preload_setup.go"
+const SQLX_SCOPE_NAME = "pkg/rules/sqlx/setup.go"
+const ENT_SCOPE_NAME = "pkg/rules/ent/setup.go"
+const ENT_TRANSACTION_SCOPE_NAME = "pkg/rules/ent/ent_transaction_setup.go"
+const PGX_SCOPE_NAME = "pkg/rules/pgx/setup.go"
+const CLICKHOUSE_SCOPE_NAME = "pkg/rules/clickhouse/setup.go"
+const GOCQL_SCOPE_NAME = "pkg/rules/gocql/setup.go"
+const OPENSEARCH_SCOPE_NAME = "pkg/rules/opensearch/os_client_setup.go"
+const RUEIDIS_SCOPE_NAME = "pkg/rules/rueidis/setup.go"
+const GOMEMCACHE_SCOPE_NAME = "pkg/rules/gomemcache/setup.go"
+const ETCD_SCOPE_NAME = "pkg/rules/etcd/setup.go"
+const SARAMA_PRODUCER_SCOPE_NAME = "pkg/rules/sarama/sarama_producer_setup.go"
+const SARAMA_CONSUMER_SCOPE_NAME = "pkg/rules/sarama/sarama_consumer_setup.go"
+const CONFLUENT_KAFKA_PRODUCER_SCOPE_NAME = "pkg/rules/confluent-kafka-go/confluent_producer_setup.go"
+const CONFLUENT_KAFKA_CONSUMER_SCOPE_NAME = "pkg/rules/confluent-kafka-go/confluent_consumer_setup.go"
+const FRANZ_KGO_PRODUCER_SCOPE_NAME = "pkg/rules/franz-go/franz_producer_setup.go"
+const FRANZ_KGO_CONSUMER_SCOPE_NAME = "pkg/rules/franz-go/franz_consumer_setup.go"
+const NATS_PRODUCER_SCOPE_NAME = "pkg/rules/nats/nats_producer_setup.go"
+const NATS_CONSUMER_SCOPE_NAME = "pkg/rules/nats/nats_consumer_setup.go"
+const NATS_CLIENT_SCOPE_NAME = "pkg/rules/nats/nats_client_setup.go"
+const NATS_SERVER_SCOPE_NAME = "pkg/rules/nats/nats_server_setup.go"
+const PULSAR_PRODUCER_SCOPE_NAME = "pkg/rules/pulsar/pulsar_producer_setup.go"
+const PULSAR_CONSUMER_SCOPE_NAME = "pkg/rules/pulsar/pulsar_consumer_setup.go"
+const ROCKETMQ_PRODUCER_SCOPE_NAME = "pkg/rules/rocketmq/rocketmq_producer_setup.go"
+const ROCKETMQ_CONSUMER_SCOPE_NAME = "pkg/rules/rocketmq/rocketmq_consumer_setup.go"
+const ASYNQ_PRODUCER_SCOPE_NAME = "pkg/rules/asynq/asynq_producer_setup.go"
+const ASYNQ_CONSUMER_SCOPE_NAME = "pkg/rules/asynq/asynq_consumer_setup.go"
+const MACHINERY_PRODUCER_SCOPE_NAME = "pkg/rules/machinery/machinery_producer_setup.go"
+const MACHINERY_CONSUMER_SCOPE_NAME = "pkg/rules/machinery/machinery_consumer_setup.go"
+const MACHINERY_V2_PRODUCER_SCOPE_NAME = "pkg/rules/machineryv2/machinery_v2_producer_setup.go"
+const MACHINERY_V2_CONSUMER_SCOPE_NAME = "pkg/rules/machineryv2/machinery_v2_consumer_setup.go"
+const TEMPORAL_SCOPE_NAME = "pkg/rules/temporal/temporal_client_setup.go"
+const AWS_SDK_SCOPE_NAME = "pkg/rules/awssdk/aws_sdk_setup.go"
+const GCP_PUBSUB_PRODUCER_SCOPE_NAME = "pkg/rules/gcppubsub/gcp_pubsub_producer_setup.go"
+const GCP_PUBSUB_CONSUMER_SCOPE_NAME = "pkg/rules/gcppubsub/gcp_pubsub_consumer_setup.go"
+const SPANNER_SCOPE_NAME = "pkg/rules/spanner/spanner_setup.go"
+const AZURE_SCOPE_NAME = "pkg/rules/azure/azure_setup.go"
+const AZURE_SERVICEBUS_PRODUCER_SCOPE_NAME = "pkg/rules/azservicebus/azservicebus_producer_setup.go"
+const AZURE_SERVICEBUS_CONSUMER_SCOPE_NAME = "pkg/rules/azservicebus/azservicebus_consumer_setup.go"
+const ALIYUN_OSS_SCOPE_NAME = "pkg/rules/aliyun-oss/oss_setup.go"
+const TABLESTORE_SCOPE_NAME = "pkg/rules/tablestore/tablestore_setup.go"
+const GOKIT_SCOPE_NAME = "pkg/rules/gokit/gokit_setup.go"
+const NET_RPC_CLIENT_SCOPE_NAME = "pkg/rules/netrpc/netrpc_client_setup.go"
+const NET_RPC_SERVER_SCOPE_NAME = "pkg/rules/netrpc/netrpc_server_setup.go"
+const GO_EXEC_SCOPE_NAME = "pkg/rules/goexec/goexec_setup.go"
+const RETRYABLE_HTTP_SCOPE_NAME = "pkg/rules/retryablehttp/retryablehttp_setup.go"
+const RESTY_SCOPE_NAME = "pkg/rules/resty/resty_setup.go"
+const ROBFIG_CRON_SCOPE_NAME = "pkg/rules/robfigcron/cron_setup.go"
+const GOCRON_SCOPE_NAME = "pkg/rules/gocron/gocron_setup.go"
+const CLIENT_GO_SCOPE_NAME = "pkg/rules/clientgo/clientgo_setup.go"
+const DOCKER_SCOPE_NAME = "pkg/rules/docker/docker_setup.go"
+const VAULT_SCOPE_NAME = "pkg/rules/vault/vault_setup.go"
+const PAHO_PRODUCER_SCOPE_NAME = "pkg/rules/paho/paho_producer_setup.go"
+const PAHO_CONSUMER_SCOPE_NAME = "pkg/rules/paho/paho_consumer_setup.go"
+const GRPC_GATEWAY_SCOPE_NAME = "pkg/rules/grpcgateway/grpcgateway_setup.go"
+const NEO4J_SCOPE_NAME = "pkg/rules/neo4j/setup.go"
+const INFLUXDB_SCOPE_NAME = "pkg/rules/influxdb/setup.go"
+const GOCB_SCOPE_NAME = "pkg/rules/gocb/gocb_setup.go"
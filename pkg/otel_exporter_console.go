@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !otel_no_console
+
+package pkg
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+var _ = registerSpanExporter("console",
+	func(ctx context.Context) (trace.SpanExporter, error) {
+		return stdouttrace.New()
+	})
+
+var _ = registerMetricReader("console",
+	func(ctx context.Context) (metric.Reader, error) {
+		exporter, err := stdoutmetric.New()
+		if err != nil {
+			return nil, err
+		}
+		metricExporter = exporter
+		return metric.NewPeriodicReader(exporter), nil
+	})
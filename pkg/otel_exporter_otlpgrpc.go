@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !otel_no_otlpgrpc
+
+package pkg
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+var _ = registerSpanExporter("otlpgrpc",
+	func(ctx context.Context) (trace.SpanExporter, error) {
+		return otlptrace.New(ctx, otlptracegrpc.NewClient())
+	})
+
+var _ = registerMetricReader("otlpgrpc",
+	func(ctx context.Context) (metric.Reader, error) {
+		exporter, err := otlpmetricgrpc.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		metricExporter = exporter
+		return metric.NewPeriodicReader(exporter), nil
+	})
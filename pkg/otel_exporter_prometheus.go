@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !otel_no_prometheus
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"log"
+	http2 "net/http"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+var _ = registerMetricReader("prometheus",
+	func(ctx context.Context) (metric.Reader, error) {
+		exporter, err := prometheus.New()
+		if err != nil {
+			return nil, err
+		}
+		go serveMetrics()
+		return exporter, nil
+	})
+
+func serveMetrics() {
+	http2.Handle("/metrics", promhttp.Handler())
+	port := os.Getenv(prometheus_exporter_port)
+	if port == "" {
+		port = default_prometheus_exporter_port
+	}
+	log.Printf("serving serveMetrics at localhost:%s/metrics", port)
+	err := http2.ListenAndServe(fmt.Sprintf(":%s", port), nil)
+	if err != nil {
+		fmt.Printf("error serving serveMetrics: %v", err)
+		return
+	}
+}
@@ -17,34 +17,27 @@ package pkg
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
+	"net"
 	http2 "net/http"
 	"os"
 	"runtime"
 	"strings"
 
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/hook"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/manifest"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/meter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/overhead"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/experimental"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/http"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
 	testaccess "github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/testaccess"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	otelruntime "go.opentelemetry.io/contrib/instrumentation/runtime"
 
 	// The version of the following packages/modules must be fixed
 	"go.opentelemetry.io/otel"
 	_ "go.opentelemetry.io/otel/baggage"
-	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
-	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
-	"go.opentelemetry.io/otel/exporters/prometheus"
-	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
-	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
-	"go.opentelemetry.io/otel/exporters/zipkin"
 	otelmetric "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/propagation"
@@ -64,6 +57,11 @@ const metrics_exporter = "OTEL_METRICS_EXPORTER"
 const trace_exporter = "OTEL_TRACES_EXPORTER"
 const prometheus_exporter_port = "OTEL_EXPORTER_PROMETHEUS_PORT"
 const default_prometheus_exporter_port = "9464"
+const hook_admin_port = "OTEL_INSTRUMENTATION_HOOK_ADMIN_PORT"
+const hook_admin_host = "OTEL_INSTRUMENTATION_HOOK_ADMIN_HOST"
+const default_hook_admin_host = "127.0.0.1"
+const overhead_metrics = "OTEL_INSTRUMENTATION_OVERHEAD_METRICS"
+const overhead_signal = "OTEL_INSTRUMENTATION_OVERHEAD_SIGNAL"
 
 var (
 	metricExporter     metric.Exporter
@@ -73,6 +71,39 @@ var (
 	batchSpanProcessor trace.SpanProcessor
 )
 
+// Exporters are linked into the binary by separate files, each of them can be
+// excluded by the otel_no_<name> build tag, e.g. otel_no_zipkin. The available
+// ones register their constructors here by name.
+var (
+	spanExporters = map[string]func(ctx context.Context) (trace.SpanExporter, error){}
+	metricReaders = map[string]func(ctx context.Context) (metric.Reader, error){}
+)
+
+func registerSpanExporter(name string,
+	newExporter func(ctx context.Context) (trace.SpanExporter, error)) bool {
+	spanExporters[name] = newExporter
+	return true
+}
+
+func registerMetricReader(name string,
+	newReader func(ctx context.Context) (metric.Reader, error)) bool {
+	metricReaders[name] = newReader
+	return true
+}
+
+// exporterName returns the name of the exporter configured by the given
+// environment variable, which falls back to otlp over http or grpc
+func exporterName(env string) string {
+	switch name := os.Getenv(env); name {
+	case "console", "zipkin", "prometheus":
+		return name
+	}
+	if os.Getenv(report_protocol) == "grpc" || os.Getenv(trace_report_protocol) == "grpc" {
+		return "otlpgrpc"
+	}
+	return "otlphttp"
+}
+
 func init() {
 	if testaccess.IsInTest() {
 		trace.GetTestSpans = testaccess.GetTestSpans
@@ -95,6 +126,17 @@ func init() {
 	if err = initOpenTelemetry(ctx); err != nil {
 		log.Fatalf("%s: %v", "Failed to initialize opentelemetry resource", err)
 	}
+	if port := os.Getenv(hook_admin_port); port != "" {
+		host := os.Getenv(hook_admin_host)
+		if host == "" {
+			host = default_hook_admin_host
+		}
+		go serveHookAdmin(net.JoinHostPort(host, port))
+	}
+	if os.Getenv(overhead_signal) == "true" {
+		overhead.Enable()
+		overhead.DumpOnSignal()
+	}
 }
 
 func newSpanProcessor(ctx context.Context) trace.SpanProcessor {
@@ -107,16 +149,12 @@ func newSpanProcessor(ctx context.Context) trace.SpanProcessor {
 		var err error
 		if os.Getenv(trace_exporter) == "none" {
 			spanExporter = tracetest.NewNoopExporter()
-		} else if os.Getenv(trace_exporter) == "console" {
-			spanExporter, err = stdouttrace.New()
-		} else if os.Getenv(trace_exporter) == "zipkin" {
-			spanExporter, err = zipkin.New("")
+		} else if newExporter, ok := spanExporters[exporterName(trace_exporter)]; ok {
+			spanExporter, err = newExporter(ctx)
 		} else {
-			if os.Getenv(report_protocol) == "grpc" || os.Getenv(trace_report_protocol) == "grpc" {
-				spanExporter, err = otlptrace.New(ctx, otlptracegrpc.NewClient())
-			} else {
-				spanExporter, err = otlptrace.New(ctx, otlptracehttp.NewClient())
-			}
+			log.Printf("The %s trace exporter is not linked into the binary, traces are dropped",
+				exporterName(trace_exporter))
+			spanExporter = tracetest.NewNoopExporter()
 		}
 		if err != nil {
 			log.Fatalf("%s: %v", "Failed to create the OpenTelemetry trace exporter", err)
@@ -144,7 +182,6 @@ func initOpenTelemetry(ctx context.Context) error {
 
 func initMetrics() error {
 	ctx := context.Background()
-	// TODO: abstract the if-else
 	var err error
 	if testaccess.IsInTest() {
 		metricsProvider = metric.NewMeterProvider(
@@ -153,32 +190,15 @@ func initMetrics() error {
 	} else {
 		if os.Getenv(metrics_exporter) == "none" {
 			metricsProvider = noop.NewMeterProvider()
-		} else if os.Getenv(metrics_exporter) == "console" {
-			metricExporter, err = stdoutmetric.New()
-			metricsProvider = metric.NewMeterProvider(
-				metric.WithReader(metric.NewPeriodicReader(metricExporter)),
-			)
-		} else if os.Getenv(metrics_exporter) == "prometheus" {
-			promExporter, err := prometheus.New()
-			if err != nil {
-				log.Fatalf("Failed to create prometheus metric exporter: %v", err)
+		} else if newReader, ok := metricReaders[exporterName(metrics_exporter)]; ok {
+			var reader metric.Reader
+			if reader, err = newReader(ctx); err == nil {
+				metricsProvider = metric.NewMeterProvider(metric.WithReader(reader))
 			}
-			metricsProvider = metric.NewMeterProvider(
-				metric.WithReader(promExporter),
-			)
-			go serveMetrics()
 		} else {
-			if os.Getenv(report_protocol) == "grpc" || os.Getenv(trace_report_protocol) == "grpc" {
-				metricExporter, err = otlpmetricgrpc.New(ctx)
-				metricsProvider = metric.NewMeterProvider(
-					metric.WithReader(metric.NewPeriodicReader(metricExporter)),
-				)
-			} else {
-				metricExporter, err = otlpmetrichttp.New(ctx)
-				metricsProvider = metric.NewMeterProvider(
-					metric.WithReader(metric.NewPeriodicReader(metricExporter)),
-				)
-			}
+			log.Printf("The %s metric exporter is not linked into the binary, metrics are dropped",
+				exporterName(metrics_exporter))
+			metricsProvider = noop.NewMeterProvider()
 		}
 	}
 	if err != nil {
@@ -198,20 +218,31 @@ func initMetrics() error {
 	db.InitDbMetrics(m)
 	// nacos experimental metrics
 	experimental.InitNacosExperimentalMetrics(m)
+	// overhead of instrumentation hooks per rule
+	if os.Getenv(overhead_metrics) == "true" {
+		overhead.Enable()
+		if err = overhead.InitMetrics(m); err != nil {
+			log.Printf("Failed to export overhead metrics: %v", err)
+		}
+	}
 	// DefaultMinimumReadMemStatsInterval is 15 second
 	return otelruntime.Start(otelruntime.WithMeterProvider(metricsProvider))
 }
 
-func serveMetrics() {
-	http2.Handle("/metrics", promhttp.Handler())
-	port := os.Getenv(prometheus_exporter_port)
-	if port == "" {
-		port = default_prometheus_exporter_port
-	}
-	log.Printf("serving serveMetrics at localhost:%s/metrics", port)
-	err := http2.ListenAndServe(fmt.Sprintf(":%s", port), nil)
+// serveHookAdmin exposes the admin endpoints to switch hooks at runtime and to
+// inspect the instrumentation manifest and overhead, it uses a dedicated mux to avoid
+// polluting the default one of the application. The endpoints are not
+// authenticated, they listen on the loopback interface unless another host is
+// configured explicitly
+func serveHookAdmin(addr string) {
+	mux := http2.NewServeMux()
+	mux.Handle("/hooks", hook.Handler())
+	mux.Handle("/manifest", manifest.Handler())
+	mux.Handle("/overhead", overhead.Handler())
+	log.Printf("serving hook admin at %s/hooks", addr)
+	err := http2.ListenAndServe(addr, mux)
 	if err != nil {
-		fmt.Printf("error serving serveMetrics: %v", err)
+		log.Printf("error serving hook admin: %v", err)
 		return
 	}
 }
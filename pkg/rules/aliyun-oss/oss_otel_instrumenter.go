@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oss
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ossEnabler = ossInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ALIYUNOSS_ENABLED") != "false"}
+
+var ossInstrumenter = buildOssInstrumenter()
+
+type ossInnerEnabler struct {
+	enabled bool
+}
+
+func (o ossInnerEnabler) Enable() bool {
+	return o.enabled
+}
+
+type ossSpanStatusExtractor struct{}
+
+func (o *ossSpanStatusExtractor) Extract(span trace.Span, request ossReq, response ossRes, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type ossRpcAttrsGetter struct{}
+
+func (getter ossRpcAttrsGetter) GetSystem(request ossReq) string {
+	return "aliyun"
+}
+
+func (getter ossRpcAttrsGetter) GetService(request ossReq) string {
+	return "OSS"
+}
+
+func (getter ossRpcAttrsGetter) GetMethod(request ossReq) string {
+	return request.operation
+}
+
+func (getter ossRpcAttrsGetter) GetServerAddress(request ossReq) string {
+	return ""
+}
+
+// ossAttrsExtractor records the bucket and the object of a request together
+// with the request id OSS assigns to it
+type ossAttrsExtractor struct{}
+
+func (extractor *ossAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request ossReq) ([]attribute.KeyValue, context.Context) {
+	if request.bucket != "" {
+		attributes = append(attributes, attribute.String("aliyun.oss.bucket", request.bucket))
+	}
+	if request.object != "" {
+		attributes = append(attributes, attribute.String("aliyun.oss.object", request.object))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *ossAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request ossReq, response ossRes, err error) ([]attribute.KeyValue, context.Context) {
+	if response.requestID != "" {
+		attributes = append(attributes, attribute.String("aliyun.request_id", response.requestID))
+	}
+	if response.statusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	return attributes, ctx
+}
+
+func buildOssInstrumenter() instrumenter.Instrumenter[ossReq, ossRes] {
+	builder := instrumenter.Builder[ossReq, ossRes]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ALIYUN_OSS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[ossReq]{Getter: ossRpcAttrsGetter{}}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[ossReq]{}).
+		SetSpanStatusExtractor(&ossSpanStatusExtractor{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[ossReq, ossRes, ossRpcAttrsGetter]{}).
+		AddAttributesExtractor(&ossAttrsExtractor{}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oss
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+type ossData struct {
+	ctx     context.Context
+	request ossReq
+}
+
+// every bucket and object operation of the client ends up in DoWithContext,
+// the operation is named after the http method and the sub resources it
+// addresses
+//
+//go:linkname beforeOssDoWithContext github.com/aliyun/aliyun-oss-go-sdk/oss.beforeOssDoWithContext
+func beforeOssDoWithContext(call api.CallContext, conn oss.Conn, ctx context.Context, method, bucketName, objectName string,
+	params map[string]interface{}, headers map[string]string, data interface{}, initCRC uint64, listener interface{}) {
+	if !ossEnabler.Enable() {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := ossReq{
+		operation: ossOperation(method, params),
+		bucket:    bucketName,
+		object:    objectName,
+	}
+	ctx = ossInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	call.SetData(ossData{ctx: ctx, request: request})
+}
+
+//go:linkname afterOssDoWithContext github.com/aliyun/aliyun-oss-go-sdk/oss.afterOssDoWithContext
+func afterOssDoWithContext(call api.CallContext, resp *oss.Response, err error) {
+	data, ok := call.GetData().(ossData)
+	if !ok {
+		return
+	}
+	response := ossRes{}
+	if resp != nil {
+		response.statusCode = resp.StatusCode
+		response.requestID = resp.Headers.Get(oss.HTTPHeaderOssRequestID)
+	}
+	var serviceErr oss.ServiceError
+	if errors.As(err, &serviceErr) {
+		response.statusCode = serviceErr.StatusCode
+		response.requestID = serviceErr.RequestID
+	}
+	ossInstrumenter.End(data.ctx, data.request, response, err)
+}
+
+// ossOperation appends the sub resources of a request such as acl or uploads
+// to its http method, sub resources are the params without a value
+func ossOperation(method string, params map[string]interface{}) string {
+	var subResources []string
+	for key, value := range params {
+		if value == nil {
+			subResources = append(subResources, key)
+		}
+	}
+	if len(subResources) == 0 {
+		return method
+	}
+	sort.Strings(subResources)
+	return method + " " + strings.Join(subResources, "&")
+}
@@ -16,6 +16,7 @@ package amqp091
 
 import (
 	"context"
+	"time"
 	_ "unsafe"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
@@ -25,13 +26,13 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-//go:linkname consumeOnEnter github.com/rabbitmq/amqp091-go.consumeOnEnter
-func consumeOnEnter(call api.CallContext,
-	_ interface{},
-	tag string,
-	msg *amqp.Delivery,
-) {
-	request := RabbitRequest{
+const (
+	rabbitMQExchangeKey    = attribute.Key("messaging.rabbitmq.exchange")
+	rabbitMQRedeliveredKey = attribute.Key("messaging.rabbitmq.message.redelivered")
+)
+
+func newConsumeRequest(msg *amqp.Delivery) RabbitRequest {
+	return RabbitRequest{
 		operationName:   "receive",
 		destinationName: msg.Exchange + ":" + msg.RoutingKey,
 		messageId:       msg.MessageId,
@@ -39,9 +40,10 @@ func consumeOnEnter(call api.CallContext,
 		conversationID:  msg.CorrelationId,
 		headers:         msg.Headers,
 	}
-	ctx := context.Background()
-	var attributes []attribute.KeyValue
-	attributes = append(attributes,
+}
+
+func consumeAttributes(request RabbitRequest, msg *amqp.Delivery) []attribute.KeyValue {
+	return []attribute.KeyValue{
 		semconv.MessagingRabbitmqDestinationRoutingKey(msg.RoutingKey),
 		semconv.MessagingRabbitmqMessageDeliveryTag(int(msg.DeliveryTag)), attribute.KeyValue{
 			Key:   semconv.MessagingOperationTypeKey,
@@ -49,9 +51,25 @@ func consumeOnEnter(call api.CallContext,
 		}, attribute.KeyValue{
 			Key:   "messaging.rabbitmq.message.consumer_tag",
 			Value: attribute.StringValue(msg.ConsumerTag),
+		}, attribute.KeyValue{
+			Key:   rabbitMQExchangeKey,
+			Value: attribute.StringValue(msg.Exchange),
+		}, attribute.KeyValue{
+			Key:   rabbitMQRedeliveredKey,
+			Value: attribute.BoolValue(msg.Redelivered),
 		},
-	)
-	ctx = RabbitMQConsumeInstrumenter.Start(ctx, request, trace.WithAttributes(attributes...))
+	}
+}
+
+//go:linkname consumeOnEnter github.com/rabbitmq/amqp091-go.consumeOnEnter
+func consumeOnEnter(call api.CallContext,
+	_ interface{},
+	tag string,
+	msg *amqp.Delivery,
+) {
+	request := newConsumeRequest(msg)
+	ctx := context.Background()
+	ctx = RabbitMQConsumeInstrumenter.Start(ctx, request, trace.WithAttributes(consumeAttributes(request, msg)...))
 	data := make(map[string]interface{})
 	data["ctx"] = ctx
 	data["rabbitMQ_consume_request"] = request
@@ -74,3 +92,22 @@ func consumeOnExit(call api.CallContext, b bool) {
 	}
 	RabbitMQConsumeInstrumenter.End(ctx, request, nil, nil)
 }
+
+//go:linkname getOnEnter github.com/rabbitmq/amqp091-go.getOnEnter
+func getOnEnter(call api.CallContext, _ *amqp.Channel, queue string, autoAck bool) {
+	call.SetData(time.Now())
+}
+
+// getOnExit traces the delivery received by Get, the span covers the whole
+// call as the message is only known once it is received
+//
+//go:linkname getOnExit github.com/rabbitmq/amqp091-go.getOnExit
+func getOnExit(call api.CallContext, msg amqp.Delivery, ok bool, err error) {
+	startTime, valid := call.GetData().(time.Time)
+	if !valid || !ok {
+		return
+	}
+	request := newConsumeRequest(&msg)
+	RabbitMQConsumeInstrumenter.StartAndEndWithOptions(context.Background(), request, nil, err,
+		startTime, time.Now(), []trace.SpanStartOption{trace.WithAttributes(consumeAttributes(request, &msg)...)}, nil)
+}
@@ -30,6 +30,12 @@ func publishWithDeferredConfirmOnEnter(call api.CallContext,
 	ch *amqp.Channel,
 	exchange, key string, mandatory, immediate bool, msg amqp.Publishing,
 ) {
+	// The trace context is carried by the headers, which are created for
+	// messages published without any
+	if msg.Headers == nil {
+		msg.Headers = amqp.Table{}
+		call.SetParam(5, msg)
+	}
 	request := RabbitRequest{
 		operationName:   "publish",
 		destinationName: exchange + ":" + key,
@@ -42,6 +48,9 @@ func publishWithDeferredConfirmOnEnter(call api.CallContext,
 	var attributes []attribute.KeyValue
 	attributes = append(attributes,
 		semconv.MessagingRabbitmqDestinationRoutingKey(key), attribute.KeyValue{
+			Key:   rabbitMQExchangeKey,
+			Value: attribute.StringValue(exchange),
+		}, attribute.KeyValue{
 			Key:   semconv.MessagingOperationTypeKey,
 			Value: attribute.StringValue(request.operationName),
 		}, attribute.KeyValue{
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotation
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type annotationSpanNameExtractor struct{}
+
+func (a annotationSpanNameExtractor) Extract(request annotationRequest) string {
+	return request.spanName
+}
+
+type annotationSpanKindExtractor struct{}
+
+func (a annotationSpanKindExtractor) Extract(request annotationRequest) trace.SpanKind {
+	return trace.SpanKindInternal
+}
+
+type annotationAttrsExtractor struct{}
+
+func (a annotationAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request annotationRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		semconv.CodeNamespace(request.namespace),
+		semconv.CodeFunctionName(request.function))
+	attributes = append(attributes, request.attributes...)
+	return attributes, parentContext
+}
+
+func (a annotationAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request annotationRequest, response annotationResponse, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildAnnotationInstrumenter() instrumenter.Instrumenter[annotationRequest, annotationResponse] {
+	builder := instrumenter.Builder[annotationRequest, annotationResponse]{}
+	return builder.Init().SetSpanNameExtractor(annotationSpanNameExtractor{}).
+		SetSpanKindExtractor(annotationSpanKindExtractor{}).
+		AddAttributesExtractor(annotationAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ANNOTATION_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
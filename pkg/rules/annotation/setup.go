@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotation
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type annotationInnerEnabler struct {
+	enabled bool
+}
+
+func (a annotationInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+// Annotated functions are instrumented on purpose, so they are traced unless
+// explicitly disabled.
+var annotationEnabler = annotationInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ANNOTATION_ENABLED") != "false"}
+
+var annotationInstrumenter = BuildAnnotationInstrumenter()
+
+// Config describes a function annotated with //otel:instrument, it is filled
+// in by the preprocess phase.
+type Config struct {
+	// Name of the span
+	SpanName string
+	// Import path of the package where the function is declared
+	Namespace string
+	// Name of the function, prefixed with its receiver type if any
+	Function string
+	// Index of the context.Context parameter, counting the receiver, or -1
+	ContextIndex int
+	// Index of the error return value, or -1
+	ErrorIndex int
+	// Static attributes of the span as key-value pairs
+	Attributes []string
+}
+
+// Annotation traces calls of an annotated function
+type Annotation struct {
+	config     Config
+	attributes []attribute.KeyValue
+}
+
+func New(config Config) *Annotation {
+	attrs := make([]attribute.KeyValue, 0, len(config.Attributes)/2)
+	for i := 0; i+1 < len(config.Attributes); i += 2 {
+		attrs = append(attrs,
+			attribute.String(config.Attributes[i], config.Attributes[i+1]))
+	}
+	return &Annotation{config: config, attributes: attrs}
+}
+
+func (a *Annotation) OnEnter(call api.CallContext) {
+	if !annotationEnabler.Enable() {
+		return
+	}
+	parent := context.Background()
+	if a.config.ContextIndex >= 0 {
+		if ctx, ok := call.GetParam(a.config.ContextIndex).(context.Context); ok && ctx != nil {
+			parent = ctx
+		}
+	}
+	request := annotationRequest{
+		spanName:   a.config.SpanName,
+		namespace:  a.config.Namespace,
+		function:   a.config.Function,
+		attributes: a.attributes,
+	}
+	ctx := annotationInstrumenter.Start(parent, request)
+	// Propagate the span to the callees through the context parameter
+	if a.config.ContextIndex >= 0 {
+		call.SetParam(a.config.ContextIndex, ctx)
+	}
+	call.SetData(map[string]interface{}{
+		"ctx":     ctx,
+		"request": request,
+	})
+}
+
+func (a *Annotation) OnExit(call api.CallContext) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx := data["ctx"].(context.Context)
+	request := data["request"].(annotationRequest)
+	var err error
+	if a.config.ErrorIndex >= 0 {
+		err, _ = call.GetReturnVal(a.config.ErrorIndex).(error)
+	}
+	annotationInstrumenter.End(ctx, request, annotationResponse{}, err)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asynq
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type asynqProcessData struct {
+	ctx     context.Context
+	request asynqConsumerReq
+}
+
+// asynqEnqueueLink links the process span to the span enqueueing the task
+func asynqEnqueueLink(task *asynq.Task) []trace.SpanStartOption {
+	carrier := asynqHeadersCarrier{headers: asynqTaskHeaders(task, false)}
+	sc := trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(context.Background(), carrier))
+	if !sc.IsValid() {
+		return nil
+	}
+	return []trace.SpanStartOption{trace.WithLinks(trace.Link{SpanContext: sc})}
+}
+
+// beforeAsynqProcess traces the handler processing a task, the context passed
+// to the handler carries the process span so that the spans of the handler
+// are its children
+//
+//go:linkname beforeAsynqProcess github.com/hibiken/asynq.beforeAsynqProcess
+func beforeAsynqProcess(call api.CallContext, p interface{}, ctx context.Context, task *asynq.Task) {
+	if !asynqEnabler.Enable() || task == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := asynqConsumerReq{task: task}
+	request.queue, _ = asynq.GetQueueName(ctx)
+	request.id, _ = asynq.GetTaskID(ctx)
+	request.retryCount, _ = asynq.GetRetryCount(ctx)
+	spanContext := asynqConsumerInstrumenter.Start(ctx, request, asynqEnqueueLink(task)...)
+	call.SetParam(1, spanContext)
+	call.SetData(asynqProcessData{ctx: spanContext, request: request})
+}
+
+//go:linkname afterAsynqProcess github.com/hibiken/asynq.afterAsynqProcess
+func afterAsynqProcess(call api.CallContext, err error) {
+	data, ok := call.GetData().(asynqProcessData)
+	if !ok {
+		return
+	}
+	asynqConsumerInstrumenter.End(data.ctx, data.request, nil, err)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asynq
+
+import "github.com/hibiken/asynq"
+
+type asynqProducerReq struct {
+	task  *asynq.Task
+	queue string
+}
+
+// asynqConsumerReq is a task processed by the handler of a server, the queue,
+// id and retry count are read from the context passed to the handler
+type asynqConsumerReq struct {
+	task       *asynq.Task
+	queue      string
+	id         string
+	retryCount int
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asynq
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	asynqTaskTypeKey   = attribute.Key("messaging.asynq.task.type")
+	asynqRetryCountKey = attribute.Key("messaging.asynq.task.retry_count")
+)
+
+var asynqEnabler = asynqInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ASYNQ_ENABLED") != "false"}
+
+var (
+	asynqProducerInstrumenter = buildAsynqProducerInstrumenter()
+	asynqConsumerInstrumenter = buildAsynqConsumerInstrumenter()
+)
+
+type asynqInnerEnabler struct {
+	enabled bool
+}
+
+func (a asynqInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+// asynqHeadersCarrier carries the trace context in the headers of a task, the
+// context is dropped if the headers of the task can not be found
+type asynqHeadersCarrier struct {
+	headers map[string]string
+}
+
+func (carrier asynqHeadersCarrier) Get(key string) string {
+	return carrier.headers[key]
+}
+
+func (carrier asynqHeadersCarrier) Set(key, value string) {
+	if carrier.headers != nil {
+		carrier.headers[key] = value
+	}
+}
+
+func (carrier asynqHeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.headers))
+	for key := range carrier.headers {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+type asynqSpanStatusExtractor[REQUEST any, RESPONSE any] struct{}
+
+func (a *asynqSpanStatusExtractor[REQUEST, RESPONSE]) Extract(span trace.Span, request REQUEST, response RESPONSE, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type asynqProducerAttrsGetter struct{}
+
+func (getter asynqProducerAttrsGetter) GetSystem(request asynqProducerReq) string {
+	return "asynq"
+}
+
+func (getter asynqProducerAttrsGetter) GetDestination(request asynqProducerReq) string {
+	return request.queue
+}
+
+func (getter asynqProducerAttrsGetter) GetDestinationTemplate(request asynqProducerReq) string {
+	return ""
+}
+
+func (getter asynqProducerAttrsGetter) IsTemporaryDestination(request asynqProducerReq) bool {
+	return false
+}
+
+func (getter asynqProducerAttrsGetter) IsAnonymousDestination(request asynqProducerReq) bool {
+	return false
+}
+
+func (getter asynqProducerAttrsGetter) GetConversationId(request asynqProducerReq) string {
+	return ""
+}
+
+func (getter asynqProducerAttrsGetter) GetMessageBodySize(request asynqProducerReq) int64 {
+	return int64(len(request.task.Payload()))
+}
+
+func (getter asynqProducerAttrsGetter) GetMessageEnvelopSize(request asynqProducerReq) int64 {
+	return 0
+}
+
+func (getter asynqProducerAttrsGetter) GetMessageId(request asynqProducerReq, response *asynq.TaskInfo) string {
+	if response == nil {
+		return ""
+	}
+	return response.ID
+}
+
+func (getter asynqProducerAttrsGetter) GetClientId(request asynqProducerReq) string {
+	return ""
+}
+
+func (getter asynqProducerAttrsGetter) GetBatchMessageCount(request asynqProducerReq, response *asynq.TaskInfo) int64 {
+	return 1
+}
+
+func (getter asynqProducerAttrsGetter) GetMessageHeader(request asynqProducerReq, name string) []string {
+	if value, ok := asynqTaskHeaders(request.task, false)[name]; ok {
+		return []string{value}
+	}
+	return nil
+}
+
+func (getter asynqProducerAttrsGetter) GetDestinationPartitionId(request asynqProducerReq) string {
+	return ""
+}
+
+type asynqConsumerAttrsGetter struct{}
+
+func (getter asynqConsumerAttrsGetter) GetSystem(request asynqConsumerReq) string {
+	return "asynq"
+}
+
+func (getter asynqConsumerAttrsGetter) GetDestination(request asynqConsumerReq) string {
+	return request.queue
+}
+
+func (getter asynqConsumerAttrsGetter) GetDestinationTemplate(request asynqConsumerReq) string {
+	return ""
+}
+
+func (getter asynqConsumerAttrsGetter) IsTemporaryDestination(request asynqConsumerReq) bool {
+	return false
+}
+
+func (getter asynqConsumerAttrsGetter) IsAnonymousDestination(request asynqConsumerReq) bool {
+	return false
+}
+
+func (getter asynqConsumerAttrsGetter) GetConversationId(request asynqConsumerReq) string {
+	return ""
+}
+
+func (getter asynqConsumerAttrsGetter) GetMessageBodySize(request asynqConsumerReq) int64 {
+	return int64(len(request.task.Payload()))
+}
+
+func (getter asynqConsumerAttrsGetter) GetMessageEnvelopSize(request asynqConsumerReq) int64 {
+	return 0
+}
+
+func (getter asynqConsumerAttrsGetter) GetMessageId(request asynqConsumerReq, response any) string {
+	return request.id
+}
+
+func (getter asynqConsumerAttrsGetter) GetClientId(request asynqConsumerReq) string {
+	return ""
+}
+
+func (getter asynqConsumerAttrsGetter) GetBatchMessageCount(request asynqConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter asynqConsumerAttrsGetter) GetMessageHeader(request asynqConsumerReq, name string) []string {
+	if value, ok := asynqTaskHeaders(request.task, false)[name]; ok {
+		return []string{value}
+	}
+	return nil
+}
+
+func (getter asynqConsumerAttrsGetter) GetDestinationPartitionId(request asynqConsumerReq) string {
+	return ""
+}
+
+type asynqProducerAttrsExtractor struct{}
+
+func (extractor *asynqProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request asynqProducerReq) ([]attribute.KeyValue, context.Context) {
+	return append(attributes, asynqTaskTypeKey.String(request.task.Type())), parentContext
+}
+
+func (extractor *asynqProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request asynqProducerReq, response *asynq.TaskInfo, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// asynqConsumerAttrsExtractor records the type of a processed task and how
+// many times it has been retried
+type asynqConsumerAttrsExtractor struct{}
+
+func (extractor *asynqConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request asynqConsumerReq) ([]attribute.KeyValue, context.Context) {
+	return append(attributes,
+		asynqTaskTypeKey.String(request.task.Type()),
+		asynqRetryCountKey.Int(request.retryCount),
+	), parentContext
+}
+
+func (extractor *asynqConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request asynqConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func buildAsynqProducerInstrumenter() instrumenter.Instrumenter[asynqProducerReq, *asynq.TaskInfo] {
+	builder := instrumenter.Builder[asynqProducerReq, *asynq.TaskInfo]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ASYNQ_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[asynqProducerReq, *asynq.TaskInfo]{
+			Getter:        asynqProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[asynqProducerReq]{}).
+		SetSpanStatusExtractor(&asynqSpanStatusExtractor[asynqProducerReq, *asynq.TaskInfo]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[asynqProducerReq, *asynq.TaskInfo, asynqProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&asynqProducerAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request asynqProducerReq) propagation.TextMapCarrier {
+				return asynqHeadersCarrier{headers: asynqTaskHeaders(request.task, true)}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+// a task may be processed long after it is enqueued and many times, the
+// process spans are linked to the enqueueing trace instead of being its
+// children, see asynqEnqueueLink
+func buildAsynqConsumerInstrumenter() instrumenter.Instrumenter[asynqConsumerReq, any] {
+	builder := instrumenter.Builder[asynqConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ASYNQ_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[asynqConsumerReq, any]{
+			Getter:        asynqConsumerAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[asynqConsumerReq]{}).
+		SetSpanStatusExtractor(&asynqSpanStatusExtractor[asynqConsumerReq, any]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[asynqConsumerReq, any, asynqConsumerAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&asynqConsumerAttrsExtractor{}).
+		BuildInstrumenter()
+}
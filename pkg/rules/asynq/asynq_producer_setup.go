@@ -0,0 +1,55 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asynq
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/hibiken/asynq"
+)
+
+type asynqEnqueueData struct {
+	ctx     context.Context
+	request asynqProducerReq
+}
+
+// beforeAsynqEnqueue also covers Enqueue, which enqueues with a background
+// context
+//
+//go:linkname beforeAsynqEnqueue github.com/hibiken/asynq.beforeAsynqEnqueue
+func beforeAsynqEnqueue(call api.CallContext, c *asynq.Client, ctx context.Context, task *asynq.Task, opts ...asynq.Option) {
+	if !asynqEnabler.Enable() || task == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := asynqProducerReq{task: task, queue: asynqQueue(task, opts)}
+	call.SetData(asynqEnqueueData{
+		ctx:     asynqProducerInstrumenter.Start(ctx, request),
+		request: request,
+	})
+}
+
+//go:linkname afterAsynqEnqueue github.com/hibiken/asynq.afterAsynqEnqueue
+func afterAsynqEnqueue(call api.CallContext, info *asynq.TaskInfo, err error) {
+	data, ok := call.GetData().(asynqEnqueueData)
+	if !ok {
+		return
+	}
+	asynqProducerInstrumenter.End(data.ctx, data.request, info, err)
+}
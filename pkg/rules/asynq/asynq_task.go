@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package asynq
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+
+	"github.com/hibiken/asynq"
+)
+
+// The headers and options of a task are unexported fields, they are looked up
+// once and the trace context is not carried if they are missing
+var (
+	asynqTaskFieldsOnce  sync.Once
+	asynqTaskHeadersIdx  []int
+	asynqTaskOptionsIdx  []int
+	asynqHeadersType     = reflect.TypeOf(map[string]string(nil))
+	asynqOptionSliceType = reflect.TypeOf([]asynq.Option(nil))
+)
+
+func lookupAsynqTaskFields() {
+	asynqTaskFieldsOnce.Do(func() {
+		taskType := reflect.TypeOf(asynq.Task{})
+		if headers, ok := taskType.FieldByName("headers"); ok && headers.Type == asynqHeadersType {
+			asynqTaskHeadersIdx = headers.Index
+		}
+		if opts, ok := taskType.FieldByName("opts"); ok && opts.Type == asynqOptionSliceType {
+			asynqTaskOptionsIdx = opts.Index
+		}
+	})
+}
+
+// asynqTaskField returns the address of an unexported field of the task, the
+// type of the field is checked when it is looked up
+func asynqTaskField(task *asynq.Task, index []int) unsafe.Pointer {
+	return unsafe.Pointer(reflect.ValueOf(task).Elem().FieldByIndex(index).UnsafeAddr())
+}
+
+// asynqTaskHeaders returns the headers of the task, a task without headers is
+// given an empty map if create is set
+func asynqTaskHeaders(task *asynq.Task, create bool) map[string]string {
+	lookupAsynqTaskFields()
+	if asynqTaskHeadersIdx == nil || task == nil {
+		return nil
+	}
+	headers := (*map[string]string)(asynqTaskField(task, asynqTaskHeadersIdx))
+	if *headers == nil && create {
+		*headers = make(map[string]string)
+	}
+	return *headers
+}
+
+// asynqQueue returns the queue the task is enqueued to, the options passed to
+// Enqueue take precedence over the options of the task
+func asynqQueue(task *asynq.Task, opts []asynq.Option) string {
+	lookupAsynqTaskFields()
+	queue := "default"
+	var taskOpts []asynq.Option
+	if asynqTaskOptionsIdx != nil {
+		taskOpts = *(*[]asynq.Option)(asynqTaskField(task, asynqTaskOptionsIdx))
+	}
+	for _, options := range [][]asynq.Option{taskOpts, opts} {
+		for _, opt := range options {
+			if opt == nil || opt.Type() != asynq.QueueOpt {
+				continue
+			}
+			if name, ok := opt.Value().(string); ok && name != "" {
+				queue = name
+			}
+		}
+	}
+	return queue
+}
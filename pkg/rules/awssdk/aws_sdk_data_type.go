@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awssdk
+
+// awsSdkReq is an operation of an aws service client, params is the input of
+// the operation such as *sqs.SendMessageInput
+type awsSdkReq struct {
+	service   string
+	operation string
+	region    string
+	params    interface{}
+	// destination is the queue or the topic of a SQS or SNS operation
+	destination  string
+	messageCount int64
+}
+
+type awsSdkRes struct {
+	requestID    string
+	statusCode   int
+	messageID    string
+	messageCount int64
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awssdk
+
+import (
+	"reflect"
+	"strings"
+)
+
+// the service clients of the sdk are not imported, so that instrumenting a
+// program does not pull all of them in, their inputs and outputs are read and
+// written through reflection instead
+
+// awsSdkMaxMessageAttributes is the number of message attributes SQS accepts
+// for a message, the trace context is not injected beyond that
+const awsSdkMaxMessageAttributes = 10
+
+var awsSdkStringPtrType = reflect.TypeOf((*string)(nil))
+
+// awsSdkStruct dereferences v down to the struct it points to
+func awsSdkStruct(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v
+}
+
+func awsSdkField(v reflect.Value, name string) reflect.Value {
+	v = awsSdkStruct(v)
+	if !v.IsValid() {
+		return reflect.Value{}
+	}
+	return v.FieldByName(name)
+}
+
+// awsSdkStringField reads a string or *string field of the struct pointed to
+// by v, such as the QueueUrl of a *sqs.SendMessageInput
+func awsSdkStringField(v interface{}, name string) string {
+	field := awsSdkField(reflect.ValueOf(v), name)
+	if field.Kind() == reflect.Ptr && !field.IsNil() {
+		field = field.Elem()
+	}
+	if field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
+
+// awsSdkSlice returns a slice field of the struct pointed to by v, such as the
+// Entries of a *sqs.SendMessageBatchInput
+func awsSdkSlice(v interface{}, name string) reflect.Value {
+	field := awsSdkField(reflect.ValueOf(v), name)
+	if field.Kind() != reflect.Slice {
+		return reflect.Value{}
+	}
+	return field
+}
+
+// awsSdkQueueName returns the name of the queue at the end of a queue url
+func awsSdkQueueName(queueURL string) string {
+	return queueURL[strings.LastIndex(queueURL, "/")+1:]
+}
+
+// awsSdkTopicName returns the name of the topic at the end of a topic arn
+func awsSdkTopicName(topicARN string) string {
+	return topicARN[strings.LastIndex(topicARN, ":")+1:]
+}
+
+// awsSdkMessageCarrier carries the trace context by the message attributes of
+// a SQS or SNS message, that is a map[string]types.MessageAttributeValue
+type awsSdkMessageCarrier struct {
+	attributes reflect.Value
+}
+
+func newAwsSdkMessageCarrier(message reflect.Value) awsSdkMessageCarrier {
+	attributes := awsSdkField(message, "MessageAttributes")
+	if attributes.Kind() != reflect.Map || attributes.Type().Key().Kind() != reflect.String ||
+		attributes.Type().Elem().Kind() != reflect.Struct {
+		return awsSdkMessageCarrier{}
+	}
+	return awsSdkMessageCarrier{attributes: attributes}
+}
+
+func (c awsSdkMessageCarrier) Get(key string) string {
+	if !c.attributes.IsValid() || c.attributes.IsNil() {
+		return ""
+	}
+	value := c.attributes.MapIndex(reflect.ValueOf(key))
+	if !value.IsValid() {
+		return ""
+	}
+	stringValue := value.FieldByName("StringValue")
+	if !stringValue.IsValid() || stringValue.Type() != awsSdkStringPtrType || stringValue.IsNil() {
+		return ""
+	}
+	return stringValue.Elem().String()
+}
+
+func (c awsSdkMessageCarrier) Set(key string, value string) {
+	if !c.attributes.IsValid() || !c.attributes.CanSet() {
+		return
+	}
+	if c.attributes.IsNil() {
+		c.attributes.Set(reflect.MakeMap(c.attributes.Type()))
+	}
+	mapKey := reflect.ValueOf(key).Convert(c.attributes.Type().Key())
+	if !c.attributes.MapIndex(mapKey).IsValid() && c.attributes.Len() >= awsSdkMaxMessageAttributes {
+		return
+	}
+	attribute := reflect.New(c.attributes.Type().Elem()).Elem()
+	dataType, stringValue := attribute.FieldByName("DataType"), attribute.FieldByName("StringValue")
+	if !dataType.IsValid() || dataType.Type() != awsSdkStringPtrType ||
+		!stringValue.IsValid() || stringValue.Type() != awsSdkStringPtrType {
+		return
+	}
+	typ := "String"
+	dataType.Set(reflect.ValueOf(&typ))
+	stringValue.Set(reflect.ValueOf(&value))
+	c.attributes.SetMapIndex(mapKey, attribute)
+}
+
+func (c awsSdkMessageCarrier) Keys() []string {
+	if !c.attributes.IsValid() {
+		return nil
+	}
+	keys := make([]string, 0, c.attributes.Len())
+	for _, key := range c.attributes.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	return keys
+}
+
+// awsSdkRequestAttributeNames makes a ReceiveMessage ask for the message
+// attributes carrying the trace context, unless all of them are asked for
+func awsSdkRequestAttributeNames(params interface{}, fields []string) {
+	names := awsSdkField(reflect.ValueOf(params), "MessageAttributeNames")
+	if names.Kind() != reflect.Slice || names.Type().Elem().Kind() != reflect.String || !names.CanSet() {
+		return
+	}
+	requested := make(map[string]bool, names.Len())
+	for i := 0; i < names.Len(); i++ {
+		name := names.Index(i).String()
+		if name == "All" || name == ".*" {
+			return
+		}
+		requested[name] = true
+	}
+	// the slice is copied as it belongs to the caller
+	extended := reflect.MakeSlice(names.Type(), 0, names.Len()+len(fields))
+	extended = reflect.AppendSlice(extended, names)
+	for _, field := range fields {
+		if !requested[field] {
+			extended = reflect.Append(extended, reflect.ValueOf(field).Convert(names.Type().Elem()))
+		}
+	}
+	names.Set(extended)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awssdk
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	awsSdkServiceS3       = "S3"
+	awsSdkServiceDynamoDB = "DynamoDB"
+	awsSdkServiceSQS      = "SQS"
+	awsSdkServiceSNS      = "SNS"
+)
+
+const awsSdkMiddlewareID = "OtelAwsSdkMiddleware"
+
+// awsSdkMiddleware traces an operation of any aws service client, it is added
+// after the service metadata is registered so that the service, operation and
+// region of the operation are known
+type awsSdkMiddleware struct{}
+
+func (m *awsSdkMiddleware) ID() string {
+	return awsSdkMiddlewareID
+}
+
+func (m *awsSdkMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	request := awsSdkReq{
+		service:   awsmiddleware.GetServiceID(ctx),
+		operation: awsmiddleware.GetOperationName(ctx),
+		region:    awsmiddleware.GetRegion(ctx),
+		params:    in.Parameters,
+	}
+	if request.service == "" {
+		// the stack is not one of an aws service client
+		return next.HandleInitialize(ctx, in)
+	}
+	propagator := otel.GetTextMapPropagator()
+	operation, messages := awsSdkMessageOperation(&request)
+	inst := awsSdkInstrumenter
+	switch operation {
+	case message.PUBLISH:
+		inst = awsSdkProducerInstrumenter
+	case message.RECEIVE:
+		inst = awsSdkConsumerInstrumenter
+		awsSdkRequestAttributeNames(in.Parameters, propagator.Fields())
+	}
+	ctx = inst.Start(ctx, request)
+	for _, msg := range messages {
+		propagator.Inject(ctx, newAwsSdkMessageCarrier(msg))
+	}
+
+	out, metadata, err = next.HandleInitialize(ctx, in)
+
+	response := awsSdkRes{}
+	response.requestID, _ = awsmiddleware.GetRequestIDMetadata(metadata)
+	if raw, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && raw.Response != nil {
+		response.statusCode = raw.StatusCode
+	}
+	switch operation {
+	case message.PUBLISH:
+		response.messageID = awsSdkStringField(out.Result, "MessageId")
+	case message.RECEIVE:
+		received := awsSdkSlice(out.Result, "Messages")
+		if received.IsValid() {
+			response.messageCount = int64(received.Len())
+			if received.Len() == 1 {
+				response.messageID = awsSdkStringField(received.Index(0).Addr().Interface(), "MessageId")
+			}
+			// a batch of messages may come from several producers, the
+			// receive span is linked to each of them
+			span := trace.SpanFromContext(ctx)
+			for i := 0; i < received.Len(); i++ {
+				carrier := newAwsSdkMessageCarrier(received.Index(i))
+				producer := trace.SpanContextFromContext(propagator.Extract(context.Background(), carrier))
+				if producer.IsValid() {
+					span.AddLink(trace.Link{SpanContext: producer})
+				}
+			}
+		}
+	}
+	inst.End(ctx, request, response, err)
+	return out, metadata, err
+}
+
+// awsSdkMessageOperation tells the SQS and SNS operations sending or receiving
+// messages, and returns the messages to carry the trace context when sending
+func awsSdkMessageOperation(request *awsSdkReq) (message.MessageOperation, []reflect.Value) {
+	params := reflect.ValueOf(request.params)
+	switch request.service {
+	case awsSdkServiceSQS:
+		request.destination = awsSdkQueueName(awsSdkStringField(request.params, "QueueUrl"))
+		switch request.operation {
+		case "SendMessage":
+			request.messageCount = 1
+			return message.PUBLISH, []reflect.Value{params}
+		case "SendMessageBatch":
+			return message.PUBLISH, awsSdkBatchMessages(request, "Entries")
+		case "ReceiveMessage":
+			return message.RECEIVE, nil
+		}
+	case awsSdkServiceSNS:
+		topic := awsSdkStringField(request.params, "TopicArn")
+		if topic == "" {
+			topic = awsSdkStringField(request.params, "TargetArn")
+		}
+		request.destination = awsSdkTopicName(topic)
+		switch request.operation {
+		case "Publish":
+			request.messageCount = 1
+			return message.PUBLISH, []reflect.Value{params}
+		case "PublishBatch":
+			return message.PUBLISH, awsSdkBatchMessages(request, "PublishBatchRequestEntries")
+		}
+	}
+	return "", nil
+}
+
+func awsSdkBatchMessages(request *awsSdkReq, field string) []reflect.Value {
+	entries := awsSdkSlice(request.params, field)
+	if !entries.IsValid() {
+		return nil
+	}
+	messages := make([]reflect.Value, 0, entries.Len())
+	for i := 0; i < entries.Len(); i++ {
+		messages = append(messages, entries.Index(i))
+	}
+	request.messageCount = int64(len(messages))
+	return messages
+}
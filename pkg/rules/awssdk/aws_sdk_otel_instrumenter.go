@@ -0,0 +1,211 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awssdk
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var awsSdkEnabler = awsSdkInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_AWSSDK_ENABLED") != "false"}
+
+var (
+	awsSdkInstrumenter         = buildAwsSdkInstrumenter()
+	awsSdkProducerInstrumenter = buildAwsSdkMessageInstrumenter(message.PUBLISH)
+	awsSdkConsumerInstrumenter = buildAwsSdkMessageInstrumenter(message.RECEIVE)
+)
+
+type awsSdkInnerEnabler struct {
+	enabled bool
+}
+
+func (a awsSdkInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+type awsSdkSpanStatusExtractor struct{}
+
+func (a *awsSdkSpanStatusExtractor) Extract(span trace.Span, request awsSdkReq, response awsSdkRes, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type awsSdkRpcAttrsGetter struct{}
+
+func (getter awsSdkRpcAttrsGetter) GetSystem(request awsSdkReq) string {
+	return "aws-api"
+}
+
+func (getter awsSdkRpcAttrsGetter) GetService(request awsSdkReq) string {
+	return request.service
+}
+
+func (getter awsSdkRpcAttrsGetter) GetMethod(request awsSdkReq) string {
+	return request.operation
+}
+
+func (getter awsSdkRpcAttrsGetter) GetServerAddress(request awsSdkReq) string {
+	return ""
+}
+
+type awsSdkMessageAttrsGetter struct{}
+
+func (getter awsSdkMessageAttrsGetter) GetSystem(request awsSdkReq) string {
+	if request.service == awsSdkServiceSNS {
+		return "aws_sns"
+	}
+	return "aws_sqs"
+}
+
+func (getter awsSdkMessageAttrsGetter) GetDestination(request awsSdkReq) string {
+	return request.destination
+}
+
+func (getter awsSdkMessageAttrsGetter) GetDestinationTemplate(request awsSdkReq) string {
+	return ""
+}
+
+func (getter awsSdkMessageAttrsGetter) IsTemporaryDestination(request awsSdkReq) bool {
+	return false
+}
+
+func (getter awsSdkMessageAttrsGetter) IsAnonymousDestination(request awsSdkReq) bool {
+	return false
+}
+
+func (getter awsSdkMessageAttrsGetter) GetConversationId(request awsSdkReq) string {
+	return ""
+}
+
+func (getter awsSdkMessageAttrsGetter) GetMessageBodySize(request awsSdkReq) int64 {
+	return 0
+}
+
+func (getter awsSdkMessageAttrsGetter) GetMessageEnvelopSize(request awsSdkReq) int64 {
+	return 0
+}
+
+func (getter awsSdkMessageAttrsGetter) GetMessageId(request awsSdkReq, response awsSdkRes) string {
+	return response.messageID
+}
+
+func (getter awsSdkMessageAttrsGetter) GetClientId(request awsSdkReq) string {
+	return ""
+}
+
+// GetBatchMessageCount returns the number of the messages sent by a batch or
+// the number of the messages received
+func (getter awsSdkMessageAttrsGetter) GetBatchMessageCount(request awsSdkReq, response awsSdkRes) int64 {
+	if response.messageCount > 0 {
+		return response.messageCount
+	}
+	return request.messageCount
+}
+
+func (getter awsSdkMessageAttrsGetter) GetMessageHeader(request awsSdkReq, name string) []string {
+	return nil
+}
+
+func (getter awsSdkMessageAttrsGetter) GetDestinationPartitionId(request awsSdkReq) string {
+	return ""
+}
+
+// awsSdkAttrsExtractor records the region and the request id of an operation
+// together with the bucket and table of S3 and DynamoDB operations
+type awsSdkAttrsExtractor struct{}
+
+func (extractor *awsSdkAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request awsSdkReq) ([]attribute.KeyValue, context.Context) {
+	if request.region != "" {
+		attributes = append(attributes, semconv.CloudRegion(request.region))
+	}
+	switch request.service {
+	case awsSdkServiceS3:
+		if bucket := awsSdkStringField(request.params, "Bucket"); bucket != "" {
+			attributes = append(attributes, semconv.AWSS3Bucket(bucket))
+		}
+		if key := awsSdkStringField(request.params, "Key"); key != "" {
+			attributes = append(attributes, semconv.AWSS3Key(key))
+		}
+	case awsSdkServiceDynamoDB:
+		if table := awsSdkStringField(request.params, "TableName"); table != "" {
+			attributes = append(attributes, semconv.AWSDynamoDBTableNames(table))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (extractor *awsSdkAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request awsSdkReq, response awsSdkRes, err error) ([]attribute.KeyValue, context.Context) {
+	if response.requestID != "" {
+		attributes = append(attributes, semconv.AWSRequestID(response.requestID))
+	}
+	if response.statusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	return attributes, ctx
+}
+
+func buildAwsSdkInstrumenter() instrumenter.Instrumenter[awsSdkReq, awsSdkRes] {
+	builder := instrumenter.Builder[awsSdkReq, awsSdkRes]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.AWS_SDK_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[awsSdkReq]{Getter: awsSdkRpcAttrsGetter{}}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[awsSdkReq]{}).
+		SetSpanStatusExtractor(&awsSdkSpanStatusExtractor{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[awsSdkReq, awsSdkRes, awsSdkRpcAttrsGetter]{}).
+		AddAttributesExtractor(&awsSdkAttrsExtractor{}).
+		BuildInstrumenter()
+}
+
+// the trace context of the messages is injected into or extracted from their
+// message attributes by awsSdkMiddleware, as a batch holds several messages
+func buildAwsSdkMessageInstrumenter(operation message.MessageOperation) instrumenter.Instrumenter[awsSdkReq, awsSdkRes] {
+	builder := instrumenter.Builder[awsSdkReq, awsSdkRes]{}
+	builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.AWS_SDK_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[awsSdkReq, awsSdkRes]{
+			Getter:        awsSdkMessageAttrsGetter{},
+			OperationName: operation,
+		}).
+		SetSpanStatusExtractor(&awsSdkSpanStatusExtractor{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[awsSdkReq, awsSdkRes, awsSdkMessageAttrsGetter]{
+			Operation: operation,
+		}).
+		AddAttributesExtractor(&rpc.RpcAttrsExtractor[awsSdkReq, awsSdkRes, awsSdkRpcAttrsGetter]{}).
+		AddAttributesExtractor(&awsSdkAttrsExtractor{})
+	if operation == message.PUBLISH {
+		builder.SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[awsSdkReq]{})
+	} else {
+		builder.SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[awsSdkReq]{})
+	}
+	return builder.BuildInstrumenter()
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awssdk
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// every operation of every aws service client builds its own middleware
+// stack, the tracing middleware is added to the stack right before it handles
+// the operation so that all service clients are instrumented at once
+//
+//go:linkname beforeAwsSdkHandleMiddleware github.com/aws/smithy-go/middleware.beforeAwsSdkHandleMiddleware
+func beforeAwsSdkHandleMiddleware(call api.CallContext, stack *middleware.Stack, ctx context.Context, input interface{}, next middleware.Handler) {
+	if !awsSdkEnabler.Enable() || stack == nil {
+		return
+	}
+	if _, ok := stack.Initialize.Get(awsSdkMiddlewareID); ok {
+		return
+	}
+	_ = stack.Initialize.Add(&awsSdkMiddleware{}, middleware.After)
+}
@@ -0,0 +1,14 @@
+module github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/rules/awssdk
+
+go 1.23.0
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg => ../../../pkg
+
+require (
+	github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg v0.0.0-00010101000000-000000000000
+	github.com/aws/aws-sdk-go-v2 v1.17.3
+	github.com/aws/smithy-go v1.13.5
+	go.opentelemetry.io/otel v1.36.0
+	go.opentelemetry.io/otel/sdk v1.36.0
+	go.opentelemetry.io/otel/trace v1.36.0
+)
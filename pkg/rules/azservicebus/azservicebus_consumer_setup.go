@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azservicebus
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type azServiceBusReceiveData struct {
+	ctx     context.Context
+	request azServiceBusConsumerReq
+}
+
+//go:linkname afterAzServiceBusNewReceiverForQueue github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus.afterAzServiceBusNewReceiverForQueue
+func afterAzServiceBusNewReceiverForQueue(call api.CallContext, receiver *azservicebus.Receiver, err error) {
+	if receiver == nil {
+		return
+	}
+	if queue, ok := call.GetParam(1).(string); ok {
+		receiver.OtelEntity = queue
+	}
+}
+
+//go:linkname afterAzServiceBusNewReceiverForSubscription github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus.afterAzServiceBusNewReceiverForSubscription
+func afterAzServiceBusNewReceiverForSubscription(call api.CallContext, receiver *azservicebus.Receiver, err error) {
+	if receiver == nil {
+		return
+	}
+	if topic, ok := call.GetParam(1).(string); ok {
+		receiver.OtelEntity = topic
+	}
+	if subscription, ok := call.GetParam(2).(string); ok {
+		receiver.OtelSubscription = subscription
+	}
+}
+
+//go:linkname beforeAzServiceBusReceiveMessages github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus.beforeAzServiceBusReceiveMessages
+func beforeAzServiceBusReceiveMessages(call api.CallContext, receiver *azservicebus.Receiver, ctx context.Context, maxMessages int, options *azservicebus.ReceiveMessagesOptions) {
+	if !azServiceBusEnabler.Enable() || receiver == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := azServiceBusConsumerReq{
+		entity:       receiver.OtelEntity,
+		subscription: receiver.OtelSubscription,
+		maxMessages:  maxMessages,
+	}
+	ctx = azServiceBusConsumerInstrumenter.Start(ctx, request)
+	call.SetData(azServiceBusReceiveData{ctx: ctx, request: request})
+}
+
+// afterAzServiceBusReceiveMessages links the receive span to the spans that
+// sent the received messages
+//
+//go:linkname afterAzServiceBusReceiveMessages github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus.afterAzServiceBusReceiveMessages
+func afterAzServiceBusReceiveMessages(call api.CallContext, messages []*azservicebus.ReceivedMessage, err error) {
+	data, ok := call.GetData().(azServiceBusReceiveData)
+	if !ok {
+		return
+	}
+	span := trace.SpanFromContext(data.ctx)
+	propagator := otel.GetTextMapPropagator()
+	for _, msg := range messages {
+		if msg == nil || msg.ApplicationProperties == nil {
+			continue
+		}
+		carrier := azServiceBusCarrier(msg.ApplicationProperties)
+		producer := trace.SpanContextFromContext(propagator.Extract(context.Background(), carrier))
+		if producer.IsValid() {
+			span.AddLink(trace.Link{SpanContext: producer})
+		}
+	}
+	azServiceBusConsumerInstrumenter.End(data.ctx, data.request, messages, err)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azservicebus
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+)
+
+// azServiceBusProducerReq is a message sent to a queue or a topic, entity is
+// the queue or the topic the sender was created for
+type azServiceBusProducerReq struct {
+	entity string
+	msg    *azservicebus.Message
+}
+
+// azServiceBusConsumerReq is a call receiving messages from a queue or a
+// topic subscription
+type azServiceBusConsumerReq struct {
+	entity       string
+	subscription string
+	maxMessages  int
+}
+
+// azServiceBusCarrier adapts the application properties of a message, whose
+// values may be of any type, to a text map carrier
+type azServiceBusCarrier map[string]any
+
+func (c azServiceBusCarrier) Get(key string) string {
+	if value, ok := c[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func (c azServiceBusCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c azServiceBusCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
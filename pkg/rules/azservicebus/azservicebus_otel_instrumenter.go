@@ -0,0 +1,240 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azservicebus
+
+import (
+	"context"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var azServiceBusEnabler = azServiceBusInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_AZSERVICEBUS_ENABLED") != "false"}
+
+var (
+	azServiceBusProducerInstrumenter = buildAzServiceBusProducerInstrumenter()
+	azServiceBusConsumerInstrumenter = buildAzServiceBusConsumerInstrumenter()
+)
+
+type azServiceBusInnerEnabler struct {
+	enabled bool
+}
+
+func (a azServiceBusInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+type azServiceBusSpanStatusExtractor[REQUEST any, RESPONSE any] struct{}
+
+func (a *azServiceBusSpanStatusExtractor[REQUEST, RESPONSE]) Extract(span trace.Span, request REQUEST, response RESPONSE, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type azServiceBusProducerAttrsGetter struct{}
+
+func (getter azServiceBusProducerAttrsGetter) GetSystem(request azServiceBusProducerReq) string {
+	return "servicebus"
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetDestination(request azServiceBusProducerReq) string {
+	return request.entity
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetDestinationTemplate(request azServiceBusProducerReq) string {
+	return ""
+}
+
+func (getter azServiceBusProducerAttrsGetter) IsTemporaryDestination(request azServiceBusProducerReq) bool {
+	return false
+}
+
+func (getter azServiceBusProducerAttrsGetter) IsAnonymousDestination(request azServiceBusProducerReq) bool {
+	return false
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetConversationId(request azServiceBusProducerReq) string {
+	if request.msg.CorrelationID != nil {
+		return *request.msg.CorrelationID
+	}
+	return ""
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetMessageBodySize(request azServiceBusProducerReq) int64 {
+	return int64(len(request.msg.Body))
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetMessageEnvelopSize(request azServiceBusProducerReq) int64 {
+	return 0
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetMessageId(request azServiceBusProducerReq, response any) string {
+	if request.msg.MessageID != nil {
+		return *request.msg.MessageID
+	}
+	return ""
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetClientId(request azServiceBusProducerReq) string {
+	return ""
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetBatchMessageCount(request azServiceBusProducerReq, response any) int64 {
+	return 1
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetMessageHeader(request azServiceBusProducerReq, name string) []string {
+	if value := azServiceBusCarrier(request.msg.ApplicationProperties).Get(name); value != "" {
+		return []string{value}
+	}
+	return nil
+}
+
+func (getter azServiceBusProducerAttrsGetter) GetDestinationPartitionId(request azServiceBusProducerReq) string {
+	return ""
+}
+
+type azServiceBusConsumerAttrsGetter struct{}
+
+func (getter azServiceBusConsumerAttrsGetter) GetSystem(request azServiceBusConsumerReq) string {
+	return "servicebus"
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetDestination(request azServiceBusConsumerReq) string {
+	return request.entity
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetDestinationTemplate(request azServiceBusConsumerReq) string {
+	return ""
+}
+
+func (getter azServiceBusConsumerAttrsGetter) IsTemporaryDestination(request azServiceBusConsumerReq) bool {
+	return false
+}
+
+func (getter azServiceBusConsumerAttrsGetter) IsAnonymousDestination(request azServiceBusConsumerReq) bool {
+	return false
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetConversationId(request azServiceBusConsumerReq) string {
+	return ""
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetMessageBodySize(request azServiceBusConsumerReq) int64 {
+	return 0
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetMessageEnvelopSize(request azServiceBusConsumerReq) int64 {
+	return 0
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetMessageId(request azServiceBusConsumerReq, response []*azservicebus.ReceivedMessage) string {
+	if len(response) == 1 {
+		return response[0].MessageID
+	}
+	return ""
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetClientId(request azServiceBusConsumerReq) string {
+	return ""
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetBatchMessageCount(request azServiceBusConsumerReq, response []*azservicebus.ReceivedMessage) int64 {
+	return int64(len(response))
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetMessageHeader(request azServiceBusConsumerReq, name string) []string {
+	return nil
+}
+
+func (getter azServiceBusConsumerAttrsGetter) GetDestinationPartitionId(request azServiceBusConsumerReq) string {
+	return ""
+}
+
+// azServiceBusConsumerAttrsExtractor records the subscription a topic is
+// received from
+type azServiceBusConsumerAttrsExtractor struct{}
+
+func (extractor *azServiceBusConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request azServiceBusConsumerReq) ([]attribute.KeyValue, context.Context) {
+	if request.subscription != "" {
+		attributes = append(attributes, semconv.MessagingDestinationSubscriptionName(request.subscription))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *azServiceBusConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request azServiceBusConsumerReq, response []*azservicebus.ReceivedMessage, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// the trace context is carried by the application properties of a message,
+// a message without application properties is given an empty map when it is
+// sent
+func buildAzServiceBusProducerInstrumenter() instrumenter.Instrumenter[azServiceBusProducerReq, any] {
+	builder := instrumenter.Builder[azServiceBusProducerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.AZURE_SERVICEBUS_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[azServiceBusProducerReq, any]{
+			Getter:        azServiceBusProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[azServiceBusProducerReq]{}).
+		SetSpanStatusExtractor(&azServiceBusSpanStatusExtractor[azServiceBusProducerReq, any]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[azServiceBusProducerReq, any, azServiceBusProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request azServiceBusProducerReq) propagation.TextMapCarrier {
+				return azServiceBusCarrier(request.msg.ApplicationProperties)
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+// a receive call returns several messages, each of them is linked to the
+// receive span instead of being its parent
+func buildAzServiceBusConsumerInstrumenter() instrumenter.Instrumenter[azServiceBusConsumerReq, []*azservicebus.ReceivedMessage] {
+	builder := instrumenter.Builder[azServiceBusConsumerReq, []*azservicebus.ReceivedMessage]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.AZURE_SERVICEBUS_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[azServiceBusConsumerReq, []*azservicebus.ReceivedMessage]{
+			Getter:        azServiceBusConsumerAttrsGetter{},
+			OperationName: message.RECEIVE,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[azServiceBusConsumerReq]{}).
+		SetSpanStatusExtractor(&azServiceBusSpanStatusExtractor[azServiceBusConsumerReq, []*azservicebus.ReceivedMessage]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[azServiceBusConsumerReq, []*azservicebus.ReceivedMessage, azServiceBusConsumerAttrsGetter]{
+			Operation: message.RECEIVE,
+		}).
+		AddAttributesExtractor(&azServiceBusConsumerAttrsExtractor{}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azservicebus
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+type azServiceBusSendData struct {
+	ctx     context.Context
+	request azServiceBusProducerReq
+}
+
+// the queue or the topic of a sender is kept in a field injected into the
+// sender, as the sender does not expose it
+//
+//go:linkname afterAzServiceBusNewSender github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus.afterAzServiceBusNewSender
+func afterAzServiceBusNewSender(call api.CallContext, sender *azservicebus.Sender, err error) {
+	if sender == nil {
+		return
+	}
+	if queueOrTopic, ok := call.GetParam(1).(string); ok {
+		sender.OtelEntity = queueOrTopic
+	}
+}
+
+//go:linkname beforeAzServiceBusSendMessage github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus.beforeAzServiceBusSendMessage
+func beforeAzServiceBusSendMessage(call api.CallContext, sender *azservicebus.Sender, ctx context.Context, msg *azservicebus.Message, options *azservicebus.SendMessageOptions) {
+	if !azServiceBusEnabler.Enable() || sender == nil || msg == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if msg.ApplicationProperties == nil {
+		msg.ApplicationProperties = make(map[string]any)
+	}
+	request := azServiceBusProducerReq{entity: sender.OtelEntity, msg: msg}
+	ctx = azServiceBusProducerInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	call.SetData(azServiceBusSendData{ctx: ctx, request: request})
+}
+
+//go:linkname afterAzServiceBusSendMessage github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus.afterAzServiceBusSendMessage
+func afterAzServiceBusSendMessage(call api.CallContext, err error) {
+	data, ok := call.GetData().(azServiceBusSendData)
+	if !ok {
+		return
+	}
+	azServiceBusProducerInstrumenter.End(data.ctx, data.request, nil, err)
+}
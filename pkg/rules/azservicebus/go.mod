@@ -0,0 +1,13 @@
+module github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/rules/azservicebus
+
+go 1.23.0
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg => ../../../pkg
+
+require (
+	github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus v1.2.0
+	github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg v0.0.0-00010101000000-000000000000
+	go.opentelemetry.io/otel v1.36.0
+	go.opentelemetry.io/otel/sdk v1.36.0
+	go.opentelemetry.io/otel/trace v1.36.0
+)
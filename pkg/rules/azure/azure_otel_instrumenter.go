@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var azureEnabler = azureInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_AZURE_ENABLED") != "false"}
+
+var azureInstrumenter = buildAzureInstrumenter()
+
+type azureInnerEnabler struct {
+	enabled bool
+}
+
+func (a azureInnerEnabler) Enable() bool {
+	return a.enabled
+}
+
+type azureSpanStatusExtractor struct{}
+
+func (a *azureSpanStatusExtractor) Extract(span trace.Span, request azureReq, response azureRes, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	} else if response.statusCode >= 400 {
+		span.SetStatus(codes.Error, "")
+	}
+}
+
+type azureRpcAttrsGetter struct{}
+
+func (getter azureRpcAttrsGetter) GetSystem(request azureReq) string {
+	return "azure"
+}
+
+func (getter azureRpcAttrsGetter) GetService(request azureReq) string {
+	return request.service
+}
+
+func (getter azureRpcAttrsGetter) GetMethod(request azureReq) string {
+	return request.operation
+}
+
+func (getter azureRpcAttrsGetter) GetServerAddress(request azureReq) string {
+	return request.host
+}
+
+// azureAttrsExtractor records the request ids azure services use to
+// correlate a request with their own logs
+type azureAttrsExtractor struct{}
+
+func (extractor *azureAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request azureReq) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (extractor *azureAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request azureReq, response azureRes, err error) ([]attribute.KeyValue, context.Context) {
+	if response.serviceRequestID != "" {
+		attributes = append(attributes, attribute.String("az.service_request_id", response.serviceRequestID))
+	}
+	if response.clientRequestID != "" {
+		attributes = append(attributes, attribute.String("az.client_request_id", response.clientRequestID))
+	}
+	if response.statusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	return attributes, ctx
+}
+
+func buildAzureInstrumenter() instrumenter.Instrumenter[azureReq, azureRes] {
+	builder := instrumenter.Builder[azureReq, azureRes]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.AZURE_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[azureReq]{Getter: azureRpcAttrsGetter{}}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[azureReq]{}).
+		SetSpanStatusExtractor(&azureSpanStatusExtractor{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[azureReq, azureRes, azureRpcAttrsGetter]{}).
+		AddAttributesExtractor(&azureAttrsExtractor{}).
+		BuildInstrumenter()
+}
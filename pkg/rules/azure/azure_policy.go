@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+const (
+	azureHeaderRequestID       = "x-ms-request-id"
+	azureHeaderClientRequestID = "x-ms-client-request-id"
+)
+
+// azurePolicy traces an operation of an azure service client. It is a per
+// call policy, so the retries of an operation are covered by a single span
+// and the request ids are the ones of the final response
+type azurePolicy struct {
+	service string
+}
+
+func newAzurePolicy(module string) *azurePolicy {
+	// module is the import path of the client module, e.g.
+	// github.com/Azure/azure-sdk-for-go/sdk/storage/azblob
+	return &azurePolicy{service: path.Base(module)}
+}
+
+func (p *azurePolicy) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	request := azureReq{
+		service:   p.service,
+		operation: azureOperation(raw),
+		host:      raw.URL.Host,
+	}
+	ctx := azureInstrumenter.Start(raw.Context(), request)
+	resp, err := req.WithContext(ctx).Next()
+	response := azureRes{
+		clientRequestID: raw.Header.Get(azureHeaderClientRequestID),
+	}
+	if resp != nil {
+		response.statusCode = resp.StatusCode
+		response.serviceRequestID = resp.Header.Get(azureHeaderRequestID)
+		if id := resp.Header.Get(azureHeaderClientRequestID); id != "" {
+			response.clientRequestID = id
+		}
+	}
+	azureInstrumenter.End(ctx, request, response, err)
+	return resp, err
+}
+
+// azureOperation names an operation after its http method, the storage
+// services tell operations sharing a method apart by the comp parameter, e.g.
+// "GET list" lists the blobs of a container
+func azureOperation(req *http.Request) string {
+	if comp := req.URL.Query().Get("comp"); comp != "" {
+		return req.Method + " " + comp
+	}
+	return req.Method
+}
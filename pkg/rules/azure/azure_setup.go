@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	_ "unsafe"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+// every azure service client, including the ones built by azcore.NewClient,
+// builds its pipeline through runtime.NewPipeline, the tracing policy is
+// appended to the per call policies of the client module
+//
+//go:linkname beforeAzureNewPipeline github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime.beforeAzureNewPipeline
+func beforeAzureNewPipeline(call api.CallContext, module, version string, plOpts runtime.PipelineOptions, options *policy.ClientOptions) {
+	if !azureEnabler.Enable() {
+		return
+	}
+	for _, p := range plOpts.PerCall {
+		if _, ok := p.(*azurePolicy); ok {
+			return
+		}
+	}
+	perCall := make([]policy.Policy, 0, len(plOpts.PerCall)+1)
+	perCall = append(perCall, plOpts.PerCall...)
+	plOpts.PerCall = append(perCall, newAzurePolicy(module))
+	call.SetParam(2, plOpts)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beego
+
+import (
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type beegoOrmAttrsGetter struct{}
+
+func (b beegoOrmAttrsGetter) GetSystem(request beegoOrmRequest) string {
+	return request.System
+}
+
+func (b beegoOrmAttrsGetter) GetServerAddress(request beegoOrmRequest) string {
+	return request.Addr
+}
+
+func (b beegoOrmAttrsGetter) GetStatement(_ beegoOrmRequest) string {
+	// Statements are built inside the orm, they are recorded by the spans of
+	// database/sql instead
+	return ""
+}
+
+func (b beegoOrmAttrsGetter) GetCollection(request beegoOrmRequest) string {
+	return request.Table
+}
+
+func (b beegoOrmAttrsGetter) GetOperation(request beegoOrmRequest) string {
+	return request.Operation
+}
+
+func (b beegoOrmAttrsGetter) GetParameters(_ beegoOrmRequest) []any {
+	return nil
+}
+
+func (b beegoOrmAttrsGetter) GetDbNamespace(request beegoOrmRequest) string {
+	return request.DbName
+}
+
+func (b beegoOrmAttrsGetter) GetBatchSize(_ beegoOrmRequest) int {
+	return 0
+}
+
+func BuildBeegoOrmInstrumenter() instrumenter.Instrumenter[beegoOrmRequest, interface{}] {
+	builder := instrumenter.Builder[beegoOrmRequest, interface{}]{}
+	getter := beegoOrmAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[beegoOrmRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[beegoOrmRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[beegoOrmRequest, any, beegoOrmAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[beegoOrmRequest, any, beegoOrmAttrsGetter]{Getter: getter}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.BEEGO_ORM_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beego
+
+import (
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/beego/beego/v2/server/web"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// The controller register is the handler of the beego http server, so the
+// server span of net/http is the local root span when the route is found, and
+// it is named by the pattern of the route, e.g. /user/:id, instead of the path.
+//
+//go:linkname beegoFindRouterOnExit github.com/beego/beego/v2/server/web.beegoFindRouterOnExit
+func beegoFindRouterOnExit(call api.CallContext, routerInfo *web.ControllerInfo, isFind bool) {
+	if !beegoEnabler.Enable() {
+		return
+	}
+	if !isFind || routerInfo == nil {
+		return
+	}
+	lcs := trace.LocalRootSpanFromGLS()
+	if lcs != nil && routerInfo.GetPattern() != "" {
+		lcs.SetName(routerInfo.GetPattern())
+	}
+}
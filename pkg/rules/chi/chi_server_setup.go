@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chi
+
+import (
+	"net/http"
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	chi "github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type chiInnerEnabler struct {
+	enabled bool
+}
+
+func (c chiInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+var chiEnabler = chiInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CHI_ENABLED") != "false"}
+
+// Routes are found once per router, i.e. a subrouter mounted by the parent
+// router finds the rest of the route after it, so the server span is named by
+// the pattern of the innermost router, which covers the whole route. As chi
+// keeps the request context of the server span intact through middlewares,
+// the span is always the local root span of the goroutine.
+//
+//go:linkname findRouteOnExit github.com/go-chi/chi/v5.findRouteOnExit
+func findRouteOnExit(call api.CallContext, n interface{}, eps interface{}, h http.Handler) {
+	if !chiEnabler.Enable() {
+		return
+	}
+	rctx, ok := call.GetParam(1).(*chi.Context)
+	if !ok || rctx == nil {
+		return
+	}
+	// the handler may be a nil HandlerFunc boxed in the interface, the
+	// pattern is only recorded once a route is matched
+	route := rctx.RoutePattern()
+	if route == "" {
+		return
+	}
+	lcs := trace.LocalRootSpanFromGLS()
+	if lcs == nil {
+		return
+	}
+	if rctx.RouteMethod != "" {
+		lcs.SetName(rctx.RouteMethod + " " + route)
+	} else {
+		lcs.SetName(route)
+	}
+	lcs.SetAttributes(semconv.HTTPRoute(route))
+}
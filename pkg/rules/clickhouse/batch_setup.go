@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouse
+
+import (
+	"context"
+	"sync"
+	_ "unsafe"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+// clickhouseBatch tracks a prepared batch until it is sent or aborted. Rows
+// and blocks already flushed to the server are accumulated here because the
+// batch itself forgets them after each flush.
+type clickhouseBatch struct {
+	ctx     context.Context
+	request clickhouseRequest
+	rows    int
+	blocks  int
+}
+
+// clickhouseBatches maps the unexported *batch and *httpBatch returned by
+// PrepareBatch to their tracking state
+var clickhouseBatches sync.Map
+
+type clickhouseBatchRows interface {
+	Rows() int
+}
+
+func pendingRows(b interface{}) int {
+	if r, ok := b.(clickhouseBatchRows); ok {
+		return r.Rows()
+	}
+	return 0
+}
+
+//go:linkname clickhousePrepareBatchOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhousePrepareBatchOnEnter
+func clickhousePrepareBatchOnEnter(call api.CallContext, ch interface{}, ctx context.Context, query string, opts ...driver.PrepareBatchOption) {
+	if !clickhouseEnabler.Enable() {
+		return
+	}
+	parent := ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	request := newClickhouseRequest(query, nil)
+	// Send reports the rows with the operation of the statement
+	batchRequest := request
+	request.Operation = "PREPARE"
+	if ctx, ok := clickhouseStart(call, ch, ctx, request); ok {
+		call.SetParam(1, ctx)
+		data := call.GetData().(map[string]interface{})
+		batchRequest.Addr = data["request"].(clickhouseRequest).Addr
+		batchRequest.DbName = data["request"].(clickhouseRequest).DbName
+		data["batch"] = &clickhouseBatch{ctx: parent, request: batchRequest}
+	}
+}
+
+//go:linkname clickhousePrepareBatchOnExit github.com/ClickHouse/clickhouse-go/v2.clickhousePrepareBatchOnExit
+func clickhousePrepareBatchOnExit(call api.CallContext, b driver.Batch, err error) {
+	clickhouseEnd(call, err)
+	if !clickhouseEnabler.Enable() || err != nil || b == nil {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	if state, ok := data["batch"].(*clickhouseBatch); ok {
+		clickhouseBatches.Store(b, state)
+	}
+}
+
+//go:linkname clickhouseBatchFlushOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseBatchFlushOnEnter
+func clickhouseBatchFlushOnEnter(call api.CallContext, b interface{}) {
+	if !clickhouseEnabler.Enable() {
+		return
+	}
+	state, ok := clickhouseBatches.Load(b)
+	if !ok {
+		return
+	}
+	data := make(map[string]interface{}, 2)
+	data["batch"] = state
+	data["rows"] = pendingRows(b)
+	call.SetData(data)
+}
+
+//go:linkname clickhouseBatchFlushOnExit github.com/ClickHouse/clickhouse-go/v2.clickhouseBatchFlushOnExit
+func clickhouseBatchFlushOnExit(call api.CallContext, err error) {
+	if !clickhouseEnabler.Enable() || err != nil {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	state, ok := data["batch"].(*clickhouseBatch)
+	if !ok {
+		return
+	}
+	if rows, _ := data["rows"].(int); rows > 0 {
+		state.rows += rows
+		state.blocks++
+	}
+}
+
+//go:linkname clickhouseBatchSendOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseBatchSendOnEnter
+func clickhouseBatchSendOnEnter(call api.CallContext, b interface{}) {
+	if !clickhouseEnabler.Enable() {
+		return
+	}
+	value, ok := clickhouseBatches.LoadAndDelete(b)
+	if !ok {
+		return
+	}
+	state := value.(*clickhouseBatch)
+	request := state.request
+	request.Batch = true
+	request.BatchRows = state.rows
+	request.BatchBlocks = state.blocks
+	if rows := pendingRows(b); rows > 0 {
+		request.BatchRows += rows
+		request.BatchBlocks++
+	}
+	ctx := clickhouseInstrumenter.Start(state.ctx, request)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname clickhouseBatchSendOnExit github.com/ClickHouse/clickhouse-go/v2.clickhouseBatchSendOnExit
+func clickhouseBatchSendOnExit(call api.CallContext, err error) {
+	clickhouseEnd(call, err)
+}
+
+//go:linkname clickhouseBatchAbortOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseBatchAbortOnEnter
+func clickhouseBatchAbortOnEnter(call api.CallContext, b interface{}) {
+	clickhouseBatches.Delete(b)
+}
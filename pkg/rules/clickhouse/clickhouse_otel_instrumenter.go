@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouse
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const (
+	clickhouseBatchRowsKey   = attribute.Key("clickhouse.batch.rows")
+	clickhouseBatchBlocksKey = attribute.Key("clickhouse.batch.blocks")
+)
+
+type clickhouseAttrsGetter struct{}
+
+func (c clickhouseAttrsGetter) GetSystem(_ clickhouseRequest) string {
+	return "clickhouse"
+}
+
+func (c clickhouseAttrsGetter) GetServerAddress(request clickhouseRequest) string {
+	return request.Addr
+}
+
+func (c clickhouseAttrsGetter) GetStatement(request clickhouseRequest) string {
+	return request.Statement
+}
+
+func (c clickhouseAttrsGetter) GetCollection(request clickhouseRequest) string {
+	return request.Collection
+}
+
+func (c clickhouseAttrsGetter) GetOperation(request clickhouseRequest) string {
+	return request.Operation
+}
+
+func (c clickhouseAttrsGetter) GetParameters(request clickhouseRequest) []any {
+	return request.Parameters
+}
+
+func (c clickhouseAttrsGetter) GetDbNamespace(request clickhouseRequest) string {
+	return request.DbName
+}
+
+func (c clickhouseAttrsGetter) GetBatchSize(_ clickhouseRequest) int {
+	return 0
+}
+
+type clickhouseBatchAttrsExtractor struct{}
+
+func (c clickhouseBatchAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request clickhouseRequest) ([]attribute.KeyValue, context.Context) {
+	if request.Batch {
+		attributes = append(attributes,
+			clickhouseBatchRowsKey.Int(request.BatchRows),
+			clickhouseBatchBlocksKey.Int(request.BatchBlocks))
+	}
+	return attributes, parentContext
+}
+
+func (c clickhouseBatchAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request clickhouseRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildClickhouseInstrumenter() instrumenter.Instrumenter[clickhouseRequest, interface{}] {
+	builder := instrumenter.Builder[clickhouseRequest, interface{}]{}
+	getter := clickhouseAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[clickhouseRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[clickhouseRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[clickhouseRequest, any, clickhouseAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[clickhouseRequest, any, clickhouseAttrsGetter]{Getter: getter}}, clickhouseBatchAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CLICKHOUSE_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
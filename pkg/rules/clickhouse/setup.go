@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouse
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	_ "unsafe"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+type clickhouseInnerEnabler struct {
+	enabled bool
+}
+
+func (c clickhouseInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+var clickhouseEnabler = clickhouseInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CLICKHOUSE_ENABLED") != "false"}
+
+var clickhouseInstrumenter = BuildClickhouseInstrumenter()
+
+type clickhouseConnInfo struct {
+	addr   string
+	dbName string
+}
+
+// clickhouseConns maps the unexported *clickhouse connection returned by
+// Open to the options it was opened with
+var clickhouseConns sync.Map
+
+func clickhouseStart(call api.CallContext, ch interface{}, ctx context.Context, request clickhouseRequest) (context.Context, bool) {
+	if !clickhouseEnabler.Enable() || ch == nil {
+		return nil, false
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if info, ok := clickhouseConns.Load(ch); ok {
+		request.Addr = info.(clickhouseConnInfo).addr
+		request.DbName = info.(clickhouseConnInfo).dbName
+	}
+	ctx = clickhouseInstrumenter.Start(ctx, request)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+	return ctx, true
+}
+
+func clickhouseEnd(call api.CallContext, err error) {
+	if !clickhouseEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(clickhouseRequest)
+	if !ok {
+		return
+	}
+	clickhouseInstrumenter.End(ctx, request, nil, err)
+}
+
+func clickhouseOperation(query string) string {
+	if fields := strings.Fields(query); len(fields) > 0 {
+		return strings.ToUpper(strings.TrimSuffix(fields[0], ";"))
+	}
+	return ""
+}
+
+// clickhouseCollection returns the table following INTO or FROM, which
+// covers the inserts of PrepareBatch as well as plain selects
+func clickhouseCollection(query string) string {
+	fields := strings.Fields(query)
+	for i := 0; i < len(fields)-1; i++ {
+		switch strings.ToUpper(fields[i]) {
+		case "INTO", "FROM":
+			table, _, _ := strings.Cut(fields[i+1], "(")
+			return strings.TrimSuffix(table, ";")
+		}
+	}
+	return ""
+}
+
+func newClickhouseRequest(query string, args []any) clickhouseRequest {
+	return clickhouseRequest{
+		Operation:  clickhouseOperation(query),
+		Statement:  query,
+		Collection: clickhouseCollection(query),
+		Parameters: args,
+	}
+}
+
+//go:linkname clickhouseOpenOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseOpenOnEnter
+func clickhouseOpenOnEnter(call api.CallContext, opt *clickhouse.Options) {
+	if !clickhouseEnabler.Enable() || opt == nil {
+		return
+	}
+	call.SetData(opt)
+}
+
+//go:linkname clickhouseOpenOnExit github.com/ClickHouse/clickhouse-go/v2.clickhouseOpenOnExit
+func clickhouseOpenOnExit(call api.CallContext, conn driver.Conn, err error) {
+	if !clickhouseEnabler.Enable() || err != nil || conn == nil {
+		return
+	}
+	opt, ok := call.GetData().(*clickhouse.Options)
+	if !ok || opt == nil {
+		return
+	}
+	clickhouseConns.Store(conn, clickhouseConnInfo{
+		addr:   strings.Join(opt.Addr, ","),
+		dbName: opt.Auth.Database,
+	})
+}
+
+//go:linkname clickhouseCloseOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseCloseOnEnter
+func clickhouseCloseOnEnter(call api.CallContext, ch interface{}) {
+	clickhouseConns.Delete(ch)
+}
+
+//go:linkname clickhouseQueryOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseQueryOnEnter
+func clickhouseQueryOnEnter(call api.CallContext, ch interface{}, ctx context.Context, query string, args ...any) {
+	if ctx, ok := clickhouseStart(call, ch, ctx, newClickhouseRequest(query, args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname clickhouseQueryOnExit github.com/ClickHouse/clickhouse-go/v2.clickhouseQueryOnExit
+func clickhouseQueryOnExit(call api.CallContext, rows driver.Rows, err error) {
+	clickhouseEnd(call, err)
+}
+
+//go:linkname clickhouseQueryRowOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseQueryRowOnEnter
+func clickhouseQueryRowOnEnter(call api.CallContext, ch interface{}, ctx context.Context, query string, args ...any) {
+	if ctx, ok := clickhouseStart(call, ch, ctx, newClickhouseRequest(query, args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname clickhouseQueryRowOnExit github.com/ClickHouse/clickhouse-go/v2.clickhouseQueryRowOnExit
+func clickhouseQueryRowOnExit(call api.CallContext, row driver.Row) {
+	var err error
+	if row != nil {
+		err = row.Err()
+	}
+	clickhouseEnd(call, err)
+}
+
+//go:linkname clickhouseExecOnEnter github.com/ClickHouse/clickhouse-go/v2.clickhouseExecOnEnter
+func clickhouseExecOnEnter(call api.CallContext, ch interface{}, ctx context.Context, query string, args ...any) {
+	if ctx, ok := clickhouseStart(call, ch, ctx, newClickhouseRequest(query, args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname clickhouseExecOnExit github.com/ClickHouse/clickhouse-go/v2.clickhouseExecOnExit
+func clickhouseExecOnExit(call api.CallContext, err error) {
+	clickhouseEnd(call, err)
+}
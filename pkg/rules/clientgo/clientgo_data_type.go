@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientgo
+
+import "net/url"
+
+type clientgoRequest struct {
+	// verb is the verb of the Kubernetes API, e.g. list or watch, while
+	// method is the HTTP method it is sent with.
+	verb        string
+	method      string
+	resource    string
+	subresource string
+	namespace   string
+	name        string
+	url         *url.URL
+}
+
+type clientgoResponse struct {
+	statusCode int
+}
+
+type clientgoReflectorRequest struct {
+	name     string
+	typeName string
+}
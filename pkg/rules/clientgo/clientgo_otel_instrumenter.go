@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientgo
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	clientgoVerbKey          = attribute.Key("k8s.api.verb")
+	clientgoResourceKey      = attribute.Key("k8s.api.resource")
+	clientgoSubresourceKey   = attribute.Key("k8s.api.subresource")
+	clientgoResourceNameKey  = attribute.Key("k8s.api.resource_name")
+	clientgoReflectorNameKey = attribute.Key("k8s.reflector.name")
+	clientgoReflectorTypeKey = attribute.Key("k8s.reflector.type")
+)
+
+type clientgoInnerEnabler struct {
+	enabled bool
+}
+
+func (c clientgoInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+var clientgoEnabler = clientgoInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CLIENTGO_ENABLED") != "false"}
+
+type clientgoSpanNameExtractor struct{}
+
+// Extract returns "{k8s.api.verb} {k8s.api.resource}", e.g. "list pods", or
+// the HTTP method for the requests that are not made to a resource.
+func (c clientgoSpanNameExtractor) Extract(request clientgoRequest) string {
+	if request.resource == "" {
+		return request.method
+	}
+	if request.subresource != "" {
+		return request.verb + " " + request.resource + "/" + request.subresource
+	}
+	return request.verb + " " + request.resource
+}
+
+type clientgoAttrsExtractor struct{}
+
+func (c clientgoAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request clientgoRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		clientgoVerbKey.String(request.verb),
+		semconv.HTTPRequestMethodKey.String(request.method),
+	)
+	if request.resource != "" {
+		attributes = append(attributes, clientgoResourceKey.String(request.resource))
+	}
+	if request.subresource != "" {
+		attributes = append(attributes, clientgoSubresourceKey.String(request.subresource))
+	}
+	if request.name != "" {
+		attributes = append(attributes, clientgoResourceNameKey.String(request.name))
+	}
+	if request.namespace != "" {
+		attributes = append(attributes, semconv.K8SNamespaceName(request.namespace))
+	}
+	if request.url != nil {
+		attributes = append(attributes, semconv.ServerAddress(request.url.Hostname()))
+		if port, err := strconv.Atoi(request.url.Port()); err == nil {
+			attributes = append(attributes, semconv.ServerPort(port))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (c clientgoAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request clientgoRequest, response clientgoResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.statusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	return attributes, context
+}
+
+// clientgoSpanStatusExtractor fails the span for both the errors and the
+// error responses of the API server, as the latter are only turned into
+// errors after the request has been made.
+type clientgoSpanStatusExtractor struct{}
+
+func (c clientgoSpanStatusExtractor) Extract(span trace.Span, request clientgoRequest, response clientgoResponse, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if response.statusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(response.statusCode))
+	}
+}
+
+func BuildClientgoRequestInstrumenter() instrumenter.Instrumenter[clientgoRequest, clientgoResponse] {
+	builder := instrumenter.Builder[clientgoRequest, clientgoResponse]{}
+	return builder.Init().SetSpanNameExtractor(clientgoSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[clientgoRequest]{}).
+		SetSpanStatusExtractor(clientgoSpanStatusExtractor{}).
+		AddAttributesExtractor(clientgoAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CLIENT_GO_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+type clientgoReflectorSpanNameExtractor struct{}
+
+// Extract returns "reflector list {k8s.reflector.type}", e.g.
+// "reflector list *v1.Pod".
+func (c clientgoReflectorSpanNameExtractor) Extract(request clientgoReflectorRequest) string {
+	return "reflector list " + request.typeName
+}
+
+type clientgoReflectorAttrsExtractor struct{}
+
+func (c clientgoReflectorAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request clientgoReflectorRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		clientgoReflectorNameKey.String(request.name),
+		clientgoReflectorTypeKey.String(request.typeName),
+	)
+	return attributes, parentContext
+}
+
+func (c clientgoReflectorAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request clientgoReflectorRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildClientgoReflectorInstrumenter() instrumenter.Instrumenter[clientgoReflectorRequest, any] {
+	builder := instrumenter.Builder[clientgoReflectorRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(clientgoReflectorSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[clientgoReflectorRequest]{}).
+		AddAttributesExtractor(clientgoReflectorAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CLIENT_GO_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientgo
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"k8s.io/client-go/tools/cache"
+)
+
+var clientgoReflectorInstrumenter = BuildClientgoReflectorInstrumenter()
+
+type clientgoReflectorCall struct {
+	ctx     context.Context
+	request clientgoReflectorRequest
+}
+
+// clientgoReflectorListOnEnter starts the span of the initial list, or relist,
+// of an informer, whose requests are made on a goroutine of their own.
+//
+//go:linkname clientgoReflectorListOnEnter k8s.io/client-go/tools/cache.clientgoReflectorListOnEnter
+func clientgoReflectorListOnEnter(call api.CallContext, r *cache.Reflector, stopCh <-chan struct{}) {
+	startReflectorList(call, r, context.Background())
+}
+
+// since client-go v0.34.0
+//
+//go:linkname clientgoReflectorListWithContextOnEnter k8s.io/client-go/tools/cache.clientgoReflectorListWithContextOnEnter
+func clientgoReflectorListWithContextOnEnter(call api.CallContext, r *cache.Reflector, ctx context.Context) {
+	startReflectorList(call, r, ctx)
+}
+
+//go:linkname clientgoReflectorListOnExit k8s.io/client-go/tools/cache.clientgoReflectorListOnExit
+func clientgoReflectorListOnExit(call api.CallContext, err error) {
+	if !clientgoEnabler.Enable() {
+		return
+	}
+	c, ok := call.GetData().(*clientgoReflectorCall)
+	if !ok || c == nil {
+		return
+	}
+	clientgoReflectorInstrumenter.End(c.ctx, c.request, nil, err)
+}
+
+func startReflectorList(call api.CallContext, r *cache.Reflector, ctx context.Context) {
+	if !clientgoEnabler.Enable() || r == nil {
+		return
+	}
+	request := clientgoReflectorRequest{name: r.Name(), typeName: r.TypeDescription()}
+	newCtx := clientgoReflectorInstrumenter.Start(ctx, request)
+	call.SetData(&clientgoReflectorCall{ctx: newCtx, request: request})
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+)
+
+var clientgoRequestInstrumenter = BuildClientgoRequestInstrumenter()
+
+type clientgoCall struct {
+	ctx      context.Context
+	request  clientgoRequest
+	response *clientgoResponse
+}
+
+//go:linkname clientgoRequestOnEnter k8s.io/client-go/rest.clientgoRequestOnEnter
+func clientgoRequestOnEnter(call api.CallContext, r *rest.Request, ctx context.Context, fn func(*http.Request, *http.Response)) {
+	if !clientgoEnabler.Enable() || r == nil {
+		return
+	}
+	request := newClientgoRequest(r, false)
+	response := &clientgoResponse{}
+	newCtx := clientgoRequestInstrumenter.Start(ctx, request)
+	call.SetParam(1, newCtx)
+	if fn != nil {
+		// the response is only handed to fn once the retries are done
+		call.SetParam(2, func(req *http.Request, resp *http.Response) {
+			response.statusCode = resp.StatusCode
+			fn(req, resp)
+		})
+	}
+	call.SetData(&clientgoCall{ctx: newCtx, request: request, response: response})
+}
+
+//go:linkname clientgoRequestOnExit k8s.io/client-go/rest.clientgoRequestOnExit
+func clientgoRequestOnExit(call api.CallContext, err error) {
+	if !clientgoEnabler.Enable() {
+		return
+	}
+	c, ok := call.GetData().(*clientgoCall)
+	if !ok || c == nil {
+		return
+	}
+	clientgoRequestInstrumenter.End(c.ctx, c.request, *c.response, err)
+}
+
+//go:linkname clientgoWatchOnEnter k8s.io/client-go/rest.clientgoWatchOnEnter
+func clientgoWatchOnEnter(call api.CallContext, r *rest.Request, ctx context.Context) {
+	if !clientgoEnabler.Enable() || r == nil {
+		return
+	}
+	request := newClientgoRequest(r, true)
+	newCtx := clientgoRequestInstrumenter.Start(ctx, request)
+	call.SetParam(1, newCtx)
+	call.SetData(&clientgoCall{ctx: newCtx, request: request})
+}
+
+// clientgoWatchOnExit ends the span once the watch is established, rather
+// than when the stream of events is closed.
+//
+//go:linkname clientgoWatchOnExit k8s.io/client-go/rest.clientgoWatchOnExit
+func clientgoWatchOnExit(call api.CallContext, w watch.Interface, err error) {
+	if !clientgoEnabler.Enable() {
+		return
+	}
+	c, ok := call.GetData().(*clientgoCall)
+	if !ok || c == nil {
+		return
+	}
+	// a watch is only established for a response of 200 OK
+	response := clientgoResponse{statusCode: http.StatusOK}
+	if err != nil {
+		response.statusCode = 0
+		var status apierrors.APIStatus
+		if errors.As(err, &status) {
+			response.statusCode = int(status.Status().Code)
+		}
+	}
+	clientgoRequestInstrumenter.End(c.ctx, c.request, response, err)
+}
+
+func newClientgoRequest(r *rest.Request, watch bool) clientgoRequest {
+	v := reflect.ValueOf(r).Elem()
+	field := func(name string) string {
+		if f := v.FieldByName(name); f.Kind() == reflect.String {
+			return f.String()
+		}
+		return ""
+	}
+	request := clientgoRequest{
+		method:      field("verb"),
+		resource:    field("resource"),
+		subresource: field("subresource"),
+		namespace:   field("namespace"),
+		name:        field("resourceName"),
+		url:         r.URL(),
+	}
+	if watch || request.url.Query().Get("watch") == "true" {
+		request.verb = "watch"
+	} else {
+		request.verb = clientgoVerb(request.method, request.name)
+	}
+	return request
+}
+
+// clientgoVerb returns the verb of the Kubernetes API for the HTTP method of
+// a request, as the API server does.
+func clientgoVerb(method, name string) string {
+	switch method {
+	case http.MethodGet:
+		if name == "" {
+			return "list"
+		}
+		return "get"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if name == "" {
+			return "deletecollection"
+		}
+		return "delete"
+	}
+	return strings.ToLower(method)
+}
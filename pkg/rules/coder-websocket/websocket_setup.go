@@ -0,0 +1,233 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/coder/websocket"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type websocketInnerEnabler struct {
+	enabled bool
+}
+
+func (w websocketInnerEnabler) Enable() bool {
+	return w.enabled
+}
+
+var websocketEnabler = websocketInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CODER_WEBSOCKET_ENABLED") != "false"}
+
+var (
+	websocketServerInstrumenter = buildWebsocketServerInstrumenter()
+	websocketClientInstrumenter = buildWebsocketClientInstrumenter()
+)
+
+// websocketConnState is attached to the connection once the handshake
+// succeeds, the same as the gorilla/websocket instrumentation does.
+type websocketConnState struct {
+	ctx          context.Context
+	request      websocketRequest
+	instrumenter instrumenter.Instrumenter[websocketRequest, websocketResponse]
+	sentID       int64
+	receivedID   int64
+	endOnce      sync.Once
+}
+
+func (s *websocketConnState) addMessageEvent(messageType attribute.KeyValue, id int64, wsType websocket.MessageType, size int) {
+	trace.SpanFromContext(s.ctx).AddEvent("message", trace.WithAttributes(
+		messageType,
+		semconv.RPCMessageIDKey.Int64(id),
+		semconv.RPCMessageUncompressedSizeKey.Int(size),
+		websocketMessageTypeKey.String(messageTypeName(wsType)),
+	))
+}
+
+func (s *websocketConnState) end(conn *websocket.Conn, err error) {
+	s.endOnce.Do(func() {
+		s.instrumenter.End(s.ctx, s.request, websocketResponse{subprotocol: conn.Subprotocol()}, err)
+	})
+}
+
+func getConnState(conn *websocket.Conn) *websocketConnState {
+	if conn == nil {
+		return nil
+	}
+	state, _ := conn.OtelConnState.(*websocketConnState)
+	return state
+}
+
+func messageTypeName(messageType websocket.MessageType) string {
+	switch messageType {
+	case websocket.MessageText:
+		return "text"
+	case websocket.MessageBinary:
+		return "binary"
+	}
+	return "unknown"
+}
+
+//go:linkname acceptOnEnter github.com/coder/websocket.acceptOnEnter
+func acceptOnEnter(call api.CallContext, w http.ResponseWriter, r *http.Request, opts *websocket.AcceptOptions) {
+	if !websocketEnabler.Enable() || r == nil {
+		return
+	}
+	parentCtx := r.Context()
+	if !trace.SpanContextFromContext(parentCtx).IsValid() {
+		parentCtx = otel.GetTextMapPropagator().Extract(parentCtx, propagation.HeaderCarrier(r.Header))
+	}
+	request := websocketRequest{
+		url:      r.URL,
+		host:     r.Host,
+		peerAddr: r.RemoteAddr,
+		header:   r.Header,
+	}
+	ctx := websocketServerInstrumenter.Start(parentCtx, request)
+	call.SetData(&websocketConnState{
+		ctx:          ctx,
+		request:      request,
+		instrumenter: websocketServerInstrumenter,
+	})
+}
+
+//go:linkname acceptOnExit github.com/coder/websocket.acceptOnExit
+func acceptOnExit(call api.CallContext, conn *websocket.Conn, err error) {
+	state, ok := call.GetData().(*websocketConnState)
+	if !ok || state == nil {
+		return
+	}
+	if err != nil || conn == nil {
+		state.instrumenter.End(state.ctx, state.request, websocketResponse{}, err)
+		return
+	}
+	conn.OtelConnState = state
+}
+
+//go:linkname dialOnEnter github.com/coder/websocket.dialOnEnter
+func dialOnEnter(call api.CallContext, ctx context.Context, urlStr string, opts *websocket.DialOptions) {
+	if !websocketEnabler.Enable() || ctx == nil {
+		return
+	}
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return
+	}
+	// Work on copies of the options and the handshake header so that the
+	// trace context is never written into anything owned by the caller.
+	newOpts := &websocket.DialOptions{}
+	if opts != nil {
+		*newOpts = *opts
+	}
+	header := make(http.Header, len(newOpts.HTTPHeader)+2)
+	for k, v := range newOpts.HTTPHeader {
+		header[k] = v
+	}
+	newOpts.HTTPHeader = header
+	request := websocketRequest{
+		url:    u,
+		host:   u.Host,
+		header: header,
+	}
+	newCtx := websocketClientInstrumenter.Start(ctx, request)
+	call.SetParam(0, newCtx)
+	call.SetParam(2, newOpts)
+	call.SetData(&websocketConnState{
+		ctx:          newCtx,
+		request:      request,
+		instrumenter: websocketClientInstrumenter,
+	})
+}
+
+//go:linkname dialOnExit github.com/coder/websocket.dialOnExit
+func dialOnExit(call api.CallContext, conn *websocket.Conn, resp *http.Response, err error) {
+	state, ok := call.GetData().(*websocketConnState)
+	if !ok || state == nil {
+		return
+	}
+	if err != nil || conn == nil {
+		state.instrumenter.End(state.ctx, state.request, websocketResponse{}, err)
+		return
+	}
+	conn.OtelConnState = state
+}
+
+//go:linkname readOnExit github.com/coder/websocket.readOnExit
+func readOnExit(call api.CallContext, messageType websocket.MessageType, p []byte, err error) {
+	conn, ok := call.GetParam(0).(*websocket.Conn)
+	if !ok {
+		return
+	}
+	state := getConnState(conn)
+	if state == nil {
+		return
+	}
+	if err != nil {
+		// The connection is closed once a read fails, so the connection span
+		// ends here even if the application never calls Close.
+		switch websocket.CloseStatus(err) {
+		case websocket.StatusNormalClosure, websocket.StatusGoingAway, websocket.StatusNoStatusRcvd:
+			err = nil
+		}
+		state.end(conn, err)
+		return
+	}
+	state.addMessageEvent(semconv.RPCMessageTypeReceived, atomic.AddInt64(&state.receivedID, 1), messageType, len(p))
+}
+
+//go:linkname writeOnExit github.com/coder/websocket.writeOnExit
+func writeOnExit(call api.CallContext, err error) {
+	conn, ok := call.GetParam(0).(*websocket.Conn)
+	if !ok || err != nil {
+		return
+	}
+	state := getConnState(conn)
+	if state == nil {
+		return
+	}
+	messageType, _ := call.GetParam(2).(websocket.MessageType)
+	data, _ := call.GetParam(3).([]byte)
+	state.addMessageEvent(semconv.RPCMessageTypeSent, atomic.AddInt64(&state.sentID, 1), messageType, len(data))
+}
+
+//go:linkname closeOnEnter github.com/coder/websocket.closeOnEnter
+func closeOnEnter(call api.CallContext, conn *websocket.Conn, code websocket.StatusCode, reason string) {
+	state := getConnState(conn)
+	if state == nil {
+		return
+	}
+	state.end(conn, nil)
+}
+
+//go:linkname closeNowOnEnter github.com/coder/websocket.closeNowOnEnter
+func closeNowOnEnter(call api.CallContext, conn *websocket.Conn) {
+	state := getConnState(conn)
+	if state == nil {
+		return
+	}
+	state.end(conn, nil)
+}
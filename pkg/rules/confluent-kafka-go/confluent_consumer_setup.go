@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"context"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// confluentConsumerGroups maps a Consumer to its group id
+var confluentConsumerGroups sync.Map
+
+//go:linkname beforeConfluentNewConsumer github.com/confluentinc/confluent-kafka-go/v2/kafka.beforeConfluentNewConsumer
+func beforeConfluentNewConsumer(call api.CallContext, conf *kafka.ConfigMap) {
+	if !confluentEnabler.Enable() || conf == nil {
+		return
+	}
+	if groupID, err := conf.Get("group.id", ""); err == nil {
+		if id, ok := groupID.(string); ok && id != "" {
+			call.SetData(id)
+		}
+	}
+}
+
+//go:linkname afterConfluentNewConsumer github.com/confluentinc/confluent-kafka-go/v2/kafka.afterConfluentNewConsumer
+func afterConfluentNewConsumer(call api.CallContext, consumer *kafka.Consumer, err error) {
+	groupID, ok := call.GetData().(string)
+	if !ok || consumer == nil {
+		return
+	}
+	confluentConsumerGroups.Store(consumer, groupID)
+}
+
+//go:linkname beforeConfluentConsumerClose github.com/confluentinc/confluent-kafka-go/v2/kafka.beforeConfluentConsumerClose
+func beforeConfluentConsumerClose(call api.CallContext, consumer *kafka.Consumer) {
+	confluentConsumerGroups.Delete(consumer)
+}
+
+type confluentPollData struct {
+	consumer       *kafka.Consumer
+	startTimestamp time.Time
+}
+
+// Poll is traced rather than ReadMessage, which polls until it gets a message
+//
+//go:linkname beforeConfluentPoll github.com/confluentinc/confluent-kafka-go/v2/kafka.beforeConfluentPoll
+func beforeConfluentPoll(call api.CallContext, consumer *kafka.Consumer, timeoutMs int) {
+	if !confluentEnabler.Enable() {
+		return
+	}
+	call.SetData(confluentPollData{consumer: consumer, startTimestamp: time.Now()})
+}
+
+//go:linkname afterConfluentPoll github.com/confluentinc/confluent-kafka-go/v2/kafka.afterConfluentPoll
+func afterConfluentPoll(call api.CallContext, event kafka.Event) {
+	data, ok := call.GetData().(confluentPollData)
+	if !ok {
+		return
+	}
+	msg, ok := event.(*kafka.Message)
+	if !ok || msg == nil {
+		return
+	}
+	request := confluentConsumerReq{msg: msg}
+	if groupID, ok := confluentConsumerGroups.Load(data.consumer); ok {
+		request.groupID = groupID.(string)
+	}
+	confluentConsumerInstrumenter.StartAndEnd(context.Background(), request, nil, msg.TopicPartition.Error, data.startTimestamp, time.Now())
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var confluentEnabler = confluentInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CONFLUENT_KAFKA_ENABLED") != "false"}
+
+var (
+	confluentProducerInstrumenter = buildConfluentProducerInstrumenter()
+	confluentConsumerInstrumenter = buildConfluentConsumerInstrumenter()
+)
+
+type confluentInnerEnabler struct {
+	enabled bool
+}
+
+func (c confluentInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+// confluentMessageCarrier injects the trace context into the headers of a
+// produced message and extracts it from the headers of a consumed one, an
+// existing header with the same key is overwritten
+type confluentMessageCarrier struct {
+	msg *kafka.Message
+}
+
+func (carrier confluentMessageCarrier) Get(key string) string {
+	for _, header := range carrier.msg.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (carrier confluentMessageCarrier) Set(key, value string) {
+	for i, header := range carrier.msg.Headers {
+		if header.Key == key {
+			carrier.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	carrier.msg.Headers = append(carrier.msg.Headers, kafka.Header{
+		Key:   key,
+		Value: []byte(value),
+	})
+}
+
+func (carrier confluentMessageCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.msg.Headers))
+	for _, header := range carrier.msg.Headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+type confluentSpanStatusExtractor[REQUEST any] struct{}
+
+func (c *confluentSpanStatusExtractor[REQUEST]) Extract(span trace.Span, request REQUEST, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type confluentProducerAttrsGetter struct{}
+
+func (getter confluentProducerAttrsGetter) GetSystem(request confluentProducerReq) string {
+	return "kafka"
+}
+
+func (getter confluentProducerAttrsGetter) GetDestination(request confluentProducerReq) string {
+	return confluentTopic(request.msg)
+}
+
+func (getter confluentProducerAttrsGetter) GetDestinationTemplate(request confluentProducerReq) string {
+	return ""
+}
+
+func (getter confluentProducerAttrsGetter) IsTemporaryDestination(request confluentProducerReq) bool {
+	return false
+}
+
+func (getter confluentProducerAttrsGetter) IsAnonymousDestination(request confluentProducerReq) bool {
+	return false
+}
+
+func (getter confluentProducerAttrsGetter) GetConversationId(request confluentProducerReq) string {
+	return ""
+}
+
+func (getter confluentProducerAttrsGetter) GetMessageBodySize(request confluentProducerReq) int64 {
+	return int64(len(request.msg.Value))
+}
+
+func (getter confluentProducerAttrsGetter) GetMessageEnvelopSize(request confluentProducerReq) int64 {
+	return 0
+}
+
+func (getter confluentProducerAttrsGetter) GetMessageId(request confluentProducerReq, response any) string {
+	return ""
+}
+
+func (getter confluentProducerAttrsGetter) GetClientId(request confluentProducerReq) string {
+	return ""
+}
+
+func (getter confluentProducerAttrsGetter) GetBatchMessageCount(request confluentProducerReq, response any) int64 {
+	return 1
+}
+
+func (getter confluentProducerAttrsGetter) GetMessageHeader(request confluentProducerReq, name string) []string {
+	return confluentHeaderValues(request.msg, name)
+}
+
+// GetDestinationPartitionId returns nothing as the partition of a produced
+// message is only known once it is delivered, see confluentProducerAttrsExtractor
+func (getter confluentProducerAttrsGetter) GetDestinationPartitionId(request confluentProducerReq) string {
+	return ""
+}
+
+type confluentConsumerAttrsGetter struct{}
+
+func (getter confluentConsumerAttrsGetter) GetSystem(request confluentConsumerReq) string {
+	return "kafka"
+}
+
+func (getter confluentConsumerAttrsGetter) GetDestination(request confluentConsumerReq) string {
+	return confluentTopic(request.msg)
+}
+
+func (getter confluentConsumerAttrsGetter) GetDestinationTemplate(request confluentConsumerReq) string {
+	return ""
+}
+
+func (getter confluentConsumerAttrsGetter) IsTemporaryDestination(request confluentConsumerReq) bool {
+	return false
+}
+
+func (getter confluentConsumerAttrsGetter) IsAnonymousDestination(request confluentConsumerReq) bool {
+	return false
+}
+
+func (getter confluentConsumerAttrsGetter) GetConversationId(request confluentConsumerReq) string {
+	return ""
+}
+
+func (getter confluentConsumerAttrsGetter) GetMessageBodySize(request confluentConsumerReq) int64 {
+	return int64(len(request.msg.Value))
+}
+
+func (getter confluentConsumerAttrsGetter) GetMessageEnvelopSize(request confluentConsumerReq) int64 {
+	return 0
+}
+
+func (getter confluentConsumerAttrsGetter) GetMessageId(request confluentConsumerReq, response any) string {
+	return ""
+}
+
+func (getter confluentConsumerAttrsGetter) GetClientId(request confluentConsumerReq) string {
+	return ""
+}
+
+func (getter confluentConsumerAttrsGetter) GetBatchMessageCount(request confluentConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter confluentConsumerAttrsGetter) GetMessageHeader(request confluentConsumerReq, name string) []string {
+	return confluentHeaderValues(request.msg, name)
+}
+
+func (getter confluentConsumerAttrsGetter) GetDestinationPartitionId(request confluentConsumerReq) string {
+	return strconv.Itoa(int(request.msg.TopicPartition.Partition))
+}
+
+func confluentTopic(msg *kafka.Message) string {
+	if msg.TopicPartition.Topic == nil {
+		return ""
+	}
+	return *msg.TopicPartition.Topic
+}
+
+func confluentHeaderValues(msg *kafka.Message, name string) []string {
+	var values []string
+	for _, header := range msg.Headers {
+		if header.Key == name {
+			values = append(values, string(header.Value))
+		}
+	}
+	return values
+}
+
+// confluentProducerAttrsExtractor records the kafka specific attributes of a
+// produced message, the partition and offset are taken from its delivery report
+type confluentProducerAttrsExtractor struct{}
+
+func (extractor *confluentProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request confluentProducerReq) ([]attribute.KeyValue, context.Context) {
+	if request.msg.Key != nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(request.msg.Key)))
+	}
+	if request.msg.Value == nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageTombstone(true))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *confluentProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request confluentProducerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if err != nil || request.msg.TopicPartition.Offset < 0 {
+		return attributes, ctx
+	}
+	return append(attributes,
+		semconv.MessagingDestinationPartitionID(strconv.Itoa(int(request.msg.TopicPartition.Partition))),
+		semconv.MessagingKafkaOffset(int(request.msg.TopicPartition.Offset)),
+	), ctx
+}
+
+type confluentConsumerAttrsExtractor struct{}
+
+func (extractor *confluentConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request confluentConsumerReq) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.MessagingKafkaOffset(int(request.msg.TopicPartition.Offset)))
+	if request.groupID != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.groupID))
+	}
+	if request.msg.Key != nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(request.msg.Key)))
+	}
+	if request.msg.Value == nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageTombstone(true))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *confluentConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request confluentConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func buildConfluentProducerInstrumenter() instrumenter.Instrumenter[confluentProducerReq, any] {
+	builder := instrumenter.Builder[confluentProducerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CONFLUENT_KAFKA_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[confluentProducerReq, any]{
+			Getter:        confluentProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[confluentProducerReq]{}).
+		SetSpanStatusExtractor(&confluentSpanStatusExtractor[confluentProducerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[confluentProducerReq, any, confluentProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&confluentProducerAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request confluentProducerReq) propagation.TextMapCarrier {
+				return confluentMessageCarrier{msg: request.msg}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func buildConfluentConsumerInstrumenter() instrumenter.Instrumenter[confluentConsumerReq, any] {
+	builder := instrumenter.Builder[confluentConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CONFLUENT_KAFKA_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[confluentConsumerReq, any]{
+			Getter:        confluentConsumerAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[confluentConsumerReq]{}).
+		SetSpanStatusExtractor(&confluentSpanStatusExtractor[confluentConsumerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[confluentConsumerReq, any, confluentConsumerAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&confluentConsumerAttrsExtractor{}).
+		BuildPropagatingFromUpstreamInstrumenter(
+			func(request confluentConsumerReq) propagation.TextMapCarrier {
+				return confluentMessageCarrier{msg: request.msg}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
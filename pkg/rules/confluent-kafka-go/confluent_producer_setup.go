@@ -0,0 +1,207 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluent
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// confluentDeliveryReports holds the delivery reports polled by a producer
+// until the opaque of their messages is looked up, they are keyed by the
+// handle of the producer and the id librdkafka carries for the message
+var confluentDeliveryReports sync.Map
+
+type confluentDeliveryKey struct {
+	handle interface{}
+	cgoid  int
+}
+
+// confluentDelivery replaces the opaque of a produced message, it travels with
+// the message through librdkafka so that its span ends once the broker acks
+// the message, the opaque of the application is restored before the delivery
+// report is handed to it
+type confluentDelivery struct {
+	opaque interface{}
+	ctx    context.Context
+	once   sync.Once
+}
+
+func (d *confluentDelivery) end(msg *kafka.Message, err error) {
+	d.once.Do(func() {
+		confluentProducerInstrumenter.End(d.ctx, confluentProducerReq{msg: msg}, nil, err)
+	})
+}
+
+type confluentProduceData struct {
+	msg      *kafka.Message
+	ctx      context.Context
+	delivery *confluentDelivery
+}
+
+// confluentForwardsDr tells whether the producer forwards delivery reports to
+// its events channel, i.e. go.delivery.reports is not disabled. The flag is an
+// unexported field of the handle of the producer, the field is looked up once
+var (
+	confluentFwdDrOnce  sync.Once
+	confluentFwdDrIndex []int
+)
+
+func confluentForwardsDr(p *kafka.Producer) bool {
+	confluentFwdDrOnce.Do(func() {
+		handle, ok := reflect.TypeOf((*kafka.Producer)(nil)).Elem().FieldByName("handle")
+		if !ok || handle.Type.Kind() != reflect.Struct {
+			return
+		}
+		fwdDr, ok := handle.Type.FieldByName("fwdDr")
+		if !ok || fwdDr.Type.Kind() != reflect.Bool {
+			return
+		}
+		confluentFwdDrIndex = append(handle.Index, fwdDr.Index...)
+	})
+	if confluentFwdDrIndex == nil {
+		return true
+	}
+	return reflect.ValueOf(p).Elem().FieldByIndex(confluentFwdDrIndex).Bool()
+}
+
+// confluentCallerContext returns the context of the span the goroutine calling
+// Produce is in
+func confluentCallerContext() context.Context {
+	ctx := context.Background()
+	if span := trace.SpanFromContext(ctx); span.SpanContext().IsValid() {
+		ctx = trace.ContextWithSpan(ctx, span)
+	}
+	return ctx
+}
+
+// startConfluentProducer starts the span of a message in the goroutine calling
+// Produce, the span is detached from it right away as it ends in the goroutine
+// polling the delivery reports
+func startConfluentProducer(msg *kafka.Message) context.Context {
+	ctx := confluentProducerInstrumenter.Start(confluentCallerContext(), confluentProducerReq{msg: msg})
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(ctx))
+	return ctx
+}
+
+//go:linkname beforeConfluentProduce github.com/confluentinc/confluent-kafka-go/v2/kafka.beforeConfluentProduce
+func beforeConfluentProduce(call api.CallContext, p *kafka.Producer, msg *kafka.Message, msgFlags int, deliveryChan chan kafka.Event) {
+	if !confluentEnabler.Enable() || msg == nil || msg.TopicPartition.Topic == nil {
+		return
+	}
+	if deliveryChan == nil && !confluentForwardsDr(p) {
+		// Nobody waits for the delivery report, so the span ends once the
+		// message is enqueued, the opaque is left untouched
+		ctx := confluentProducerInstrumenter.Start(confluentCallerContext(), confluentProducerReq{msg: msg})
+		call.SetData(confluentProduceData{msg: msg, ctx: ctx})
+		return
+	}
+	delivery := &confluentDelivery{opaque: msg.Opaque, ctx: startConfluentProducer(msg)}
+	msg.Opaque = delivery
+	call.SetData(confluentProduceData{msg: msg, delivery: delivery})
+}
+
+//go:linkname afterConfluentProduce github.com/confluentinc/confluent-kafka-go/v2/kafka.afterConfluentProduce
+func afterConfluentProduce(call api.CallContext, err error) {
+	data, ok := call.GetData().(confluentProduceData)
+	if !ok {
+		return
+	}
+	if data.delivery == nil {
+		confluentProducerInstrumenter.End(data.ctx, confluentProducerReq{msg: data.msg}, nil, err)
+		return
+	}
+	data.msg.Opaque = data.delivery.opaque
+	if err != nil {
+		data.delivery.end(data.msg, err)
+	}
+}
+
+// beforeConfluentChannelProducer runs in the goroutine producing the messages
+// sent on ProduceChannel, which inherits the span of the goroutine creating
+// the producer, the span is unrelated to the messages and is dropped so that
+// they are traced as roots unless their headers carry a parent
+//
+//go:linkname beforeConfluentChannelProducer github.com/confluentinc/confluent-kafka-go/v2/kafka.beforeConfluentChannelProducer
+func beforeConfluentChannelProducer(call api.CallContext, p *kafka.Producer) {
+	if span := trace.SpanFromContext(context.Background()); span.SpanContext().IsValid() {
+		sdktrace.DetachSpanFromGLS(span)
+	}
+}
+
+//go:linkname afterConfluentNewMessageFromC github.com/confluentinc/confluent-kafka-go/v2/kafka.afterConfluentNewMessageFromC
+func afterConfluentNewMessageFromC(call api.CallContext, msg *kafka.Message) {
+	if msg == nil {
+		return
+	}
+	// the delivery report is only built from the C message, which carries the
+	// id of the opaque looked up right after
+	cmsg := reflect.ValueOf(call.GetParam(1))
+	if cmsg.Kind() != reflect.Ptr || cmsg.IsNil() {
+		return
+	}
+	private := cmsg.Elem().FieldByName("_private")
+	if !private.IsValid() || private.Kind() != reflect.UnsafePointer || private.Pointer() == 0 {
+		return
+	}
+	key := confluentDeliveryKey{handle: call.GetParam(0), cgoid: int(private.Pointer())}
+	confluentDeliveryReports.Store(key, msg)
+}
+
+//go:linkname afterConfluentCgoGet github.com/confluentinc/confluent-kafka-go/v2/kafka.afterConfluentCgoGet
+func afterConfluentCgoGet(call api.CallContext, cg interface{}, found bool) {
+	if !found || cg == nil {
+		return
+	}
+	// cgoGet returns the cgoDr struct holding the delivery channel and the
+	// opaque of the message, which is unexported and is returned by value.
+	// The opaque is swapped on an addressable copy of it, reflect refuses to
+	// set unexported fields so the field is accessed by its address. The rules
+	// are limited to the versions whose cgoDr is known to hold the opaque as an
+	// interface{} field, any other layout is left untouched
+	v := reflect.New(reflect.TypeOf(cg)).Elem()
+	v.Set(reflect.ValueOf(cg))
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	field := v.FieldByName("opaque")
+	if !field.IsValid() || field.Kind() != reflect.Interface {
+		return
+	}
+	opaque := reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+	delivery, ok := opaque.Interface().(*confluentDelivery)
+	if !ok {
+		return
+	}
+	if delivery.opaque == nil {
+		opaque.Set(reflect.Zero(field.Type()))
+	} else {
+		opaque.Set(reflect.ValueOf(delivery.opaque))
+	}
+	call.SetReturnVal(0, v.Interface())
+
+	key := confluentDeliveryKey{handle: call.GetParam(0), cgoid: call.GetParam(1).(int)}
+	if report, ok := confluentDeliveryReports.LoadAndDelete(key); ok {
+		msg := report.(*kafka.Message)
+		delivery.end(msg, msg.TopicPartition.Error)
+	}
+}
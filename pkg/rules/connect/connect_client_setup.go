@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import (
+	_ "unsafe"
+
+	"connectrpc.com/connect"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+//go:linkname newClientConfigOnEnter connectrpc.com/connect.newClientConfigOnEnter
+func newClientConfigOnEnter(call api.CallContext, rawURL string, options []connect.ClientOption) {
+	if !connectEnabler.Enable() {
+		return
+	}
+	opts := make([]connect.ClientOption, 0, len(options)+1)
+	opts = append(opts, connect.WithInterceptors(otelInterceptor{}))
+	opts = append(opts, options...)
+	call.SetParam(1, opts)
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	connectClientInstrumenter = BuildConnectClientInstrumenter()
+	connectServerInstrumenter = BuildConnectServerInstrumenter()
+)
+
+// otelInterceptor is installed as the outermost interceptor of every Connect
+// client and handler, so that it sees the calls before any user interceptor.
+type otelInterceptor struct{}
+
+func newConnectRequest(spec connect.Spec, peer connect.Peer) connectRequest {
+	return connectRequest{
+		procedure:     spec.Procedure,
+		serverAddress: peer.Addr,
+		streamType:    spec.StreamType,
+	}
+}
+
+// serverParentContext returns the context the server span starts from. The
+// net/http instrumentation has normally extracted the upstream context
+// already, the headers are only extracted when nothing has done it before.
+func serverParentContext(ctx context.Context, header http.Header) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+func (o otelInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !connectEnabler.Enable() {
+			return next(ctx, req)
+		}
+		request := newConnectRequest(req.Spec(), req.Peer())
+		if req.Spec().IsClient {
+			ctx = connectClientInstrumenter.Start(ctx, request)
+			otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header()))
+			resp, err := next(ctx, req)
+			connectClientInstrumenter.End(ctx, request, connectResponse{}, err)
+			return resp, err
+		}
+		ctx = connectServerInstrumenter.Start(serverParentContext(ctx, req.Header()), request)
+		resp, err := next(ctx, req)
+		connectServerInstrumenter.End(ctx, request, connectResponse{}, err)
+		return resp, err
+	}
+}
+
+func (o otelInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		if !connectEnabler.Enable() {
+			return next(ctx, spec)
+		}
+		request := newConnectRequest(spec, connect.Peer{})
+		ctx = connectClientInstrumenter.Start(ctx, request)
+		conn := next(ctx, spec)
+		// The peer is only known once the connection has been created
+		request.serverAddress = conn.Peer().Addr
+		trace.SpanFromContext(ctx).SetAttributes(semconv.ServerAddress(request.serverAddress))
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(conn.RequestHeader()))
+		return &tracedClientConn{
+			StreamingClientConn: conn,
+			ctx:                 ctx,
+			request:             request,
+		}
+	}
+}
+
+func (o otelInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !connectEnabler.Enable() {
+			return next(ctx, conn)
+		}
+		request := newConnectRequest(conn.Spec(), conn.Peer())
+		ctx = connectServerInstrumenter.Start(serverParentContext(ctx, conn.RequestHeader()), request)
+		err := next(ctx, &tracedHandlerConn{
+			StreamingHandlerConn: conn,
+			span:                 trace.SpanFromContext(ctx),
+		})
+		connectServerInstrumenter.End(ctx, request, connectResponse{}, err)
+		return err
+	}
+}
+
+// tracedClientConn records the messages of a streaming call as the events of
+// the client span and ends the span once the response is closed.
+type tracedClientConn struct {
+	connect.StreamingClientConn
+	ctx        context.Context
+	request    connectRequest
+	sentID     int64
+	receivedID int64
+	err        error
+	errMu      sync.Mutex
+	endOnce    sync.Once
+}
+
+func (c *tracedClientConn) Send(msg any) error {
+	err := c.StreamingClientConn.Send(msg)
+	if err == nil {
+		addMessageEvent(trace.SpanFromContext(c.ctx), semconv.RPCMessageTypeSent, atomic.AddInt64(&c.sentID, 1))
+	} else if !errors.Is(err, io.EOF) {
+		c.setErr(err)
+	}
+	return err
+}
+
+func (c *tracedClientConn) Receive(msg any) error {
+	err := c.StreamingClientConn.Receive(msg)
+	if err == nil {
+		addMessageEvent(trace.SpanFromContext(c.ctx), semconv.RPCMessageTypeReceived, atomic.AddInt64(&c.receivedID, 1))
+	} else if !errors.Is(err, io.EOF) {
+		c.setErr(err)
+	}
+	return err
+}
+
+func (c *tracedClientConn) CloseResponse() error {
+	err := c.StreamingClientConn.CloseResponse()
+	c.endOnce.Do(func() {
+		c.errMu.Lock()
+		defer c.errMu.Unlock()
+		connectClientInstrumenter.End(c.ctx, c.request, connectResponse{}, c.err)
+	})
+	return err
+}
+
+func (c *tracedClientConn) setErr(err error) {
+	c.errMu.Lock()
+	defer c.errMu.Unlock()
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+type tracedHandlerConn struct {
+	connect.StreamingHandlerConn
+	span       trace.Span
+	sentID     int64
+	receivedID int64
+}
+
+func (c *tracedHandlerConn) Send(msg any) error {
+	err := c.StreamingHandlerConn.Send(msg)
+	if err == nil {
+		addMessageEvent(c.span, semconv.RPCMessageTypeSent, atomic.AddInt64(&c.sentID, 1))
+	}
+	return err
+}
+
+func (c *tracedHandlerConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil {
+		addMessageEvent(c.span, semconv.RPCMessageTypeReceived, atomic.AddInt64(&c.receivedID, 1))
+	}
+	return err
+}
+
+func addMessageEvent(span trace.Span, messageType attribute.KeyValue, id int64) {
+	span.AddEvent("message", trace.WithAttributes(
+		messageType,
+		semconv.RPCMessageIDKey.Int64(id),
+	))
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type connectInnerEnabler struct {
+	enabled bool
+}
+
+func (c connectInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+var connectEnabler = connectInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_CONNECT_ENABLED") != "false"}
+
+type connectAttrsGetter struct {
+}
+
+func (c connectAttrsGetter) GetSystem(request connectRequest) string {
+	return semconv.RPCSystemConnectRPC.Value.AsString()
+}
+
+// GetService returns "acme.foo.v1.FooService" of the procedure
+// "/acme.foo.v1.FooService/Bar".
+func (c connectAttrsGetter) GetService(request connectRequest) string {
+	procedure := strings.TrimPrefix(request.procedure, "/")
+	slashIndex := strings.LastIndex(procedure, "/")
+	if slashIndex == -1 {
+		return ""
+	}
+	return procedure[:slashIndex]
+}
+
+func (c connectAttrsGetter) GetMethod(request connectRequest) string {
+	slashIndex := strings.LastIndex(request.procedure, "/")
+	if slashIndex == -1 {
+		return ""
+	}
+	return request.procedure[slashIndex+1:]
+}
+
+func (c connectAttrsGetter) GetServerAddress(request connectRequest) string {
+	return request.serverAddress
+}
+
+type connectErrorCodeExtractor struct{}
+
+func (c connectErrorCodeExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request connectRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (c connectErrorCodeExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request connectRequest, response connectResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if err == nil {
+		return attributes, context
+	}
+	code := connect.CodeOf(err)
+	if code == connect.CodeCanceled {
+		// The semantic conventions spell it "cancelled"
+		return append(attributes, semconv.RPCConnectRPCErrorCodeCancelled), context
+	}
+	return append(attributes, semconv.RPCConnectRPCErrorCodeKey.String(code.String())), context
+}
+
+func BuildConnectClientInstrumenter() instrumenter.Instrumenter[connectRequest, connectResponse] {
+	builder := instrumenter.Builder[connectRequest, connectResponse]{}
+	clientGetter := connectAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[connectRequest]{Getter: clientGetter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[connectRequest]{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[connectRequest, connectResponse, connectAttrsGetter]{Base: rpc.RpcAttrsExtractor[connectRequest, connectResponse, connectAttrsGetter]{Getter: clientGetter}}, connectErrorCodeExtractor{}).
+		AddOperationListeners(rpc.RpcClientMetrics("connect.client")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CONNECT_CLIENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func BuildConnectServerInstrumenter() instrumenter.Instrumenter[connectRequest, connectResponse] {
+	builder := instrumenter.Builder[connectRequest, connectResponse]{}
+	serverGetter := connectAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[connectRequest]{Getter: serverGetter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[connectRequest]{}).
+		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[connectRequest, connectResponse, connectAttrsGetter]{Base: rpc.RpcAttrsExtractor[connectRequest, connectResponse, connectAttrsGetter]{Getter: serverGetter}}, connectErrorCodeExtractor{}).
+		AddOperationListeners(rpc.RpcServerMetrics("connect.server")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.CONNECT_SERVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package databasesql
+
+import (
+	"reflect"
+	"strings"
+)
+
+// driverPackages maps the import paths of well-known drivers to the names
+// they are usually registered with, so that a driver opened through
+// sql.OpenDB or reached at the driver level still gets its database system.
+var driverPackages = []struct {
+	path       string
+	driverName string
+}{
+	{"github.com/go-sql-driver/mysql", "mysql"},
+	{"github.com/lib/pq", "postgres"},
+	{"github.com/jackc/pgx", "pgx"},
+	{"github.com/mattn/go-sqlite3", "sqlite3"},
+	{"modernc.org/sqlite", "sqlite"},
+	{"github.com/glebarez/go-sqlite", "sqlite"},
+	{"github.com/ncruces/go-sqlite3", "sqlite3"},
+	{"github.com/microsoft/go-mssqldb", "sqlserver"},
+	{"github.com/denisenkom/go-mssqldb", "sqlserver"},
+	{"github.com/sijms/go-ora", "oracle"},
+	{"github.com/godror/godror", "godror"},
+	{"github.com/ClickHouse/clickhouse-go", "clickhouse"},
+	{"github.com/trinodb/trino-go-client", "trino"},
+	{"github.com/googleapis/go-sql-spanner", "spanner"},
+	{"github.com/SAP/go-hdb", "hdb"},
+	{"github.com/nakagami/firebirdsql", "firebirdsql"},
+}
+
+// driverNameOf infers the driver name from the package of the driver, or of
+// the driver.Conn, driver.Stmt or driver.Tx it returns.
+func driverNameOf(v any) string {
+	if v == nil {
+		return ""
+	}
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	pkgPath := t.PkgPath()
+	for _, p := range driverPackages {
+		if pkgPath == p.path || strings.HasPrefix(pkgPath, p.path+"/") {
+			return p.driverName
+		}
+	}
+	return ""
+}
+
+// dbSystem infers db.system.name from the driver name, databases without a
+// well-known driver fall back to other_sql.
+func dbSystem(driverName string) string {
+	switch driverName {
+	case "mysql":
+		return "mysql"
+	case "postgres", "postgresql", "pgx":
+		return "postgresql"
+	case "sqlite", "sqlite3":
+		return "sqlite"
+	case "sqlserver", "mssql":
+		return "microsoft.sql_server"
+	case "oracle", "godror":
+		return "oracle.db"
+	case "clickhouse":
+		return "clickhouse"
+	case "trino":
+		return "trino"
+	case "spanner":
+		return "gcp.spanner"
+	case "hdb":
+		return "sap.hana"
+	case "firebirdsql":
+		return "firebirdsql"
+	}
+	return "other_sql"
+}
@@ -26,15 +26,7 @@ type databaseSqlAttrsGetter struct {
 }
 
 func (d databaseSqlAttrsGetter) GetSystem(request databaseSqlRequest) string {
-	switch request.driverName {
-	case "mysql":
-		return "mysql"
-	case "postgres":
-		fallthrough
-	case "postgresql":
-		return "postgresql"
-	}
-	return "database"
+	return dbSystem(request.driverName)
 }
 
 func (d databaseSqlAttrsGetter) GetServerAddress(request databaseSqlRequest) string {
@@ -85,3 +77,15 @@ func BuildDatabaseSqlOtelInstrumenter() instrumenter.Instrumenter[databaseSqlReq
 		}).AddOperationListeners(db.DbClientMetrics("database.sql")).
 		BuildInstrumenter()
 }
+
+func BuildDatabaseSqlDriverOtelInstrumenter() instrumenter.Instrumenter[databaseSqlRequest, any] {
+	builder := instrumenter.Builder[databaseSqlRequest, any]{}
+	getter := databaseSqlAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[databaseSqlRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[databaseSqlRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[databaseSqlRequest, any, db.DbClientAttrsGetter[databaseSqlRequest]]{Base: db.DbClientCommonAttrsExtractor[databaseSqlRequest, any, db.DbClientAttrsGetter[databaseSqlRequest]]{Getter: getter}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.DATABASE_SQL_DRIVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
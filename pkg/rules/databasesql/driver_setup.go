@@ -0,0 +1,235 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package databasesql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var databaseSqlDriverInstrumenter = BuildDatabaseSqlDriverOtelInstrumenter()
+
+type dbSqlDriverInnerEnabler struct {
+	enabled bool
+}
+
+func (d dbSqlDriverInnerEnabler) Enable() bool {
+	return d.enabled
+}
+
+var dbSqlDriverEnabler = dbSqlDriverInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_DATABASESQL_DRIVER_ENABLED") != "false"}
+
+type driverInfoKey struct{}
+
+// withDriverInfo carries the endpoint of the database down to the driver
+// level, where only the driver.Conn is at hand.
+func withDriverInfo(ctx context.Context, endpoint, driverName, dsn string) context.Context {
+	if ctx == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, driverInfoKey{}, databaseSqlRequest{
+		endpoint:   endpoint,
+		driverName: driverName,
+		dsn:        dsn,
+	})
+}
+
+// driverTraced reports whether the call of the driver is already covered by a
+// span of database/sql. The driver level only traces what database/sql does
+// not, such as preparing statements, or everything if database/sql is disabled.
+func driverTraced(ctx context.Context) bool {
+	span, ok := trace.SpanFromContext(ctx).(sdktrace.ReadOnlySpan)
+	if !ok {
+		return false
+	}
+	scopeName := span.InstrumentationScope().Name
+	return scopeName == utils.DATABASE_SQL_SCOPE_NAME || scopeName == utils.DATABASE_SQL_DRIVER_SCOPE_NAME
+}
+
+func driverInstrumentStart(call api.CallContext, ctx context.Context, opType, query string, source any, nvdargs []driver.NamedValue) (context.Context, bool) {
+	if !dbSqlDriverEnabler.Enable() {
+		return nil, false
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if driverTraced(ctx) {
+		return nil, false
+	}
+	req, _ := ctx.Value(driverInfoKey{}).(databaseSqlRequest)
+	if req.driverName == "" {
+		req.driverName = driverNameOf(source)
+	}
+	req.opType = opType
+	req.sql = query
+	if len(nvdargs) > 0 {
+		req.params = make([]any, 0, len(nvdargs))
+		for _, arg := range nvdargs {
+			req.params = append(req.params, arg.Value)
+		}
+	}
+	newCtx := databaseSqlDriverInstrumenter.Start(ctx, req)
+	call.SetData(map[string]interface{}{
+		"dbRequest": req,
+		"newCtx":    newCtx,
+	})
+	return newCtx, true
+}
+
+func driverInstrumentEnd(call api.CallContext, err error) (databaseSqlRequest, bool) {
+	callData, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return databaseSqlRequest{}, false
+	}
+	dbRequest, ok := callData["dbRequest"].(databaseSqlRequest)
+	if !ok {
+		return databaseSqlRequest{}, false
+	}
+	newCtx, ok := callData["newCtx"].(context.Context)
+	if !ok {
+		return databaseSqlRequest{}, false
+	}
+	// database/sql falls back to prepared statements on driver.ErrSkip
+	if errors.Is(err, driver.ErrSkip) {
+		err = nil
+	}
+	databaseSqlDriverInstrumenter.End(newCtx, dbRequest, nil, err)
+	return dbRequest, true
+}
+
+// driverTx traces the commit and rollback of transactions begun at the driver
+// level, database/sql calls them on the driver.Tx without a context.
+type driverTx struct {
+	driver.Tx
+	request databaseSqlRequest
+}
+
+func (t *driverTx) Commit() error {
+	return t.finish("COMMIT", t.Tx.Commit)
+}
+
+func (t *driverTx) Rollback() error {
+	return t.finish("ROLLBACK", t.Tx.Rollback)
+}
+
+func (t *driverTx) finish(query string, fn func() error) error {
+	ctx := context.Background()
+	if !dbSqlDriverEnabler.Enable() || driverTraced(ctx) {
+		return fn()
+	}
+	req := t.request
+	req.opType = query
+	req.sql = query
+	req.params = nil
+	ctx = databaseSqlDriverInstrumenter.Start(ctx, req)
+	err := fn()
+	databaseSqlDriverInstrumenter.End(ctx, req, nil, err)
+	return err
+}
+
+//go:linkname beforeCtxDriverPrepareInstrumentation database/sql.beforeCtxDriverPrepareInstrumentation
+func beforeCtxDriverPrepareInstrumentation(call api.CallContext, ctx context.Context, ci driver.Conn, query string) {
+	if newCtx, ok := driverInstrumentStart(call, ctx, "PREPARE", query, ci, nil); ok {
+		call.SetParam(0, newCtx)
+	}
+}
+
+//go:linkname afterCtxDriverPrepareInstrumentation database/sql.afterCtxDriverPrepareInstrumentation
+func afterCtxDriverPrepareInstrumentation(call api.CallContext, si driver.Stmt, err error) {
+	driverInstrumentEnd(call, err)
+}
+
+//go:linkname beforeCtxDriverExecInstrumentation database/sql.beforeCtxDriverExecInstrumentation
+func beforeCtxDriverExecInstrumentation(call api.CallContext, ctx context.Context, execerCtx driver.ExecerContext, execer driver.Execer, query string, nvdargs []driver.NamedValue) {
+	var source any = execer
+	if execerCtx != nil {
+		source = execerCtx
+	}
+	if newCtx, ok := driverInstrumentStart(call, ctx, calOp(query), query, source, nvdargs); ok {
+		call.SetParam(0, newCtx)
+	}
+}
+
+//go:linkname afterCtxDriverExecInstrumentation database/sql.afterCtxDriverExecInstrumentation
+func afterCtxDriverExecInstrumentation(call api.CallContext, res driver.Result, err error) {
+	driverInstrumentEnd(call, err)
+}
+
+//go:linkname beforeCtxDriverQueryInstrumentation database/sql.beforeCtxDriverQueryInstrumentation
+func beforeCtxDriverQueryInstrumentation(call api.CallContext, ctx context.Context, queryerCtx driver.QueryerContext, queryer driver.Queryer, query string, nvdargs []driver.NamedValue) {
+	var source any = queryer
+	if queryerCtx != nil {
+		source = queryerCtx
+	}
+	if newCtx, ok := driverInstrumentStart(call, ctx, calOp(query), query, source, nvdargs); ok {
+		call.SetParam(0, newCtx)
+	}
+}
+
+//go:linkname afterCtxDriverQueryInstrumentation database/sql.afterCtxDriverQueryInstrumentation
+func afterCtxDriverQueryInstrumentation(call api.CallContext, rows driver.Rows, err error) {
+	driverInstrumentEnd(call, err)
+}
+
+// The statement of a driver.Stmt is not exposed, such spans are named EXECUTE
+
+//go:linkname beforeCtxDriverStmtExecInstrumentation database/sql.beforeCtxDriverStmtExecInstrumentation
+func beforeCtxDriverStmtExecInstrumentation(call api.CallContext, ctx context.Context, si driver.Stmt, nvdargs []driver.NamedValue) {
+	if newCtx, ok := driverInstrumentStart(call, ctx, "EXECUTE", "", si, nvdargs); ok {
+		call.SetParam(0, newCtx)
+	}
+}
+
+//go:linkname afterCtxDriverStmtExecInstrumentation database/sql.afterCtxDriverStmtExecInstrumentation
+func afterCtxDriverStmtExecInstrumentation(call api.CallContext, res driver.Result, err error) {
+	driverInstrumentEnd(call, err)
+}
+
+//go:linkname beforeCtxDriverStmtQueryInstrumentation database/sql.beforeCtxDriverStmtQueryInstrumentation
+func beforeCtxDriverStmtQueryInstrumentation(call api.CallContext, ctx context.Context, si driver.Stmt, nvdargs []driver.NamedValue) {
+	if newCtx, ok := driverInstrumentStart(call, ctx, "EXECUTE", "", si, nvdargs); ok {
+		call.SetParam(0, newCtx)
+	}
+}
+
+//go:linkname afterCtxDriverStmtQueryInstrumentation database/sql.afterCtxDriverStmtQueryInstrumentation
+func afterCtxDriverStmtQueryInstrumentation(call api.CallContext, rows driver.Rows, err error) {
+	driverInstrumentEnd(call, err)
+}
+
+//go:linkname beforeCtxDriverBeginInstrumentation database/sql.beforeCtxDriverBeginInstrumentation
+func beforeCtxDriverBeginInstrumentation(call api.CallContext, ctx context.Context, opts *sql.TxOptions, ci driver.Conn) {
+	if newCtx, ok := driverInstrumentStart(call, ctx, "START", "START TRANSACTION", ci, nil); ok {
+		call.SetParam(0, newCtx)
+	}
+}
+
+//go:linkname afterCtxDriverBeginInstrumentation database/sql.afterCtxDriverBeginInstrumentation
+func afterCtxDriverBeginInstrumentation(call api.CallContext, txi driver.Tx, err error) {
+	dbRequest, ok := driverInstrumentEnd(call, err)
+	if !ok || txi == nil {
+		return
+	}
+	call.SetReturnVal(0, &driverTx{Tx: txi, request: dbRequest})
+}
@@ -17,6 +17,7 @@ package databasesql
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"log"
 	"os"
 	"strings"
@@ -94,6 +95,32 @@ func afterOpenInstrumentation(call api.CallContext, db *sql.DB, err error) {
 	}
 }
 
+//go:linkname beforeOpenDBInstrumentation database/sql.beforeOpenDBInstrumentation
+func beforeOpenDBInstrumentation(call api.CallContext, c driver.Connector) {
+	if !dbSqlEnabler.Enable() {
+		return
+	}
+	if c == nil {
+		return
+	}
+	call.SetData(driverNameOf(c.Driver()))
+}
+
+//go:linkname afterOpenDBInstrumentation database/sql.afterOpenDBInstrumentation
+func afterOpenDBInstrumentation(call api.CallContext, db *sql.DB) {
+	if !dbSqlEnabler.Enable() {
+		return
+	}
+	if db == nil {
+		return
+	}
+	// sql.Open overrides it with the registered name of the driver
+	driverName, ok := call.GetData().(string)
+	if ok {
+		db.DriverName = driverName
+	}
+}
+
 //go:linkname beforePingContextInstrumentation database/sql.beforePingContextInstrumentation
 func beforePingContextInstrumentation(call api.CallContext, db *sql.DB, ctx context.Context) {
 	if !dbSqlEnabler.Enable() {
@@ -102,7 +129,7 @@ func beforePingContextInstrumentation(call api.CallContext, db *sql.DB, ctx cont
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "ping", "ping", db.Endpoint, db.DriverName, db.DSN)
+	call.SetParam(1, instrumentStart(call, ctx, "ping", "ping", db.Endpoint, db.DriverName, db.DSN))
 }
 
 //go:linkname afterPingContextInstrumentation database/sql.afterPingContextInstrumentation
@@ -127,6 +154,7 @@ func beforePrepareContextInstrumentation(call api.CallContext, db *sql.DB, ctx c
 		"driver":   db.DriverName,
 		"dsn":      db.DSN,
 	})
+	call.SetParam(1, withDriverInfo(ctx, db.Endpoint, db.DriverName, db.DSN))
 }
 
 //go:linkname afterPrepareContextInstrumentation database/sql.afterPrepareContextInstrumentation
@@ -157,7 +185,7 @@ func beforeExecContextInstrumentation(call api.CallContext, db *sql.DB, ctx cont
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "exec", query, db.Endpoint, db.DriverName, db.DSN, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "exec", query, db.Endpoint, db.DriverName, db.DSN, args...))
 }
 
 //go:linkname afterExecContextInstrumentation database/sql.afterExecContextInstrumentation
@@ -176,7 +204,7 @@ func beforeQueryContextInstrumentation(call api.CallContext, db *sql.DB, ctx con
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "query", query, db.Endpoint, db.DriverName, db.DSN, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "query", query, db.Endpoint, db.DriverName, db.DSN, args...))
 }
 
 //go:linkname afterQueryContextInstrumentation database/sql.afterQueryContextInstrumentation
@@ -195,7 +223,7 @@ func beforeTxInstrumentation(call api.CallContext, db *sql.DB, ctx context.Conte
 	if db == nil {
 		return
 	}
-	instrumentStart(call, ctx, "begin", "START TRANSACTION", db.Endpoint, db.DriverName, db.DSN)
+	call.SetParam(1, instrumentStart(call, ctx, "begin", "START TRANSACTION", db.Endpoint, db.DriverName, db.DSN))
 }
 
 //go:linkname afterTxInstrumentation database/sql.afterTxInstrumentation
@@ -269,7 +297,7 @@ func beforeConnPingContextInstrumentation(call api.CallContext, conn *sql.Conn,
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "ping", "ping", conn.Endpoint, conn.DriverName, conn.DSN)
+	call.SetParam(1, instrumentStart(call, ctx, "ping", "ping", conn.Endpoint, conn.DriverName, conn.DSN))
 }
 
 //go:linkname afterConnPingContextInstrumentation database/sql.afterConnPingContextInstrumentation
@@ -294,6 +322,7 @@ func beforeConnPrepareContextInstrumentation(call api.CallContext, conn *sql.Con
 		"driver":   conn.DriverName,
 		"dsn":      conn.DSN,
 	})
+	call.SetParam(1, withDriverInfo(ctx, conn.Endpoint, conn.DriverName, conn.DSN))
 }
 
 //go:linkname afterConnPrepareContextInstrumentation database/sql.afterConnPrepareContextInstrumentation
@@ -324,7 +353,7 @@ func beforeConnExecContextInstrumentation(call api.CallContext, conn *sql.Conn,
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "exec", query, conn.Endpoint, conn.DriverName, conn.DSN, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "exec", query, conn.Endpoint, conn.DriverName, conn.DSN, args...))
 }
 
 //go:linkname afterConnExecContextInstrumentation database/sql.afterConnExecContextInstrumentation
@@ -343,7 +372,7 @@ func beforeConnQueryContextInstrumentation(call api.CallContext, conn *sql.Conn,
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "query", query, conn.Endpoint, conn.DriverName, conn.DSN, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "query", query, conn.Endpoint, conn.DriverName, conn.DSN, args...))
 }
 
 //go:linkname afterConnQueryContextInstrumentation database/sql.afterConnQueryContextInstrumentation
@@ -362,7 +391,7 @@ func beforeConnTxInstrumentation(call api.CallContext, conn *sql.Conn, ctx conte
 	if conn == nil {
 		return
 	}
-	instrumentStart(call, ctx, "start", "START TRANSACTION", conn.Endpoint, conn.DriverName, conn.DSN)
+	call.SetParam(1, instrumentStart(call, ctx, "start", "START TRANSACTION", conn.Endpoint, conn.DriverName, conn.DSN))
 }
 
 //go:linkname afterConnTxInstrumentation database/sql.afterConnTxInstrumentation
@@ -387,6 +416,7 @@ func beforeTxPrepareContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx
 		"driver":   tx.DriverName,
 		"dsn":      tx.DSN,
 	})
+	call.SetParam(1, withDriverInfo(ctx, tx.Endpoint, tx.DriverName, tx.DSN))
 }
 
 //go:linkname afterTxPrepareContextInstrumentation database/sql.afterTxPrepareContextInstrumentation
@@ -422,6 +452,7 @@ func beforeTxStmtContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx co
 		"driver":   stmt.Data["driver"],
 		"dsn":      stmt.DSN,
 	})
+	call.SetParam(1, withDriverInfo(ctx, stmt.Data["endpoint"], stmt.Data["driver"], stmt.DSN))
 }
 
 //go:linkname afterTxStmtContextInstrumentation database/sql.afterTxStmtContextInstrumentation
@@ -459,7 +490,7 @@ func beforeTxExecContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx co
 	if tx == nil {
 		return
 	}
-	instrumentStart(call, ctx, "exec", query, tx.Endpoint, tx.DriverName, tx.DSN, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "exec", query, tx.Endpoint, tx.DriverName, tx.DSN, args...))
 }
 
 //go:linkname afterTxExecContextInstrumentation database/sql.afterTxExecContextInstrumentation
@@ -478,7 +509,7 @@ func beforeTxQueryContextInstrumentation(call api.CallContext, tx *sql.Tx, ctx c
 	if tx == nil {
 		return
 	}
-	instrumentStart(call, ctx, "query", query, tx.Endpoint, tx.DriverName, tx.DSN, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "query", query, tx.Endpoint, tx.DriverName, tx.DSN, args...))
 }
 
 //go:linkname afterTxQueryContextInstrumentation database/sql.afterTxQueryContextInstrumentation
@@ -539,7 +570,7 @@ func beforeStmtExecContextInstrumentation(call api.CallContext, stmt *sql.Stmt,
 	if stmt.Data != nil {
 		sql, endpoint, driverName, dsn = stmt.Data["sql"], stmt.Data["endpoint"], stmt.Data["driver"], stmt.DSN
 	}
-	instrumentStart(call, ctx, "exec", sql, endpoint, driverName, dsn, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "exec", sql, endpoint, driverName, dsn, args...))
 }
 
 //go:linkname afterStmtExecContextInstrumentation database/sql.afterStmtExecContextInstrumentation
@@ -562,7 +593,7 @@ func beforeStmtQueryContextInstrumentation(call api.CallContext, stmt *sql.Stmt,
 	if stmt.Data != nil {
 		sql, endpoint, driverName, dsn = stmt.Data["sql"], stmt.Data["endpoint"], stmt.Data["driver"], stmt.DSN
 	}
-	instrumentStart(call, ctx, "query", sql, endpoint, driverName, dsn, args...)
+	call.SetParam(1, instrumentStart(call, ctx, "query", sql, endpoint, driverName, dsn, args...))
 }
 
 //go:linkname afterStmtQueryContextInstrumentation database/sql.afterStmtQueryContextInstrumentation
@@ -572,7 +603,7 @@ func afterStmtQueryContextInstrumentation(call api.CallContext, rows *sql.Rows,
 	}
 	instrumentEnd(call, err)
 }
-func instrumentStart(call api.CallContext, ctx context.Context, spanName, query, endpoint, driverName, dsn string, args ...any) {
+func instrumentStart(call api.CallContext, ctx context.Context, spanName, query, endpoint, driverName, dsn string, args ...any) context.Context {
 	req := databaseSqlRequest{
 		opType:     calOp(query),
 		sql:        query,
@@ -586,6 +617,7 @@ func instrumentStart(call api.CallContext, ctx context.Context, spanName, query,
 		"dbRequest": req,
 		"newCtx":    newCtx,
 	})
+	return newCtx
 }
 func instrumentEnd(call api.CallContext, err error) {
 	callData, ok := call.GetData().(map[string]interface{})
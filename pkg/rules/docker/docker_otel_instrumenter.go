@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	dockerOperationKey = attribute.Key("docker.operation")
+	dockerExecIDKey    = attribute.Key("docker.exec.id")
+)
+
+type dockerInnerEnabler struct {
+	enabled bool
+}
+
+func (d dockerInnerEnabler) Enable() bool {
+	return d.enabled
+}
+
+var dockerEnabler = dockerInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_DOCKER_ENABLED") != "false"}
+
+type dockerSpanNameExtractor struct{}
+
+// Extract returns "docker {docker.operation}", e.g. "docker ContainerCreate".
+func (d dockerSpanNameExtractor) Extract(request dockerRequest) string {
+	return "docker " + request.operation
+}
+
+type dockerAttrsExtractor struct{}
+
+func (d dockerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request dockerRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, dockerOperationKey.String(request.operation))
+	if request.name != "" {
+		attributes = append(attributes, semconv.ContainerName(request.name))
+	}
+	if request.image != "" {
+		attributes = append(attributes, semconv.ContainerImageName(request.image))
+	}
+	if request.containerID != "" {
+		attributes = append(attributes, semconv.ContainerID(request.containerID))
+	}
+	if request.execID != "" {
+		attributes = append(attributes, dockerExecIDKey.String(request.execID))
+	}
+	return attributes, parentContext
+}
+
+// OnEnd adds the ids, as those of the created containers and execs are only
+// known from the response.
+func (d dockerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request dockerRequest, response dockerResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.containerID != "" {
+		attributes = append(attributes, semconv.ContainerID(response.containerID))
+	}
+	if response.execID != "" {
+		attributes = append(attributes, dockerExecIDKey.String(response.execID))
+	}
+	return attributes, context
+}
+
+func BuildDockerClientInstrumenter() instrumenter.Instrumenter[dockerRequest, dockerResponse] {
+	builder := instrumenter.Builder[dockerRequest, dockerResponse]{}
+	return builder.Init().SetSpanNameExtractor(dockerSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[dockerRequest]{}).
+		AddAttributesExtractor(dockerAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.DOCKER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+var dockerInstrumenter = BuildDockerClientInstrumenter()
+
+type dockerCall struct {
+	ctx     context.Context
+	request dockerRequest
+}
+
+// The options of the operations are declared as interface{}, as their types
+// have been moved between the packages of the API types over the versions.
+
+//go:linkname dockerContainerCreateOnEnter github.com/docker/docker/client.dockerContainerCreateOnEnter
+func dockerContainerCreateOnEnter(call api.CallContext, cli *client.Client, ctx context.Context, config *container.Config, hostConfig *container.HostConfig, networkingConfig *network.NetworkingConfig, platform interface{}, containerName string) {
+	request := dockerRequest{operation: "ContainerCreate", name: containerName}
+	if config != nil {
+		request.image = config.Image
+	}
+	startDockerOperation(call, ctx, request)
+}
+
+//go:linkname dockerContainerCreateOnExit github.com/docker/docker/client.dockerContainerCreateOnExit
+func dockerContainerCreateOnExit(call api.CallContext, resp container.CreateResponse, err error) {
+	endDockerOperation(call, dockerResponse{containerID: resp.ID}, err)
+}
+
+//go:linkname dockerContainerStartOnEnter github.com/docker/docker/client.dockerContainerStartOnEnter
+func dockerContainerStartOnEnter(call api.CallContext, cli *client.Client, ctx context.Context, containerID string, options interface{}) {
+	startDockerOperation(call, ctx, dockerRequest{operation: "ContainerStart", containerID: containerID})
+}
+
+//go:linkname dockerOperationOnExit github.com/docker/docker/client.dockerOperationOnExit
+func dockerOperationOnExit(call api.CallContext, err error) {
+	endDockerOperation(call, dockerResponse{}, err)
+}
+
+//go:linkname dockerImagePullOnEnter github.com/docker/docker/client.dockerImagePullOnEnter
+func dockerImagePullOnEnter(call api.CallContext, cli *client.Client, ctx context.Context, refStr string, options interface{}) {
+	startDockerOperation(call, ctx, dockerRequest{operation: "ImagePull", image: refStr})
+}
+
+// dockerImagePullOnExit ends the span once the progress of the pull, which
+// is streamed in the returned body, has been closed by the caller.
+//
+//go:linkname dockerImagePullOnExit github.com/docker/docker/client.dockerImagePullOnExit
+func dockerImagePullOnExit(call api.CallContext, body io.ReadCloser, err error) {
+	if err != nil || body == nil {
+		endDockerOperation(call, dockerResponse{}, err)
+		return
+	}
+	if !dockerEnabler.Enable() {
+		return
+	}
+	c, ok := call.GetData().(*dockerCall)
+	if !ok || c == nil {
+		return
+	}
+	call.SetReturnVal(0, &dockerPullBody{ReadCloser: body, call: c})
+}
+
+//go:linkname dockerContainerExecCreateOnEnter github.com/docker/docker/client.dockerContainerExecCreateOnEnter
+func dockerContainerExecCreateOnEnter(call api.CallContext, cli *client.Client, ctx context.Context, containerID string, options interface{}) {
+	startDockerOperation(call, ctx, dockerRequest{operation: "ContainerExecCreate", containerID: containerID})
+}
+
+//go:linkname dockerContainerExecCreateOnExit github.com/docker/docker/client.dockerContainerExecCreateOnExit
+func dockerContainerExecCreateOnExit(call api.CallContext, resp interface{}, err error) {
+	response := dockerResponse{}
+	if v := reflect.ValueOf(resp); v.Kind() == reflect.Struct {
+		if id := v.FieldByName("ID"); id.Kind() == reflect.String {
+			response.execID = id.String()
+		}
+	}
+	endDockerOperation(call, response, err)
+}
+
+//go:linkname dockerContainerExecStartOnEnter github.com/docker/docker/client.dockerContainerExecStartOnEnter
+func dockerContainerExecStartOnEnter(call api.CallContext, cli *client.Client, ctx context.Context, execID string, config interface{}) {
+	startDockerOperation(call, ctx, dockerRequest{operation: "ContainerExecStart", execID: execID})
+}
+
+//go:linkname dockerContainerExecAttachOnEnter github.com/docker/docker/client.dockerContainerExecAttachOnEnter
+func dockerContainerExecAttachOnEnter(call api.CallContext, cli *client.Client, ctx context.Context, execID string, config interface{}) {
+	startDockerOperation(call, ctx, dockerRequest{operation: "ContainerExecAttach", execID: execID})
+}
+
+// dockerContainerExecAttachOnExit ends the span once the connection to the
+// exec has been hijacked, rather than when it is closed.
+//
+//go:linkname dockerContainerExecAttachOnExit github.com/docker/docker/client.dockerContainerExecAttachOnExit
+func dockerContainerExecAttachOnExit(call api.CallContext, resp interface{}, err error) {
+	endDockerOperation(call, dockerResponse{}, err)
+}
+
+func startDockerOperation(call api.CallContext, ctx context.Context, request dockerRequest) {
+	if !dockerEnabler.Enable() {
+		return
+	}
+	newCtx := dockerInstrumenter.Start(ctx, request)
+	call.SetParam(1, newCtx)
+	call.SetData(&dockerCall{ctx: newCtx, request: request})
+}
+
+func endDockerOperation(call api.CallContext, response dockerResponse, err error) {
+	if !dockerEnabler.Enable() {
+		return
+	}
+	c, ok := call.GetData().(*dockerCall)
+	if !ok || c == nil {
+		return
+	}
+	dockerInstrumenter.End(c.ctx, c.request, response, err)
+}
+
+type dockerPullBody struct {
+	io.ReadCloser
+	call *dockerCall
+	once sync.Once
+}
+
+func (b *dockerPullBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		dockerInstrumenter.End(b.call.ctx, b.call.request, dockerResponse{}, nil)
+	})
+	return err
+}
@@ -35,6 +35,7 @@ func dubboConsumerGracefulShutdownFilterInvokeOnEnter(call api.CallContext, _ in
 		methodName:    invocation.MethodName(),
 		serviceKey:    invoker.GetURL().ServiceKey(),
 		serverAddress: invoker.GetURL().Address(),
+		protocol:      invoker.GetURL().Protocol,
 	}
 
 	ctx = dubboClientInstrumenter.Start(ctx, req)
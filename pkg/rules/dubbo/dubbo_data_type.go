@@ -19,6 +19,7 @@ type dubboRequest struct {
 	serviceKey    string
 	serverAddress string
 	attachments   map[string]any
+	protocol      string
 }
 
 type dubboResponse struct {
@@ -15,14 +15,17 @@
 package dubbo
 
 import (
+	"context"
 	"os"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -67,6 +70,21 @@ func (g dubboStatusExtractor[REQUEST, RESPONSE]) Extract(span trace.Span, reques
 	}
 }
 
+// dubboAttrsExtractor records the protocol an invocation is made over, as
+// dubbo-go serves the same service over both triple and dubbo protocols
+type dubboAttrsExtractor struct{}
+
+func (d *dubboAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request dubboRequest) ([]attribute.KeyValue, context.Context) {
+	if request.protocol != "" {
+		attributes = append(attributes, semconv.NetworkProtocolName(request.protocol))
+	}
+	return attributes, parentContext
+}
+
+func (d *dubboAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request dubboRequest, response dubboResponse, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
 func BuildDubboClientInstrumenter() instrumenter.Instrumenter[dubboRequest, dubboResponse] {
 	builder := instrumenter.Builder[dubboRequest, dubboResponse]{}
 	clientGetter := dubboAttrsGetter{}
@@ -75,6 +93,7 @@ func BuildDubboClientInstrumenter() instrumenter.Instrumenter[dubboRequest, dubb
 		SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[dubboRequest]{Getter: clientGetter}).
 		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[dubboRequest]{}).
 		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[dubboRequest, dubboResponse, dubboAttrsGetter]{}).
+		AddAttributesExtractor(&dubboAttrsExtractor{}).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.DUBBO_CLIENT_SCOPE_NAME,
 			Version: version.Tag,
@@ -91,6 +110,7 @@ func BuildDubboServerInstrumenter() instrumenter.Instrumenter[dubboRequest, dubb
 		SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[dubboRequest]{Getter: serverGetter}).
 		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[dubboRequest]{}).
 		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[dubboRequest, dubboResponse, dubboAttrsGetter]{}).
+		AddAttributesExtractor(&dubboAttrsExtractor{}).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.DUBBO_SERVER_SCOPE_NAME,
 			Version: version.Tag,
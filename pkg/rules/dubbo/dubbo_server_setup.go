@@ -41,6 +41,7 @@ func dubboProviderGracefulShutdownFilterInvokeOnEnter(call api.CallContext, _ in
 		methodName:    invocation.MethodName(),
 		serviceKey:    invoker.GetURL().ServiceKey(),
 		serverAddress: invoker.GetURL().Address(),
+		protocol:      invoker.GetURL().Protocol,
 		attachments:   attachments,
 	}
 
@@ -34,14 +34,18 @@ func (s *dubboMetadataSupplier) Get(key string) string {
 	if s.metadata == nil {
 		return ""
 	}
-	item, ok := s.metadata[key].([]string)
-	if !ok {
-		return ""
-	}
-	if len(item) == 0 {
-		return ""
+	// attachments decoded by the triple protocol are string slices while the
+	// ones decoded by the dubbo protocol are plain strings
+	switch item := s.metadata[key].(type) {
+	case string:
+		return item
+	case []string:
+		if len(item) == 0 {
+			return ""
+		}
+		return item[0]
 	}
-	return item[0]
+	return ""
 }
 
 func (s *dubboMetadataSupplier) Set(key string, value string) {
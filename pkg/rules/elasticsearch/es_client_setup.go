@@ -15,9 +15,13 @@
 package elasticsearch
 
 import (
+	"bufio"
 	"context"
+	"io"
 	"net/http"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	_ "unsafe"
 
@@ -38,6 +42,16 @@ func (g esInnerEnabler) Enable() bool {
 
 var esEnabler = esInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ELASTICSEARCH_ENABLED") != "false"}
 
+// elasticsearch always puts "took" at the head of the response body
+var esTookRegexp = regexp.MustCompile(`^\s*\{\s*"took"\s*:\s*(\d+)`)
+
+const esTookPeekSize = 64
+
+type esResponseBody struct {
+	io.Reader
+	io.Closer
+}
+
 //go:linkname beforeElasticSearchPerform github.com/elastic/go-elasticsearch/v8.beforeElasticSearchPerform
 func beforeElasticSearchPerform(call api.CallContext, client *elasticsearch.BaseClient, request *http.Request) {
 	if !esEnabler.Enable() {
@@ -52,6 +66,7 @@ func beforeElasticSearchPerform(call api.CallContext, client *elasticsearch.Base
 		request: request,
 		address: strings.Join(addresses, ","),
 		op:      op,
+		index:   getEsIndex(request),
 		params:  params,
 	}
 	newCtx := esInstrumenter.Start(request.Context(), er)
@@ -66,6 +81,10 @@ func afterElasticSearchPerform(call api.CallContext, response *http.Response, er
 	}
 	newCtx := call.GetKeyData("ctx").(context.Context)
 	er := call.GetKeyData("request").(*esRequest)
+	if response != nil {
+		er.statusCode = response.StatusCode
+	}
+	er.took = peekEsTook(response)
 	esInstrumenter.End(newCtx, er, response, err)
 }
 
@@ -88,3 +107,34 @@ func getEsOpAndParams(req *http.Request) (string, []any) {
 	}
 	return paths[2], params
 }
+
+func getEsIndex(req *http.Request) string {
+	if req == nil || req.URL == nil {
+		return ""
+	}
+	paths := strings.Split(req.URL.Path, "/")
+	if len(paths) <= 1 || paths[1] == "" || strings.HasPrefix(paths[1], "_") {
+		return ""
+	}
+	return paths[1]
+}
+
+// peekEsTook reads the took-time of the response without consuming the body,
+// it returns -1 if the response does not carry one
+func peekEsTook(response *http.Response) int64 {
+	if response == nil || response.Body == nil || response.Body == http.NoBody {
+		return -1
+	}
+	reader := bufio.NewReader(response.Body)
+	response.Body = &esResponseBody{Reader: reader, Closer: response.Body}
+	head, _ := reader.Peek(esTookPeekSize)
+	matches := esTookRegexp.FindSubmatch(head)
+	if matches == nil {
+		return -1
+	}
+	took, err := strconv.ParseInt(string(matches[1]), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return took
+}
@@ -17,8 +17,11 @@ package elasticsearch
 import "net/http"
 
 type esRequest struct {
-	request *http.Request
-	address string
-	op      string
-	params  []any
+	request    *http.Request
+	address    string
+	op         string
+	index      string
+	params     []any
+	statusCode int
+	took       int64
 }
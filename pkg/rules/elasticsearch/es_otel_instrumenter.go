@@ -15,12 +15,17 @@
 package elasticsearch
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/http"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 )
 
 type elasticSearchGetter struct {
@@ -43,8 +48,7 @@ func (e elasticSearchGetter) GetOperation(request *esRequest) string {
 }
 
 func (e elasticSearchGetter) GetCollection(request *esRequest) string {
-	// TBD: We need to implement retrieving the collection later.
-	return ""
+	return request.index
 }
 
 func (e elasticSearchGetter) GetParameters(request *esRequest) []any {
@@ -59,6 +63,24 @@ func (e elasticSearchGetter) GetBatchSize(request *esRequest) int {
 	return 0
 }
 
+type esAttrsExtractor struct {
+}
+
+func (e esAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *esRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.HTTPRequestMethodKey.String(request.request.Method))
+	return attributes, parentContext
+}
+
+func (e esAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *esRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if request.statusCode > 0 {
+		attributes = append(attributes, semconv.DBResponseStatusCodeKey.String(strconv.Itoa(request.statusCode)))
+	}
+	if request.took >= 0 {
+		attributes = append(attributes, attribute.Int64("elasticsearch.took", request.took))
+	}
+	return attributes, context
+}
+
 func BuildElasticSearchInstrumenter() instrumenter.Instrumenter[*esRequest, interface{}] {
 	builder := instrumenter.Builder[*esRequest, any]{}
 	getter := elasticSearchGetter{}
@@ -68,6 +90,6 @@ func BuildElasticSearchInstrumenter() instrumenter.Instrumenter[*esRequest, inte
 			Name:    utils.ELASTICSEARCH_SCOPE_NAME,
 			Version: version.Tag,
 		}).
-		AddAttributesExtractor(&db.DbClientAttrsExtractor[*esRequest, any, db.DbClientAttrsGetter[*esRequest]]{Base: db.DbClientCommonAttrsExtractor[*esRequest, any, db.DbClientAttrsGetter[*esRequest]]{Getter: getter}}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[*esRequest, any, db.DbClientAttrsGetter[*esRequest]]{Base: db.DbClientCommonAttrsExtractor[*esRequest, any, db.DbClientAttrsGetter[*esRequest]]{Getter: getter}}, esAttrsExtractor{}).
 		BuildInstrumenter()
 }
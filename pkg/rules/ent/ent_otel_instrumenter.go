@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	entOperationKey          = attribute.Key("ent.operation")
+	entTransactionOutcomeKey = attribute.Key("db.transaction.outcome")
+)
+
+type entAttrsGetter struct{}
+
+func (e entAttrsGetter) GetSystem(request entRequest) string {
+	return request.System
+}
+
+func (e entAttrsGetter) GetServerAddress(request entRequest) string {
+	return request.Addr
+}
+
+func (e entAttrsGetter) GetStatement(request entRequest) string {
+	return request.Statement
+}
+
+func (e entAttrsGetter) GetCollection(request entRequest) string {
+	return request.Collection
+}
+
+func (e entAttrsGetter) GetOperation(request entRequest) string {
+	return request.Operation
+}
+
+func (e entAttrsGetter) GetParameters(request entRequest) []any {
+	return request.Parameters
+}
+
+func (e entAttrsGetter) GetDbNamespace(_ entRequest) string {
+	return ""
+}
+
+func (e entAttrsGetter) GetBatchSize(_ entRequest) int {
+	return 0
+}
+
+type entOperationAttrsExtractor struct{}
+
+func (e entOperationAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request entRequest) ([]attribute.KeyValue, context.Context) {
+	if request.EntOperation == "" {
+		return attributes, parentContext
+	}
+	return append(attributes, entOperationKey.String(request.EntOperation)), parentContext
+}
+
+func (e entOperationAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request entRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+type entTransactionSpanNameExtractor struct{}
+
+func (e entTransactionSpanNameExtractor) Extract(request entTransactionRequest) string {
+	return "transaction"
+}
+
+type entTransactionAttrsExtractor struct{}
+
+func (e entTransactionAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request entTransactionRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.DBSystemNameKey.String(request.System))
+	if request.Addr != "" {
+		attributes = append(attributes, semconv.ServerAddress(request.Addr))
+	}
+	return attributes, parentContext
+}
+
+func (e entTransactionAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request entTransactionRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	if request.Outcome != "" {
+		attributes = append(attributes, entTransactionOutcomeKey.String(request.Outcome))
+	}
+	return attributes, context
+}
+
+func BuildEntInstrumenter() instrumenter.Instrumenter[entRequest, interface{}] {
+	builder := instrumenter.Builder[entRequest, interface{}]{}
+	getter := entAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[entRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[entRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[entRequest, any, entAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[entRequest, any, entAttrsGetter]{Getter: getter}}, entOperationAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+// The transaction is not a database call of its own, its span is internal so
+// that the spans of the queries within are not suppressed.
+func BuildEntTransactionInstrumenter() instrumenter.Instrumenter[entTransactionRequest, interface{}] {
+	builder := instrumenter.Builder[entTransactionRequest, interface{}]{}
+	return builder.Init().SetSpanNameExtractor(entTransactionSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[entTransactionRequest]{}).
+		AddAttributesExtractor(entTransactionAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ENT_TRANSACTION_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+	_ "unsafe"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+var entTransactionInstrumenter = BuildEntTransactionInstrumenter()
+
+type entTransaction struct {
+	ctx     context.Context
+	request entTransactionRequest
+}
+
+// entTransactions maps the *sql.Tx of every ongoing transaction to its span,
+// the queries of the transaction are issued with the contexts of the caller
+// rather than the one the transaction began with.
+var entTransactions sync.Map
+
+func transactionOf(e entsql.ExecQuerier) *entTransaction {
+	tx, ok := e.(*sql.Tx)
+	if !ok || tx == nil {
+		return nil
+	}
+	value, ok := entTransactions.Load(tx)
+	if !ok {
+		return nil
+	}
+	return value.(*entTransaction)
+}
+
+// otelTx ends the span of the transaction when it is committed or rolled
+// back, ent delegates both to the driver.Tx it holds.
+type otelTx struct {
+	driver.Tx
+	tx *sql.Tx
+}
+
+func (t *otelTx) Commit() error {
+	err := t.Tx.Commit()
+	endTransaction(t.tx, "commit", err)
+	return err
+}
+
+func (t *otelTx) Rollback() error {
+	err := t.Tx.Rollback()
+	endTransaction(t.tx, "rollback", nil)
+	return err
+}
+
+//go:linkname beginTxOnEnter entgo.io/ent/dialect/sql.beginTxOnEnter
+func beginTxOnEnter(call api.CallContext, d *entsql.Driver, ctx context.Context, opts *entsql.TxOptions) {
+	if !entEnabler.Enable() || d == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	addr, driverName := entDbInfo(d.ExecQuerier)
+	request := entTransactionRequest{
+		System: entDbSystem(driverName),
+		Addr:   addr,
+	}
+	ctx = entTransactionInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	call.SetData(&entTransaction{ctx: ctx, request: request})
+}
+
+//go:linkname beginTxOnExit entgo.io/ent/dialect/sql.beginTxOnExit
+func beginTxOnExit(call api.CallContext, tx dialect.Tx, err error) {
+	transaction, ok := call.GetData().(*entTransaction)
+	if !ok {
+		return
+	}
+	t, ok := tx.(*entsql.Tx)
+	if err != nil || !ok || t == nil || t.Tx == nil {
+		entTransactionInstrumenter.End(transaction.ctx, transaction.request, nil, err)
+		return
+	}
+	sqlTx, ok := t.ExecQuerier.(*sql.Tx)
+	if !ok || sqlTx == nil {
+		entTransactionInstrumenter.End(transaction.ctx, transaction.request, nil, nil)
+		return
+	}
+	entTransactions.Store(sqlTx, transaction)
+	t.Tx = &otelTx{Tx: t.Tx, tx: sqlTx}
+}
+
+// endTransaction ends the span of the transaction even if the instrumentation
+// has been disabled since it began.
+func endTransaction(tx *sql.Tx, outcome string, err error) {
+	value, ok := entTransactions.LoadAndDelete(tx)
+	if !ok {
+		return
+	}
+	transaction := value.(*entTransaction)
+	transaction.request.Outcome = outcome
+	entTransactionInstrumenter.End(transaction.ctx, transaction.request, nil, err)
+}
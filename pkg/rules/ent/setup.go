@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strings"
+	_ "unsafe"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type entInnerEnabler struct {
+	enabled bool
+}
+
+func (e entInnerEnabler) Enable() bool {
+	return e.enabled
+}
+
+var entEnabler = entInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ENT_ENABLED") != "false"}
+
+var entInstrumenter = BuildEntInstrumenter()
+
+type entOperationCtxKey struct{}
+
+// entOperation is the graph operation of the generated client that issues
+// the queries, it is carried to the driver layer by the context.
+type entOperation struct {
+	table string
+	name  string
+}
+
+func withEntOperation(call api.CallContext, ctx context.Context, table, name string) {
+	if !entEnabler.Enable() || ctx == nil {
+		return
+	}
+	call.SetParam(0, context.WithValue(ctx, entOperationCtxKey{}, entOperation{table: table, name: name}))
+}
+
+func entStart(call api.CallContext, c entsql.Conn, ctx context.Context, query string, args any) {
+	if !entEnabler.Enable() {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	addr, driverName := entDbInfo(c.ExecQuerier)
+	request := entRequest{
+		System:    entDbSystem(driverName),
+		Operation: entSqlOperation(query),
+		Statement: query,
+		Addr:      addr,
+	}
+	if argv, ok := args.([]any); ok {
+		request.Parameters = argv
+	}
+	if op, ok := ctx.Value(entOperationCtxKey{}).(entOperation); ok {
+		request.Collection = op.table
+		request.EntOperation = op.name
+	}
+	parent := ctx
+	if tx := transactionOf(c.ExecQuerier); tx != nil {
+		parent = tx.ctx
+	}
+	spanCtx := entInstrumenter.Start(parent, request)
+	// Keep the values of the caller's context, such as the session variables
+	// of ent, only the span is taken from the new one
+	call.SetParam(1, trace.ContextWithSpan(ctx, trace.SpanFromContext(spanCtx)))
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = spanCtx
+	data["request"] = request
+	call.SetData(data)
+}
+
+func entEnd(call api.CallContext, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(entRequest)
+	if !ok {
+		return
+	}
+	entInstrumenter.End(ctx, request, nil, err)
+}
+
+func entDbInfo(e entsql.ExecQuerier) (string, string) {
+	switch v := e.(type) {
+	case *sql.DB:
+		if v != nil {
+			return v.Endpoint, v.DriverName
+		}
+	case *sql.Tx:
+		if v != nil {
+			return v.Endpoint, v.DriverName
+		}
+	case *sql.Conn:
+		if v != nil {
+			return v.Endpoint, v.DriverName
+		}
+	}
+	return "", ""
+}
+
+func entSqlOperation(query string) string {
+	if fields := strings.Fields(query); len(fields) > 0 {
+		return strings.ToUpper(fields[0])
+	}
+	return ""
+}
+
+func entDbSystem(driverName string) string {
+	switch driverName {
+	case dialect.MySQL:
+		return "mysql"
+	case dialect.Postgres, "postgresql", "pgx":
+		return "postgresql"
+	case dialect.SQLite, "sqlite":
+		return "sqlite"
+	case dialect.Gremlin:
+		return "gremlin"
+	case "":
+		return ""
+	}
+	return "other_sql"
+}
+
+//go:linkname connExecOnEnter entgo.io/ent/dialect/sql.connExecOnEnter
+func connExecOnEnter(call api.CallContext, c entsql.Conn, ctx context.Context, query string, args, v any) {
+	entStart(call, c, ctx, query, args)
+}
+
+//go:linkname connExecOnExit entgo.io/ent/dialect/sql.connExecOnExit
+func connExecOnExit(call api.CallContext, err error) {
+	entEnd(call, err)
+}
+
+//go:linkname connQueryOnEnter entgo.io/ent/dialect/sql.connQueryOnEnter
+func connQueryOnEnter(call api.CallContext, c entsql.Conn, ctx context.Context, query string, args, v any) {
+	entStart(call, c, ctx, query, args)
+}
+
+//go:linkname connQueryOnExit entgo.io/ent/dialect/sql.connQueryOnExit
+func connQueryOnExit(call api.CallContext, err error) {
+	entEnd(call, err)
+}
+
+//go:linkname createNodeOnEnter entgo.io/ent/dialect/sql/sqlgraph.createNodeOnEnter
+func createNodeOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.CreateSpec) {
+	if spec != nil {
+		withEntOperation(call, ctx, spec.Table, "create")
+	}
+}
+
+//go:linkname batchCreateOnEnter entgo.io/ent/dialect/sql/sqlgraph.batchCreateOnEnter
+func batchCreateOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.BatchCreateSpec) {
+	if spec != nil && len(spec.Nodes) > 0 && spec.Nodes[0] != nil {
+		withEntOperation(call, ctx, spec.Nodes[0].Table, "create_bulk")
+	}
+}
+
+//go:linkname updateNodeOnEnter entgo.io/ent/dialect/sql/sqlgraph.updateNodeOnEnter
+func updateNodeOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.UpdateSpec) {
+	if spec != nil && spec.Node != nil {
+		withEntOperation(call, ctx, spec.Node.Table, "update_one")
+	}
+}
+
+//go:linkname updateNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.updateNodesOnEnter
+func updateNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.UpdateSpec) {
+	if spec != nil && spec.Node != nil {
+		withEntOperation(call, ctx, spec.Node.Table, "update")
+	}
+}
+
+//go:linkname deleteNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.deleteNodesOnEnter
+func deleteNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.DeleteSpec) {
+	if spec != nil && spec.Node != nil {
+		withEntOperation(call, ctx, spec.Node.Table, "delete")
+	}
+}
+
+//go:linkname queryNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.queryNodesOnEnter
+func queryNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.QuerySpec) {
+	if spec != nil && spec.Node != nil {
+		withEntOperation(call, ctx, spec.Node.Table, "query")
+	}
+}
+
+//go:linkname countNodesOnEnter entgo.io/ent/dialect/sql/sqlgraph.countNodesOnEnter
+func countNodesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.QuerySpec) {
+	if spec != nil && spec.Node != nil {
+		withEntOperation(call, ctx, spec.Node.Table, "count")
+	}
+}
+
+//go:linkname queryEdgesOnEnter entgo.io/ent/dialect/sql/sqlgraph.queryEdgesOnEnter
+func queryEdgesOnEnter(call api.CallContext, ctx context.Context, drv dialect.Driver, spec *sqlgraph.EdgeQuerySpec) {
+	if spec != nil && spec.Edge != nil {
+		withEntOperation(call, ctx, spec.Edge.Table, "query_edges")
+	}
+}
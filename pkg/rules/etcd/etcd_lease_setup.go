@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+//go:linkname beforeEtcdNewLeaseFromLeaseClient go.etcd.io/etcd/client/v3.beforeEtcdNewLeaseFromLeaseClient
+func beforeEtcdNewLeaseFromLeaseClient(call api.CallContext, remote pb.LeaseClient, c *clientv3.Client, keepAliveTimeout time.Duration) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	call.SetData(c)
+}
+
+//go:linkname afterEtcdNewLeaseFromLeaseClient go.etcd.io/etcd/client/v3.afterEtcdNewLeaseFromLeaseClient
+func afterEtcdNewLeaseFromLeaseClient(call api.CallContext, lease clientv3.Lease) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	rememberEtcdClient(call, lease)
+}
+
+func startEtcdLease(call api.CallContext, lessor interface{}, ctx context.Context, operation string, id clientv3.LeaseID) {
+	startEtcd(call, ctx, &etcdRequest{
+		operation: operation,
+		endpoint:  getEtcdEndpoint(lessor),
+		leaseID:   int64(id),
+	})
+}
+
+//go:linkname beforeEtcdLeaseGrant go.etcd.io/etcd/client/v3.beforeEtcdLeaseGrant
+func beforeEtcdLeaseGrant(call api.CallContext, lessor interface{}, ctx context.Context, ttl int64) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	startEtcdLease(call, lessor, ctx, "lease_grant", 0)
+}
+
+//go:linkname afterEtcdLeaseGrant go.etcd.io/etcd/client/v3.afterEtcdLeaseGrant
+func afterEtcdLeaseGrant(call api.CallContext, resp *clientv3.LeaseGrantResponse, err error) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	if request, ok := call.GetKeyData("request").(*etcdRequest); ok && resp != nil {
+		request.leaseID = int64(resp.ID)
+	}
+	endEtcd(call, err)
+}
+
+//go:linkname beforeEtcdLeaseRevoke go.etcd.io/etcd/client/v3.beforeEtcdLeaseRevoke
+func beforeEtcdLeaseRevoke(call api.CallContext, lessor interface{}, ctx context.Context, id clientv3.LeaseID) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	startEtcdLease(call, lessor, ctx, "lease_revoke", id)
+}
+
+//go:linkname afterEtcdLeaseRevoke go.etcd.io/etcd/client/v3.afterEtcdLeaseRevoke
+func afterEtcdLeaseRevoke(call api.CallContext, resp *clientv3.LeaseRevokeResponse, err error) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	endEtcd(call, err)
+}
+
+//go:linkname beforeEtcdLeaseTimeToLive go.etcd.io/etcd/client/v3.beforeEtcdLeaseTimeToLive
+func beforeEtcdLeaseTimeToLive(call api.CallContext, lessor interface{}, ctx context.Context, id clientv3.LeaseID, opts ...clientv3.LeaseOption) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	startEtcdLease(call, lessor, ctx, "lease_time_to_live", id)
+}
+
+//go:linkname afterEtcdLeaseTimeToLive go.etcd.io/etcd/client/v3.afterEtcdLeaseTimeToLive
+func afterEtcdLeaseTimeToLive(call api.CallContext, resp *clientv3.LeaseTimeToLiveResponse, err error) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	endEtcd(call, err)
+}
+
+//go:linkname beforeEtcdLeaseKeepAliveOnce go.etcd.io/etcd/client/v3.beforeEtcdLeaseKeepAliveOnce
+func beforeEtcdLeaseKeepAliveOnce(call api.CallContext, lessor interface{}, ctx context.Context, id clientv3.LeaseID) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	startEtcdLease(call, lessor, ctx, "lease_keep_alive_once", id)
+}
+
+//go:linkname afterEtcdLeaseKeepAliveOnce go.etcd.io/etcd/client/v3.afterEtcdLeaseKeepAliveOnce
+func afterEtcdLeaseKeepAliveOnce(call api.CallContext, resp *clientv3.LeaseKeepAliveResponse, err error) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	endEtcd(call, err)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type etcdAttrsGetter struct {
+}
+
+func (e etcdAttrsGetter) GetSystem(request *etcdRequest) string {
+	return "etcd"
+}
+
+func (e etcdAttrsGetter) GetServerAddress(request *etcdRequest) string {
+	return request.endpoint
+}
+
+func (e etcdAttrsGetter) GetStatement(request *etcdRequest) string {
+	if request.keyPrefix == "" {
+		return request.operation
+	}
+	return request.operation + " " + request.keyPrefix
+}
+
+func (e etcdAttrsGetter) GetOperation(request *etcdRequest) string {
+	return request.operation
+}
+
+func (e etcdAttrsGetter) GetCollection(request *etcdRequest) string {
+	return ""
+}
+
+func (e etcdAttrsGetter) GetParameters(request *etcdRequest) []any {
+	return nil
+}
+
+func (e etcdAttrsGetter) GetDbNamespace(request *etcdRequest) string {
+	return ""
+}
+
+func (e etcdAttrsGetter) GetBatchSize(request *etcdRequest) int {
+	return request.batchSize
+}
+
+type etcdAttrsExtractor struct {
+}
+
+func (e etcdAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *etcdRequest) ([]attribute.KeyValue, context.Context) {
+	if request.keyPrefix != "" {
+		attributes = append(attributes, attribute.String("etcd.key.prefix", request.keyPrefix))
+	}
+	return attributes, parentContext
+}
+
+func (e etcdAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *etcdRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	// the id of a granted lease is only known once it is done
+	if request.leaseID != 0 {
+		attributes = append(attributes, attribute.Int64("etcd.lease.id", request.leaseID))
+	}
+	return attributes, context
+}
+
+func BuildEtcdOtelInstrumenter() instrumenter.Instrumenter[*etcdRequest, any] {
+	builder := instrumenter.Builder[*etcdRequest, any]{}
+	getter := etcdAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[*etcdRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[*etcdRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[*etcdRequest, any, db.DbClientAttrsGetter[*etcdRequest]]{Base: db.DbClientCommonAttrsExtractor[*etcdRequest, any, db.DbClientAttrsGetter[*etcdRequest]]{Getter: getter}}, etcdAttrsExtractor{}).
+		AddOperationListeners(db.DbClientMetrics("nosql.etcd")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ETCD_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
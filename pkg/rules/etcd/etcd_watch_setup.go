@@ -0,0 +1,149 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/meter"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const etcd_watch_events = "etcd.client.watch.events"
+
+var (
+	watchEventsCounter metric.Int64Counter
+	watchEventsMu      sync.Mutex
+)
+
+// getWatchEventsCounter creates the counter lazily since the meter is not
+// ready until the otel setup is done
+func getWatchEventsCounter() metric.Int64Counter {
+	watchEventsMu.Lock()
+	defer watchEventsMu.Unlock()
+	if watchEventsCounter != nil {
+		return watchEventsCounter
+	}
+	m := meter.GetMeter()
+	if m == nil {
+		return nil
+	}
+	c, err := m.Int64Counter(etcd_watch_events,
+		metric.WithUnit("{event}"),
+		metric.WithDescription("Number of events received by etcd watchers."))
+	if err != nil {
+		log.Printf("failed to create %s, err is %v\n", etcd_watch_events, err)
+		return nil
+	}
+	watchEventsCounter = c
+	return c
+}
+
+//go:linkname beforeEtcdNewWatchFromWatchClient go.etcd.io/etcd/client/v3.beforeEtcdNewWatchFromWatchClient
+func beforeEtcdNewWatchFromWatchClient(call api.CallContext, wc pb.WatchClient, c *clientv3.Client) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	call.SetData(c)
+}
+
+//go:linkname afterEtcdNewWatchFromWatchClient go.etcd.io/etcd/client/v3.afterEtcdNewWatchFromWatchClient
+func afterEtcdNewWatchFromWatchClient(call api.CallContext, w clientv3.Watcher) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	rememberEtcdClient(call, w)
+}
+
+type etcdWatch struct {
+	ctx     context.Context
+	request *etcdRequest
+	start   time.Time
+}
+
+func getEtcdWatchKeyPrefix(key string, opts []clientv3.OpOption) (prefix string) {
+	defer func() {
+		// options conflicting with each other are rejected by the watch itself
+		if r := recover(); r != nil {
+			prefix = ""
+		}
+	}()
+	op := clientv3.OpGet(key, opts...)
+	return getEtcdKeyPrefix(op.KeyBytes(), op.RangeBytes())
+}
+
+//go:linkname beforeEtcdWatch go.etcd.io/etcd/client/v3.beforeEtcdWatch
+func beforeEtcdWatch(call api.CallContext, w interface{}, ctx context.Context, key string, opts ...clientv3.OpOption) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	call.SetData(&etcdWatch{
+		ctx: ctx,
+		request: &etcdRequest{
+			operation: "watch",
+			keyPrefix: getEtcdWatchKeyPrefix(key, opts),
+			endpoint:  getEtcdEndpoint(w),
+		},
+		start: time.Now(),
+	})
+}
+
+// afterEtcdWatch traces the creation of the watch, the events received
+// afterwards are counted rather than traced
+//
+//go:linkname afterEtcdWatch go.etcd.io/etcd/client/v3.afterEtcdWatch
+func afterEtcdWatch(call api.CallContext, ch clientv3.WatchChan) {
+	if !etcdEnabler.Enable() || ch == nil {
+		return
+	}
+	watch, ok := call.GetData().(*etcdWatch)
+	if !ok {
+		return
+	}
+	etcdInstrumenter.StartAndEnd(watch.ctx, watch.request, nil, nil, watch.start, time.Now())
+	out := make(chan clientv3.WatchResponse)
+	go forwardEtcdWatchEvents(watch.ctx, watch.request.keyPrefix, ch, out)
+	call.SetReturnVal(0, clientv3.WatchChan(out))
+}
+
+func forwardEtcdWatchEvents(ctx context.Context, keyPrefix string, in clientv3.WatchChan, out chan<- clientv3.WatchResponse) {
+	defer close(out)
+	for resp := range in {
+		if counter := getWatchEventsCounter(); counter != nil {
+			for _, ev := range resp.Events {
+				counter.Add(ctx, 1, metric.WithAttributes(
+					attribute.String("etcd.key.prefix", keyPrefix),
+					attribute.String("etcd.event.type", ev.Type.String())))
+			}
+		}
+		select {
+		case out <- resp:
+		case <-ctx.Done():
+			// the watcher closes the channel once the context is done, the
+			// rest of the responses are dropped as nobody is receiving
+		}
+	}
+}
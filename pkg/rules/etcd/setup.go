@@ -0,0 +1,224 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+var etcdInstrumenter = BuildEtcdOtelInstrumenter()
+
+type etcdInnerEnabler struct {
+	enabled bool
+}
+
+func (e etcdInnerEnabler) Enable() bool {
+	return e.enabled
+}
+
+var etcdEnabler = etcdInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ETCD_ENABLED") != "false"}
+
+// the kv, lease and watcher implementations do not keep the client they are
+// created from, which is remembered here to resolve the cluster endpoints
+var etcdClients sync.Map
+
+func rememberEtcdClient(call api.CallContext, impl interface{}) {
+	c, ok := call.GetData().(*clientv3.Client)
+	if !ok || c == nil || impl == nil {
+		return
+	}
+	etcdClients.Store(impl, c)
+}
+
+func getEtcdEndpoint(impl interface{}) string {
+	c, ok := etcdClients.Load(impl)
+	if !ok {
+		return ""
+	}
+	return strings.Join(c.(*clientv3.Client).Endpoints(), ",")
+}
+
+// getEtcdKeyPrefix returns the prefix of the key instead of the key itself,
+// which is the key of a prefix range, or the part up to the last slash
+func getEtcdKeyPrefix(key, end []byte) string {
+	if len(end) > 0 && string(end) == clientv3.GetPrefixRangeEnd(string(key)) {
+		return string(key)
+	}
+	idx := strings.LastIndexByte(string(key), '/')
+	if idx < 0 {
+		return ""
+	}
+	return string(key[:idx+1])
+}
+
+func getEtcdOperation(op clientv3.Op) string {
+	switch {
+	case op.IsGet():
+		return "get"
+	case op.IsPut():
+		return "put"
+	case op.IsDelete():
+		return "delete"
+	case op.IsTxn():
+		return "txn"
+	}
+	return "unknown"
+}
+
+func startEtcd(call api.CallContext, ctx context.Context, request *etcdRequest) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	newCtx := etcdInstrumenter.Start(ctx, request)
+	call.SetKeyData("ctx", newCtx)
+	call.SetKeyData("request", request)
+}
+
+func endEtcd(call api.CallContext, err error) {
+	newCtx, ok := call.GetKeyData("ctx").(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := call.GetKeyData("request").(*etcdRequest)
+	if !ok {
+		return
+	}
+	etcdInstrumenter.End(newCtx, request, nil, err)
+}
+
+//go:linkname beforeEtcdNewKV go.etcd.io/etcd/client/v3.beforeEtcdNewKV
+func beforeEtcdNewKV(call api.CallContext, c *clientv3.Client) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	call.SetData(c)
+}
+
+//go:linkname afterEtcdNewKV go.etcd.io/etcd/client/v3.afterEtcdNewKV
+func afterEtcdNewKV(call api.CallContext, kv clientv3.KV) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	rememberEtcdClient(call, kv)
+}
+
+//go:linkname beforeEtcdNewKVFromKVClient go.etcd.io/etcd/client/v3.beforeEtcdNewKVFromKVClient
+func beforeEtcdNewKVFromKVClient(call api.CallContext, remote pb.KVClient, c *clientv3.Client) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	call.SetData(c)
+}
+
+//go:linkname afterEtcdNewKVFromKVClient go.etcd.io/etcd/client/v3.afterEtcdNewKVFromKVClient
+func afterEtcdNewKVFromKVClient(call api.CallContext, kv clientv3.KV) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	rememberEtcdClient(call, kv)
+}
+
+//go:linkname beforeEtcdKVDo go.etcd.io/etcd/client/v3.beforeEtcdKVDo
+func beforeEtcdKVDo(call api.CallContext, kv interface{}, ctx context.Context, op clientv3.Op) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	startEtcd(call, ctx, &etcdRequest{
+		operation: getEtcdOperation(op),
+		keyPrefix: getEtcdKeyPrefix(op.KeyBytes(), op.RangeBytes()),
+		endpoint:  getEtcdEndpoint(kv),
+	})
+}
+
+//go:linkname afterEtcdKVDo go.etcd.io/etcd/client/v3.afterEtcdKVDo
+func afterEtcdKVDo(call api.CallContext, resp clientv3.OpResponse, err error) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	endEtcd(call, err)
+}
+
+// etcdTxn traces the commit of a transaction, the txn of the client is
+// built in several calls and only talks to the server once committed
+type etcdTxn struct {
+	clientv3.Txn
+	ctx      context.Context
+	endpoint string
+	ops      []clientv3.Op
+}
+
+func (t *etcdTxn) If(cs ...clientv3.Cmp) clientv3.Txn {
+	t.Txn = t.Txn.If(cs...)
+	return t
+}
+
+func (t *etcdTxn) Then(ops ...clientv3.Op) clientv3.Txn {
+	t.Txn = t.Txn.Then(ops...)
+	t.ops = append(t.ops, ops...)
+	return t
+}
+
+func (t *etcdTxn) Else(ops ...clientv3.Op) clientv3.Txn {
+	t.Txn = t.Txn.Else(ops...)
+	t.ops = append(t.ops, ops...)
+	return t
+}
+
+func (t *etcdTxn) Commit() (*clientv3.TxnResponse, error) {
+	request := &etcdRequest{
+		operation: "txn",
+		endpoint:  t.endpoint,
+		batchSize: len(t.ops),
+	}
+	if len(t.ops) > 0 {
+		request.keyPrefix = getEtcdKeyPrefix(t.ops[0].KeyBytes(), t.ops[0].RangeBytes())
+	}
+	ctx := etcdInstrumenter.Start(t.ctx, request)
+	resp, err := t.Txn.Commit()
+	etcdInstrumenter.End(ctx, request, nil, err)
+	return resp, err
+}
+
+//go:linkname beforeEtcdKVTxn go.etcd.io/etcd/client/v3.beforeEtcdKVTxn
+func beforeEtcdKVTxn(call api.CallContext, kv interface{}, ctx context.Context) {
+	if !etcdEnabler.Enable() {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	call.SetData(&etcdTxn{ctx: ctx, endpoint: getEtcdEndpoint(kv)})
+}
+
+//go:linkname afterEtcdKVTxn go.etcd.io/etcd/client/v3.afterEtcdKVTxn
+func afterEtcdKVTxn(call api.CallContext, txn clientv3.Txn) {
+	if !etcdEnabler.Enable() || txn == nil {
+		return
+	}
+	t, ok := call.GetData().(*etcdTxn)
+	if !ok {
+		return
+	}
+	t.Txn = txn
+	call.SetReturnVal(0, t)
+}
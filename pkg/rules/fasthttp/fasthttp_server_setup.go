@@ -15,8 +15,9 @@
 package fasthttp
 
 import (
+	"net"
 	"net/url"
-	"time"
+	"reflect"
 	_ "unsafe"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
@@ -25,12 +26,19 @@ import (
 
 var fastHttpServerInstrumenter = BuildFastHttpServerOtelInstrumenter()
 
+// A server may serve several listeners and connections, and the servers of
+// fasthttp.ServeConn are pooled with their handlers replaced, so handlers are
+// told apart by the code pointer shared by all the delegate handlers
+var fastHttpDelegateHandlerPtr = reflect.ValueOf(newFastHttpServerDelegateHandler(nil)).Pointer()
+
+// The server span is started before the handler, so the spans created by the
+// handler are nested in it, and the context of the upstream is extracted from
+// the fasthttp request headers.
 func newFastHttpServerDelegateHandler(handler fasthttp.RequestHandler) fasthttp.RequestHandler {
 	return func(ctx *fasthttp.RequestCtx) {
-		startTime := time.Now()
-		handler(ctx)
 		u, err := url.Parse(ctx.URI().String())
 		if err != nil {
+			handler(ctx)
 			return
 		}
 		request := fastHttpRequest{
@@ -39,21 +47,39 @@ func newFastHttpServerDelegateHandler(handler fasthttp.RequestHandler) fasthttp.
 			isTls:  ctx.IsTLS(),
 			header: &ctx.Request.Header,
 		}
-		fastHttpServerInstrumenter.StartAndEnd(ctx, request, fastHttpResponse{
+		spanCtx := fastHttpServerInstrumenter.Start(ctx, request)
+		handler(ctx)
+		fastHttpServerInstrumenter.End(spanCtx, request, fastHttpResponse{
 			statusCode: ctx.Response.StatusCode(),
 			header:     &ctx.Response.Header,
-		}, ctx.Err(), startTime, time.Now())
+		}, ctx.Err())
 	}
 }
 
-//go:linkname listenAndServeFastHttpOnEnter github.com/valyala/fasthttp.listenAndServeFastHttpOnEnter
-func listenAndServeFastHttpOnEnter(call api.CallContext, s *fasthttp.Server, addr string) {
-	if !fastHttpEnabler.Enable() {
+func instrumentFastHttpServer(s *fasthttp.Server) {
+	if s == nil || s.Handler == nil {
 		return
 	}
-	if s == nil {
+	if reflect.ValueOf(s.Handler).Pointer() == fastHttpDelegateHandlerPtr {
 		return
 	}
 	s.Handler = newFastHttpServerDelegateHandler(s.Handler)
-	call.SetParam(0, s)
+}
+
+// Every ListenAndServe* method of the server ends up serving the listener
+//
+//go:linkname serveFastHttpOnEnter github.com/valyala/fasthttp.serveFastHttpOnEnter
+func serveFastHttpOnEnter(call api.CallContext, s *fasthttp.Server, ln net.Listener) {
+	if !fastHttpEnabler.Enable() {
+		return
+	}
+	instrumentFastHttpServer(s)
+}
+
+//go:linkname serveConnFastHttpOnEnter github.com/valyala/fasthttp.serveConnFastHttpOnEnter
+func serveConnFastHttpOnEnter(call api.CallContext, s *fasthttp.Server, c net.Conn) {
+	if !fastHttpEnabler.Enable() {
+		return
+	}
+	instrumentFastHttpServer(s)
 }
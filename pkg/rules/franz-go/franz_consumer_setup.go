@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package franz
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type franzPollData struct {
+	cl             *kgo.Client
+	ctx            context.Context
+	startTimestamp time.Time
+}
+
+// beforeFranzPollRecords also covers PollFetches, which polls without a limit
+//
+//go:linkname beforeFranzPollRecords github.com/twmb/franz-go/pkg/kgo.beforeFranzPollRecords
+func beforeFranzPollRecords(call api.CallContext, cl *kgo.Client, ctx context.Context, maxPollRecords int) {
+	if !franzEnabler.Enable() || cl == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	call.SetData(franzPollData{cl: cl, ctx: ctx, startTimestamp: time.Now()})
+}
+
+// afterFranzPollRecords records a span for the batch of each topic of the
+// poll, a poll without records, such as one interrupted by the context or by
+// closing the client, is not recorded
+//
+//go:linkname afterFranzPollRecords github.com/twmb/franz-go/pkg/kgo.afterFranzPollRecords
+func afterFranzPollRecords(call api.CallContext, fetches kgo.Fetches) {
+	data, ok := call.GetData().(franzPollData)
+	if !ok || fetches.NumRecords() == 0 {
+		return
+	}
+	endTimestamp := time.Now()
+	groupID, _ := data.cl.OptValue(kgo.ConsumerGroup).(string)
+	err := fetches.Err0()
+	fetches.EachTopic(func(topic kgo.FetchTopic) {
+		var records []*kgo.Record
+		for _, partition := range topic.Partitions {
+			records = append(records, partition.Records...)
+		}
+		if len(records) == 0 {
+			return
+		}
+		request := franzConsumerReq{topic: topic.Topic, records: records, groupID: groupID}
+		franzConsumerInstrumenter.StartAndEndWithOptions(data.ctx, request, nil, err, data.startTimestamp, endTimestamp, franzBatchLinks(records), nil)
+	})
+}
+
+// franzBatchLinks links to the distinct trace contexts carried by the records
+func franzBatchLinks(records []*kgo.Record) []trace.SpanStartOption {
+	var links []trace.Link
+	seen := make(map[trace.SpanID]struct{})
+	for _, record := range records {
+		sc := trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(context.Background(), franzRecordCarrier{record: record}))
+		if !sc.IsValid() {
+			continue
+		}
+		if _, ok := seen[sc.SpanID()]; ok {
+			continue
+		}
+		seen[sc.SpanID()] = struct{}{}
+		links = append(links, trace.Link{SpanContext: sc})
+	}
+	if len(links) == 0 {
+		return nil
+	}
+	return []trace.SpanStartOption{trace.WithLinks(links...)}
+}
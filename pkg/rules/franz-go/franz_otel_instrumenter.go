@@ -0,0 +1,328 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package franz
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var franzEnabler = franzInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_FRANZ_GO_ENABLED") != "false"}
+
+var (
+	franzProducerInstrumenter = buildFranzProducerInstrumenter()
+	franzConsumerInstrumenter = buildFranzConsumerInstrumenter()
+)
+
+type franzInnerEnabler struct {
+	enabled bool
+}
+
+func (f franzInnerEnabler) Enable() bool {
+	return f.enabled
+}
+
+// franzRecordCarrier injects the trace context into the headers of a
+// produced record and extracts it from the headers of a polled one, an
+// existing header with the same key is overwritten
+type franzRecordCarrier struct {
+	record *kgo.Record
+}
+
+func (carrier franzRecordCarrier) Get(key string) string {
+	for _, header := range carrier.record.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (carrier franzRecordCarrier) Set(key, value string) {
+	for i, header := range carrier.record.Headers {
+		if header.Key == key {
+			carrier.record.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	carrier.record.Headers = append(carrier.record.Headers, kgo.RecordHeader{
+		Key:   key,
+		Value: []byte(value),
+	})
+}
+
+func (carrier franzRecordCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.record.Headers))
+	for _, header := range carrier.record.Headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+type franzSpanStatusExtractor[REQUEST any] struct{}
+
+func (f *franzSpanStatusExtractor[REQUEST]) Extract(span trace.Span, request REQUEST, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type franzProducerAttrsGetter struct{}
+
+func (getter franzProducerAttrsGetter) GetSystem(request franzProducerReq) string {
+	return "kafka"
+}
+
+func (getter franzProducerAttrsGetter) GetDestination(request franzProducerReq) string {
+	return request.record.Topic
+}
+
+func (getter franzProducerAttrsGetter) GetDestinationTemplate(request franzProducerReq) string {
+	return ""
+}
+
+func (getter franzProducerAttrsGetter) IsTemporaryDestination(request franzProducerReq) bool {
+	return false
+}
+
+func (getter franzProducerAttrsGetter) IsAnonymousDestination(request franzProducerReq) bool {
+	return false
+}
+
+func (getter franzProducerAttrsGetter) GetConversationId(request franzProducerReq) string {
+	return ""
+}
+
+func (getter franzProducerAttrsGetter) GetMessageBodySize(request franzProducerReq) int64 {
+	return int64(len(request.record.Value))
+}
+
+func (getter franzProducerAttrsGetter) GetMessageEnvelopSize(request franzProducerReq) int64 {
+	return 0
+}
+
+func (getter franzProducerAttrsGetter) GetMessageId(request franzProducerReq, response any) string {
+	return ""
+}
+
+func (getter franzProducerAttrsGetter) GetClientId(request franzProducerReq) string {
+	return ""
+}
+
+func (getter franzProducerAttrsGetter) GetBatchMessageCount(request franzProducerReq, response any) int64 {
+	return 1
+}
+
+func (getter franzProducerAttrsGetter) GetMessageHeader(request franzProducerReq, name string) []string {
+	return franzHeaderValues([]*kgo.Record{request.record}, name)
+}
+
+// GetDestinationPartitionId returns nothing as the partition of a produced
+// record is only known once it is sent, see franzProducerAttrsExtractor
+func (getter franzProducerAttrsGetter) GetDestinationPartitionId(request franzProducerReq) string {
+	return ""
+}
+
+type franzConsumerAttrsGetter struct{}
+
+func (getter franzConsumerAttrsGetter) GetSystem(request franzConsumerReq) string {
+	return "kafka"
+}
+
+func (getter franzConsumerAttrsGetter) GetDestination(request franzConsumerReq) string {
+	return request.topic
+}
+
+func (getter franzConsumerAttrsGetter) GetDestinationTemplate(request franzConsumerReq) string {
+	return ""
+}
+
+func (getter franzConsumerAttrsGetter) IsTemporaryDestination(request franzConsumerReq) bool {
+	return false
+}
+
+func (getter franzConsumerAttrsGetter) IsAnonymousDestination(request franzConsumerReq) bool {
+	return false
+}
+
+func (getter franzConsumerAttrsGetter) GetConversationId(request franzConsumerReq) string {
+	return ""
+}
+
+func (getter franzConsumerAttrsGetter) GetMessageBodySize(request franzConsumerReq) int64 {
+	var size int64
+	for _, record := range request.records {
+		size += int64(len(record.Value))
+	}
+	return size
+}
+
+func (getter franzConsumerAttrsGetter) GetMessageEnvelopSize(request franzConsumerReq) int64 {
+	return 0
+}
+
+func (getter franzConsumerAttrsGetter) GetMessageId(request franzConsumerReq, response any) string {
+	return ""
+}
+
+func (getter franzConsumerAttrsGetter) GetClientId(request franzConsumerReq) string {
+	return ""
+}
+
+func (getter franzConsumerAttrsGetter) GetBatchMessageCount(request franzConsumerReq, response any) int64 {
+	return int64(len(request.records))
+}
+
+func (getter franzConsumerAttrsGetter) GetMessageHeader(request franzConsumerReq, name string) []string {
+	return franzHeaderValues(request.records, name)
+}
+
+// GetDestinationPartitionId returns the partition of the batch only if all of
+// its records come from the same one
+func (getter franzConsumerAttrsGetter) GetDestinationPartitionId(request franzConsumerReq) string {
+	if len(request.records) == 0 {
+		return ""
+	}
+	partition := request.records[0].Partition
+	for _, record := range request.records[1:] {
+		if record.Partition != partition {
+			return ""
+		}
+	}
+	return strconv.Itoa(int(partition))
+}
+
+func franzHeaderValues(records []*kgo.Record, name string) []string {
+	var values []string
+	for _, record := range records {
+		for _, header := range record.Headers {
+			if header.Key == name {
+				values = append(values, string(header.Value))
+			}
+		}
+	}
+	return values
+}
+
+// franzProducerAttrsExtractor records the kafka specific attributes of a
+// produced record, the partition and offset are assigned by the broker
+type franzProducerAttrsExtractor struct{}
+
+func (extractor *franzProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request franzProducerReq) ([]attribute.KeyValue, context.Context) {
+	if request.record.Key != nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(request.record.Key)))
+	}
+	if request.record.Value == nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageTombstone(true))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *franzProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request franzProducerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if err != nil {
+		return attributes, ctx
+	}
+	return append(attributes,
+		semconv.MessagingDestinationPartitionID(strconv.Itoa(int(request.record.Partition))),
+		semconv.MessagingKafkaOffset(int(request.record.Offset)),
+	), ctx
+}
+
+// franzConsumerAttrsExtractor records the consumer group of a polled batch,
+// the key and offset are only recorded for a batch of a single record
+type franzConsumerAttrsExtractor struct{}
+
+func (extractor *franzConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request franzConsumerReq) ([]attribute.KeyValue, context.Context) {
+	if request.groupID != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.groupID))
+	}
+	if len(request.records) != 1 {
+		return attributes, parentContext
+	}
+	record := request.records[0]
+	attributes = append(attributes, semconv.MessagingKafkaOffset(int(record.Offset)))
+	if record.Key != nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(record.Key)))
+	}
+	if record.Value == nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageTombstone(true))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *franzConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request franzConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func buildFranzProducerInstrumenter() instrumenter.Instrumenter[franzProducerReq, any] {
+	builder := instrumenter.Builder[franzProducerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.FRANZ_KGO_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[franzProducerReq, any]{
+			Getter:        franzProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[franzProducerReq]{}).
+		SetSpanStatusExtractor(&franzSpanStatusExtractor[franzProducerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[franzProducerReq, any, franzProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&franzProducerAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request franzProducerReq) propagation.TextMapCarrier {
+				return franzRecordCarrier{record: request.record}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+// a polled batch may carry records of many producers, the consumer spans are
+// linked to them instead of being their children, see franzBatchLinks
+func buildFranzConsumerInstrumenter() instrumenter.Instrumenter[franzConsumerReq, any] {
+	builder := instrumenter.Builder[franzConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.FRANZ_KGO_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[franzConsumerReq, any]{
+			Getter:        franzConsumerAttrsGetter{},
+			OperationName: message.RECEIVE,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[franzConsumerReq]{}).
+		SetSpanStatusExtractor(&franzSpanStatusExtractor[franzConsumerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[franzConsumerReq, any, franzConsumerAttrsGetter]{
+			Operation: message.RECEIVE,
+		}).
+		AddAttributesExtractor(&franzConsumerAttrsExtractor{}).
+		BuildInstrumenter()
+}
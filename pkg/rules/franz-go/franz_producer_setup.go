@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package franz
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/twmb/franz-go/pkg/kgo"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startFranzProducer starts the span of a record in the goroutine producing
+// it, the span is detached from it right away as it ends in the goroutine
+// calling the promise
+func startFranzProducer(parentContext context.Context, record *kgo.Record) context.Context {
+	ctx := franzProducerInstrumenter.Start(parentContext, franzProducerReq{record: record})
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(ctx))
+	return ctx
+}
+
+// beforeFranzProduce covers Produce, TryProduce and ProduceSync, the span of a
+// record ends once its promise is called, which is when the broker acks it or
+// the record fails
+//
+//go:linkname beforeFranzProduce github.com/twmb/franz-go/pkg/kgo.beforeFranzProduce
+func beforeFranzProduce(call api.CallContext, cl *kgo.Client, ctx context.Context, r *kgo.Record, promise func(*kgo.Record, error), block bool) {
+	if !franzEnabler.Enable() || cl == nil || r == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if r.Topic == "" {
+		if topic, ok := cl.OptValue(kgo.DefaultProduceTopic).(string); ok {
+			r.Topic = topic
+		}
+	}
+	spanContext := startFranzProducer(ctx, r)
+	call.SetParam(3, func(record *kgo.Record, err error) {
+		franzProducerInstrumenter.End(spanContext, franzProducerReq{record: r}, nil, err)
+		if promise != nil {
+			promise(record, err)
+		}
+	})
+}
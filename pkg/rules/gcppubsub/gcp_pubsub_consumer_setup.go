@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsub
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+// gcpPubsubMinAckDeadline is the least ack deadline the subscriber extends
+// the leases of the messages by
+const gcpPubsubMinAckDeadline = 10 * time.Second
+
+// gcpPubsubAckDeadline returns the ack deadline the subscriber starts with,
+// later leases adapt to the time the messages take to be processed
+func gcpPubsubAckDeadline(settings pubsub.ReceiveSettings) time.Duration {
+	deadline := gcpPubsubMinAckDeadline
+	if settings.MinExtensionPeriod > deadline {
+		deadline = settings.MinExtensionPeriod
+	}
+	if settings.MaxExtensionPeriod > 0 && settings.MaxExtensionPeriod < deadline {
+		deadline = settings.MaxExtensionPeriod
+	}
+	return deadline
+}
+
+// beforeGcpPubsubReceive wraps the function handling the messages so that
+// each message is processed under a span continuing the trace of its
+// publisher
+//
+//go:linkname beforeGcpPubsubReceive cloud.google.com/go/pubsub.beforeGcpPubsubReceive
+func beforeGcpPubsubReceive(call api.CallContext, s *pubsub.Subscription, ctx context.Context, f func(context.Context, *pubsub.Message)) {
+	if !gcpPubsubEnabler.Enable() || s == nil || f == nil {
+		return
+	}
+	subscription := s.ID()
+	ackDeadline := gcpPubsubAckDeadline(s.ReceiveSettings)
+	call.SetParam(2, func(ctx context.Context, msg *pubsub.Message) {
+		request := gcpPubsubConsumerReq{subscription: subscription, ackDeadline: ackDeadline, msg: msg}
+		ctx = gcpPubsubConsumerInstrumenter.Start(ctx, request)
+		defer gcpPubsubConsumerInstrumenter.End(ctx, request, nil, nil)
+		f(ctx, msg)
+	})
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsub
+
+import (
+	"context"
+	"os"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var gcpPubsubEnabler = gcpPubsubInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GCPPUBSUB_ENABLED") != "false"}
+
+var (
+	gcpPubsubProducerInstrumenter = buildGcpPubsubProducerInstrumenter()
+	gcpPubsubConsumerInstrumenter = buildGcpPubsubConsumerInstrumenter()
+)
+
+type gcpPubsubInnerEnabler struct {
+	enabled bool
+}
+
+func (g gcpPubsubInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+type gcpPubsubSpanStatusExtractor[REQUEST any, RESPONSE any] struct{}
+
+func (g *gcpPubsubSpanStatusExtractor[REQUEST, RESPONSE]) Extract(span trace.Span, request REQUEST, response RESPONSE, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type gcpPubsubProducerAttrsGetter struct{}
+
+func (getter gcpPubsubProducerAttrsGetter) GetSystem(request gcpPubsubProducerReq) string {
+	return "gcp_pubsub"
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetDestination(request gcpPubsubProducerReq) string {
+	return request.topic
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetDestinationTemplate(request gcpPubsubProducerReq) string {
+	return ""
+}
+
+func (getter gcpPubsubProducerAttrsGetter) IsTemporaryDestination(request gcpPubsubProducerReq) bool {
+	return false
+}
+
+func (getter gcpPubsubProducerAttrsGetter) IsAnonymousDestination(request gcpPubsubProducerReq) bool {
+	return false
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetConversationId(request gcpPubsubProducerReq) string {
+	return ""
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetMessageBodySize(request gcpPubsubProducerReq) int64 {
+	return int64(len(request.msg.Data))
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetMessageEnvelopSize(request gcpPubsubProducerReq) int64 {
+	return 0
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetMessageId(request gcpPubsubProducerReq, response string) string {
+	return response
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetClientId(request gcpPubsubProducerReq) string {
+	return ""
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetBatchMessageCount(request gcpPubsubProducerReq, response string) int64 {
+	return 1
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetMessageHeader(request gcpPubsubProducerReq, name string) []string {
+	if value, ok := request.msg.Attributes[name]; ok {
+		return []string{value}
+	}
+	return nil
+}
+
+func (getter gcpPubsubProducerAttrsGetter) GetDestinationPartitionId(request gcpPubsubProducerReq) string {
+	return ""
+}
+
+type gcpPubsubConsumerAttrsGetter struct{}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetSystem(request gcpPubsubConsumerReq) string {
+	return "gcp_pubsub"
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetDestination(request gcpPubsubConsumerReq) string {
+	return request.subscription
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetDestinationTemplate(request gcpPubsubConsumerReq) string {
+	return ""
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) IsTemporaryDestination(request gcpPubsubConsumerReq) bool {
+	return false
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) IsAnonymousDestination(request gcpPubsubConsumerReq) bool {
+	return false
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetConversationId(request gcpPubsubConsumerReq) string {
+	return ""
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetMessageBodySize(request gcpPubsubConsumerReq) int64 {
+	return int64(len(request.msg.Data))
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetMessageEnvelopSize(request gcpPubsubConsumerReq) int64 {
+	return 0
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetMessageId(request gcpPubsubConsumerReq, response any) string {
+	return request.msg.ID
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetClientId(request gcpPubsubConsumerReq) string {
+	return ""
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetBatchMessageCount(request gcpPubsubConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetMessageHeader(request gcpPubsubConsumerReq, name string) []string {
+	if value, ok := request.msg.Attributes[name]; ok {
+		return []string{value}
+	}
+	return nil
+}
+
+func (getter gcpPubsubConsumerAttrsGetter) GetDestinationPartitionId(request gcpPubsubConsumerReq) string {
+	return ""
+}
+
+// gcpPubsubProducerAttrsExtractor records the ordering key of a message
+type gcpPubsubProducerAttrsExtractor struct{}
+
+func (extractor *gcpPubsubProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gcpPubsubProducerReq) ([]attribute.KeyValue, context.Context) {
+	if request.msg.OrderingKey != "" {
+		attributes = append(attributes, semconv.MessagingGCPPubsubMessageOrderingKey(request.msg.OrderingKey))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *gcpPubsubProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request gcpPubsubProducerReq, response string, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// gcpPubsubConsumerAttrsExtractor records the subscription, ordering key,
+// ack deadline and delivery attempt of a message
+type gcpPubsubConsumerAttrsExtractor struct{}
+
+func (extractor *gcpPubsubConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gcpPubsubConsumerReq) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		semconv.MessagingDestinationSubscriptionName(request.subscription),
+		semconv.MessagingGCPPubsubMessageAckDeadline(int(request.ackDeadline.Seconds())))
+	if request.msg.OrderingKey != "" {
+		attributes = append(attributes, semconv.MessagingGCPPubsubMessageOrderingKey(request.msg.OrderingKey))
+	}
+	// the delivery attempt is only set for subscriptions with a dead letter
+	// policy
+	if request.msg.DeliveryAttempt != nil {
+		attributes = append(attributes, semconv.MessagingGCPPubsubMessageDeliveryAttempt(*request.msg.DeliveryAttempt))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *gcpPubsubConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request gcpPubsubConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// the trace context is carried by the message attributes, a message without
+// attributes is given an empty map when it is published
+func buildGcpPubsubProducerInstrumenter() instrumenter.Instrumenter[gcpPubsubProducerReq, string] {
+	builder := instrumenter.Builder[gcpPubsubProducerReq, string]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GCP_PUBSUB_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[gcpPubsubProducerReq, string]{
+			Getter:        gcpPubsubProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[gcpPubsubProducerReq]{}).
+		SetSpanStatusExtractor(&gcpPubsubSpanStatusExtractor[gcpPubsubProducerReq, string]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[gcpPubsubProducerReq, string, gcpPubsubProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&gcpPubsubProducerAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request gcpPubsubProducerReq) propagation.TextMapCarrier {
+				return propagation.MapCarrier(request.msg.Attributes)
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func buildGcpPubsubConsumerInstrumenter() instrumenter.Instrumenter[gcpPubsubConsumerReq, any] {
+	builder := instrumenter.Builder[gcpPubsubConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GCP_PUBSUB_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[gcpPubsubConsumerReq, any]{
+			Getter:        gcpPubsubConsumerAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[gcpPubsubConsumerReq]{}).
+		SetSpanStatusExtractor(&gcpPubsubSpanStatusExtractor[gcpPubsubConsumerReq, any]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[gcpPubsubConsumerReq, any, gcpPubsubConsumerAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&gcpPubsubConsumerAttrsExtractor{}).
+		BuildPropagatingFromUpstreamInstrumenter(
+			func(request gcpPubsubConsumerReq) propagation.TextMapCarrier {
+				return propagation.MapCarrier(request.msg.Attributes)
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsub
+
+import (
+	"context"
+	_ "unsafe"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type gcpPubsubPublishData struct {
+	ctx     context.Context
+	request gcpPubsubProducerReq
+}
+
+//go:linkname beforeGcpPubsubPublish cloud.google.com/go/pubsub.beforeGcpPubsubPublish
+func beforeGcpPubsubPublish(call api.CallContext, t *pubsub.Topic, ctx context.Context, msg *pubsub.Message) {
+	if !gcpPubsubEnabler.Enable() || t == nil || msg == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if msg.Attributes == nil {
+		msg.Attributes = make(map[string]string)
+	}
+	request := gcpPubsubProducerReq{topic: t.ID(), msg: msg}
+	ctx = gcpPubsubProducerInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	call.SetData(gcpPubsubPublishData{ctx: ctx, request: request})
+}
+
+// afterGcpPubsubPublish ends the span once the message is sent by the
+// bundler of the topic, which happens on another goroutine, so the span is
+// detached from the calling goroutine
+//
+//go:linkname afterGcpPubsubPublish cloud.google.com/go/pubsub.afterGcpPubsubPublish
+func afterGcpPubsubPublish(call api.CallContext, result *pubsub.PublishResult) {
+	data, ok := call.GetData().(gcpPubsubPublishData)
+	if !ok {
+		return
+	}
+	if result == nil {
+		gcpPubsubProducerInstrumenter.End(data.ctx, data.request, "", nil)
+		return
+	}
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(data.ctx))
+	go func() {
+		id, err := result.Get(context.Background())
+		gcpPubsubProducerInstrumenter.End(data.ctx, data.request, id, err)
+	}()
+}
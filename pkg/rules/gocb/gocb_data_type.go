@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocb
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Attributes gocb sets to the spans of its request tracer
+const (
+	gocbAttrBucket     = "db.name"
+	gocbAttrScope      = "db.couchbase.scope"
+	gocbAttrCollection = "db.couchbase.collection"
+	gocbAttrOperation  = "db.operation"
+	gocbAttrStatement  = "db.statement"
+	gocbAttrService    = "db.couchbase.service"
+	gocbAttrDurability = "db.couchbase.durability"
+)
+
+// gocbRequest is filled by gocb after the span has been started, so that it is
+// only read once the span ends.
+type gocbRequest struct {
+	mu         sync.Mutex
+	operation  string
+	bucket     string
+	scope      string
+	collection string
+	statement  string
+	service    string
+	durability string
+	addr       string
+}
+
+func (r *gocbRequest) set(key string, value interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch key {
+	case gocbAttrBucket:
+		r.bucket = fmt.Sprint(value)
+	case gocbAttrScope:
+		r.scope = fmt.Sprint(value)
+	case gocbAttrCollection:
+		r.collection = fmt.Sprint(value)
+	case gocbAttrOperation:
+		r.operation = fmt.Sprint(value)
+	case gocbAttrStatement:
+		r.statement = fmt.Sprint(value)
+	case gocbAttrService:
+		r.service = fmt.Sprint(value)
+	case gocbAttrDurability:
+		r.durability = fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocb
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const (
+	gocbScopeKey      = attribute.Key("db.couchbase.scope")
+	gocbServiceKey    = attribute.Key("db.couchbase.service")
+	gocbDurabilityKey = attribute.Key("db.couchbase.durability")
+)
+
+type gocbAttrsGetter struct{}
+
+func (g gocbAttrsGetter) GetSystem(_ *gocbRequest) string {
+	return "couchbase"
+}
+
+func (g gocbAttrsGetter) GetServerAddress(request *gocbRequest) string {
+	return request.addr
+}
+
+func (g gocbAttrsGetter) GetStatement(request *gocbRequest) string {
+	request.mu.Lock()
+	defer request.mu.Unlock()
+	return request.statement
+}
+
+func (g gocbAttrsGetter) GetCollection(request *gocbRequest) string {
+	request.mu.Lock()
+	defer request.mu.Unlock()
+	return request.collection
+}
+
+func (g gocbAttrsGetter) GetOperation(request *gocbRequest) string {
+	request.mu.Lock()
+	defer request.mu.Unlock()
+	return request.operation
+}
+
+func (g gocbAttrsGetter) GetParameters(_ *gocbRequest) []any {
+	return nil
+}
+
+func (g gocbAttrsGetter) GetDbNamespace(request *gocbRequest) string {
+	request.mu.Lock()
+	defer request.mu.Unlock()
+	return request.bucket
+}
+
+func (g gocbAttrsGetter) GetBatchSize(_ *gocbRequest) int {
+	return 0
+}
+
+type gocbAttrsExtractor struct{}
+
+func (g gocbAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *gocbRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+// OnEnd adds the scope, service and durability level, which gocb sets after
+// the span has been started.
+func (g gocbAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *gocbRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	request.mu.Lock()
+	defer request.mu.Unlock()
+	if request.scope != "" {
+		attributes = append(attributes, gocbScopeKey.String(request.scope))
+	}
+	if request.service != "" {
+		attributes = append(attributes, gocbServiceKey.String(request.service))
+	}
+	if request.durability != "" {
+		attributes = append(attributes, gocbDurabilityKey.String(request.durability))
+	}
+	return attributes, context
+}
+
+// gocbSpanNameExtractor names the span once again when it ends, as gocb only
+// sets the collection after the span has been started
+var gocbSpanNameExtractor = &db.DBSpanNameExtractor[*gocbRequest]{Getter: gocbAttrsGetter{}}
+
+func BuildGocbInstrumenter() instrumenter.Instrumenter[*gocbRequest, interface{}] {
+	builder := instrumenter.Builder[*gocbRequest, interface{}]{}
+	getter := gocbAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(gocbSpanNameExtractor).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[*gocbRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[*gocbRequest, any, gocbAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[*gocbRequest, any, gocbAttrsGetter]{Getter: getter}}, gocbAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GOCB_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
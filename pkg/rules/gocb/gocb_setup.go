@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocb
+
+import (
+	"context"
+	"os"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	gocb "github.com/couchbase/gocb/v2"
+)
+
+type gocbInnerEnabler struct {
+	enabled bool
+}
+
+func (g gocbInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var gocbEnabler = gocbInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GOCB_ENABLED") != "false"}
+
+var gocbInstrumenter = BuildGocbInstrumenter()
+
+const gocbTransactionOperation = "transaction"
+
+// gocbAddr returns the first host of the connection string, e.g. "db1" for
+// "couchbases://db1,db2?network=external"
+func gocbAddr(connStr string) string {
+	if _, rest, ok := strings.Cut(connStr, "://"); ok {
+		connStr = rest
+	}
+	connStr, _, _ = strings.Cut(connStr, "?")
+	connStr, _, _ = strings.Cut(connStr, ",")
+	connStr, _, _ = strings.Cut(connStr, ";")
+	return strings.TrimSuffix(connStr, "/")
+}
+
+func gocbDurability(level gocb.DurabilityLevel) string {
+	switch level {
+	case gocb.DurabilityLevelNone:
+		return "none"
+	case gocb.DurabilityLevelMajority:
+		return "majority"
+	case gocb.DurabilityLevelMajorityAndPersistOnMaster:
+		return "majorityAndPersistActive"
+	case gocb.DurabilityLevelPersistToMajority:
+		return "persistToMajority"
+	}
+	return ""
+}
+
+// The request tracer of the application is kept, e.g. that of the
+// gocb-opentelemetry module, as it traces the operations already.
+//
+//go:linkname gocbConnectOnEnter github.com/couchbase/gocb/v2.gocbConnectOnEnter
+func gocbConnectOnEnter(call api.CallContext, connStr string, opts gocb.ClusterOptions) {
+	if !gocbEnabler.Enable() || opts.Tracer != nil {
+		return
+	}
+	opts.Tracer = gocbTracer{addr: gocbAddr(connStr)}
+	call.SetParam(1, opts)
+}
+
+// Transactions run their attempts on the collections themselves, so that they
+// are traced as a whole, with the durability level the mutations are made
+// with.
+//
+//go:linkname gocbTransactionsRunOnEnter github.com/couchbase/gocb/v2.gocbTransactionsRunOnEnter
+func gocbTransactionsRunOnEnter(call api.CallContext, t *gocb.Transactions, logicFn gocb.AttemptFunc, perConfig *gocb.TransactionOptions) {
+	if !gocbEnabler.Enable() {
+		return
+	}
+	request := &gocbRequest{operation: gocbTransactionOperation}
+	if perConfig != nil {
+		request.durability = gocbDurability(perConfig.DurabilityLevel)
+	}
+	call.SetData(map[string]interface{}{
+		"ctx":     gocbInstrumenter.Start(context.Background(), request),
+		"request": request,
+	})
+}
+
+//go:linkname gocbTransactionsRunOnExit github.com/couchbase/gocb/v2.gocbTransactionsRunOnExit
+func gocbTransactionsRunOnExit(call api.CallContext, result *gocb.TransactionResult, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(*gocbRequest)
+	if !ok {
+		return
+	}
+	gocbInstrumenter.End(ctx, request, nil, err)
+}
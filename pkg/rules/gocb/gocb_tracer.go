@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocb
+
+import (
+	"context"
+	"time"
+
+	gocb "github.com/couchbase/gocb/v2"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Spans gocb creates around the encoding and the dispatch of every request,
+// which are left out as they only time the internals of the operations
+var gocbInternalSpans = map[string]bool{
+	"request_encoding":   true,
+	"dispatch_to_server": true,
+}
+
+// gocbTracer is the request tracer of the cluster, gocb starts a span for each
+// of the KV operations, queries, analytics queries and the other services
+// through it, and sets its attributes to it.
+type gocbTracer struct {
+	addr string
+}
+
+func (t gocbTracer) RequestSpan(parentContext gocb.RequestSpanContext, operationName string) gocb.RequestSpan {
+	// The span of the goroutine is the parent if the application has not
+	// given any to the options of the operation
+	ctx, ok := parentContext.(context.Context)
+	if !ok || ctx == nil {
+		ctx = context.Background()
+	}
+	if !gocbEnabler.Enable() || gocbInternalSpans[operationName] || operationName == gocbTransactionOperation {
+		return gocbPassThroughSpan{ctx: ctx}
+	}
+	request := &gocbRequest{operation: operationName, addr: t.addr}
+	return &gocbSpan{ctx: gocbInstrumenter.Start(ctx, request), request: request}
+}
+
+type gocbSpan struct {
+	ctx     context.Context
+	request *gocbRequest
+}
+
+func (s *gocbSpan) End() {
+	trace.SpanFromContext(s.ctx).SetName(gocbSpanNameExtractor.Extract(s.request))
+	gocbInstrumenter.End(s.ctx, s.request, nil, nil)
+}
+
+func (s *gocbSpan) Context() gocb.RequestSpanContext {
+	return s.ctx
+}
+
+func (s *gocbSpan) AddEvent(name string, timestamp time.Time) {
+	trace.SpanFromContext(s.ctx).AddEvent(name, trace.WithTimestamp(timestamp))
+}
+
+func (s *gocbSpan) SetAttribute(key string, value interface{}) {
+	s.request.set(key, value)
+}
+
+// gocbPassThroughSpan is not recorded, the spans started from it are children
+// of its parent
+type gocbPassThroughSpan struct {
+	ctx context.Context
+}
+
+func (s gocbPassThroughSpan) End() {}
+
+func (s gocbPassThroughSpan) Context() gocb.RequestSpanContext {
+	return s.ctx
+}
+
+func (s gocbPassThroughSpan) AddEvent(name string, timestamp time.Time) {}
+
+func (s gocbPassThroughSpan) SetAttribute(key string, value interface{}) {}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gocql/gocql"
+)
+
+type gocqlObservationKey struct{}
+
+// gocqlObservation receives the outcome of the attempts of a query, as the
+// error of the returned iterator is only exposed by closing it. The last
+// attempt wins, speculative executions may report concurrently.
+type gocqlObservation struct {
+	mu   sync.Mutex
+	err  error
+	host *gocql.HostInfo
+}
+
+func (o *gocqlObservation) observe(err error, host *gocql.HostInfo) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.err = err
+	o.host = host
+}
+
+func (o *gocqlObservation) get() (*gocql.HostInfo, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.host, o.err
+}
+
+// gocqlQueryObserver is installed on every session and chains to the
+// observer configured by the user
+type gocqlQueryObserver struct {
+	next gocql.QueryObserver
+}
+
+func (g gocqlQueryObserver) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	if observation, ok := ctx.Value(gocqlObservationKey{}).(*gocqlObservation); ok {
+		observation.observe(q.Err, q.Host)
+	}
+	if g.next != nil {
+		g.next.ObserveQuery(ctx, q)
+	}
+}
+
+type gocqlBatchObserver struct {
+	next gocql.BatchObserver
+}
+
+func (g gocqlBatchObserver) ObserveBatch(ctx context.Context, b gocql.ObservedBatch) {
+	if observation, ok := ctx.Value(gocqlObservationKey{}).(*gocqlObservation); ok {
+		observation.observe(b.Err, b.Host)
+	}
+	if g.next != nil {
+		g.next.ObserveBatch(ctx, b)
+	}
+}
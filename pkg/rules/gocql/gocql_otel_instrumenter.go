@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type gocqlAttrsGetter struct{}
+
+func (g gocqlAttrsGetter) GetSystem(_ gocqlRequest) string {
+	return "cassandra"
+}
+
+func (g gocqlAttrsGetter) GetServerAddress(request gocqlRequest) string {
+	return request.Addr
+}
+
+func (g gocqlAttrsGetter) GetStatement(request gocqlRequest) string {
+	return request.Statement
+}
+
+func (g gocqlAttrsGetter) GetCollection(request gocqlRequest) string {
+	return request.Collection
+}
+
+func (g gocqlAttrsGetter) GetOperation(request gocqlRequest) string {
+	return request.Operation
+}
+
+func (g gocqlAttrsGetter) GetParameters(_ gocqlRequest) []any {
+	// Statements are sanitized, the bound values are never recorded
+	return nil
+}
+
+func (g gocqlAttrsGetter) GetDbNamespace(request gocqlRequest) string {
+	return request.Keyspace
+}
+
+func (g gocqlAttrsGetter) GetBatchSize(request gocqlRequest) int {
+	return request.BatchSize
+}
+
+type gocqlCassandraAttrsExtractor struct{}
+
+func (g gocqlCassandraAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gocqlRequest) ([]attribute.KeyValue, context.Context) {
+	if request.Consistency != "" {
+		attributes = append(attributes, semconv.CassandraConsistencyLevelKey.String(request.Consistency))
+	}
+	return attributes, parentContext
+}
+
+func (g gocqlCassandraAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gocqlRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	if request.CoordinatorID != "" {
+		attributes = append(attributes, semconv.CassandraCoordinatorIDKey.String(request.CoordinatorID))
+	}
+	if request.CoordinatorDC != "" {
+		attributes = append(attributes, semconv.CassandraCoordinatorDCKey.String(request.CoordinatorDC))
+	}
+	return attributes, context
+}
+
+func BuildGocqlInstrumenter() instrumenter.Instrumenter[gocqlRequest, interface{}] {
+	builder := instrumenter.Builder[gocqlRequest, interface{}]{}
+	getter := gocqlAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[gocqlRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[gocqlRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[gocqlRequest, any, gocqlAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[gocqlRequest, any, gocqlAttrsGetter]{Getter: getter}}, gocqlCassandraAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GOCQL_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/gocql/gocql"
+)
+
+type gocqlInnerEnabler struct {
+	enabled bool
+}
+
+func (g gocqlInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var gocqlEnabler = gocqlInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GOCQL_ENABLED") != "false"}
+
+var gocqlInstrumenter = BuildGocqlInstrumenter()
+
+func gocqlOperation(stmt string) string {
+	if fields := strings.Fields(stmt); len(fields) > 0 {
+		return strings.ToUpper(strings.TrimSuffix(fields[0], ";"))
+	}
+	return ""
+}
+
+// gocqlCollection returns the table of a DML statement, that is the one
+// following FROM or INTO, or the one being updated
+func gocqlCollection(stmt string) string {
+	fields := strings.Fields(stmt)
+	for i := 0; i < len(fields)-1; i++ {
+		keyword := strings.ToUpper(fields[i])
+		if keyword == "FROM" || keyword == "INTO" || (i == 0 && keyword == "UPDATE") {
+			table, _, _ := strings.Cut(fields[i+1], "(")
+			return strings.TrimSuffix(table, ";")
+		}
+	}
+	return ""
+}
+
+func gocqlStart(call api.CallContext, ctx context.Context, request gocqlRequest) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = gocqlInstrumenter.Start(ctx, request)
+	observation := &gocqlObservation{}
+	data := make(map[string]interface{}, 3)
+	data["ctx"] = ctx
+	data["request"] = request
+	data["observation"] = observation
+	call.SetData(data)
+	return context.WithValue(ctx, gocqlObservationKey{}, observation)
+}
+
+func gocqlEnd(call api.CallContext) {
+	if !gocqlEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(gocqlRequest)
+	if !ok {
+		return
+	}
+	var err error
+	if observation, ok := data["observation"].(*gocqlObservation); ok {
+		var host *gocql.HostInfo
+		host, err = observation.get()
+		if host != nil {
+			request.Addr = net.JoinHostPort(host.ConnectAddress().String(), strconv.Itoa(host.Port()))
+			request.CoordinatorID = host.HostID()
+			request.CoordinatorDC = host.DataCenter()
+		}
+	}
+	gocqlInstrumenter.End(ctx, request, nil, err)
+}
+
+//go:linkname gocqlNewSessionOnEnter github.com/gocql/gocql.gocqlNewSessionOnEnter
+func gocqlNewSessionOnEnter(call api.CallContext, cfg gocql.ClusterConfig) {
+	if !gocqlEnabler.Enable() {
+		return
+	}
+	cfg.QueryObserver = gocqlQueryObserver{next: cfg.QueryObserver}
+	cfg.BatchObserver = gocqlBatchObserver{next: cfg.BatchObserver}
+	call.SetParam(0, cfg)
+}
+
+//go:linkname gocqlExecuteQueryOnEnter github.com/gocql/gocql.gocqlExecuteQueryOnEnter
+func gocqlExecuteQueryOnEnter(call api.CallContext, s *gocql.Session, qry *gocql.Query) {
+	if !gocqlEnabler.Enable() || qry == nil {
+		return
+	}
+	stmt := qry.Statement()
+	ctx := gocqlStart(call, qry.Context(), gocqlRequest{
+		Operation:   gocqlOperation(stmt),
+		Statement:   db.SanitizeStatement(stmt),
+		Keyspace:    qry.Keyspace(),
+		Collection:  gocqlCollection(stmt),
+		Consistency: strings.ToLower(qry.GetConsistency().String()),
+	})
+	// The observers find the span through the context of the executed query
+	call.SetParam(1, qry.WithContext(ctx))
+}
+
+//go:linkname gocqlExecuteQueryOnExit github.com/gocql/gocql.gocqlExecuteQueryOnExit
+func gocqlExecuteQueryOnExit(call api.CallContext, iter *gocql.Iter) {
+	gocqlEnd(call)
+}
+
+//go:linkname gocqlExecuteBatchOnEnter github.com/gocql/gocql.gocqlExecuteBatchOnEnter
+func gocqlExecuteBatchOnEnter(call api.CallContext, s *gocql.Session, batch *gocql.Batch) {
+	if !gocqlEnabler.Enable() || batch == nil {
+		return
+	}
+	stmts := make([]string, 0, len(batch.Entries))
+	for _, entry := range batch.Entries {
+		stmts = append(stmts, db.SanitizeStatement(entry.Stmt))
+	}
+	ctx := gocqlStart(call, batch.Context(), gocqlRequest{
+		Operation:   "BATCH",
+		Statement:   strings.Join(stmts, "; "),
+		Keyspace:    batch.Keyspace(),
+		Consistency: strings.ToLower(batch.GetConsistency().String()),
+		BatchSize:   batch.Size(),
+	})
+	call.SetParam(1, batch.WithContext(ctx))
+}
+
+//go:linkname gocqlExecuteBatchOnExit github.com/gocql/gocql.gocqlExecuteBatchOnExit
+func gocqlExecuteBatchOnExit(call api.CallContext, iter *gocql.Iter) {
+	gocqlEnd(call)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocron
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const (
+	gocronJobNameKey = attribute.Key("gocron.job.name")
+	gocronJobTagsKey = attribute.Key("gocron.job.tags")
+)
+
+type gocronInnerEnabler struct {
+	enabled bool
+}
+
+func (g gocronInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var gocronEnabler = gocronInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GOCRON_ENABLED") != "false"}
+
+type gocronSpanNameExtractor struct{}
+
+// Extract returns "gocron {gocron.job.name}", e.g. "gocron main.report".
+func (g gocronSpanNameExtractor) Extract(request gocronJobRequest) string {
+	return "gocron " + request.name
+}
+
+type gocronAttrsExtractor struct{}
+
+func (g gocronAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gocronJobRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, gocronJobNameKey.String(request.name))
+	if len(request.tags) > 0 {
+		attributes = append(attributes, gocronJobTagsKey.StringSlice(request.tags))
+	}
+	return attributes, parentContext
+}
+
+func (g gocronAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gocronJobRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildGocronJobInstrumenter() instrumenter.Instrumenter[gocronJobRequest, any] {
+	builder := instrumenter.Builder[gocronJobRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(gocronSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[gocronJobRequest]{}).
+		AddAttributesExtractor(gocronAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GOCRON_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocron
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/go-co-op/gocron"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var gocronJobInstrumenter = BuildGocronJobInstrumenter()
+
+// gocronJobTags keeps the tags of the jobs by name, as they are only known to
+// gocron.Job but not to the copy of the job function that is run.
+var gocronJobTags sync.Map
+
+// gocronRunningJobs keeps the latest running run of the jobs by name, so that
+// the runs triggered while it is still running are recorded on its span.
+var gocronRunningJobs sync.Map
+
+// gocronRuns keeps the runs by the span id, so that the error returned by the
+// job function is attributed to the run executing it.
+var gocronRuns sync.Map
+
+type gocronRun struct {
+	ctx     context.Context
+	request gocronJobRequest
+	err     error
+}
+
+//go:linkname gocronSchedulerRunOnEnter github.com/go-co-op/gocron.gocronSchedulerRunOnEnter
+func gocronSchedulerRunOnEnter(call api.CallContext, s *gocron.Scheduler, job *gocron.Job) {
+	if !gocronEnabler.Enable() || job == nil {
+		return
+	}
+	name := job.GetName()
+	gocronJobTags.Store(name, job.Tags())
+	if !job.IsRunning() {
+		return
+	}
+	// the run is skipped or delayed, depending on the mode of the job and the
+	// limit of the scheduler, as the previous run has not finished yet
+	if run, ok := gocronRunningJobs.Load(name); ok {
+		trace.SpanFromContext(run.(*gocronRun).ctx).AddEvent("gocron.run.overlapped")
+	}
+}
+
+//go:linkname gocronRunJobOnEnter github.com/go-co-op/gocron.gocronRunJobOnEnter
+func gocronRunJobOnEnter(call api.CallContext, f interface{}) {
+	if !gocronEnabler.Enable() {
+		return
+	}
+	name := jobFunctionName(f)
+	if name == "" {
+		return
+	}
+	request := gocronJobRequest{name: name}
+	if tags, ok := gocronJobTags.Load(name); ok {
+		request.tags = tags.([]string)
+	}
+	run := &gocronRun{request: request}
+	run.ctx = gocronJobInstrumenter.Start(context.Background(), request, trace.WithNewRoot())
+	gocronRunningJobs.Store(name, run)
+	gocronRuns.Store(trace.SpanContextFromContext(run.ctx).SpanID(), run)
+	call.SetData(run)
+}
+
+//go:linkname gocronRunJobOnExit github.com/go-co-op/gocron.gocronRunJobOnExit
+func gocronRunJobOnExit(call api.CallContext) {
+	if !gocronEnabler.Enable() {
+		return
+	}
+	run, ok := call.GetData().(*gocronRun)
+	if !ok || run == nil {
+		return
+	}
+	gocronRunningJobs.CompareAndDelete(run.request.name, run)
+	gocronRuns.Delete(trace.SpanContextFromContext(run.ctx).SpanID())
+	gocronJobInstrumenter.End(run.ctx, run.request, nil, run.err)
+}
+
+// gocronCallJobFuncOnExit records the error returned by the job function. The
+// event listeners called the same way never return an error.
+//
+//go:linkname gocronCallJobFuncOnExit github.com/go-co-op/gocron.gocronCallJobFuncOnExit
+func gocronCallJobFuncOnExit(call api.CallContext, err error) {
+	if !gocronEnabler.Enable() || err == nil {
+		return
+	}
+	span := sdktrace.SpanFromGLS()
+	if span == nil {
+		return
+	}
+	if run, ok := gocronRuns.Load(span.SpanContext().SpanID()); ok {
+		run.(*gocronRun).err = err
+	}
+}
+
+// jobFunctionName returns the name of the unexported job function of gocron,
+// which is the name given to the job or else the name of its function.
+func jobFunctionName(f interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(f))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	for _, field := range []string{"jobName", "funcName"} {
+		if name := v.FieldByName(field); name.Kind() == reflect.String && name.String() != "" {
+			return name.String()
+		}
+	}
+	return ""
+}
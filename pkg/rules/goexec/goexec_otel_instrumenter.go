@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goexec
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type execSpanNameExtractor struct{}
+
+// Extract returns "exec {process.executable.name}", e.g. "exec git".
+func (e execSpanNameExtractor) Extract(request execRequest) string {
+	return "exec " + filepath.Base(request.path)
+}
+
+type execAttrsExtractor struct{}
+
+func (e execAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request execRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		semconv.ProcessExecutableName(filepath.Base(request.path)),
+		semconv.ProcessExecutablePath(request.path),
+	)
+	if goExecArgsEnabler.Enable() {
+		attributes = append(attributes, semconv.ProcessCommandArgs(request.args...))
+	}
+	return attributes, parentContext
+}
+
+func (e execAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request execRequest, response execResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.pid > 0 {
+		attributes = append(attributes, semconv.ProcessPID(response.pid))
+	}
+	if response.exited {
+		attributes = append(attributes, semconv.ProcessExitCode(response.exitCode))
+	}
+	return attributes, context
+}
+
+func BuildExecInstrumenter() instrumenter.Instrumenter[execRequest, execResponse] {
+	builder := instrumenter.Builder[execRequest, execResponse]{}
+	return builder.Init().SetSpanNameExtractor(execSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[execRequest]{}).
+		AddAttributesExtractor(execAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GO_EXEC_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goexec
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type goExecInnerEnabler struct {
+	enabled bool
+}
+
+func (g goExecInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var goExecEnabler = goExecInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_EXEC_ENABLED") != "false"}
+
+// The context of the span is passed to the child process through environment
+// variables, e.g. TRACEPARENT, only when asked to, as the child may not expect
+// its environment to be changed.
+var goExecPropagationEnabler = goExecInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_EXEC_PROPAGATION_ENABLED") == "true"}
+
+// Arguments of the command are recorded as process.command_args only when
+// asked to, as they often carry credentials, tokens or personal data.
+var goExecArgsEnabler = goExecInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_EXEC_CAPTURE_ARGS") == "true"}
+
+var execInstrumenter = BuildExecInstrumenter()
+
+// The span covers the command from Start to Wait, Run and Output call both of
+// them. It is kept in the goroutine while the process is being started so
+// that the span of os.StartProcess is its child.
+//
+//go:linkname execCmdStartOnEnter os/exec.execCmdStartOnEnter
+func execCmdStartOnEnter(call api.CallContext, c *exec.Cmd) {
+	if !goExecEnabler.Enable() || c == nil || c.Process != nil {
+		return
+	}
+	ctx := execInstrumenter.Start(context.Background(), execRequest{
+		path: c.Path,
+		args: c.Args,
+	})
+	if goExecPropagationEnabler.Enable() {
+		injectEnv(ctx, c)
+	}
+	c.OtelContext = ctx
+	call.SetData(c)
+}
+
+//go:linkname execCmdStartOnExit os/exec.execCmdStartOnExit
+func execCmdStartOnExit(call api.CallContext, err error) {
+	c, ok := call.GetData().(*exec.Cmd)
+	if !ok {
+		return
+	}
+	ctx, ok := c.OtelContext.(context.Context)
+	if !ok {
+		return
+	}
+	if err != nil {
+		c.OtelContext = nil
+		execInstrumenter.End(ctx, execRequest{path: c.Path, args: c.Args}, execResponse{}, err)
+		return
+	}
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(ctx))
+}
+
+//go:linkname execCmdWaitOnExit os/exec.execCmdWaitOnExit
+func execCmdWaitOnExit(call api.CallContext, err error) {
+	c, ok := call.GetParam(0).(*exec.Cmd)
+	if !ok || c == nil {
+		return
+	}
+	ctx, ok := c.OtelContext.(context.Context)
+	if !ok {
+		return
+	}
+	c.OtelContext = nil
+	response := execResponse{}
+	if c.Process != nil {
+		response.pid = c.Process.Pid
+	}
+	if c.ProcessState != nil {
+		response.exitCode = c.ProcessState.ExitCode()
+		response.exited = true
+	}
+	execInstrumenter.End(ctx, execRequest{path: c.Path, args: c.Args}, response, err)
+}
+
+// injectEnv adds the context of the span to the environment of the command in
+// the form of the environment variables carrier, e.g. TRACEPARENT.
+func injectEnv(ctx context.Context, c *exec.Cmd) {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return
+	}
+	env := c.Env
+	if env == nil {
+		env = c.Environ()
+	}
+	for key, value := range carrier {
+		env = append(env, strings.ToUpper(key)+"="+value)
+	}
+	c.Env = env
+}
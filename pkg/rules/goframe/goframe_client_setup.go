@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goframe
+
+import (
+	"net/http"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/gogf/gf/v2/net/gclient"
+)
+
+// The gclient sends requests by net/http, whose client spans and metrics
+// already cover them with the context propagated, so the built-in tracing
+// middleware of goframe is skipped to avoid a duplicated client span.
+//
+//go:linkname goframeClientTracingOnEnter github.com/gogf/gf/v2/net/gclient.goframeClientTracingOnEnter
+func goframeClientTracingOnEnter(call api.CallContext, c *gclient.Client, r *http.Request) {
+	if !goframeEnabler.Enable() {
+		return
+	}
+	if c == nil || r == nil {
+		return
+	}
+	call.SetSkipCall(true)
+	resp, err := c.Next(r)
+	call.SetReturnVal(0, resp)
+	call.SetReturnVal(1, err)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goframe
+
+import (
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type goframeGdbAttrsGetter struct{}
+
+func (g goframeGdbAttrsGetter) GetSystem(request goframeGdbRequest) string {
+	return request.System
+}
+
+func (g goframeGdbAttrsGetter) GetServerAddress(request goframeGdbRequest) string {
+	return request.Addr
+}
+
+func (g goframeGdbAttrsGetter) GetStatement(request goframeGdbRequest) string {
+	return request.Statement
+}
+
+func (g goframeGdbAttrsGetter) GetCollection(_ goframeGdbRequest) string {
+	// Tables are parsed from the statements by the spans of database/sql
+	return ""
+}
+
+func (g goframeGdbAttrsGetter) GetOperation(request goframeGdbRequest) string {
+	return request.Operation
+}
+
+func (g goframeGdbAttrsGetter) GetParameters(_ goframeGdbRequest) []any {
+	return nil
+}
+
+func (g goframeGdbAttrsGetter) GetDbNamespace(request goframeGdbRequest) string {
+	return request.DbName
+}
+
+func (g goframeGdbAttrsGetter) GetBatchSize(_ goframeGdbRequest) int {
+	return 0
+}
+
+func BuildGoframeGdbInstrumenter() instrumenter.Instrumenter[goframeGdbRequest, interface{}] {
+	builder := instrumenter.Builder[goframeGdbRequest, interface{}]{}
+	getter := goframeGdbAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[goframeGdbRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[goframeGdbRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[goframeGdbRequest, any, goframeGdbAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[goframeGdbRequest, any, goframeGdbAttrsGetter]{Getter: getter}}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GOFRAME_GDB_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
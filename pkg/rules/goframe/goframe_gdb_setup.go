@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goframe
+
+import (
+	"context"
+	"net"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+var goframeGdbInstrumenter = BuildGoframeGdbInstrumenter()
+
+// Every statement and transaction of gdb is committed to database/sql by
+// DoCommit, so the span of it is the parent of the spans of database/sql as
+// well as the internal span created by gdb itself.
+//
+//go:linkname goframeDoCommitOnEnter github.com/gogf/gf/v2/database/gdb.goframeDoCommitOnEnter
+func goframeDoCommitOnEnter(call api.CallContext, c *gdb.Core, ctx context.Context, in gdb.DoCommitInput) {
+	if !goframeEnabler.Enable() {
+		return
+	}
+	if c == nil {
+		return
+	}
+	request := goframeGdbRequest{
+		Statement: in.Sql,
+		Operation: goframeGdbOperation(in.Sql, string(in.Type)),
+	}
+	if config := c.GetConfig(); config != nil {
+		request.System = goframeDbSystem(config.Type)
+		request.DbName = config.Name
+		if config.Host != "" && config.Port != "" {
+			request.Addr = net.JoinHostPort(config.Host, config.Port)
+		} else {
+			request.Addr = config.Host
+		}
+	}
+	ctx = goframeGdbInstrumenter.Start(ctx, request)
+	call.SetParam(1, ctx)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+}
+
+//go:linkname goframeDoCommitOnExit github.com/gogf/gf/v2/database/gdb.goframeDoCommitOnExit
+func goframeDoCommitOnExit(call api.CallContext, out gdb.DoCommitOutput, err error) {
+	if !goframeEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(goframeGdbRequest)
+	if !ok {
+		return
+	}
+	goframeGdbInstrumenter.End(ctx, request, nil, err)
+}
+
+// goframeGdbOperation takes the keyword of the statement, e.g. SELECT, or the
+// type of the commit for the ones without statements, e.g. Begin of DB.Begin
+func goframeGdbOperation(sql string, sqlType string) string {
+	if fields := strings.Fields(sql); len(fields) > 0 {
+		return strings.ToUpper(fields[0])
+	}
+	if i := strings.LastIndex(sqlType, "."); i >= 0 {
+		return sqlType[i+1:]
+	}
+	return sqlType
+}
+
+func goframeDbSystem(dbType string) string {
+	switch dbType {
+	case "pgsql":
+		return "postgresql"
+	case "mysql", "mariadb", "tidb", "mssql", "sqlite", "oracle", "clickhouse":
+		return dbType
+	case "":
+		return ""
+	}
+	return "other_sql"
+}
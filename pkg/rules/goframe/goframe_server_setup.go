@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goframe
+
+import (
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// The ghttp server is served by net/http, whose server span already covers
+// the request, so the built-in tracing middleware of goframe is skipped to
+// avoid a duplicated server span, and the span of net/http is named by the
+// pattern of the matched route instead, e.g. /user/{id}. The router of the
+// request is only known after the rest of the middlewares are executed.
+//
+//go:linkname goframeServerTracingOnEnter github.com/gogf/gf/v2/net/ghttp.goframeServerTracingOnEnter
+func goframeServerTracingOnEnter(call api.CallContext, r *ghttp.Request) {
+	if !goframeEnabler.Enable() {
+		return
+	}
+	if r == nil {
+		return
+	}
+	call.SetSkipCall(true)
+	r.Middleware.Next()
+	if r.Router == nil || r.Router.Uri == "" {
+		return
+	}
+	lcs := trace.LocalRootSpanFromGLS()
+	if lcs != nil {
+		lcs.SetName(r.Router.Uri)
+	}
+}
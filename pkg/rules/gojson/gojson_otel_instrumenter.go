@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gojson
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const (
+	jsonValueTypeKey = attribute.Key("json.value.type")
+	jsonSizeKey      = attribute.Key("json.size")
+)
+
+type jsonSpanNameExtractor struct{}
+
+func (j jsonSpanNameExtractor) Extract(request jsonRequest) string {
+	return "json." + request.operation
+}
+
+type jsonAttrsExtractor struct{}
+
+func (j jsonAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request jsonRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, jsonValueTypeKey.String(request.valueType))
+	if request.operation == jsonOperationUnmarshal {
+		attributes = append(attributes, jsonSizeKey.Int(request.size))
+	}
+	return attributes, parentContext
+}
+
+func (j jsonAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request jsonRequest, response jsonResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if request.operation == jsonOperationMarshal {
+		attributes = append(attributes, jsonSizeKey.Int(response.size))
+	}
+	return attributes, context
+}
+
+func BuildJsonInstrumenter() instrumenter.Instrumenter[jsonRequest, jsonResponse] {
+	builder := instrumenter.Builder[jsonRequest, jsonResponse]{}
+	return builder.Init().SetSpanNameExtractor(jsonSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[jsonRequest]{}).
+		AddAttributesExtractor(jsonAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GO_JSON_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
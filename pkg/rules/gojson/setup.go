@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gojson
+
+import (
+	"context"
+	"fmt"
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type goJsonInnerEnabler struct {
+	enabled bool
+}
+
+func (g goJsonInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var goJsonEnabler = goJsonInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_JSON_ENABLED") == "true"}
+
+var jsonInstrumenter = BuildJsonInstrumenter()
+
+func shouldRecord() bool {
+	return goJsonEnabler.Enable() && sdktrace.SpanFromGLS() != nil
+}
+
+//go:linkname jsonMarshalOnEnter encoding/json.jsonMarshalOnEnter
+func jsonMarshalOnEnter(call api.CallContext, v any) {
+	if !shouldRecord() {
+		return
+	}
+	request := jsonRequest{
+		operation: jsonOperationMarshal,
+		valueType: fmt.Sprintf("%T", v),
+	}
+	ctx := jsonInstrumenter.Start(context.Background(), request)
+	call.SetData(map[string]interface{}{
+		"ctx":     ctx,
+		"request": request,
+	})
+}
+
+//go:linkname jsonMarshalOnExit encoding/json.jsonMarshalOnExit
+func jsonMarshalOnExit(call api.CallContext, data []byte, err error) {
+	callData, ok := call.GetData().(map[string]interface{})
+	if !ok || callData == nil {
+		return
+	}
+	ctx := callData["ctx"].(context.Context)
+	request := callData["request"].(jsonRequest)
+	jsonInstrumenter.End(ctx, request, jsonResponse{size: len(data)}, err)
+}
+
+//go:linkname jsonUnmarshalOnEnter encoding/json.jsonUnmarshalOnEnter
+func jsonUnmarshalOnEnter(call api.CallContext, data []byte, v any) {
+	if !shouldRecord() {
+		return
+	}
+	request := jsonRequest{
+		operation: jsonOperationUnmarshal,
+		valueType: fmt.Sprintf("%T", v),
+		size:      len(data),
+	}
+	ctx := jsonInstrumenter.Start(context.Background(), request)
+	call.SetData(map[string]interface{}{
+		"ctx":     ctx,
+		"request": request,
+	})
+}
+
+//go:linkname jsonUnmarshalOnExit encoding/json.jsonUnmarshalOnExit
+func jsonUnmarshalOnExit(call api.CallContext, err error) {
+	callData, ok := call.GetData().(map[string]interface{})
+	if !ok || callData == nil {
+		return
+	}
+	ctx := callData["ctx"].(context.Context)
+	request := callData["request"].(jsonRequest)
+	jsonInstrumenter.End(ctx, request, jsonResponse{}, err)
+}
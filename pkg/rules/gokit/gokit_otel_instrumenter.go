@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gokit
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type gokitInnerEnabler struct {
+	enabled bool
+}
+
+func (g gokitInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var gokitEnabler = gokitInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GOKIT_ENABLED") != "false"}
+
+const (
+	gokitEndpointKey  = attribute.Key("gokit.endpoint")
+	gokitTransportKey = attribute.Key("gokit.transport")
+)
+
+type gokitSpanNameExtractor struct {
+}
+
+func (g gokitSpanNameExtractor) Extract(request gokitRequest) string {
+	if request.endpoint == "" {
+		return "gokit.endpoint"
+	}
+	return request.endpoint
+}
+
+type gokitAttrsExtractor struct {
+}
+
+func (g gokitAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gokitRequest) ([]attribute.KeyValue, context.Context) {
+	return append(attributes,
+		gokitEndpointKey.String(request.endpoint),
+		gokitTransportKey.String(request.transport),
+	), parentContext
+}
+
+func (g gokitAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gokitRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildGokitEndpointInstrumenter() instrumenter.Instrumenter[gokitRequest, any] {
+	builder := instrumenter.Builder[gokitRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(gokitSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[gokitRequest]{}).
+		AddAttributesExtractor(gokitAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GOKIT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gokit
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+var gokitEndpointInstrumenter = BuildGokitEndpointInstrumenter()
+
+//go:linkname gokitHTTPNewServerOnEnter github.com/go-kit/kit/transport/http.gokitHTTPNewServerOnEnter
+func gokitHTTPNewServerOnEnter(call api.CallContext, e endpoint.Endpoint, dec httptransport.DecodeRequestFunc, enc httptransport.EncodeResponseFunc, options ...httptransport.ServerOption) {
+	if !gokitEnabler.Enable() || e == nil {
+		return
+	}
+	call.SetParam(0, traceEndpoint(e, "http"))
+}
+
+//go:linkname gokitGRPCNewServerOnEnter github.com/go-kit/kit/transport/grpc.gokitGRPCNewServerOnEnter
+func gokitGRPCNewServerOnEnter(call api.CallContext, e endpoint.Endpoint, dec grpctransport.DecodeRequestFunc, enc grpctransport.EncodeResponseFunc, options ...grpctransport.ServerOption) {
+	if !gokitEnabler.Enable() || e == nil {
+		return
+	}
+	call.SetParam(0, traceEndpoint(e, "grpc"))
+}
+
+// traceEndpoint wraps the endpoint served by a transport server so that every
+// execution of it is recorded as a span under the span of net/http or grpc.
+func traceEndpoint(e endpoint.Endpoint, transport string) endpoint.Endpoint {
+	request := gokitRequest{
+		endpoint:  endpointName(e),
+		transport: transport,
+	}
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		ctx = gokitEndpointInstrumenter.Start(ctx, request)
+		resp, err := e(ctx, req)
+		gokitEndpointInstrumenter.End(ctx, request, resp, err)
+		return resp, err
+	}
+}
+
+// endpointName names the endpoint by the function that implements it, e.g.
+// "addservice.MakeSumEndpoint" for the closure returned by MakeSumEndpoint of
+// package addservice, or "addservice.Endpoints.Sum" for a method value.
+func endpointName(e endpoint.Endpoint) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(e).Pointer())
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = strings.TrimSuffix(name, "-fm")
+	parts := strings.Split(name, ".")
+	for len(parts) > 2 && isClosureSuffix(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, ".")
+}
+
+// isClosureSuffix reports whether the part of a function name is added by the
+// compiler for closures, e.g. "func1" or "2" of "MakeSumEndpoint.func1.2".
+func isClosureSuffix(part string) bool {
+	part = strings.TrimPrefix(part, "func")
+	if part == "" {
+		return false
+	}
+	for _, c := range part {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
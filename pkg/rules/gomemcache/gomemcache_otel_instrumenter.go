@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomemcache
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type memcacheAttrsGetter struct {
+}
+
+func (m memcacheAttrsGetter) GetSystem(request *memcacheRequest) string {
+	return "memcached"
+}
+
+func (m memcacheAttrsGetter) GetServerAddress(request *memcacheRequest) string {
+	return request.endpoint
+}
+
+func (m memcacheAttrsGetter) GetStatement(request *memcacheRequest) string {
+	// keys may carry user data, they are only recorded on demand
+	if !memcacheKeysEnabled || len(request.keys) == 0 {
+		return request.operation
+	}
+	return request.operation + " " + strings.Join(request.keys, " ")
+}
+
+func (m memcacheAttrsGetter) GetOperation(request *memcacheRequest) string {
+	return request.operation
+}
+
+func (m memcacheAttrsGetter) GetCollection(request *memcacheRequest) string {
+	return ""
+}
+
+func (m memcacheAttrsGetter) GetParameters(request *memcacheRequest) []any {
+	return nil
+}
+
+func (m memcacheAttrsGetter) GetDbNamespace(request *memcacheRequest) string {
+	return ""
+}
+
+func (m memcacheAttrsGetter) GetBatchSize(request *memcacheRequest) int {
+	return 0
+}
+
+type memcacheAttrsExtractor struct {
+}
+
+func (m memcacheAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *memcacheRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, attribute.Int("memcached.key.count", len(request.keys)))
+	return attributes, parentContext
+}
+
+func (m memcacheAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *memcacheRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if !request.lookup || err != nil {
+		return attributes, context
+	}
+	if len(request.keys) == 1 {
+		attributes = append(attributes, attribute.Bool("memcached.hit", request.hits > 0))
+	} else {
+		attributes = append(attributes, attribute.Int("memcached.hit.count", request.hits),
+			attribute.Int("memcached.miss.count", len(request.keys)-request.hits))
+	}
+	return attributes, context
+}
+
+func BuildMemcacheOtelInstrumenter() instrumenter.Instrumenter[*memcacheRequest, any] {
+	builder := instrumenter.Builder[*memcacheRequest, any]{}
+	getter := memcacheAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[*memcacheRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[*memcacheRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[*memcacheRequest, any, db.DbClientAttrsGetter[*memcacheRequest]]{Base: db.DbClientCommonAttrsExtractor[*memcacheRequest, any, db.DbClientAttrsGetter[*memcacheRequest]]{Getter: getter}}, memcacheAttrsExtractor{}).
+		AddOperationListeners(db.DbClientMetrics("nosql.gomemcache")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GOMEMCACHE_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomemcache
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+var memcacheInstrumenter = BuildMemcacheOtelInstrumenter()
+
+type memcacheInnerEnabler struct {
+	enabled bool
+}
+
+func (m memcacheInnerEnabler) Enable() bool {
+	return m.enabled
+}
+
+var memcacheEnabler = memcacheInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GOMEMCACHE_ENABLED") != "false"}
+
+// raw keys are not recorded by default, only the number of them
+var memcacheKeysEnabled = func() bool {
+	enabled, err := strconv.ParseBool(os.Getenv("OTEL_INSTRUMENTATION_GOMEMCACHE_KEYS_ENABLED"))
+	return err == nil && enabled
+}()
+
+// the client does not expose its selector, which is remembered on creation
+// to resolve the servers of the keys
+var memcacheSelectors sync.Map
+
+//go:linkname beforeMemcacheNewFromSelector github.com/bradfitz/gomemcache/memcache.beforeMemcacheNewFromSelector
+func beforeMemcacheNewFromSelector(call api.CallContext, ss memcache.ServerSelector) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	call.SetData(ss)
+}
+
+//go:linkname afterMemcacheNewFromSelector github.com/bradfitz/gomemcache/memcache.afterMemcacheNewFromSelector
+func afterMemcacheNewFromSelector(call api.CallContext, client *memcache.Client) {
+	if !memcacheEnabler.Enable() || client == nil {
+		return
+	}
+	if ss, ok := call.GetData().(memcache.ServerSelector); ok && ss != nil {
+		memcacheSelectors.Store(client, ss)
+	}
+}
+
+func getMemcacheEndpoint(client *memcache.Client, keys []string) string {
+	s, ok := memcacheSelectors.Load(client)
+	if !ok {
+		return ""
+	}
+	ss := s.(memcache.ServerSelector)
+	addrs := make([]string, 0, 1)
+	for _, key := range keys {
+		addr, err := ss.PickServer(key)
+		if err != nil || addr == nil {
+			continue
+		}
+		found := false
+		for _, a := range addrs {
+			if a == addr.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			addrs = append(addrs, addr.String())
+		}
+	}
+	sort.Strings(addrs)
+	return strings.Join(addrs, ",")
+}
+
+func startMemcache(call api.CallContext, client *memcache.Client, operation string, keys []string, lookup bool) {
+	request := &memcacheRequest{
+		operation: operation,
+		keys:      keys,
+		endpoint:  getMemcacheEndpoint(client, keys),
+		lookup:    lookup,
+	}
+	ctx := memcacheInstrumenter.Start(context.Background(), request)
+	call.SetKeyData("ctx", ctx)
+	call.SetKeyData("request", request)
+}
+
+func endMemcache(call api.CallContext, hits int, err error) {
+	ctx, ok := call.GetKeyData("ctx").(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := call.GetKeyData("request").(*memcacheRequest)
+	if !ok {
+		return
+	}
+	// a missing key is an expected outcome rather than a failure
+	if err == memcache.ErrCacheMiss {
+		err = nil
+	}
+	request.hits = hits
+	memcacheInstrumenter.End(ctx, request, nil, err)
+}
+
+//go:linkname beforeMemcacheGet github.com/bradfitz/gomemcache/memcache.beforeMemcacheGet
+func beforeMemcacheGet(call api.CallContext, client *memcache.Client, key string) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	startMemcache(call, client, "get", []string{key}, true)
+}
+
+//go:linkname afterMemcacheGet github.com/bradfitz/gomemcache/memcache.afterMemcacheGet
+func afterMemcacheGet(call api.CallContext, item *memcache.Item, err error) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	hits := 0
+	if err == nil && item != nil {
+		hits = 1
+	}
+	endMemcache(call, hits, err)
+}
+
+//go:linkname beforeMemcacheGetMulti github.com/bradfitz/gomemcache/memcache.beforeMemcacheGetMulti
+func beforeMemcacheGetMulti(call api.CallContext, client *memcache.Client, keys []string) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	startMemcache(call, client, "get_multi", append([]string(nil), keys...), true)
+}
+
+//go:linkname afterMemcacheGetMulti github.com/bradfitz/gomemcache/memcache.afterMemcacheGetMulti
+func afterMemcacheGetMulti(call api.CallContext, items map[string]*memcache.Item, err error) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	endMemcache(call, len(items), err)
+}
+
+//go:linkname beforeMemcacheSet github.com/bradfitz/gomemcache/memcache.beforeMemcacheSet
+func beforeMemcacheSet(call api.CallContext, client *memcache.Client, item *memcache.Item) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	var keys []string
+	if item != nil {
+		keys = []string{item.Key}
+	}
+	startMemcache(call, client, "set", keys, false)
+}
+
+//go:linkname afterMemcacheSet github.com/bradfitz/gomemcache/memcache.afterMemcacheSet
+func afterMemcacheSet(call api.CallContext, err error) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	endMemcache(call, 0, err)
+}
+
+//go:linkname beforeMemcacheDelete github.com/bradfitz/gomemcache/memcache.beforeMemcacheDelete
+func beforeMemcacheDelete(call api.CallContext, client *memcache.Client, key string) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	startMemcache(call, client, "delete", []string{key}, false)
+}
+
+//go:linkname afterMemcacheDelete github.com/bradfitz/gomemcache/memcache.afterMemcacheDelete
+func afterMemcacheDelete(call api.CallContext, err error) {
+	if !memcacheEnabler.Enable() {
+		return
+	}
+	endMemcache(call, 0, err)
+}
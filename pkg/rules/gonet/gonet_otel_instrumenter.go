@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonet
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	netDNSAnswersKey = attribute.Key("dns.answers")
+	netDurationKey   = attribute.Key("net.operation.duration")
+)
+
+type netSpanNameExtractor struct{}
+
+func (n netSpanNameExtractor) Extract(request netRequest) string {
+	return "net." + request.operation
+}
+
+type netSpanKindExtractor struct{}
+
+func (n netSpanKindExtractor) Extract(request netRequest) trace.SpanKind {
+	if request.operation == netOperationDial || request.operation == netOperationLookup {
+		return trace.SpanKindClient
+	}
+	return trace.SpanKindInternal
+}
+
+type netAttrsExtractor struct{}
+
+func (n netAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request netRequest) ([]attribute.KeyValue, context.Context) {
+	if request.operation == netOperationLookup {
+		return append(attributes, semconv.DNSQuestionName(request.address)), parentContext
+	}
+	attributes = append(attributes, semconv.NetworkTransportKey.String(request.network))
+	host, port := splitHostPort(request.address)
+	if request.operation == netOperationDial {
+		attributes = append(attributes, semconv.ServerAddress(host))
+		if port > 0 {
+			attributes = append(attributes, semconv.ServerPort(port))
+		}
+	} else {
+		attributes = append(attributes, semconv.NetworkLocalAddress(host))
+		if port > 0 {
+			attributes = append(attributes, semconv.NetworkLocalPort(port))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (n netAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request netRequest, response netResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if len(response.answers) > 0 {
+		attributes = append(attributes, netDNSAnswersKey.StringSlice(response.answers))
+	}
+	if response.peerAddr != "" {
+		host, port := splitHostPort(response.peerAddr)
+		attributes = append(attributes, semconv.NetworkPeerAddress(host))
+		if port > 0 {
+			attributes = append(attributes, semconv.NetworkPeerPort(port))
+		}
+	}
+	if request.operation == netOperationListen && response.localAddr != "" {
+		// The listener may have been bound to an ephemeral port
+		_, port := splitHostPort(response.localAddr)
+		if port > 0 {
+			attributes = append(attributes, semconv.NetworkLocalPort(port))
+		}
+	}
+	return attributes, context
+}
+
+func splitHostPort(address string) (string, int) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return address, -1
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, -1
+	}
+	return host, port
+}
+
+func BuildNetInstrumenter() instrumenter.Instrumenter[netRequest, netResponse] {
+	builder := instrumenter.Builder[netRequest, netResponse]{}
+	return builder.Init().SetSpanNameExtractor(netSpanNameExtractor{}).
+		SetSpanKindExtractor(netSpanKindExtractor{}).
+		AddAttributesExtractor(netAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GO_NET_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
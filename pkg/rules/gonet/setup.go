@@ -0,0 +1,191 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gonet
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"os"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type goNetInnerEnabler struct {
+	enabled bool
+}
+
+func (g goNetInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+// Dial and listen are called very frequently, including by the exporters
+// themselves, so the instrumentation is opt-in.
+var goNetEnabler = goNetInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_NET_ENABLED") == "true"}
+
+// The operations may be recorded as events of the current span rather than
+// spans of their own, which keeps traces of chatty clients small while still
+// telling how long resolving and connecting took.
+var goNetEventsEnabler = goNetInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_NET_EVENTS_ENABLED") == "true"}
+
+var netInstrumenter = BuildNetInstrumenter()
+
+// Only record the operation when it happens within an existing trace, this
+// avoids emitting orphan spans for background connections.
+func shouldRecord() bool {
+	return goNetEnabler.Enable() && sdktrace.SpanFromGLS() != nil
+}
+
+// netOperation is the state of an operation between its enter and exit hooks,
+// either the context of its span or the span it is recorded as an event of.
+type netOperation struct {
+	request netRequest
+	ctx     context.Context
+	parent  trace.Span
+	start   time.Time
+}
+
+func startNetOperation(call api.CallContext, ctx context.Context, request netRequest) {
+	op := &netOperation{request: request}
+	if goNetEventsEnabler.Enable() {
+		op.parent = sdktrace.SpanFromGLS()
+		op.start = time.Now()
+	} else {
+		op.ctx = netInstrumenter.Start(ctx, request)
+	}
+	call.SetData(op)
+}
+
+func endNetOperation(call api.CallContext, response netResponse, err error) {
+	op, ok := call.GetData().(*netOperation)
+	if !ok || op == nil {
+		return
+	}
+	if op.parent == nil {
+		netInstrumenter.End(op.ctx, op.request, response, err)
+		return
+	}
+	attrs, _ := netAttrsExtractor{}.OnStart(nil, context.Background(), op.request)
+	attrs, _ = netAttrsExtractor{}.OnEnd(attrs, context.Background(), op.request, response, err)
+	attrs = append(attrs, netDurationKey.Float64(time.Since(op.start).Seconds()))
+	if err != nil {
+		attrs = append(attrs, semconv.ExceptionMessage(err.Error()))
+	}
+	op.parent.AddEvent(netSpanNameExtractor{}.Extract(op.request),
+		trace.WithTimestamp(op.start),
+		trace.WithAttributes(attrs...))
+}
+
+//go:linkname dialContextOnEnter net.dialContextOnEnter
+func dialContextOnEnter(call api.CallContext, d *net.Dialer, ctx context.Context, network, address string) {
+	if !shouldRecord() {
+		return
+	}
+	startNetOperation(call, ctx, netRequest{
+		operation: netOperationDial,
+		network:   network,
+		address:   address,
+	})
+}
+
+//go:linkname dialContextOnExit net.dialContextOnExit
+func dialContextOnExit(call api.CallContext, conn net.Conn, err error) {
+	response := netResponse{}
+	if conn != nil {
+		if addr := conn.LocalAddr(); addr != nil {
+			response.localAddr = addr.String()
+		}
+		if addr := conn.RemoteAddr(); addr != nil {
+			response.peerAddr = addr.String()
+		}
+	}
+	endNetOperation(call, response, err)
+}
+
+//go:linkname listenOnEnter net.listenOnEnter
+func listenOnEnter(call api.CallContext, lc *net.ListenConfig, ctx context.Context, network, address string) {
+	if !shouldRecord() {
+		return
+	}
+	startNetOperation(call, ctx, netRequest{
+		operation: netOperationListen,
+		network:   network,
+		address:   address,
+	})
+}
+
+//go:linkname listenOnExit net.listenOnExit
+func listenOnExit(call api.CallContext, ln net.Listener, err error) {
+	response := netResponse{}
+	if ln != nil {
+		if addr := ln.Addr(); addr != nil {
+			response.localAddr = addr.String()
+		}
+	}
+	endNetOperation(call, response, err)
+}
+
+// lookupIPAddr resolves the hosts of Dial and of LookupIP, LookupIPAddr and
+// LookupNetIP, IP addresses are returned as is without asking the resolver.
+//
+//go:linkname lookupIPAddrOnEnter net.lookupIPAddrOnEnter
+func lookupIPAddrOnEnter(call api.CallContext, r *net.Resolver, ctx context.Context, network, host string) {
+	if !shouldRecord() || !isHostName(host) {
+		return
+	}
+	startNetOperation(call, ctx, netRequest{
+		operation: netOperationLookup,
+		network:   network,
+		address:   host,
+	})
+}
+
+//go:linkname lookupIPAddrOnExit net.lookupIPAddrOnExit
+func lookupIPAddrOnExit(call api.CallContext, addrs []net.IPAddr, err error) {
+	response := netResponse{}
+	for _, addr := range addrs {
+		response.answers = append(response.answers, addr.String())
+	}
+	endNetOperation(call, response, err)
+}
+
+//go:linkname lookupHostOnEnter net.lookupHostOnEnter
+func lookupHostOnEnter(call api.CallContext, r *net.Resolver, ctx context.Context, host string) {
+	if !shouldRecord() || !isHostName(host) {
+		return
+	}
+	startNetOperation(call, ctx, netRequest{
+		operation: netOperationLookup,
+		address:   host,
+	})
+}
+
+//go:linkname lookupHostOnExit net.lookupHostOnExit
+func lookupHostOnExit(call api.CallContext, addrs []string, err error) {
+	endNetOperation(call, netResponse{answers: addrs}, err)
+}
+
+func isHostName(host string) bool {
+	if host == "" {
+		return false
+	}
+	_, err := netip.ParseAddr(host)
+	return err != nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goos
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const osFileFlagKey = attribute.Key("os.file.flag")
+
+type osSpanNameExtractor struct{}
+
+func (o osSpanNameExtractor) Extract(request osRequest) string {
+	return "os." + request.operation
+}
+
+type osAttrsExtractor struct{}
+
+func (o osAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request osRequest) ([]attribute.KeyValue, context.Context) {
+	switch request.operation {
+	case osOperationOpen:
+		attributes = append(attributes,
+			semconv.FilePath(request.path),
+			osFileFlagKey.Int(request.flag))
+	case osOperationStartProcess:
+		attributes = append(attributes,
+			semconv.ProcessExecutablePath(request.path),
+			semconv.ProcessCommandArgs(request.args...))
+	}
+	return attributes, parentContext
+}
+
+func (o osAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request osRequest, response osResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if request.operation == osOperationStartProcess && response.pid > 0 {
+		attributes = append(attributes, semconv.ProcessPID(response.pid))
+	}
+	return attributes, context
+}
+
+func BuildOsInstrumenter() instrumenter.Instrumenter[osRequest, osResponse] {
+	builder := instrumenter.Builder[osRequest, osResponse]{}
+	return builder.Init().SetSpanNameExtractor(osSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[osRequest]{}).
+		AddAttributesExtractor(osAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GO_OS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
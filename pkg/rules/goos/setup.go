@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goos
+
+import (
+	"context"
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type goOsInnerEnabler struct {
+	enabled bool
+}
+
+func (g goOsInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+// File access is pervasive and mostly uninteresting, so the instrumentation
+// is opt-in.
+var goOsEnabler = goOsInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_OS_ENABLED") == "true"}
+
+var osInstrumenter = BuildOsInstrumenter()
+
+// Only record the operation when it happens within an existing trace, this
+// avoids emitting orphan spans for files opened during initialization.
+func shouldRecord() bool {
+	return goOsEnabler.Enable() && sdktrace.SpanFromGLS() != nil
+}
+
+//go:linkname openFileOnEnter os.openFileOnEnter
+func openFileOnEnter(call api.CallContext, name string, flag int, perm os.FileMode) {
+	if !shouldRecord() {
+		return
+	}
+	request := osRequest{
+		operation: osOperationOpen,
+		path:      name,
+		flag:      flag,
+	}
+	ctx := osInstrumenter.Start(context.Background(), request)
+	call.SetData(map[string]interface{}{
+		"ctx":     ctx,
+		"request": request,
+	})
+}
+
+//go:linkname openFileOnExit os.openFileOnExit
+func openFileOnExit(call api.CallContext, file *os.File, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx := data["ctx"].(context.Context)
+	request := data["request"].(osRequest)
+	osInstrumenter.End(ctx, request, osResponse{}, err)
+}
+
+//go:linkname startProcessOnEnter os.startProcessOnEnter
+func startProcessOnEnter(call api.CallContext, name string, argv []string, attr *os.ProcAttr) {
+	if !shouldRecord() {
+		return
+	}
+	request := osRequest{
+		operation: osOperationStartProcess,
+		path:      name,
+		args:      argv,
+	}
+	ctx := osInstrumenter.Start(context.Background(), request)
+	call.SetData(map[string]interface{}{
+		"ctx":     ctx,
+		"request": request,
+	})
+}
+
+//go:linkname startProcessOnExit os.startProcessOnExit
+func startProcessOnExit(call api.CallContext, process *os.Process, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx := data["ctx"].(context.Context)
+	request := data["request"].(osRequest)
+	response := osResponse{}
+	if process != nil {
+		response.pid = process.Pid
+	}
+	osInstrumenter.End(ctx, request, response, err)
+}
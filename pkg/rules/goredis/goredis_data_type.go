@@ -16,9 +16,14 @@ package goredis
 
 import (
 	redis "github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type goRedisRequest struct {
-	cmd      redis.Cmder
-	endpoint string
+	cmd       redis.Cmder
+	endpoint  string
+	batchSize int
+	channel   string
+	cluster   bool
+	spanKind  trace.SpanKind
 }
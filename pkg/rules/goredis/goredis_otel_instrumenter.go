@@ -15,13 +15,18 @@
 package goredis
 
 import (
+	"context"
 	"fmt"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
 	redis "github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+	"net"
 	"strconv"
 	"time"
 	"unicode/utf8"
@@ -67,8 +72,7 @@ func (d goRedisAttrsGetter) GetOperation(request goRedisRequest) string {
 }
 
 func (d goRedisAttrsGetter) GetCollection(request goRedisRequest) string {
-	// TBD: We need to implement retrieving the collection later.
-	return ""
+	return request.channel
 }
 
 func (d goRedisAttrsGetter) GetParameters(request goRedisRequest) []any {
@@ -80,14 +84,48 @@ func (d goRedisAttrsGetter) GetDbNamespace(request goRedisRequest) string {
 }
 
 func (d goRedisAttrsGetter) GetBatchSize(request goRedisRequest) int {
-	return 0
+	return request.batchSize
+}
+
+type goRedisSpanKindExtractor struct {
+}
+
+func (e *goRedisSpanKindExtractor) Extract(request goRedisRequest) trace.SpanKind {
+	if request.spanKind == trace.SpanKindUnspecified {
+		return trace.SpanKindClient
+	}
+	return request.spanKind
+}
+
+type goRedisAttrsExtractor struct {
+}
+
+func (e goRedisAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request goRedisRequest) ([]attribute.KeyValue, context.Context) {
+	if request.channel != "" {
+		attributes = append(attributes, semconv.MessagingSystemKey.String("redis"),
+			semconv.MessagingDestinationName(request.channel))
+	}
+	if request.cluster {
+		// the node which the cluster client routes the command to
+		if host, port, err := net.SplitHostPort(request.endpoint); err == nil {
+			attributes = append(attributes, semconv.NetworkPeerAddress(host))
+			if p, err := strconv.Atoi(port); err == nil {
+				attributes = append(attributes, semconv.NetworkPeerPort(p))
+			}
+		}
+	}
+	return attributes, parentContext
+}
+
+func (e goRedisAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request goRedisRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
 }
 
 func BuildGoRedisOtelInstrumenter() instrumenter.Instrumenter[goRedisRequest, any] {
 	builder := instrumenter.Builder[goRedisRequest, any]{}
 	getter := goRedisAttrsGetter{}
-	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[goRedisRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[goRedisRequest]{}).
-		AddAttributesExtractor(&db.DbClientAttrsExtractor[goRedisRequest, any, db.DbClientAttrsGetter[goRedisRequest]]{Base: db.DbClientCommonAttrsExtractor[goRedisRequest, any, db.DbClientAttrsGetter[goRedisRequest]]{Getter: getter}}).
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[goRedisRequest]{Getter: getter}).SetSpanKindExtractor(&goRedisSpanKindExtractor{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[goRedisRequest, any, db.DbClientAttrsGetter[goRedisRequest]]{Base: db.DbClientCommonAttrsExtractor[goRedisRequest, any, db.DbClientAttrsGetter[goRedisRequest]]{Getter: getter}}, goRedisAttrsExtractor{}).
 		AddOperationListeners(db.DbClientMetrics("nosql.goredisv9")).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.GO_REDIS_V9_SCOPE_NAME,
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goredis
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	redis "github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// the publish spans are kept in a bounded registry, so that a message received
+// by a subscriber in the same process can be linked to the span publishing it
+const redisV9PublishRegistrySize = 1024
+
+var redisV9Publishes = newRedisV9PublishRegistry(redisV9PublishRegistrySize)
+
+// redisV9PubSubAddrs maps a *redis.PubSub to the address of the client creating it
+var redisV9PubSubAddrs sync.Map
+
+type redisV9PublishRegistry struct {
+	mu    sync.Mutex
+	keys  []string
+	next  int
+	spans map[string]trace.SpanContext
+}
+
+func newRedisV9PublishRegistry(size int) *redisV9PublishRegistry {
+	return &redisV9PublishRegistry{
+		keys:  make([]string, size),
+		spans: make(map[string]trace.SpanContext, size),
+	}
+}
+
+func (r *redisV9PublishRegistry) put(channel, payload string, sc trace.SpanContext) {
+	if !sc.IsValid() {
+		return
+	}
+	key := channel + "\x00" + payload
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if old := r.keys[r.next]; old != "" {
+		delete(r.spans, old)
+	}
+	r.keys[r.next] = key
+	r.spans[key] = sc
+	r.next = (r.next + 1) % len(r.keys)
+}
+
+func (r *redisV9PublishRegistry) get(channel, payload string) (trace.SpanContext, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sc, ok := r.spans[channel+"\x00"+payload]
+	return sc, ok
+}
+
+func redisV9Publish(cmd redis.Cmder) (string, string, bool) {
+	name := cmd.Name()
+	if name != "publish" && name != "spublish" {
+		return "", "", false
+	}
+	args := cmd.Args()
+	if len(args) < 3 {
+		return "", "", false
+	}
+	channel, ok := args[1].(string)
+	if !ok {
+		return "", "", false
+	}
+	return channel, redisV9String(redisV9AppendArg(nil, args[2])), true
+}
+
+type redisV9ReceiveData struct {
+	ctx   context.Context
+	start time.Time
+	addr  string
+}
+
+//go:linkname beforeRedisV9ClientPubSub github.com/redis/go-redis/v9.beforeRedisV9ClientPubSub
+func beforeRedisV9ClientPubSub(call api.CallContext, client *redis.Client) {
+	if !rv9Enabler.Enable() {
+		return
+	}
+	call.SetData(client.Options().Addr)
+}
+
+//go:linkname beforeRedisV9ClusterPubSub github.com/redis/go-redis/v9.beforeRedisV9ClusterPubSub
+func beforeRedisV9ClusterPubSub(call api.CallContext, client *redis.ClusterClient) {
+	if !rv9Enabler.Enable() {
+		return
+	}
+	call.SetData(strings.Join(client.Options().Addrs, ","))
+}
+
+//go:linkname afterRedisV9ClientPubSub github.com/redis/go-redis/v9.afterRedisV9ClientPubSub
+func afterRedisV9ClientPubSub(call api.CallContext, pubsub *redis.PubSub) {
+	storeRedisV9PubSubAddr(call, pubsub)
+}
+
+//go:linkname afterRedisV9ClusterPubSub github.com/redis/go-redis/v9.afterRedisV9ClusterPubSub
+func afterRedisV9ClusterPubSub(call api.CallContext, pubsub *redis.PubSub) {
+	storeRedisV9PubSubAddr(call, pubsub)
+}
+
+func storeRedisV9PubSubAddr(call api.CallContext, pubsub *redis.PubSub) {
+	if !rv9Enabler.Enable() || pubsub == nil {
+		return
+	}
+	if addr, ok := call.GetData().(string); ok {
+		redisV9PubSubAddrs.Store(pubsub, addr)
+	}
+}
+
+//go:linkname beforeRedisV9PubSubClose github.com/redis/go-redis/v9.beforeRedisV9PubSubClose
+func beforeRedisV9PubSubClose(call api.CallContext, pubsub *redis.PubSub) {
+	redisV9PubSubAddrs.Delete(pubsub)
+}
+
+//go:linkname beforeRedisV9PubSubReceive github.com/redis/go-redis/v9.beforeRedisV9PubSubReceive
+func beforeRedisV9PubSubReceive(call api.CallContext, pubsub *redis.PubSub, ctx context.Context, timeout time.Duration) {
+	if !rv9Enabler.Enable() {
+		return
+	}
+	data := redisV9ReceiveData{ctx: ctx, start: time.Now()}
+	if addr, ok := redisV9PubSubAddrs.Load(pubsub); ok {
+		data.addr = addr.(string)
+	}
+	call.SetData(data)
+}
+
+//go:linkname afterRedisV9PubSubReceive github.com/redis/go-redis/v9.afterRedisV9PubSubReceive
+func afterRedisV9PubSubReceive(call api.CallContext, msg interface{}, err error) {
+	if !rv9Enabler.Enable() || err != nil {
+		return
+	}
+	message, ok := msg.(*redis.Message)
+	if !ok {
+		return
+	}
+	data, ok := call.GetData().(redisV9ReceiveData)
+	if !ok {
+		return
+	}
+	ctx := data.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := goRedisRequest{
+		cmd:      redis.NewCmd(ctx, "receive", message.Channel),
+		endpoint: data.addr,
+		channel:  message.Channel,
+		spanKind: trace.SpanKindConsumer,
+	}
+	var startOptions []trace.SpanStartOption
+	if sc, ok := redisV9Publishes.get(message.Channel, message.Payload); ok {
+		startOptions = append(startOptions, trace.WithLinks(trace.Link{SpanContext: sc}))
+	}
+	goRedisInstrumenter.StartAndEndWithOptions(ctx, request, nil, nil, data.start, time.Now(), startOptions, nil)
+}
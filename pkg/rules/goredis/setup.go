@@ -22,6 +22,8 @@ import (
 	_ "unsafe"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 
 	redis "github.com/redis/go-redis/v9"
@@ -63,7 +65,9 @@ func afterNewClusterClient(call api.CallContext, client *redis.ClusterClient) {
 		return
 	}
 	client.OnNewNode(func(rdb *redis.Client) {
-		rdb.AddHook(newOtRedisHook(rdb.Options().Addr))
+		hook := newOtRedisHook(rdb.Options().Addr)
+		hook.cluster = true
+		rdb.AddHook(hook)
 	})
 }
 
@@ -94,7 +98,8 @@ func afterClientConn(call api.CallContext, client *redis.Conn) {
 }
 
 type otRedisHook struct {
-	Addr string
+	Addr    string
+	cluster bool
 }
 
 func newOtRedisHook(addr string) *otRedisHook {
@@ -121,8 +126,17 @@ func (o *otRedisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
 		request := goRedisRequest{
 			cmd:      cmd,
 			endpoint: o.Addr,
+			cluster:  o.cluster,
+		}
+		channel, payload, isPublish := redisV9Publish(cmd)
+		if isPublish {
+			request.channel = channel
+			request.spanKind = trace.SpanKindProducer
 		}
 		ctx = goRedisInstrumenter.Start(ctx, request)
+		if isPublish {
+			redisV9Publishes.put(channel, payload, trace.SpanContextFromContext(ctx))
+		}
 		if err := next(ctx, cmd); err != nil {
 			goRedisInstrumenter.End(ctx, request, nil, err)
 			return err
@@ -147,15 +161,35 @@ func (o *otRedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.
 		}
 		cmd := redis.NewCmd(ctx, "pipeline", summary)
 		request := goRedisRequest{
-			cmd:      cmd,
-			endpoint: o.Addr,
+			cmd:       cmd,
+			endpoint:  o.Addr,
+			batchSize: len(cmds),
+			cluster:   o.cluster,
 		}
 		ctx = goRedisInstrumenter.Start(ctx, request)
-		if err := next(ctx, cmds); err != nil {
-			goRedisInstrumenter.End(ctx, request, nil, err)
-			return err
+		err := next(ctx, cmds)
+		addRedisV9PipelineEvents(ctx, cmds)
+		goRedisInstrumenter.End(ctx, request, nil, err)
+		return err
+	}
+}
+
+// addRedisV9PipelineEvents records every command of the pipeline as an event
+// of the pipeline span, so the commands are visible without a span per command
+func addRedisV9PipelineEvents(ctx context.Context, cmds []redis.Cmder) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	getter := goRedisAttrsGetter{}
+	for _, cmd := range cmds {
+		attrs := []attribute.KeyValue{
+			semconv.DBOperationName(cmd.FullName()),
+			semconv.DBQueryText(getter.GetStatement(goRedisRequest{cmd: cmd})),
 		}
-		goRedisInstrumenter.End(ctx, request, nil, nil)
-		return nil
+		if err := cmd.Err(); err != nil && err != redis.Nil {
+			attrs = append(attrs, semconv.ExceptionMessage(err.Error()))
+		}
+		span.AddEvent("redis.command", trace.WithAttributes(attrs...))
 	}
 }
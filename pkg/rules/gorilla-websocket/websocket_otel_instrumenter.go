@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	websocketSubprotocolKey = attribute.Key("websocket.subprotocol")
+	websocketMessageTypeKey = attribute.Key("websocket.message.type")
+)
+
+type websocketSpanNameExtractor struct{}
+
+func (w websocketSpanNameExtractor) Extract(request websocketRequest) string {
+	if request.url == nil || request.url.Path == "" {
+		return "WebSocket /"
+	}
+	return "WebSocket " + request.url.Path
+}
+
+type websocketAttrsExtractor struct{}
+
+func (w websocketAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request websocketRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.NetworkProtocolName("websocket"))
+	if request.host != "" {
+		attributes = append(attributes, semconv.ServerAddress(request.host))
+	}
+	if request.url != nil {
+		attributes = append(attributes, semconv.URLPath(request.url.Path))
+		if request.url.Scheme != "" {
+			attributes = append(attributes, semconv.URLFull(request.url.String()))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (w websocketAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request websocketRequest, response websocketResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.subprotocol != "" {
+		attributes = append(attributes, websocketSubprotocolKey.String(response.subprotocol))
+	}
+	if response.peerAddr != "" {
+		attributes = append(attributes, semconv.NetworkPeerAddress(response.peerAddr))
+	}
+	return attributes, context
+}
+
+func buildWebsocketServerInstrumenter() instrumenter.Instrumenter[websocketRequest, websocketResponse] {
+	builder := instrumenter.Builder[websocketRequest, websocketResponse]{}
+	return builder.Init().SetSpanNameExtractor(websocketSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[websocketRequest]{}).
+		AddAttributesExtractor(websocketAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GORILLA_WEBSOCKET_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func buildWebsocketClientInstrumenter() instrumenter.Instrumenter[websocketRequest, websocketResponse] {
+	builder := instrumenter.Builder[websocketRequest, websocketResponse]{}
+	return builder.Init().SetSpanNameExtractor(websocketSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[websocketRequest]{}).
+		AddAttributesExtractor(websocketAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GORILLA_WEBSOCKET_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildPropagatingToDownstreamInstrumenter(func(w websocketRequest) propagation.TextMapCarrier {
+			if w.header == nil {
+				return nil
+			}
+			return propagation.HeaderCarrier(w.header)
+		}, otel.GetTextMapPropagator())
+}
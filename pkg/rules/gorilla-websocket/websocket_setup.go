@@ -0,0 +1,238 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type websocketInnerEnabler struct {
+	enabled bool
+}
+
+func (w websocketInnerEnabler) Enable() bool {
+	return w.enabled
+}
+
+var websocketEnabler = websocketInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GORILLA_WEBSOCKET_ENABLED") != "false"}
+
+var (
+	websocketServerInstrumenter = buildWebsocketServerInstrumenter()
+	websocketClientInstrumenter = buildWebsocketClientInstrumenter()
+)
+
+// websocketConnState is attached to the connection once the handshake
+// succeeds. It keeps the connection span open until the connection is closed
+// and numbers the messages recorded as the events of that span.
+type websocketConnState struct {
+	ctx          context.Context
+	request      websocketRequest
+	instrumenter instrumenter.Instrumenter[websocketRequest, websocketResponse]
+	sentID       int64
+	receivedID   int64
+	endOnce      sync.Once
+}
+
+func (s *websocketConnState) addMessageEvent(messageType attribute.KeyValue, id int64, wsType int, size int) {
+	trace.SpanFromContext(s.ctx).AddEvent("message", trace.WithAttributes(
+		messageType,
+		semconv.RPCMessageIDKey.Int64(id),
+		semconv.RPCMessageUncompressedSizeKey.Int(size),
+		websocketMessageTypeKey.String(messageTypeName(wsType)),
+	))
+}
+
+func (s *websocketConnState) end(conn *websocket.Conn, err error) {
+	s.endOnce.Do(func() {
+		response := websocketResponse{subprotocol: conn.Subprotocol()}
+		if addr := conn.RemoteAddr(); addr != nil {
+			response.peerAddr = addr.String()
+		}
+		s.instrumenter.End(s.ctx, s.request, response, err)
+	})
+}
+
+func getConnState(conn *websocket.Conn) *websocketConnState {
+	if conn == nil {
+		return nil
+	}
+	state, _ := conn.OtelConnState.(*websocketConnState)
+	return state
+}
+
+func messageTypeName(messageType int) string {
+	switch messageType {
+	case websocket.TextMessage:
+		return "text"
+	case websocket.BinaryMessage:
+		return "binary"
+	case websocket.CloseMessage:
+		return "close"
+	case websocket.PingMessage:
+		return "ping"
+	case websocket.PongMessage:
+		return "pong"
+	}
+	return "unknown"
+}
+
+//go:linkname upgradeOnEnter github.com/gorilla/websocket.upgradeOnEnter
+func upgradeOnEnter(call api.CallContext, u *websocket.Upgrader, w http.ResponseWriter, r *http.Request, responseHeader http.Header) {
+	if !websocketEnabler.Enable() || r == nil {
+		return
+	}
+	// The request context already carries the net/http server span, the
+	// upgrade request is only extracted when nothing has done it before.
+	parentCtx := r.Context()
+	if !trace.SpanContextFromContext(parentCtx).IsValid() {
+		parentCtx = otel.GetTextMapPropagator().Extract(parentCtx, propagation.HeaderCarrier(r.Header))
+	}
+	request := websocketRequest{
+		url:    r.URL,
+		host:   r.Host,
+		header: r.Header,
+	}
+	ctx := websocketServerInstrumenter.Start(parentCtx, request)
+	call.SetData(&websocketConnState{
+		ctx:          ctx,
+		request:      request,
+		instrumenter: websocketServerInstrumenter,
+	})
+}
+
+//go:linkname upgradeOnExit github.com/gorilla/websocket.upgradeOnExit
+func upgradeOnExit(call api.CallContext, conn *websocket.Conn, err error) {
+	state, ok := call.GetData().(*websocketConnState)
+	if !ok || state == nil {
+		return
+	}
+	if err != nil || conn == nil {
+		state.instrumenter.End(state.ctx, state.request, websocketResponse{}, err)
+		return
+	}
+	conn.OtelConnState = state
+}
+
+//go:linkname dialContextOnEnter github.com/gorilla/websocket.dialContextOnEnter
+func dialContextOnEnter(call api.CallContext, d *websocket.Dialer, ctx context.Context, urlStr string, requestHeader http.Header) {
+	if !websocketEnabler.Enable() || ctx == nil {
+		return
+	}
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return
+	}
+	// Copy the handshake header so that the trace context is never written
+	// into a header owned by the caller.
+	header := make(http.Header, len(requestHeader)+2)
+	for k, v := range requestHeader {
+		header[k] = v
+	}
+	request := websocketRequest{
+		url:    u,
+		host:   u.Host,
+		header: header,
+	}
+	newCtx := websocketClientInstrumenter.Start(ctx, request)
+	call.SetParam(3, header)
+	call.SetData(&websocketConnState{
+		ctx:          newCtx,
+		request:      request,
+		instrumenter: websocketClientInstrumenter,
+	})
+}
+
+//go:linkname dialContextOnExit github.com/gorilla/websocket.dialContextOnExit
+func dialContextOnExit(call api.CallContext, conn *websocket.Conn, resp *http.Response, err error) {
+	state, ok := call.GetData().(*websocketConnState)
+	if !ok || state == nil {
+		return
+	}
+	if err != nil || conn == nil {
+		state.instrumenter.End(state.ctx, state.request, websocketResponse{}, err)
+		return
+	}
+	conn.OtelConnState = state
+}
+
+//go:linkname readMessageOnExit github.com/gorilla/websocket.readMessageOnExit
+func readMessageOnExit(call api.CallContext, messageType int, p []byte, err error) {
+	conn, ok := call.GetParam(0).(*websocket.Conn)
+	if !ok {
+		return
+	}
+	state := getConnState(conn)
+	if state == nil {
+		return
+	}
+	if err != nil {
+		// A failed read leaves the connection unusable, so the connection
+		// span ends here even if the application never calls Close.
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived) {
+			err = nil
+		}
+		state.end(conn, err)
+		return
+	}
+	state.addMessageEvent(semconv.RPCMessageTypeReceived, atomic.AddInt64(&state.receivedID, 1), messageType, len(p))
+}
+
+//go:linkname writeMessageOnEnter github.com/gorilla/websocket.writeMessageOnEnter
+func writeMessageOnEnter(call api.CallContext, conn *websocket.Conn, messageType int, data []byte) {
+	state := getConnState(conn)
+	if state == nil {
+		return
+	}
+	// WriteMessage reslices data while writing it out, take the size before.
+	call.SetData(map[string]interface{}{
+		"state":       state,
+		"messageType": messageType,
+		"size":        len(data),
+	})
+}
+
+//go:linkname writeMessageOnExit github.com/gorilla/websocket.writeMessageOnExit
+func writeMessageOnExit(call api.CallContext, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil || err != nil {
+		return
+	}
+	state := data["state"].(*websocketConnState)
+	state.addMessageEvent(semconv.RPCMessageTypeSent, atomic.AddInt64(&state.sentID, 1), data["messageType"].(int), data["size"].(int))
+}
+
+//go:linkname closeOnEnter github.com/gorilla/websocket.closeOnEnter
+func closeOnEnter(call api.CallContext, conn *websocket.Conn) {
+	state := getConnState(conn)
+	if state == nil {
+		return
+	}
+	state.end(conn, nil)
+}
@@ -15,9 +15,26 @@
 package gorm
 
 type gormRequest struct {
-	DbName    string
-	Endpoint  string
-	Operation string
-	User      string
-	System    string
+	DbName       string
+	Endpoint     string
+	Operation    string
+	User         string
+	System       string
+	Statement    string
+	Collection   string
+	Parameters   []any
+	RowsAffected int64
+}
+
+type gormTransactionRequest struct {
+	DbName   string
+	Endpoint string
+	System   string
+	// Outcome is either "commit" or "rollback" once the transaction ends
+	Outcome string
+}
+
+type gormPreloadRequest struct {
+	Collection string
+	Preloads   []string
 }
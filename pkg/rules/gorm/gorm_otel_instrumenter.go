@@ -15,11 +15,21 @@
 package gorm
 
 import (
+	"context"
+
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	gormRowsAffectedKey       = attribute.Key("db.rows_affected")
+	gormTransactionOutcomeKey = attribute.Key("db.transaction.outcome")
+	gormPreloadsKey           = attribute.Key("gorm.preloads")
 )
 
 type gormAttrsGetter struct {
@@ -34,12 +44,11 @@ func (g gormAttrsGetter) GetServerAddress(gormRequest gormRequest) string {
 }
 
 func (g gormAttrsGetter) GetStatement(gormRequest gormRequest) string {
-	return ""
+	return gormRequest.Statement
 }
 
 func (e gormAttrsGetter) GetCollection(gormRequest gormRequest) string {
-	// TBD: We need to implement retrieving the collection later.
-	return ""
+	return gormRequest.Collection
 }
 
 func (g gormAttrsGetter) GetOperation(gormRequest gormRequest) string {
@@ -47,11 +56,11 @@ func (g gormAttrsGetter) GetOperation(gormRequest gormRequest) string {
 }
 
 func (g gormAttrsGetter) GetParameters(gormRequest gormRequest) []any {
-	return nil
+	return gormRequest.Parameters
 }
 
 func (g gormAttrsGetter) GetDbNamespace(gormRequest gormRequest) string {
-	return ""
+	return gormRequest.DbName
 }
 
 func (g gormAttrsGetter) GetBatchSize(gormRequest gormRequest) int {
@@ -62,10 +71,101 @@ func BuildGormInstrumenter() instrumenter.Instrumenter[gormRequest, interface{}]
 	builder := instrumenter.Builder[gormRequest, interface{}]{}
 	getter := gormAttrsGetter{}
 	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[gormRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[gormRequest]{}).
-		AddAttributesExtractor(&db.DbClientAttrsExtractor[gormRequest, any, gormAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[gormRequest, any, gormAttrsGetter]{Getter: getter}}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[gormRequest, any, gormAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[gormRequest, any, gormAttrsGetter]{Getter: getter}}, gormRowsAttrsExtractor{}).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.GORM_SCOPE_NAME,
 			Version: version.Tag,
 		}).
 		BuildInstrumenter()
 }
+
+type gormRowsAttrsExtractor struct {
+}
+
+func (g gormRowsAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gormRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+// OnEnd records the rows affected by the statement, rows of "row" operations
+// are scanned by the caller later, so they are unknown here.
+func (g gormRowsAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gormRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	if request.Operation == "row" || request.RowsAffected < 0 {
+		return attributes, context
+	}
+	return append(attributes, gormRowsAffectedKey.Int64(request.RowsAffected)), context
+}
+
+type gormTransactionSpanNameExtractor struct {
+}
+
+func (g gormTransactionSpanNameExtractor) Extract(request gormTransactionRequest) string {
+	return "transaction"
+}
+
+type gormTransactionAttrsExtractor struct {
+}
+
+func (g gormTransactionAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gormTransactionRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.DBSystemNameKey.String(request.System))
+	if request.Endpoint != "" {
+		attributes = append(attributes, semconv.ServerAddress(request.Endpoint))
+	}
+	if request.DbName != "" {
+		attributes = append(attributes, semconv.DBNamespace(request.DbName))
+	}
+	return attributes, parentContext
+}
+
+func (g gormTransactionAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gormTransactionRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	if request.Outcome != "" {
+		attributes = append(attributes, gormTransactionOutcomeKey.String(request.Outcome))
+	}
+	return attributes, context
+}
+
+type gormPreloadSpanNameExtractor struct {
+}
+
+func (g gormPreloadSpanNameExtractor) Extract(request gormPreloadRequest) string {
+	if request.Collection == "" {
+		return "preload"
+	}
+	return "preload " + request.Collection
+}
+
+type gormPreloadAttrsExtractor struct {
+}
+
+func (g gormPreloadAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gormPreloadRequest) ([]attribute.KeyValue, context.Context) {
+	return append(attributes, gormPreloadsKey.StringSlice(request.Preloads)), parentContext
+}
+
+func (g gormPreloadAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gormPreloadRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+// Transactions and preloads are not database calls of their own, their spans
+// are internal so that the spans of the calls within are not suppressed.
+func BuildGormTransactionInstrumenter() instrumenter.Instrumenter[gormTransactionRequest, interface{}] {
+	builder := instrumenter.Builder[gormTransactionRequest, interface{}]{}
+	return builder.Init().SetSpanNameExtractor(gormTransactionSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[gormTransactionRequest]{}).
+		AddAttributesExtractor(gormTransactionAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GORM_TRANSACTION_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func BuildGormPreloadInstrumenter() instrumenter.Instrumenter[gormPreloadRequest, interface{}] {
+	builder := instrumenter.Builder[gormPreloadRequest, interface{}]{}
+	return builder.Init().SetSpanNameExtractor(gormPreloadSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[gormPreloadRequest]{}).
+		AddAttributesExtractor(gormPreloadAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GORM_PRELOAD_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"context"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+var gormPreloadInstrumenter = BuildGormPreloadInstrumenter()
+
+var preloadContextKey = "otel-preload-context"
+var preloadRequestKey = "otel-preload-request"
+var preloadParentContextKey = "otel-preload-parent-context"
+
+// registerPreloadCallbacks traces the preloads of a query, the queries that
+// load the associations are the children of the preload span.
+func registerPreloadCallbacks(db *gorm.DB) {
+	_ = db.Callback().Query().Before("gorm:preload").Register("otel_create_preload_span", beforePreloadCallback)
+	_ = db.Callback().Query().After("gorm:preload").Register("otel_end_preload_span", afterPreloadCallback)
+}
+
+func beforePreloadCallback(db *gorm.DB) {
+	if !gormEnabler.Enable() || db.Error != nil || len(db.Statement.Preloads) == 0 {
+		return
+	}
+	preloads := make([]string, 0, len(db.Statement.Preloads))
+	for name := range db.Statement.Preloads {
+		preloads = append(preloads, name)
+	}
+	sort.Strings(preloads)
+	request := gormPreloadRequest{
+		Collection: db.Statement.Table,
+		Preloads:   preloads,
+	}
+	parent := db.Statement.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx := gormPreloadInstrumenter.Start(parent, request)
+	db.Set(preloadContextKey, ctx)
+	db.Set(preloadRequestKey, request)
+	db.Set(preloadParentContextKey, db.Statement.Context)
+	db.Statement.Context = ctx
+}
+
+func afterPreloadCallback(db *gorm.DB) {
+	iCtx, ok := db.Get(preloadContextKey)
+	if !ok {
+		return
+	}
+	ctx, ok := iCtx.(context.Context)
+	if !ok {
+		return
+	}
+	iRequest, ok := db.Get(preloadRequestKey)
+	if !ok {
+		return
+	}
+	request, ok := iRequest.(gormPreloadRequest)
+	if !ok {
+		return
+	}
+	db.Statement.Settings.Delete(preloadContextKey)
+	db.Statement.Settings.Delete(preloadRequestKey)
+	if iParent, ok := db.Get(preloadParentContextKey); ok {
+		if parent, ok := iParent.(context.Context); ok {
+			db.Statement.Context = parent
+		}
+		db.Statement.Settings.Delete(preloadParentContextKey)
+	}
+	gormPreloadInstrumenter.End(ctx, request, nil, db.Error)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"gorm.io/gorm"
+)
+
+var gormTransactionInstrumenter = BuildGormTransactionInstrumenter()
+
+type gormTransaction struct {
+	ctx     context.Context
+	request gormTransactionRequest
+}
+
+// gormTransactions maps the connection pool of every ongoing transaction to
+// its span. Gorm begins the default transaction of a statement on a session
+// of its own and commits it on the statement, they only share the pool.
+var gormTransactions sync.Map
+
+func transactionKey(db *gorm.DB) (interface{}, bool) {
+	if db == nil || db.Statement == nil || db.Statement.ConnPool == nil {
+		return nil, false
+	}
+	pool := db.Statement.ConnPool
+	return pool, reflect.TypeOf(pool).Comparable()
+}
+
+func transactionOf(db *gorm.DB) *gormTransaction {
+	key, ok := transactionKey(db)
+	if !ok {
+		return nil
+	}
+	tx, ok := gormTransactions.Load(key)
+	if !ok {
+		return nil
+	}
+	return tx.(*gormTransaction)
+}
+
+//go:linkname beginOnExit gorm.io/gorm.beginOnExit
+func beginOnExit(call api.CallContext, tx *gorm.DB) {
+	if !gormEnabler.Enable() || tx == nil || tx.Statement == nil {
+		return
+	}
+	// Statements of an ongoing transaction try to begin their default
+	// transaction as well, it is not a transaction of its own
+	if tx.Error == gorm.ErrInvalidTransaction {
+		return
+	}
+	dbName, addr, system, _ := getDbInfo(tx.Config.Dialector)
+	request := gormTransactionRequest{
+		DbName:   dbName,
+		Endpoint: addr,
+		System:   system,
+	}
+	parent := tx.Statement.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx := gormTransactionInstrumenter.Start(parent, request)
+	key, ok := transactionKey(tx)
+	if tx.Error != nil || !ok {
+		gormTransactionInstrumenter.End(ctx, request, nil, tx.Error)
+		return
+	}
+	gormTransactions.Store(key, &gormTransaction{ctx: ctx, request: request})
+}
+
+//go:linkname commitOnExit gorm.io/gorm.commitOnExit
+func commitOnExit(call api.CallContext, db *gorm.DB) {
+	endTransaction(db, "commit")
+}
+
+//go:linkname rollbackOnExit gorm.io/gorm.rollbackOnExit
+func rollbackOnExit(call api.CallContext, db *gorm.DB) {
+	endTransaction(db, "rollback")
+}
+
+// endTransaction ends the span of the transaction even if the instrumentation
+// has been disabled since it began.
+func endTransaction(db *gorm.DB, outcome string) {
+	key, ok := transactionKey(db)
+	if !ok {
+		return
+	}
+	value, ok := gormTransactions.LoadAndDelete(key)
+	if !ok {
+		return
+	}
+	tx := value.(*gormTransaction)
+	tx.request.Outcome = outcome
+	var err error
+	if outcome == "commit" {
+		err = db.Error
+	}
+	gormTransactionInstrumenter.End(tx.ctx, tx.request, nil, err)
+}
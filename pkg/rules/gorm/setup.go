@@ -20,6 +20,7 @@ import (
 	_ "unsafe"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	dbsemconv "github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
 	driver "github.com/go-sql-driver/mysql"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -27,6 +28,7 @@ import (
 
 var contextKey = "otel-context"
 var requestKey = "otel-request"
+var parentContextKey = "otel-parent-context"
 
 type gormInnerEnabler struct {
 	enabled bool
@@ -56,28 +58,34 @@ func afterGormOpen(call api.CallContext, db *gorm.DB, err error) {
 	_ = db.Callback().Row().Before("gorm:row").Register("otel_create_row_span", beforeCallback("", "row"))
 	_ = db.Callback().Raw().Before("gorm:raw").Register("otel_create_raw_span", beforeCallback("", "raw"))
 
-	// after database operation
+	// after database operation, the query span covers the preloads as well
 	_ = db.Callback().Create().After("gorm:create").Register("otel_end_create_span", afterCallback(""))
-	_ = db.Callback().Query().After("gorm:query").Register("otel_end_query_span", afterCallback(""))
+	_ = db.Callback().Query().After("gorm:after_query").Register("otel_end_query_span", afterCallback(""))
 	_ = db.Callback().Update().After("gorm:update").Register("otel_end_update_span", afterCallback(""))
 	_ = db.Callback().Delete().After("gorm:delete").Register("otel_end_delete_span", afterCallback(""))
 	_ = db.Callback().Row().After("gorm:row").Register("otel_end_row_span", afterCallback(""))
 	_ = db.Callback().Raw().After("gorm:raw").Register("otel_end_raw_span", afterCallback(""))
+
+	registerPreloadCallbacks(db)
 }
 
 func beforeCallback(endpoint string, op string) func(db *gorm.DB) {
 	return func(db *gorm.DB) {
 		dbName, addr, system, user := getDbInfo(db.Config.Dialector)
 		request := gormRequest{
-			DbName:    dbName,
-			Endpoint:  addr,
-			Operation: op,
-			User:      user,
-			System:    system,
+			DbName:     dbName,
+			Endpoint:   addr,
+			Operation:  op,
+			User:       user,
+			System:     system,
+			Collection: db.Statement.Table,
 		}
-		ctx := gormInstrumenter.Start(context.Background(), request)
+		ctx := gormInstrumenter.Start(parentContext(db), request)
 		db.Set(contextKey, ctx)
 		db.Set(requestKey, request)
+		db.Set(parentContextKey, db.Statement.Context)
+		// The statement is executed within the span, so are the preloads
+		db.Statement.Context = ctx
 	}
 }
 
@@ -99,10 +107,39 @@ func afterCallback(endpoint string) func(db *gorm.DB) {
 		if !ok {
 			return
 		}
+		// The statement may be executed again, e.g. by a reused session, the
+		// state of this execution must not leak into the next one
+		db.Statement.Settings.Delete(contextKey)
+		db.Statement.Settings.Delete(requestKey)
+		if iParent, ok := db.Get(parentContextKey); ok {
+			if parent, ok := iParent.(context.Context); ok {
+				db.Statement.Context = parent
+			}
+			db.Statement.Settings.Delete(parentContextKey)
+		}
+		// gorm never inlines the parameters, but raw statements may embed values
+		request.Statement = dbsemconv.SanitizeStatement(db.Statement.SQL.String())
+		request.Parameters = db.Statement.Vars
+		request.RowsAffected = db.RowsAffected
+		if request.Collection == "" {
+			request.Collection = db.Statement.Table
+		}
 		gormInstrumenter.End(ctx, request, nil, db.Statement.Error)
 	}
 }
 
+// parentContext returns the context the span of the statement starts from,
+// statements executed in a traced transaction are the children of it.
+func parentContext(db *gorm.DB) context.Context {
+	if tx := transactionOf(db); tx != nil {
+		return tx.ctx
+	}
+	if db.Statement.Context == nil {
+		return context.Background()
+	}
+	return db.Statement.Context
+}
+
 func getDbInfo(dial gorm.Dialector) (string, string, string, string) {
 	// TODO: support other database
 	res, ok := dial.(*mysql.Dialector)
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gotls
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type tlsSpanNameExtractor struct{}
+
+func (t tlsSpanNameExtractor) Extract(request tlsRequest) string {
+	return "tls.handshake"
+}
+
+type tlsAttrsExtractor struct{}
+
+func (t tlsAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request tlsRequest) ([]attribute.KeyValue, context.Context) {
+	if request.conn != nil {
+		if addr := request.conn.RemoteAddr(); addr != nil {
+			attributes = append(attributes, semconv.NetworkPeerAddress(addr.String()))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (t tlsAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request tlsRequest, response tlsResponse, err error) ([]attribute.KeyValue, context.Context) {
+	state := response.state
+	attributes = append(attributes, semconv.TLSEstablished(state.HandshakeComplete))
+	if !state.HandshakeComplete {
+		return attributes, context
+	}
+	// tls.VersionName returns values like "TLS 1.3", while the semantic
+	// conventions expect the protocol name and version separately
+	if name, ver, ok := strings.Cut(tls.VersionName(state.Version), " "); ok {
+		attributes = append(attributes,
+			semconv.TLSProtocolNameKey.String(strings.ToLower(name)),
+			semconv.TLSProtocolVersion(ver))
+	}
+	attributes = append(attributes,
+		semconv.TLSCipher(tls.CipherSuiteName(state.CipherSuite)),
+		semconv.TLSResumed(state.DidResume))
+	if state.ServerName != "" {
+		attributes = append(attributes, semconv.ServerAddress(state.ServerName))
+	}
+	if state.NegotiatedProtocol != "" {
+		attributes = append(attributes, semconv.TLSNextProtocol(state.NegotiatedProtocol))
+	}
+	return attributes, context
+}
+
+func BuildTlsInstrumenter() instrumenter.Instrumenter[tlsRequest, tlsResponse] {
+	builder := instrumenter.Builder[tlsRequest, tlsResponse]{}
+	return builder.Init().SetSpanNameExtractor(tlsSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[tlsRequest]{}).
+		AddAttributesExtractor(tlsAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GO_TLS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
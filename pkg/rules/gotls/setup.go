@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gotls
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type goTlsInnerEnabler struct {
+	enabled bool
+}
+
+func (g goTlsInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var goTlsEnabler = goTlsInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_TLS_ENABLED") == "true"}
+
+var tlsInstrumenter = BuildTlsInstrumenter()
+
+//go:linkname handshakeContextOnEnter crypto/tls.handshakeContextOnEnter
+func handshakeContextOnEnter(call api.CallContext, c *tls.Conn, ctx context.Context) {
+	if !goTlsEnabler.Enable() || sdktrace.SpanFromGLS() == nil {
+		return
+	}
+	// handshakeContext is invoked on every Read and Write, only the first
+	// call actually performs the handshake
+	if c == nil || c.ConnectionState().HandshakeComplete {
+		return
+	}
+	request := tlsRequest{conn: c}
+	newCtx := tlsInstrumenter.Start(ctx, request)
+	call.SetData(map[string]interface{}{
+		"ctx":     newCtx,
+		"request": request,
+	})
+}
+
+//go:linkname handshakeContextOnExit crypto/tls.handshakeContextOnExit
+func handshakeContextOnExit(call api.CallContext, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx := data["ctx"].(context.Context)
+	request := data["request"].(tlsRequest)
+	response := tlsResponse{state: request.conn.ConnectionState()}
+	tlsInstrumenter.End(ctx, request, response, err)
+}
@@ -0,0 +1,12 @@
+module github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/rules/gozero
+
+go 1.23.0
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg => ../../../pkg
+
+require (
+	github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg v0.0.0-00010101000000-000000000000
+	github.com/zeromicro/go-zero v1.5.6
+	go.opentelemetry.io/otel/sdk v1.36.0
+	google.golang.org/grpc v1.71.0
+)
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gozero
+
+import (
+	"net/http"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/zeromicro/go-zero/rest/handler"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// The rest server is served by net/http, whose server span already covers
+// the request, so the built-in tracing handler of go-zero is replaced by one
+// naming the span of net/http by the path of the route, e.g. /user/:id.
+//
+//go:linkname goZeroTraceHandlerOnEnter github.com/zeromicro/go-zero/rest/handler.goZeroTraceHandlerOnEnter
+func goZeroTraceHandlerOnEnter(call api.CallContext, serviceName, path string, opts ...handler.TraceOption) {
+	if !goZeroEnabler.Enable() {
+		return
+	}
+	call.SetSkipCall(true)
+	call.SetReturnVal(0, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lcs := trace.LocalRootSpanFromGLS()
+			if lcs != nil && path != "" && r.URL != nil && path != r.URL.Path {
+				lcs.SetName(path)
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+}
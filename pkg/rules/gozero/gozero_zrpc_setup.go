@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gozero
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"google.golang.org/grpc"
+)
+
+// The zrpc clients and servers are built on grpc, whose client and server
+// spans already cover the calls with the context propagated, so the built-in
+// tracing interceptors of go-zero are skipped to avoid duplicated spans.
+
+//go:linkname goZeroUnaryServerTracingOnEnter github.com/zeromicro/go-zero/zrpc/internal/serverinterceptors.goZeroUnaryServerTracingOnEnter
+func goZeroUnaryServerTracingOnEnter(call api.CallContext, ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) {
+	if !goZeroEnabler.Enable() || handler == nil {
+		return
+	}
+	call.SetSkipCall(true)
+	resp, err := handler(ctx, req)
+	call.SetReturnVal(0, resp)
+	call.SetReturnVal(1, err)
+}
+
+//go:linkname goZeroStreamServerTracingOnEnter github.com/zeromicro/go-zero/zrpc/internal/serverinterceptors.goZeroStreamServerTracingOnEnter
+func goZeroStreamServerTracingOnEnter(call api.CallContext, svr any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) {
+	if !goZeroEnabler.Enable() || handler == nil {
+		return
+	}
+	call.SetSkipCall(true)
+	call.SetReturnVal(0, handler(svr, ss))
+}
+
+//go:linkname goZeroUnaryClientTracingOnEnter github.com/zeromicro/go-zero/zrpc/internal/clientinterceptors.goZeroUnaryClientTracingOnEnter
+func goZeroUnaryClientTracingOnEnter(call api.CallContext, ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) {
+	if !goZeroEnabler.Enable() || invoker == nil {
+		return
+	}
+	call.SetSkipCall(true)
+	call.SetReturnVal(0, invoker(ctx, method, req, reply, cc, opts...))
+}
+
+//go:linkname goZeroStreamClientTracingOnEnter github.com/zeromicro/go-zero/zrpc/internal/clientinterceptors.goZeroStreamClientTracingOnEnter
+func goZeroStreamClientTracingOnEnter(call api.CallContext, ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) {
+	if !goZeroEnabler.Enable() || streamer == nil {
+		return
+	}
+	call.SetSkipCall(true)
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	call.SetReturnVal(0, stream)
+	call.SetReturnVal(1, err)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gqlgen
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type gqlgenInnerEnabler struct {
+	enabled bool
+}
+
+func (g gqlgenInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var gqlgenEnabler = gqlgenInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GQLGEN_ENABLED") != "false"}
+
+// Spans of the fields that have a user-specified resolver are recorded unless
+// they are turned off, fields resolved from struct members never get a span
+var gqlgenResolverEnabler = gqlgenInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GQLGEN_RESOLVER_SPANS_ENABLED") != "false"}
+
+const (
+	graphqlFieldNameKey   = attribute.Key("graphql.field.name")
+	graphqlFieldPathKey   = attribute.Key("graphql.field.path")
+	graphqlFieldObjectKey = attribute.Key("graphql.field.object")
+)
+
+type gqlgenSpanNameExtractor struct {
+}
+
+// Extract returns "{graphql.operation.type} {graphql.operation.name}", or the
+// operation type only for anonymous operations.
+func (g gqlgenSpanNameExtractor) Extract(request gqlgenRequest) string {
+	if request.operationType == "" {
+		return "GraphQL Operation"
+	}
+	if request.operationName == "" {
+		return request.operationType
+	}
+	return request.operationType + " " + request.operationName
+}
+
+type gqlgenAttrsExtractor struct {
+}
+
+func (g gqlgenAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gqlgenRequest) ([]attribute.KeyValue, context.Context) {
+	return append(attributes, operationAttrs(request.operationName, request.operationType)...), parentContext
+}
+
+func (g gqlgenAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gqlgenRequest, response gqlgenResponse, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+type gqlgenResolverSpanNameExtractor struct {
+}
+
+// Extract returns "{object}.{field}", e.g. "Query.user".
+func (g gqlgenResolverSpanNameExtractor) Extract(request gqlgenResolverRequest) string {
+	return request.object + "." + request.field
+}
+
+type gqlgenResolverAttrsExtractor struct {
+}
+
+func (g gqlgenResolverAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request gqlgenResolverRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, operationAttrs(request.operationName, request.operationType)...)
+	return append(attributes,
+		graphqlFieldObjectKey.String(request.object),
+		graphqlFieldNameKey.String(request.field),
+		graphqlFieldPathKey.String(request.path),
+	), parentContext
+}
+
+func (g gqlgenResolverAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request gqlgenResolverRequest, response gqlgenResponse, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func operationAttrs(operationName, operationType string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, 2)
+	if operationName != "" {
+		attrs = append(attrs, semconv.GraphqlOperationName(operationName))
+	}
+	if operationType != "" {
+		attrs = append(attrs, semconv.GraphqlOperationTypeKey.String(operationType))
+	}
+	return attrs
+}
+
+func BuildGqlgenOperationInstrumenter() instrumenter.Instrumenter[gqlgenRequest, gqlgenResponse] {
+	builder := instrumenter.Builder[gqlgenRequest, gqlgenResponse]{}
+	return builder.Init().SetSpanNameExtractor(gqlgenSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[gqlgenRequest]{}).
+		AddAttributesExtractor(gqlgenAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GQLGEN_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func BuildGqlgenResolverInstrumenter() instrumenter.Instrumenter[gqlgenResolverRequest, gqlgenResponse] {
+	builder := instrumenter.Builder[gqlgenResolverRequest, gqlgenResponse]{}
+	return builder.Init().SetSpanNameExtractor(gqlgenResolverSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[gqlgenResolverRequest]{}).
+		AddAttributesExtractor(gqlgenResolverAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GQLGEN_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gqlgen
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+var (
+	gqlgenOperationInstrumenter = BuildGqlgenOperationInstrumenter()
+	gqlgenResolverInstrumenter  = BuildGqlgenResolverInstrumenter()
+)
+
+// otelTracer is the first extension used by every executor, so that the
+// operation span covers the extensions added by the user.
+type otelTracer struct{}
+
+var (
+	_ graphql.HandlerExtension    = otelTracer{}
+	_ graphql.ResponseInterceptor = otelTracer{}
+	_ graphql.FieldInterceptor    = otelTracer{}
+)
+
+func (o otelTracer) ExtensionName() string {
+	return "OpenTelemetry"
+}
+
+func (o otelTracer) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse traces the operation, the resolvers of queries and
+// mutations run while the response is produced, and every event of a
+// subscription produces a response of its own.
+func (o otelTracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !gqlgenEnabler.Enable() || !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	operationName, operationType := operationOf(graphql.GetOperationContext(ctx))
+	request := gqlgenRequest{
+		operationName: operationName,
+		operationType: operationType,
+	}
+	ctx = gqlgenOperationInstrumenter.Start(ctx, request)
+	resp := next(ctx)
+	var err error
+	if resp != nil && len(resp.Errors) > 0 {
+		err = resp.Errors
+	}
+	gqlgenOperationInstrumenter.End(ctx, request, gqlgenResponse{}, err)
+	return resp
+}
+
+func (o otelTracer) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	if !gqlgenEnabler.Enable() || !gqlgenResolverEnabler.Enable() {
+		return next(ctx)
+	}
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	request := gqlgenResolverRequest{
+		object: fc.Object,
+		field:  fc.Field.Name,
+		path:   fc.Path().String(),
+	}
+	if graphql.HasOperationContext(ctx) {
+		request.operationName, request.operationType = operationOf(graphql.GetOperationContext(ctx))
+	}
+	ctx = gqlgenResolverInstrumenter.Start(ctx, request)
+	res, err := next(ctx)
+	gqlgenResolverInstrumenter.End(ctx, request, gqlgenResponse{}, err)
+	return res, err
+}
+
+func operationOf(rc *graphql.OperationContext) (string, string) {
+	if rc == nil || rc.Operation == nil {
+		return "", ""
+	}
+	return rc.Operation.Name, string(rc.Operation.Operation)
+}
+
+//go:linkname newExecutorOnExit github.com/99designs/gqlgen/graphql/executor.newExecutorOnExit
+func newExecutorOnExit(call api.CallContext, e *executor.Executor) {
+	if !gqlgenEnabler.Enable() || e == nil {
+		return
+	}
+	e.Use(otelTracer{})
+}
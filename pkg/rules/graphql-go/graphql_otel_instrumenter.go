@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type graphqlInnerEnabler struct {
+	enabled bool
+}
+
+func (g graphqlInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var graphqlEnabler = graphqlInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GRAPHQL_GO_ENABLED") != "false"}
+
+type graphqlSpanNameExtractor struct {
+}
+
+// Extract returns "{graphql.operation.type} {graphql.operation.name}", or the
+// operation type only for anonymous operations. The operation is unknown
+// until the document has been parsed.
+func (g graphqlSpanNameExtractor) Extract(request graphqlRequest) string {
+	if request.operationType == "" {
+		return "GraphQL Operation"
+	}
+	if request.operationName == "" {
+		return request.operationType
+	}
+	return request.operationType + " " + request.operationName
+}
+
+type graphqlAttrsExtractor struct {
+}
+
+func (g graphqlAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request graphqlRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+// OnEnd records the operation, which is found in the parsed document after
+// the span has started.
+func (g graphqlAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request graphqlRequest, response graphqlResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if request.operationName != "" {
+		attributes = append(attributes, semconv.GraphqlOperationName(request.operationName))
+	}
+	if request.operationType != "" {
+		attributes = append(attributes, semconv.GraphqlOperationTypeKey.String(request.operationType))
+	}
+	return attributes, context
+}
+
+func BuildGraphqlInstrumenter() instrumenter.Instrumenter[graphqlRequest, graphqlResponse] {
+	builder := instrumenter.Builder[graphqlRequest, graphqlResponse]{}
+	return builder.Init().SetSpanNameExtractor(graphqlSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[graphqlRequest]{}).
+		AddAttributesExtractor(graphqlAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GRAPHQL_GO_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
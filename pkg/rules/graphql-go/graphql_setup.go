@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"context"
+	"errors"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var graphqlInstrumenter = BuildGraphqlInstrumenter()
+
+type graphqlRequestKey struct{}
+
+type graphqlState struct {
+	ctx     context.Context
+	request *graphqlRequest
+}
+
+//go:linkname doOnEnter github.com/graphql-go/graphql.doOnEnter
+func doOnEnter(call api.CallContext, p graphql.Params) {
+	if !graphqlEnabler.Enable() {
+		return
+	}
+	ctx := p.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := &graphqlRequest{operationName: p.OperationName}
+	ctx = graphqlInstrumenter.Start(ctx, *request)
+	// Execute finds the operation in the parsed document and completes the
+	// request through the context
+	p.Context = context.WithValue(ctx, graphqlRequestKey{}, request)
+	call.SetParam(0, p)
+	call.SetData(graphqlState{ctx: ctx, request: request})
+}
+
+//go:linkname doOnExit github.com/graphql-go/graphql.doOnExit
+func doOnExit(call api.CallContext, result *graphql.Result) {
+	if !graphqlEnabler.Enable() {
+		return
+	}
+	state, ok := call.GetData().(graphqlState)
+	if !ok {
+		return
+	}
+	graphqlInstrumenter.End(state.ctx, *state.request, graphqlResponse{}, resultError(result))
+}
+
+//go:linkname executeOnEnter github.com/graphql-go/graphql.executeOnEnter
+func executeOnEnter(call api.CallContext, p graphql.ExecuteParams) {
+	if !graphqlEnabler.Enable() {
+		return
+	}
+	operationName, operationType := operationOf(p.AST, p.OperationName)
+	if p.Context != nil {
+		if request, ok := p.Context.Value(graphqlRequestKey{}).(*graphqlRequest); ok {
+			request.operationName, request.operationType = operationName, operationType
+			trace.SpanFromContext(p.Context).SetName(graphqlSpanNameExtractor{}.Extract(*request))
+			return
+		}
+	}
+	// The document is executed without Do, it has been parsed by the caller
+	ctx := p.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := &graphqlRequest{operationName: operationName, operationType: operationType}
+	ctx = graphqlInstrumenter.Start(ctx, *request)
+	p.Context = ctx
+	call.SetParam(0, p)
+	call.SetData(graphqlState{ctx: ctx, request: request})
+}
+
+//go:linkname executeOnExit github.com/graphql-go/graphql.executeOnExit
+func executeOnExit(call api.CallContext, result *graphql.Result) {
+	if !graphqlEnabler.Enable() {
+		return
+	}
+	state, ok := call.GetData().(graphqlState)
+	if !ok {
+		return
+	}
+	graphqlInstrumenter.End(state.ctx, *state.request, graphqlResponse{}, resultError(result))
+}
+
+// operationOf returns the name and the type of the operation to execute, it
+// is the only operation of the document if no operation name is given.
+func operationOf(document *ast.Document, operationName string) (string, string) {
+	if document == nil {
+		return operationName, ""
+	}
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		name := ""
+		if operation.Name != nil {
+			name = operation.Name.Value
+		}
+		if operationName == "" || operationName == name {
+			return name, operation.Operation
+		}
+	}
+	return operationName, ""
+}
+
+func resultError(result *graphql.Result) error {
+	if result == nil || len(result.Errors) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(result.Errors))
+	for _, err := range result.Errors {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
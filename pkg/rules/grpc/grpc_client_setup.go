@@ -58,7 +58,7 @@ func NewClientHandler(opts ...Option) stats.Handler {
 
 // TagRPC can attach some information to the given context.
 func (h *clientHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
-	if info.FullMethodName == grpcTraceExporterPath || info.FullMethodName == grpcMetricExporterPath {
+	if isUntracedMethod(info.FullMethodName) {
 		return ctx
 	}
 	nCtx := grpcClientInstrument.Start(ctx, grpcRequest{
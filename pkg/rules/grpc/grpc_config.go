@@ -16,6 +16,8 @@ package grpc
 
 import (
 	"context"
+	"os"
+	"strconv"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
@@ -25,6 +27,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// defaultStreamEventLimit is the number of messages recorded as events per
+// direction of a stream.
+const defaultStreamEventLimit = 64
+
 type Filter func(*InterceptorInfo) bool
 
 // grpcOtelConfig is a group of options for this instrumentation.
@@ -37,6 +43,11 @@ type grpcOtelConfig struct {
 	ReceivedEvent bool
 	SentEvent     bool
 
+	// StreamEvents records the messages of streams even if ReceivedEvent
+	// and SentEvent are not set, at most StreamEventLimit per direction.
+	StreamEvents     bool
+	StreamEventLimit int64
+
 	tracer trace.Tracer
 
 	DestId string
@@ -52,76 +63,85 @@ func (c *grpcOtelConfig) handleRPC(ctx context.Context, rs stats.RPCStats, isSer
 	if span == nil {
 		return
 	}
-	var (
-		messageId int64
-	)
 	gctx, _ := ctx.Value(gRPCContextKey{}).(*gRPCContext)
+	if gctx == nil {
+		return
+	}
 	switch rs := rs.(type) {
 	case *stats.Begin:
+		gctx.isClientStream = rs.IsClientStream
+		gctx.isServerStream = rs.IsServerStream
 	case *stats.InPayload:
-		if c.ReceivedEvent {
+		messageId := gctx.receivedMessages.Add(1)
+		gctx.receivedBytes.Add(int64(rs.Length))
+		// every message of a stream is recorded with the time it is received
+		if c.ReceivedEvent || c.recordStreamEvent(gctx, messageId) {
 			span.AddEvent("message",
+				trace.WithTimestamp(rs.RecvTime),
 				trace.WithAttributes(
 					semconv.MessageTypeReceived,
 					semconv.MessageIDKey.Int64(messageId),
+					semconv.MessageCompressedSizeKey.Int(rs.WireLength),
 					semconv.MessageUncompressedSizeKey.Int(rs.Length),
 				),
 			)
 		}
 	case *stats.OutPayload:
-		if c.SentEvent {
+		messageId := gctx.sentMessages.Add(1)
+		gctx.sentBytes.Add(int64(rs.Length))
+		if c.SentEvent || c.recordStreamEvent(gctx, messageId) {
 			span.AddEvent("message",
+				trace.WithTimestamp(rs.SentTime),
 				trace.WithAttributes(
 					semconv.MessageTypeSent,
 					semconv.MessageIDKey.Int64(messageId),
-					//semconv.MessageCompressedSizeKey.Int(rs.CompressedLength),
+					semconv.MessageCompressedSizeKey.Int(rs.WireLength),
 					semconv.MessageUncompressedSizeKey.Int(rs.Length),
 				),
 			)
 		}
 	case *stats.OutTrailer:
 	case *stats.End:
+		// the end of a stream is reported once it is closed by both sides,
+		// io.EOF received by the client is not reported as an error
+		request := grpcRequest{
+			methodName: gctx.methodName,
+		}
+		response := grpcResponse{
+			statusCode: 200,
+			messages:   gctx,
+		}
 		if rs.Error != nil {
 			s, _ := status.FromError(rs.Error)
-			if isServer {
-				grpcServerInstrument.End(ctx, grpcRequest{}, grpcResponse{
-					statusCode: int(s.Code()),
-				}, rs.Error)
-			} else {
-				grpcClientInstrument.End(ctx, grpcRequest{}, grpcResponse{
-					statusCode: int(s.Code()),
-				}, rs.Error)
-			}
-
+			response.statusCode = int(s.Code())
+			response.grpcStatusCode = int(s.Code())
+		}
+		if isServer {
+			grpcServerInstrument.End(ctx, request, response, rs.Error)
 		} else {
-			methodName := ""
-			if gctx != nil {
-				methodName = gctx.methodName
-			}
-			if isServer {
-				grpcServerInstrument.End(ctx, grpcRequest{
-					methodName: methodName,
-				}, grpcResponse{
-					statusCode: 200,
-				}, nil)
-			} else {
-				grpcClientInstrument.End(ctx, grpcRequest{
-					methodName: methodName,
-				}, grpcResponse{
-					statusCode: 200,
-				}, nil)
-			}
-
+			grpcClientInstrument.End(ctx, request, response, rs.Error)
 		}
 	default:
 		return
 	}
 }
 
+// recordStreamEvent reports whether the message of a stream is recorded as
+// an event, long-lived streams would otherwise exceed the event limit of the
+// span. The messages beyond the limit are still counted.
+func (c *grpcOtelConfig) recordStreamEvent(gctx *gRPCContext, messageId int64) bool {
+	return c.StreamEvents && gctx.isStream() && messageId <= c.StreamEventLimit
+}
+
 // newConfig returns a grpcOtelConfig configured with all the passed Options.
 func newConfig(opts []Option, role string) *grpcOtelConfig {
 	c := &grpcOtelConfig{
-		Propagators: otel.GetTextMapPropagator(),
+		Propagators:      otel.GetTextMapPropagator(),
+		StreamEvents:     os.Getenv("OTEL_INSTRUMENTATION_GRPC_STREAM_EVENTS") != "false",
+		StreamEventLimit: defaultStreamEventLimit,
+	}
+	if limit, err := strconv.ParseInt(os.Getenv("OTEL_INSTRUMENTATION_GRPC_STREAM_EVENTS_LIMIT"), 10, 64); err == nil && limit >= 0 {
+		c.StreamEventLimit = limit
 	}
 	for _, o := range opts {
 		o.apply(c)
@@ -15,13 +15,37 @@
 package grpc
 
 import (
+	"strings"
+	"sync/atomic"
+
 	"go.opentelemetry.io/otel/propagation"
 )
 
 const (
 	grpcTraceExporterPath = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
-	grpcMetricExporterPath = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
-)
+	grpcMetricExporterPath = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export")
+
+// grpcUntracedServices are the services of the streams opened by grpc itself
+// for discovery, load reporting and health checking, they live as long as the
+// connection and are not part of any request.
+var grpcUntracedServices = []string{
+	"/envoy.service.discovery.v3.AggregatedDiscoveryService/",
+	"/envoy.service.load_stats.v3.LoadReportingService/",
+	"/xds.service.orca.v3.OpenRcaService/",
+	"/grpc.health.v1.Health/Watch",
+}
+
+func isUntracedMethod(fullMethodName string) bool {
+	if fullMethodName == grpcTraceExporterPath || fullMethodName == grpcMetricExporterPath {
+		return true
+	}
+	for _, service := range grpcUntracedServices {
+		if strings.HasPrefix(fullMethodName, service) {
+			return true
+		}
+	}
+	return false
+}
 
 var grpcClientInstrument = BuildGrpcClientInstrumenter()
 
@@ -32,11 +56,25 @@ type grpcRequest struct {
 }
 
 type grpcResponse struct {
-	statusCode int
+	statusCode     int
+	grpcStatusCode int
+	messages       *gRPCContext
 }
 
 type gRPCContextKey struct{}
 
+// gRPCContext is shared by the stats of a single rpc, the messages of a
+// stream may be sent and received by different goroutines.
 type gRPCContext struct {
-	methodName string
+	methodName       string
+	isClientStream   bool
+	isServerStream   bool
+	sentMessages     atomic.Int64
+	receivedMessages atomic.Int64
+	sentBytes        atomic.Int64
+	receivedBytes    atomic.Int64
+}
+
+func (g *gRPCContext) isStream() bool {
+	return g.isClientStream || g.isServerStream
 }
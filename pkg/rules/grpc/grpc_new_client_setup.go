@@ -52,11 +52,7 @@ func NewClientNewHandler(opts ...Option) stats.Handler {
 
 // TagRPC can attach some information to the given context.
 func (h *clientNewHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
-	if info.FullMethodName == grpcTraceExporterPath || info.FullMethodName == grpcMetricExporterPath {
-		return ctx
-	}
-	filter, _ := ctx.Value("stream_filter").(*bool)
-	if filter != nil && *filter {
+	if isUntracedMethod(info.FullMethodName) {
 		return ctx
 	}
 	nCtx := grpcClientInstrument.Start(ctx, grpcRequest{
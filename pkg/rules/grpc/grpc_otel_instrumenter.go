@@ -15,6 +15,7 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
@@ -24,7 +25,9 @@ import (
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -67,6 +70,40 @@ func (g grpcAttrsGetter) GetServerAddress(request grpcRequest) string {
 	return request.serverAddress
 }
 
+const (
+	grpcMessagesSentKey     = attribute.Key("rpc.grpc.messages.sent")
+	grpcMessagesReceivedKey = attribute.Key("rpc.grpc.messages.received")
+	grpcBytesSentKey        = attribute.Key("rpc.grpc.bytes.sent")
+	grpcBytesReceivedKey    = attribute.Key("rpc.grpc.bytes.received")
+	grpcClientStreamKey     = attribute.Key("rpc.grpc.client_stream")
+	grpcServerStreamKey     = attribute.Key("rpc.grpc.server_stream")
+)
+
+type grpcAttrsExtractor struct {
+}
+
+func (g grpcAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request grpcRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+// OnEnd records the status code of the rpc, and the number and the total
+// size of the messages sent and received through a stream.
+func (g grpcAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request grpcRequest, response grpcResponse, err error) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.RPCGRPCStatusCodeKey.Int(response.grpcStatusCode))
+	messages := response.messages
+	if messages == nil || !messages.isStream() {
+		return attributes, context
+	}
+	return append(attributes,
+		grpcClientStreamKey.Bool(messages.isClientStream),
+		grpcServerStreamKey.Bool(messages.isServerStream),
+		grpcMessagesSentKey.Int64(messages.sentMessages.Load()),
+		grpcMessagesReceivedKey.Int64(messages.receivedMessages.Load()),
+		grpcBytesSentKey.Int64(messages.sentBytes.Load()),
+		grpcBytesReceivedKey.Int64(messages.receivedBytes.Load()),
+	), context
+}
+
 type grpcStatusCodeExtractor[REQUEST grpcRequest, RESPONSE grpcResponse] struct {
 }
 
@@ -88,6 +125,7 @@ func BuildGrpcClientInstrumenter() instrumenter.Instrumenter[grpcRequest, grpcRe
 	return builder.Init().SetSpanStatusExtractor(&grpcStatusCodeExtractor[grpcRequest, grpcResponse]{}).SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[grpcRequest]{Getter: clientGetter}).
 		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[grpcRequest]{}).
 		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[grpcRequest, grpcResponse, grpcAttrsGetter]{}).
+		AddAttributesExtractor(grpcAttrsExtractor{}).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.GRPC_CLIENT_SCOPE_NAME,
 			Version: version.Tag,
@@ -102,6 +140,7 @@ func BuildGrpcServerInstrumenter() instrumenter.Instrumenter[grpcRequest, grpcRe
 	return builder.Init().SetSpanStatusExtractor(&grpcStatusCodeExtractor[grpcRequest, grpcResponse]{}).SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[grpcRequest]{Getter: serverGetter}).
 		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[grpcRequest]{}).
 		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[grpcRequest, grpcResponse, grpcAttrsGetter]{}).
+		AddAttributesExtractor(grpcAttrsExtractor{}).
 		SetInstrumentationScope(instrumentation.Scope{
 			Name:    utils.GRPC_SERVER_SCOPE_NAME,
 			Version: version.Tag,
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcgateway
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type grpcGatewayInnerEnabler struct {
+	enabled bool
+}
+
+func (g grpcGatewayInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var grpcGatewayEnabler = grpcGatewayInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_GRPCGATEWAY_ENABLED") != "false"}
+
+type grpcGatewaySpanNameExtractor struct{}
+
+// Extract returns "grpc-gateway {rpc method}", e.g.
+// "grpc-gateway /helloworld.Greeter/SayHello".
+func (g grpcGatewaySpanNameExtractor) Extract(request grpcGatewayRequest) string {
+	return "grpc-gateway " + request.rpcMethod
+}
+
+type grpcGatewayAttrsExtractor struct{}
+
+func (g grpcGatewayAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request grpcGatewayRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.RPCSystemGRPC, semconv.HTTPRequestMethodKey.String(request.httpMethod))
+	if service, method, ok := strings.Cut(strings.TrimPrefix(request.rpcMethod, "/"), "/"); ok {
+		attributes = append(attributes, semconv.RPCService(service), semconv.RPCMethod(method))
+	}
+	if request.route != "" {
+		attributes = append(attributes, semconv.HTTPRoute(request.route))
+	}
+	return attributes, parentContext
+}
+
+func (g grpcGatewayAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request grpcGatewayRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+// BuildGrpcGatewayInstrumenter traces the translation of an HTTP request into
+// the gRPC call, the span is a child of the HTTP server span and the parent of
+// the gRPC client span.
+func BuildGrpcGatewayInstrumenter() instrumenter.Instrumenter[grpcGatewayRequest, any] {
+	builder := instrumenter.Builder[grpcGatewayRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(grpcGatewaySpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[grpcGatewayRequest]{}).
+		AddAttributesExtractor(grpcGatewayAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.GRPC_GATEWAY_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcgateway
+
+import (
+	"context"
+	"net/http"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+var grpcGatewayInstrumenter = BuildGrpcGatewayInstrumenter()
+
+type grpcGatewayCallKey struct{}
+
+// grpcGatewayCall is carried by the request context from the mux to the
+// generated handler, which annotates the context of the gRPC call and reports
+// its error with the same request.
+type grpcGatewayCall struct {
+	ctx     context.Context
+	request grpcGatewayRequest
+	err     error
+}
+
+//go:linkname grpcGatewayServeHTTPOnEnter github.com/grpc-ecosystem/grpc-gateway/v2/runtime.grpcGatewayServeHTTPOnEnter
+func grpcGatewayServeHTTPOnEnter(call api.CallContext, mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request) {
+	if !grpcGatewayEnabler.Enable() || r == nil {
+		return
+	}
+	gc := &grpcGatewayCall{}
+	call.SetParam(2, r.WithContext(context.WithValue(r.Context(), grpcGatewayCallKey{}, gc)))
+	call.SetData(gc)
+}
+
+//go:linkname grpcGatewayServeHTTPOnExit github.com/grpc-ecosystem/grpc-gateway/v2/runtime.grpcGatewayServeHTTPOnExit
+func grpcGatewayServeHTTPOnExit(call api.CallContext) {
+	gc, ok := call.GetData().(*grpcGatewayCall)
+	if !ok || gc.ctx == nil {
+		return
+	}
+	grpcGatewayInstrumenter.End(gc.ctx, gc.request, nil, gc.err)
+}
+
+// Generated handlers annotate the context with the path template of the HTTP
+// rule, which names the server span instead of the raw path, as the request
+// reaches the handler only after the mux matched the route.
+//
+//go:linkname grpcGatewayAnnotateContextOnExit github.com/grpc-ecosystem/grpc-gateway/v2/runtime.grpcGatewayAnnotateContextOnExit
+func grpcGatewayAnnotateContextOnExit(call api.CallContext, ctx context.Context, err error) {
+	if !grpcGatewayEnabler.Enable() || err != nil || ctx == nil {
+		return
+	}
+	req, ok := call.GetParam(2).(*http.Request)
+	if !ok || req == nil {
+		return
+	}
+	gc, ok := req.Context().Value(grpcGatewayCallKey{}).(*grpcGatewayCall)
+	if !ok || gc.ctx != nil {
+		return
+	}
+	rpcMethod, _ := call.GetParam(3).(string)
+	route, _ := runtime.HTTPPathPattern(ctx)
+	if lcs := trace.LocalRootSpanFromGLS(); lcs != nil && route != "" {
+		lcs.SetName(route)
+	}
+	gc.request = grpcGatewayRequest{
+		rpcMethod:  rpcMethod,
+		route:      route,
+		httpMethod: req.Method,
+	}
+	gc.ctx = grpcGatewayInstrumenter.Start(ctx, gc.request)
+	call.SetReturnVal(0, gc.ctx)
+}
+
+//go:linkname grpcGatewayHTTPErrorOnEnter github.com/grpc-ecosystem/grpc-gateway/v2/runtime.grpcGatewayHTTPErrorOnEnter
+func grpcGatewayHTTPErrorOnEnter(call api.CallContext, ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if r == nil {
+		return
+	}
+	if gc, ok := r.Context().Value(grpcGatewayCallKey{}).(*grpcGatewayCall); ok {
+		gc.err = err
+	}
+}
@@ -38,6 +38,10 @@ var hertzServerEnabler = hertzServerInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION
 
 var hertzInstrumenter = BuildHertzServerInstrumenter()
 
+// hertzServerTracedKey marks the request contexts whose server spans are
+// already recorded by the middleware
+const hertzServerTracedKey = "__otel_hertz_server_traced__"
+
 type hertzOpentelemetryTracer struct{}
 
 func (m *hertzOpentelemetryTracer) Start(ctx context.Context, c *app.RequestContext) context.Context {
@@ -45,6 +49,9 @@ func (m *hertzOpentelemetryTracer) Start(ctx context.Context, c *app.RequestCont
 }
 
 func (m *hertzOpentelemetryTracer) Finish(ctx context.Context, c *app.RequestContext) {
+	if _, traced := c.Get(hertzServerTracedKey); traced {
+		return
+	}
 	if c.GetTraceInfo().Stats().GetEvent(stats.HTTPStart) != nil && c.GetTraceInfo().Stats().GetEvent(stats.HTTPFinish) != nil {
 		start := c.GetTraceInfo().Stats().GetEvent(stats.HTTPStart)
 		end := c.GetTraceInfo().Stats().GetEvent(stats.HTTPFinish)
@@ -66,3 +73,31 @@ func beforeHertzServerBuild(call api.CallContext, opts ...config.Option) {
 	opts = append(opts, server.WithTracer(&hertzOpentelemetryTracer{}))
 	call.SetParam(0, opts)
 }
+
+// The headers are not read yet when the tracer starts, so the server span is
+// started by a middleware in front of the route handler chain, which makes it
+// the parent of the spans created by handlers. Requests that never reach the
+// handler chain, e.g. the ones without matched routes, are still recorded by
+// the tracer when they finish.
+func otelServerMiddleware(ctx context.Context, c *app.RequestContext) {
+	c.Set(hertzServerTracedKey, true)
+	req := &c.Request
+	ctx = hertzInstrumenter.Start(ctx, req)
+	c.Next(ctx)
+	var err error
+	if last := c.Errors.Last(); last != nil {
+		err = last
+	}
+	hertzInstrumenter.End(ctx, req, &c.Response, err)
+}
+
+//go:linkname afterHertzServerBuild github.com/cloudwego/hertz/pkg/app/server.afterHertzServerBuild
+func afterHertzServerBuild(call api.CallContext, h *server.Hertz) {
+	if !hertzServerEnabler.Enable() {
+		return
+	}
+	if h == nil {
+		return
+	}
+	h.Use(otelServerMiddleware)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdb
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const (
+	influxdbOrgKey    = attribute.Key("influxdb.org")
+	influxdbBucketKey = attribute.Key("influxdb.bucket")
+)
+
+type influxdbAttrsGetter struct{}
+
+func (i influxdbAttrsGetter) GetSystem(_ influxdbRequest) string {
+	return "influxdb"
+}
+
+func (i influxdbAttrsGetter) GetServerAddress(request influxdbRequest) string {
+	return request.Addr
+}
+
+func (i influxdbAttrsGetter) GetStatement(request influxdbRequest) string {
+	return request.Statement
+}
+
+// GetCollection returns the bucket, which holds the series as tables do rows
+func (i influxdbAttrsGetter) GetCollection(request influxdbRequest) string {
+	return request.Bucket
+}
+
+func (i influxdbAttrsGetter) GetOperation(request influxdbRequest) string {
+	return request.Operation
+}
+
+func (i influxdbAttrsGetter) GetParameters(_ influxdbRequest) []any {
+	return nil
+}
+
+func (i influxdbAttrsGetter) GetDbNamespace(request influxdbRequest) string {
+	return request.Org
+}
+
+func (i influxdbAttrsGetter) GetBatchSize(request influxdbRequest) int {
+	return request.Points
+}
+
+type influxdbAttrsExtractor struct{}
+
+func (i influxdbAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request influxdbRequest) ([]attribute.KeyValue, context.Context) {
+	if request.Org != "" {
+		attributes = append(attributes, influxdbOrgKey.String(request.Org))
+	}
+	if request.Bucket != "" {
+		attributes = append(attributes, influxdbBucketKey.String(request.Bucket))
+	}
+	return attributes, parentContext
+}
+
+func (i influxdbAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request influxdbRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildInfluxdbInstrumenter() instrumenter.Instrumenter[influxdbRequest, interface{}] {
+	builder := instrumenter.Builder[influxdbRequest, interface{}]{}
+	getter := influxdbAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[influxdbRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[influxdbRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[influxdbRequest, any, influxdbAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[influxdbRequest, any, influxdbAttrsGetter]{Getter: getter}}, influxdbAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.INFLUXDB_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
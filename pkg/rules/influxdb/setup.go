@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdb
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	influxapi "github.com/influxdata/influxdb-client-go/v2/api"
+	http2 "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+type influxdbInnerEnabler struct {
+	enabled bool
+}
+
+func (i influxdbInnerEnabler) Enable() bool {
+	return i.enabled
+}
+
+var influxdbEnabler = influxdbInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_INFLUXDB_ENABLED") != "false"}
+
+var influxdbInstrumenter = BuildInfluxdbInstrumenter()
+
+var fluxBucketRegexp = regexp.MustCompile(`from\s*\(\s*bucket\s*:\s*"([^"]*)"`)
+
+// influxdbField returns the string field of the struct pointed to by v, the
+// write service and the query API are internal and unexported respectively,
+// so their fields are read by reflection.
+func influxdbField(v interface{}, name string) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ""
+	}
+	field := rv.Elem().FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
+
+func influxdbAddr(rawURL string) string {
+	if u, err := url.Parse(rawURL); err == nil {
+		return u.Host
+	}
+	return ""
+}
+
+func influxdbPoints(lines string) int {
+	points := 0
+	for _, line := range strings.Split(lines, "\n") {
+		if strings.TrimSpace(line) != "" {
+			points++
+		}
+	}
+	return points
+}
+
+func influxdbStart(call api.CallContext, ctx context.Context, request influxdbRequest) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = influxdbInstrumenter.Start(ctx, request)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+	call.SetParam(1, ctx)
+}
+
+func influxdbEnd(call api.CallContext, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(influxdbRequest)
+	if !ok {
+		return
+	}
+	// The query API resolves its url on the first query
+	if request.Addr == "" {
+		request.Addr = influxdbAddr(influxdbField(data["api"], "url"))
+	}
+	influxdbInstrumenter.End(ctx, request, nil, err)
+}
+
+// Both the blocking and the non-blocking write API send their batches, as well
+// as the retries of them, by the write service.
+//
+//go:linkname influxdbWriteBatchOnEnter github.com/influxdata/influxdb-client-go/v2/internal/write.influxdbWriteBatchOnEnter
+func influxdbWriteBatchOnEnter(call api.CallContext, service interface{}, ctx context.Context, batch interface{}) {
+	if !influxdbEnabler.Enable() {
+		return
+	}
+	influxdbStart(call, ctx, influxdbRequest{
+		Operation: "write",
+		Org:       influxdbField(service, "org"),
+		Bucket:    influxdbField(service, "bucket"),
+		Addr:      influxdbAddr(influxdbField(service, "url")),
+		Points:    influxdbPoints(influxdbField(batch, "Batch")),
+	})
+}
+
+//go:linkname influxdbWriteBatchOnExit github.com/influxdata/influxdb-client-go/v2/internal/write.influxdbWriteBatchOnExit
+func influxdbWriteBatchOnExit(call api.CallContext, perr *http2.Error) {
+	var err error
+	if perr != nil {
+		err = perr
+	}
+	influxdbEnd(call, err)
+}
+
+func influxdbQueryOnEnter(call api.CallContext, q interface{}, ctx context.Context, query string) {
+	request := influxdbRequest{
+		Operation: "query",
+		Statement: query,
+		Org:       influxdbField(q, "org"),
+		Addr:      influxdbAddr(influxdbField(q, "url")),
+	}
+	if match := fluxBucketRegexp.FindStringSubmatch(query); match != nil {
+		request.Bucket = match[1]
+	}
+	influxdbStart(call, ctx, request)
+	if data, ok := call.GetData().(map[string]interface{}); ok {
+		data["api"] = q
+	}
+}
+
+//go:linkname influxdbQueryWithParamsOnEnter github.com/influxdata/influxdb-client-go/v2/api.influxdbQueryWithParamsOnEnter
+func influxdbQueryWithParamsOnEnter(call api.CallContext, q interface{}, ctx context.Context, query string, params interface{}) {
+	if !influxdbEnabler.Enable() {
+		return
+	}
+	influxdbQueryOnEnter(call, q, ctx, query)
+}
+
+//go:linkname influxdbQueryWithParamsOnExit github.com/influxdata/influxdb-client-go/v2/api.influxdbQueryWithParamsOnExit
+func influxdbQueryWithParamsOnExit(call api.CallContext, result *influxapi.QueryTableResult, err error) {
+	influxdbEnd(call, err)
+}
+
+//go:linkname influxdbQueryRawWithParamsOnEnter github.com/influxdata/influxdb-client-go/v2/api.influxdbQueryRawWithParamsOnEnter
+func influxdbQueryRawWithParamsOnEnter(call api.CallContext, q interface{}, ctx context.Context, query string, dialect *domain.Dialect, params interface{}) {
+	if !influxdbEnabler.Enable() {
+		return
+	}
+	influxdbQueryOnEnter(call, q, ctx, query)
+}
+
+//go:linkname influxdbQueryRawWithParamsOnExit github.com/influxdata/influxdb-client-go/v2/api.influxdbQueryRawWithParamsOnExit
+func influxdbQueryRawWithParamsOnExit(call api.CallContext, result string, err error) {
+	influxdbEnd(call, err)
+}
@@ -30,9 +30,29 @@ func irisHttpOnEnter(call api.CallContext, _ interface{}, iCtx *iContext.Context
 	if iCtx == nil {
 		return
 	}
+	call.SetData(iCtx)
+}
+
+// The route of the request is only known after it is handled, the server span
+// of net/http, which is the local root span of the request, is named by the
+// registered path of the route, e.g. /user/{id:uint64}, if it is a template.
+//
+//go:linkname irisHttpOnExit github.com/kataras/iris/v12/core/router.irisHttpOnExit
+func irisHttpOnExit(call api.CallContext) {
+	if !irisEnabler.Enable() {
+		return
+	}
+	iCtx, ok := call.GetData().(*iContext.Context)
+	if !ok || iCtx == nil {
+		return
+	}
+	route := iCtx.GetCurrentRoute()
 	r := iCtx.Request()
+	if route == nil || r == nil || r.URL == nil {
+		return
+	}
 	lcs := trace.LocalRootSpanFromGLS()
-	if lcs != nil && r != nil && iCtx.Path() != "" && r.URL != nil && (iCtx.Path() != r.URL.Path) {
-		lcs.SetName(iCtx.Path())
+	if lcs != nil && route.Path() != "" && route.Path() != r.URL.Path {
+		lcs.SetName(route.Path())
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/cloudwego/kitex/pkg/endpoint"
 	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2/metadata"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/streaming"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	sdktrace "go.opentelemetry.io/otel/trace"
 )
@@ -74,7 +75,11 @@ func ClientMiddleware() endpoint.Middleware {
 			for k, v := range md {
 				ctx = metainfo.WithValue(ctx, k, v)
 			}
-			return next(ctx, req, resp)
+			err = next(ctx, req, resp)
+			if res, ok := resp.(*streaming.Result); ok && err == nil && res.Stream != nil {
+				res.Stream = newTracedStream(res.Stream.Context(), res.Stream, sdktrace.SpanFromContext(ctx))
+			}
+			return err
 		}
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/cloudwego/kitex/pkg/endpoint"
 	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2/metadata"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/streaming"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	sdktrace "go.opentelemetry.io/otel/trace"
 )
@@ -80,7 +81,11 @@ func ServerMiddleware() endpoint.Middleware {
 			ctx = Extract(ctx, md)
 			ri := rpcinfo.GetRPCInfo(ctx)
 			ctx = kitexServerInstrumenter.Start(ctx, ri)
-			tc.SetSpan(sdktrace.SpanFromContext(ctx))
+			span := sdktrace.SpanFromContext(ctx)
+			tc.SetSpan(span)
+			if args, ok := req.(*streaming.Args); ok && args.Stream != nil {
+				args.Stream = newTracedStream(ctx, args.Stream, span)
+			}
 			return next(ctx, req, resp)
 		}
 	}
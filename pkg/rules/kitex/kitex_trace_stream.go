@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kitex
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/cloudwego/kitex/pkg/streaming"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	sdktrace "go.opentelemetry.io/otel/trace"
+)
+
+// tracedStream records the messages of a streaming call as the events of its
+// span, the same as the grpc instrumentation does. The context of the stream
+// is replaced on the server side so that the handler, which only sees the
+// stream, still creates its spans under the server span.
+type tracedStream struct {
+	streaming.Stream
+	ctx        context.Context
+	span       sdktrace.Span
+	sentID     int64
+	receivedID int64
+}
+
+func newTracedStream(ctx context.Context, st streaming.Stream, span sdktrace.Span) *tracedStream {
+	return &tracedStream{Stream: st, ctx: ctx, span: span}
+}
+
+func (s *tracedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tracedStream) SendMsg(m interface{}) error {
+	err := s.Stream.SendMsg(m)
+	if err == nil {
+		s.span.AddEvent("message", sdktrace.WithAttributes(
+			semconv.RPCMessageTypeSent,
+			semconv.RPCMessageIDKey.Int64(atomic.AddInt64(&s.sentID, 1)),
+		))
+	}
+	return err
+}
+
+func (s *tracedStream) RecvMsg(m interface{}) error {
+	err := s.Stream.RecvMsg(m)
+	if err == nil {
+		s.span.AddEvent("message", sdktrace.WithAttributes(
+			semconv.RPCMessageTypeReceived,
+			semconv.RPCMessageIDKey.Int64(atomic.AddInt64(&s.receivedID, 1)),
+		))
+	}
+	return err
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"os"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+//go:linkname kratosGRPCDialOnEnter github.com/go-kratos/kratos/v2/transport/grpc.kratosGRPCDialOnEnter
+func kratosGRPCDialOnEnter(call api.CallContext, ctx context.Context, opts ...grpc.ClientOption) {
+	if !kratosEnabler.Enable() {
+		return
+	}
+	if os.Getenv(OTEL_INSTRUMENTATION_KRATOS_EXPERIMENTAL_SPAN_ENABLE) != "true" {
+		return
+	}
+	opts = append(opts, grpc.WithMiddleware(ClientTracingMiddleWare()))
+	call.SetParam(1, opts)
+}
+
+func ClientTracingMiddleWare() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromClientContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			request := kratosRequest{
+				operation: tr.Operation(),
+				client:    true,
+			}
+			switch tr.Kind() {
+			case transport.KindGRPC:
+				request.protocolType = "grpc"
+			case transport.KindHTTP:
+				request.protocolType = "http"
+			default:
+				return handler(ctx, req)
+			}
+			ctx = kratosInternalInstrument.Start(ctx, request)
+			reply, err = handler(ctx, req)
+			kratosInternalInstrument.End(ctx, request, nil, err)
+			return reply, err
+		}
+	}
+}
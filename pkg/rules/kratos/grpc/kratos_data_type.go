@@ -21,4 +21,6 @@ type kratosRequest struct {
 	serviceVersion  string
 	serviceEndpoint []string
 	serviceMeta     map[string]string
+	operation       string
+	client          bool
 }
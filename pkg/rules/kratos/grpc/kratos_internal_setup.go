@@ -33,6 +33,9 @@ var kratosInternalInstrument = BuildKratosInternalInstrumenter()
 
 //go:linkname kratosNewGRPCServiceOnEnter github.com/go-kratos/kratos/v2/transport/grpc.kratosNewGRPCServiceOnEnter
 func kratosNewGRPCServiceOnEnter(call api.CallContext, opts ...grpc.ServerOption) {
+	if !kratosEnabler.Enable() {
+		return
+	}
 	if os.Getenv(OTEL_INSTRUMENTATION_KRATOS_EXPERIMENTAL_SPAN_ENABLE) != "true" {
 		return
 	}
@@ -74,6 +77,7 @@ func ServerTracingMiddleWare() middleware.Middleware {
 					serviceVersion:  serviceVersion,
 					serviceEndpoint: serviceEndpoint,
 					serviceMeta:     serviceMeta,
+					operation:       tr.Operation(),
 				}
 				switch tr.Kind() {
 				case transport.KindGRPC:
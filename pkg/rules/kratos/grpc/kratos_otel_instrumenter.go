@@ -30,6 +30,7 @@ const kratos_service_id = "kratos.service.id"
 const kratos_service_version = "kratos.service.version"
 const kratos_service_meta = "kratos.service.meta"
 const kratos_service_endpoint = "kratos.service.endpoint"
+const kratos_operation = "kratos.operation"
 
 type kratosExperimentalAttributeExtractor struct {
 }
@@ -51,6 +52,12 @@ func (k kratosExperimentalAttributeExtractor) OnStart(attributes []attribute.Key
 		Key:   kratos_service_endpoint,
 		Value: attribute.StringSliceValue(request.serviceEndpoint),
 	})
+	if request.operation != "" {
+		attributes = append(attributes, attribute.KeyValue{
+			Key:   kratos_operation,
+			Value: attribute.StringValue(request.operation),
+		})
+	}
 	if request.serviceMeta != nil {
 		for k, v := range request.serviceMeta {
 			attributes = append(attributes, attribute.KeyValue{
@@ -70,6 +77,9 @@ type kratosExperimentalSpanNameExtractor struct {
 }
 
 func (k kratosExperimentalSpanNameExtractor) Extract(request kratosRequest) string {
+	if request.client {
+		return "kratos." + request.protocolType + ".client"
+	}
 	if request.protocolType == "grpc" {
 		return "kratos.grpc." + request.serviceName
 	}
@@ -33,10 +33,13 @@ var kratosInternalInstrument = BuildKratosInternalInstrumenter()
 
 //go:linkname kratosNewHTTPServiceOnEnter github.com/go-kratos/kratos/v2/transport/http.kratosNewHTTPServiceOnEnter
 func kratosNewHTTPServiceOnEnter(call api.CallContext, opts ...http.ServerOption) {
-	if os.Getenv(OTEL_INSTRUMENTATION_KRATOS_EXPERIMENTAL_SPAN_ENABLE) != "true" {
+	if !kratosEnabler.Enable() {
 		return
 	}
-	opts = append(opts, AddHTTPMiddleware(ServerTracingMiddleWare()))
+	opts = append(opts, AddHTTPMiddleware(ServerRouteMiddleware()))
+	if os.Getenv(OTEL_INSTRUMENTATION_KRATOS_EXPERIMENTAL_SPAN_ENABLE) == "true" {
+		opts = append(opts, AddHTTPMiddleware(ServerTracingMiddleWare()))
+	}
 	call.SetParam(0, opts)
 }
 
@@ -74,6 +77,7 @@ func ServerTracingMiddleWare() middleware.Middleware {
 					serviceVersion:  serviceVersion,
 					serviceEndpoint: serviceEndpoint,
 					serviceMeta:     serviceMeta,
+					operation:       tr.Operation(),
 				}
 				switch tr.Kind() {
 				case transport.KindGRPC:
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ServerRouteMiddleware names the span of net/http, which already covers the
+// request served by the kratos HTTP server, by the path template of the
+// matched route, e.g. /helloworld/{name}.
+func ServerRouteMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if ht, ok := tr.(http.Transporter); ok && ht.PathTemplate() != "" {
+					lcs := trace.LocalRootSpanFromGLS()
+					if lcs != nil {
+						lcs.SetName(ht.PathTemplate())
+					}
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// The tracing middlewares of kratos start their own server and client spans
+// on top of the spans of net/http and grpc, so they are replaced when the
+// application already uses them to avoid duplicated spans. The server side
+// still names the span by the route as ServerRouteMiddleware does.
+//
+//go:linkname kratosTracingServerOnEnter github.com/go-kratos/kratos/v2/middleware/tracing.kratosTracingServerOnEnter
+func kratosTracingServerOnEnter(call api.CallContext, opts ...tracing.Option) {
+	if !kratosEnabler.Enable() {
+		return
+	}
+	call.SetSkipCall(true)
+	call.SetReturnVal(0, ServerRouteMiddleware())
+}
+
+//go:linkname kratosTracingClientOnEnter github.com/go-kratos/kratos/v2/middleware/tracing.kratosTracingClientOnEnter
+func kratosTracingClientOnEnter(call api.CallContext, opts ...tracing.Option) {
+	if !kratosEnabler.Enable() {
+		return
+	}
+	call.SetSkipCall(true)
+	call.SetReturnVal(0, middleware.Middleware(func(handler middleware.Handler) middleware.Handler {
+		return handler
+	}))
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineryv2
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/RichardKnop/machinery/v2"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+type machineryProcessData struct {
+	ctx     context.Context
+	request machineryTaskReq
+}
+
+// beforeMachineryV2Process starts the span of a task processed by a worker as a
+// child of the span sending it. The span is on the goroutine calling the task
+// function, so the spans of the task function are its children
+//
+//go:linkname beforeMachineryV2Process github.com/RichardKnop/machinery/v2.beforeMachineryV2Process
+func beforeMachineryV2Process(call api.CallContext, worker *machinery.Worker, signature *tasks.Signature) {
+	if !machineryEnabler.Enable() || worker == nil || signature == nil {
+		return
+	}
+	request := machineryTaskReq{
+		name:       signature.Name,
+		uuid:       signature.UUID,
+		queue:      signature.RoutingKey,
+		retryCount: signature.RetryCount,
+		headers:    signature.Headers,
+	}
+	if request.queue == "" {
+		request.queue = worker.Queue
+	}
+	call.SetData(machineryProcessData{
+		ctx:     machineryConsumerInstrumenter.Start(context.Background(), request),
+		request: request,
+	})
+}
+
+//go:linkname afterMachineryV2Process github.com/RichardKnop/machinery/v2.afterMachineryV2Process
+func afterMachineryV2Process(call api.CallContext, err error) {
+	data, ok := call.GetData().(machineryProcessData)
+	if !ok {
+		return
+	}
+	machineryConsumerInstrumenter.End(data.ctx, data.request, nil, err)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineryv2
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	machineryTaskNameKey   = attribute.Key("messaging.machinery.task.name")
+	machineryRetryCountKey = attribute.Key("messaging.machinery.task.retry_count")
+)
+
+var machineryEnabler = machineryInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_MACHINERY_ENABLED") != "false"}
+
+var (
+	machineryProducerInstrumenter = buildMachineryProducerInstrumenter()
+	machineryConsumerInstrumenter = buildMachineryConsumerInstrumenter()
+)
+
+type machineryInnerEnabler struct {
+	enabled bool
+}
+
+func (m machineryInnerEnabler) Enable() bool {
+	return m.enabled
+}
+
+// machineryHeadersCarrier carries the trace context in the headers of a task
+// signature, a header which is not a string is ignored
+type machineryHeadersCarrier struct {
+	headers map[string]interface{}
+}
+
+func (carrier machineryHeadersCarrier) Get(key string) string {
+	value, _ := carrier.headers[key].(string)
+	return value
+}
+
+func (carrier machineryHeadersCarrier) Set(key, value string) {
+	carrier.headers[key] = value
+}
+
+func (carrier machineryHeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.headers))
+	for key := range carrier.headers {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+type machinerySpanStatusExtractor struct{}
+
+func (m *machinerySpanStatusExtractor) Extract(span trace.Span, request machineryTaskReq, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type machineryAttrsGetter struct{}
+
+func (getter machineryAttrsGetter) GetSystem(request machineryTaskReq) string {
+	return "machinery"
+}
+
+func (getter machineryAttrsGetter) GetDestination(request machineryTaskReq) string {
+	return request.queue
+}
+
+func (getter machineryAttrsGetter) GetDestinationTemplate(request machineryTaskReq) string {
+	return ""
+}
+
+func (getter machineryAttrsGetter) IsTemporaryDestination(request machineryTaskReq) bool {
+	return false
+}
+
+func (getter machineryAttrsGetter) IsAnonymousDestination(request machineryTaskReq) bool {
+	return false
+}
+
+func (getter machineryAttrsGetter) GetConversationId(request machineryTaskReq) string {
+	return ""
+}
+
+func (getter machineryAttrsGetter) GetMessageBodySize(request machineryTaskReq) int64 {
+	return 0
+}
+
+func (getter machineryAttrsGetter) GetMessageEnvelopSize(request machineryTaskReq) int64 {
+	return 0
+}
+
+func (getter machineryAttrsGetter) GetMessageId(request machineryTaskReq, response any) string {
+	return request.uuid
+}
+
+func (getter machineryAttrsGetter) GetClientId(request machineryTaskReq) string {
+	return ""
+}
+
+func (getter machineryAttrsGetter) GetBatchMessageCount(request machineryTaskReq, response any) int64 {
+	return 1
+}
+
+func (getter machineryAttrsGetter) GetMessageHeader(request machineryTaskReq, name string) []string {
+	if value, ok := request.headers[name]; ok {
+		return []string{fmt.Sprint(value)}
+	}
+	return nil
+}
+
+func (getter machineryAttrsGetter) GetDestinationPartitionId(request machineryTaskReq) string {
+	return ""
+}
+
+// machineryAttrsExtractor records the name of a task and how many times it
+// is left to be retried
+type machineryAttrsExtractor struct{}
+
+func (extractor *machineryAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request machineryTaskReq) ([]attribute.KeyValue, context.Context) {
+	return append(attributes,
+		machineryTaskNameKey.String(request.name),
+		machineryRetryCountKey.Int(request.retryCount),
+	), parentContext
+}
+
+func (extractor *machineryAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request machineryTaskReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func machineryCarrier(request machineryTaskReq) propagation.TextMapCarrier {
+	return machineryHeadersCarrier{headers: request.headers}
+}
+
+func buildMachineryProducerInstrumenter() instrumenter.Instrumenter[machineryTaskReq, any] {
+	builder := instrumenter.Builder[machineryTaskReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.MACHINERY_V2_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[machineryTaskReq, any]{
+			Getter:        machineryAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[machineryTaskReq]{}).
+		SetSpanStatusExtractor(&machinerySpanStatusExtractor{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[machineryTaskReq, any, machineryAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&machineryAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(machineryCarrier, otel.GetTextMapPropagator())
+}
+
+func buildMachineryConsumerInstrumenter() instrumenter.Instrumenter[machineryTaskReq, any] {
+	builder := instrumenter.Builder[machineryTaskReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.MACHINERY_V2_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[machineryTaskReq, any]{
+			Getter:        machineryAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[machineryTaskReq]{}).
+		SetSpanStatusExtractor(&machinerySpanStatusExtractor{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[machineryTaskReq, any, machineryAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&machineryAttrsExtractor{}).
+		BuildPropagatingFromUpstreamInstrumenter(machineryCarrier, otel.GetTextMapPropagator())
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineryv2
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/RichardKnop/machinery/v2"
+	"github.com/RichardKnop/machinery/v2/backends/result"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+type machinerySendData struct {
+	ctx     context.Context
+	request machineryTaskReq
+}
+
+// beforeMachineryV2SendTask also covers SendTask and the first task of a chain,
+// the tasks of a group are published without it. A task without routing key
+// is sent to the default queue of the server
+//
+//go:linkname beforeMachineryV2SendTask github.com/RichardKnop/machinery/v2.beforeMachineryV2SendTask
+func beforeMachineryV2SendTask(call api.CallContext, server *machinery.Server, ctx context.Context, signature *tasks.Signature) {
+	if !machineryEnabler.Enable() || server == nil || signature == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+	request := machineryTaskReq{
+		name:       signature.Name,
+		uuid:       signature.UUID,
+		queue:      signature.RoutingKey,
+		retryCount: signature.RetryCount,
+		headers:    signature.Headers,
+	}
+	if request.queue == "" {
+		request.queue = server.GetConfig().DefaultQueue
+	}
+	call.SetData(machinerySendData{
+		ctx:     machineryProducerInstrumenter.Start(ctx, request),
+		request: request,
+	})
+}
+
+//go:linkname afterMachineryV2SendTask github.com/RichardKnop/machinery/v2.afterMachineryV2SendTask
+func afterMachineryV2SendTask(call api.CallContext, _ *result.AsyncResult, err error) {
+	data, ok := call.GetData().(machinerySendData)
+	if !ok {
+		return
+	}
+	machineryProducerInstrumenter.End(data.ctx, data.request, nil, err)
+}
@@ -40,6 +40,24 @@ func (m mongoInnerEnabler) Enable() bool {
 
 var mongoEnabler = mongoInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_MONGO_ENABLED") != "false"}
 
+type mongoCommand struct {
+	ctx     context.Context
+	request mongoRequest
+}
+
+// mongoCollection returns the collection the command operates on, which is
+// the value of the command name for most of the commands
+func mongoCollection(startedEvent *event.CommandStartedEvent) string {
+	key := startedEvent.CommandName
+	if key == "getMore" {
+		key = "collection"
+	}
+	if collection, ok := startedEvent.Command.Lookup(key).StringValueOK(); ok {
+		return collection
+	}
+	return ""
+}
+
 //go:linkname mongoOnEnter go.mongodb.org/mongo-driver/mongo.mongoOnEnter
 func mongoOnEnter(call api.CallContext, opts ...*options.ClientOptions) {
 	if !mongoEnabler.Enable() {
@@ -53,13 +71,12 @@ func mongoOnEnter(call api.CallContext, opts ...*options.ClientOptions) {
 			continue
 		}
 		configuredMonitor := opt.Monitor
-		var host string
 		opt.Monitor = &event.CommandMonitor{
 			Started: func(ctx context.Context, startedEvent *event.CommandStartedEvent) {
 				if configuredMonitor != nil {
 					configuredMonitor.Started(ctx, startedEvent)
 				}
-				host = hosts[0]
+				host := hosts[0]
 				if hostLength > 1 {
 					if infoSplit := strings.Index(startedEvent.ConnectionID, "["); infoSplit > 0 && strings.HasSuffix(startedEvent.ConnectionID, "]") {
 						host = startedEvent.ConnectionID[0:infoSplit]
@@ -68,21 +85,19 @@ func mongoOnEnter(call api.CallContext, opts ...*options.ClientOptions) {
 				mongoRequest := mongoRequest{
 					CommandName: startedEvent.CommandName,
 					Host:        host,
+					DbName:      startedEvent.DatabaseName,
+					Collection:  mongoCollection(startedEvent),
 				}
 				newCtx := mongoInstrumenter.Start(ctx, mongoRequest)
-				syncMap.Store(fmt.Sprintf("%d", startedEvent.RequestID), newCtx)
+				syncMap.Store(fmt.Sprintf("%d", startedEvent.RequestID), mongoCommand{ctx: newCtx, request: mongoRequest})
 			},
 			Succeeded: func(ctx context.Context, succeededEvent *event.CommandSucceededEvent) {
 				if configuredMonitor != nil {
 					configuredMonitor.Succeeded(ctx, succeededEvent)
 				}
-				if newCtx, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", succeededEvent.RequestID)); ok && newCtx != nil {
-					newContext, ok := newCtx.(context.Context)
-					if ok {
-						mongoInstrumenter.End(newContext, mongoRequest{
-							CommandName: succeededEvent.CommandName,
-							Host:        host,
-						}, nil, nil)
+				if command, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", succeededEvent.RequestID)); ok && command != nil {
+					if command, ok := command.(mongoCommand); ok {
+						mongoInstrumenter.End(command.ctx, command.request, nil, nil)
 					}
 				}
 			},
@@ -90,13 +105,9 @@ func mongoOnEnter(call api.CallContext, opts ...*options.ClientOptions) {
 				if configuredMonitor != nil {
 					configuredMonitor.Failed(ctx, failedEvent)
 				}
-				if newCtx, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", failedEvent.RequestID)); ok && newCtx != nil {
-					newContext, ok := newCtx.(context.Context)
-					if ok {
-						mongoInstrumenter.End(newContext, mongoRequest{
-							CommandName: failedEvent.CommandName,
-							Host:        host,
-						}, nil, errors.New(failedEvent.Failure))
+				if command, ok := syncMap.LoadAndDelete(fmt.Sprintf("%d", failedEvent.RequestID)); ok && command != nil {
+					if command, ok := command.(mongoCommand); ok {
+						mongoInstrumenter.End(command.ctx, command.request, nil, errors.New(failedEvent.Failure))
 					}
 				}
 			},
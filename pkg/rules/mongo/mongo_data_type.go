@@ -17,4 +17,6 @@ package mongo
 type mongoRequest struct {
 	CommandName string
 	Host        string
+	DbName      string
+	Collection  string
 }
@@ -15,11 +15,15 @@
 package mongo
 
 import (
+	"context"
+
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -39,8 +43,7 @@ func (m mongoAttrsGetter) GetStatement(request mongoRequest) string {
 }
 
 func (m mongoAttrsGetter) GetCollection(request mongoRequest) string {
-	// TBD: We need to implement retrieving the collection later.
-	return ""
+	return request.Collection
 }
 
 func (m mongoAttrsGetter) GetOperation(request mongoRequest) string {
@@ -56,7 +59,7 @@ func (m mongoAttrsGetter) GetBatchSize(request mongoRequest) int {
 }
 
 func (m mongoAttrsGetter) GetDbNamespace(request mongoRequest) string {
-	return ""
+	return request.DbName
 }
 
 type mongoSpanNameExtractor struct {
@@ -70,7 +73,26 @@ func (m *mongoSpanKindExtractor) Extract(request mongoRequest) trace.SpanKind {
 }
 
 func (m *mongoSpanNameExtractor) Extract(request mongoRequest) string {
-	return request.CommandName
+	return mongoSummary(request)
+}
+
+// mongoSummary summarizes the command as "{command} {collection}", the
+// command documents themselves are never recorded
+func mongoSummary(request mongoRequest) string {
+	if request.Collection == "" {
+		return request.CommandName
+	}
+	return request.CommandName + " " + request.Collection
+}
+
+type mongoSummaryAttrsExtractor struct{}
+
+func (m mongoSummaryAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request mongoRequest) ([]attribute.KeyValue, context.Context) {
+	return append(attributes, semconv.DBQuerySummary(mongoSummary(request))), parentContext
+}
+
+func (m mongoSummaryAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request mongoRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
 }
 
 func BuildMongoOtelInstrumenter() instrumenter.Instrumenter[mongoRequest, interface{}] {
@@ -82,6 +104,6 @@ func BuildMongoOtelInstrumenter() instrumenter.Instrumenter[mongoRequest, interf
 			Name:    utils.MONGO_SCOPE_NAME,
 			Version: version.Tag,
 		}).
-		AddAttributesExtractor(&db.DbClientAttrsExtractor[mongoRequest, any, db.DbClientAttrsGetter[mongoRequest]]{Base: db.DbClientCommonAttrsExtractor[mongoRequest, any, db.DbClientAttrsGetter[mongoRequest]]{Getter: mongoAttrsGetter{}}}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[mongoRequest, any, db.DbClientAttrsGetter[mongoRequest]]{Base: db.DbClientCommonAttrsExtractor[mongoRequest, any, db.DbClientAttrsGetter[mongoRequest]]{Getter: mongoAttrsGetter{}}}, mongoSummaryAttrsExtractor{}).
 		BuildInstrumenter()
 }
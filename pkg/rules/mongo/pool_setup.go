@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongo
+
+import (
+	"context"
+	"strings"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+)
+
+type mongoCheckOut struct {
+	ctx   context.Context
+	start time.Time
+}
+
+//go:linkname mongoPoolCheckOutOnEnter go.mongodb.org/mongo-driver/x/mongo/driver/topology.mongoPoolCheckOutOnEnter
+func mongoPoolCheckOutOnEnter(call api.CallContext, p interface{}, ctx context.Context) {
+	if !mongoEnabler.Enable() {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	call.SetData(mongoCheckOut{ctx: ctx, start: time.Now()})
+}
+
+//go:linkname mongoPoolCheckOutOnExit go.mongodb.org/mongo-driver/x/mongo/driver/topology.mongoPoolCheckOutOnExit
+func mongoPoolCheckOutOnExit(call api.CallContext, conn interface{}, err error) {
+	if !mongoEnabler.Enable() || err != nil {
+		return
+	}
+	checkOut, ok := call.GetData().(mongoCheckOut)
+	if !ok {
+		return
+	}
+	// The pool is named after its server, whose address prefixes the
+	// connection id as in "localhost:27017[-1]"
+	c, ok := conn.(interface{ ID() string })
+	if !ok {
+		return
+	}
+	poolName, _, _ := strings.Cut(c.ID(), "[")
+	db.RecordDbClientConnectionWaitTime(checkOut.ctx, "mongodb", poolName, time.Since(checkOut.start))
+}
@@ -17,8 +17,10 @@ package mux
 import (
 	"net/http"
 	"os"
+	"strings"
 	_ "unsafe"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
@@ -35,21 +37,61 @@ func (m muxInnerEnabler) Enable() bool {
 
 var muxEnabler = muxInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_MUX_ENABLED") != "false"}
 
+// Route variables captured as attributes of the server span, either "true" for
+// all of them or a comma-separated list of their names, e.g. "id,country".
+// Nothing is captured by default as variables may be of high cardinality or
+// sensitive.
+var muxCapturedVars = parseCapturedVars(os.Getenv("OTEL_INSTRUMENTATION_MUX_CAPTURE_VARS"))
+
+// Prefix of attributes of captured route variables, e.g. http.route.param.id
+const muxVarAttrPrefix = "http.route.param."
+
+func parseCapturedVars(value string) map[string]bool {
+	if value == "" || value == "false" {
+		return nil
+	}
+	captured := make(map[string]bool)
+	if value == "true" {
+		return captured
+	}
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			captured[name] = true
+		}
+	}
+	return captured
+}
+
+// nameByRoute names the server span by the template of the matched route
+// rather than the raw path, and captures the route variables if configured
+func nameByRoute(req *http.Request, route *mux.Route) {
+	lcs := trace.LocalRootSpanFromGLS()
+	if lcs == nil || route == nil {
+		return
+	}
+	tmpl, err := route.GetPathTemplate()
+	if err == nil && req.URL != nil && tmpl != req.URL.Path {
+		lcs.SetName(tmpl)
+	}
+	if muxCapturedVars == nil {
+		return
+	}
+	// Variables are set to the request before the route
+	for name, value := range mux.Vars(req) {
+		if len(muxCapturedVars) == 0 || muxCapturedVars[name] {
+			lcs.SetAttributes(attribute.String(muxVarAttrPrefix+name, value))
+		}
+	}
+}
+
 //go:linkname muxRoute130OnEnter github.com/gorilla/mux.muxRoute130OnEnter
 func muxRoute130OnEnter(call api.CallContext, req *http.Request, route interface{}) {
 	if !muxEnabler.Enable() {
 		return
 	}
 	if req != nil {
-		lcs := trace.LocalRootSpanFromGLS()
-		if lcs != nil && route != nil {
-			r, ok := route.(*mux.Route)
-			if ok {
-				tmpl, err := r.GetPathTemplate()
-				if err == nil && req.URL != nil && tmpl != req.URL.Path {
-					lcs.SetName(tmpl)
-				}
-			}
+		if r, ok := route.(*mux.Route); ok {
+			nameByRoute(req, r)
 		}
 	}
 }
@@ -62,12 +104,6 @@ func muxRoute174OnEnter(call api.CallContext, req *http.Request, route *mux.Rout
 		return
 	}
 	if req != nil {
-		lcs := trace.LocalRootSpanFromGLS()
-		if lcs != nil && route != nil {
-			tmpl, err := route.GetPathTemplate()
-			if err == nil && req.URL != nil && tmpl != req.URL.Path {
-				lcs.SetName(tmpl)
-			}
-		}
+		nameByRoute(req, route)
 	}
 }
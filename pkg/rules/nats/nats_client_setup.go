@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"strings"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/nats-io/nats.go"
+)
+
+type natsRequestData struct {
+	ctx     context.Context
+	request natsRequestReq
+}
+
+// natsStartRequest starts the span of a request sent by Request, RequestMsg
+// or their variants taking a context, the span ends once the reply arrives,
+// the headers of the request carrying the trace context are returned
+func natsStartRequest(call api.CallContext, nc *nats.Conn, ctx context.Context, subj string, hdr, data []byte) ([]byte, bool) {
+	if !natsEnabler.Enable() || nc == nil || ctx == nil || subj == "" || strings.HasPrefix(subj, natsJetStreamPrefix) {
+		return nil, false
+	}
+	if natsNested(ctx) {
+		return nil, false
+	}
+	header, err := natsDecodeHeader(hdr)
+	if err != nil {
+		return nil, false
+	}
+	request := natsRequestReq{subject: subj, header: header, size: len(data), address: natsServerAddress(nc)}
+	ctx = natsClientInstrumenter.Start(ctx, request)
+	call.SetData(natsRequestData{ctx: ctx, request: request})
+	if !nc.HeadersSupported() {
+		return nil, false
+	}
+	encoded, err := natsEncodeHeader(header)
+	if err != nil {
+		return nil, false
+	}
+	return encoded, true
+}
+
+//go:linkname beforeNatsRequest github.com/nats-io/nats.go.beforeNatsRequest
+func beforeNatsRequest(call api.CallContext, nc *nats.Conn, subj string, hdr, data []byte, timeout time.Duration) {
+	if encoded, ok := natsStartRequest(call, nc, context.Background(), subj, hdr, data); ok {
+		call.SetParam(2, encoded)
+	}
+}
+
+//go:linkname beforeNatsRequestWithContext github.com/nats-io/nats.go.beforeNatsRequestWithContext
+func beforeNatsRequestWithContext(call api.CallContext, nc *nats.Conn, ctx context.Context, subj string, hdr, data []byte) {
+	if encoded, ok := natsStartRequest(call, nc, ctx, subj, hdr, data); ok {
+		call.SetParam(3, encoded)
+	}
+}
+
+func natsEndRequest(call api.CallContext, m *nats.Msg, err error) {
+	data, ok := call.GetData().(natsRequestData)
+	if !ok {
+		return
+	}
+	natsClientInstrumenter.End(data.ctx, data.request, m, err)
+}
+
+//go:linkname afterNatsRequest github.com/nats-io/nats.go.afterNatsRequest
+func afterNatsRequest(call api.CallContext, m *nats.Msg, err error) {
+	natsEndRequest(call, m, err)
+}
+
+//go:linkname afterNatsRequestWithContext github.com/nats-io/nats.go.afterNatsRequestWithContext
+func afterNatsRequestWithContext(call api.CallContext, m *nats.Msg, err error) {
+	natsEndRequest(call, m, err)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/nats-io/nats.go"
+)
+
+// natsTracedHandler runs the callback of a subscription within the span of
+// the message it handles, which covers Subscribe, QueueSubscribe and the
+// callbacks consuming JetStream
+func natsTracedHandler(cb nats.MsgHandler) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		if !natsEnabler.Enable() || msg == nil || natsIsStatus(msg) {
+			cb(msg)
+			return
+		}
+		if natsIsRequest(msg) {
+			natsServe(msg, cb)
+			return
+		}
+		request := natsConsumerReq{msg: msg}
+		ctx := natsProcessInstrumenter.Start(context.Background(), request)
+		cb(msg)
+		natsProcessInstrumenter.End(ctx, request, nil, nil)
+	}
+}
+
+// beforeNatsSubscribe only traces the subscriptions having a callback, the
+// messages of a channel are handed over to the application as is
+//
+//go:linkname beforeNatsSubscribe github.com/nats-io/nats.go.beforeNatsSubscribe
+func beforeNatsSubscribe(call api.CallContext, nc *nats.Conn, subj, queue string, cb nats.MsgHandler, ch chan *nats.Msg, isSync bool, js interface{}) {
+	if cb != nil {
+		call.SetParam(3, natsTracedHandler(cb))
+	}
+}
+
+// beforeNatsSubscribe138 is for v1.38.0 and later, which report the errors
+// of a subscription on a channel
+//
+//go:linkname beforeNatsSubscribe138 github.com/nats-io/nats.go.beforeNatsSubscribe138
+func beforeNatsSubscribe138(call api.CallContext, nc *nats.Conn, subj, queue string, cb nats.MsgHandler, ch chan *nats.Msg, errCh chan error, isSync bool, js interface{}) {
+	if cb != nil {
+		call.SetParam(3, natsTracedHandler(cb))
+	}
+}
+
+type natsReceiveData struct {
+	ctx            context.Context
+	startTimestamp time.Time
+}
+
+func natsStartReceive(call api.CallContext, ctx context.Context) {
+	if !natsEnabler.Enable() || natsNested(ctx) {
+		return
+	}
+	call.SetData(natsReceiveData{ctx: ctx, startTimestamp: time.Now()})
+}
+
+// beforeNatsNextMsg skips the replies a request waits for on a synchronous
+// subscription, they are part of the span of the request
+//
+//go:linkname beforeNatsNextMsg github.com/nats-io/nats.go.beforeNatsNextMsg
+func beforeNatsNextMsg(call api.CallContext, s *nats.Subscription, timeout time.Duration) {
+	natsStartReceive(call, context.Background())
+}
+
+//go:linkname beforeNatsNextMsgWithContext github.com/nats-io/nats.go.beforeNatsNextMsgWithContext
+func beforeNatsNextMsgWithContext(call api.CallContext, s *nats.Subscription, ctx context.Context) {
+	if ctx == nil {
+		return
+	}
+	natsStartReceive(call, ctx)
+}
+
+// natsEndReceive records the message received, a poll receiving nothing,
+// such as one timing out, is not recorded
+func natsEndReceive(call api.CallContext, msg *nats.Msg, err error) {
+	data, ok := call.GetData().(natsReceiveData)
+	if !ok || msg == nil || natsIsStatus(msg) {
+		return
+	}
+	request := natsConsumerReq{msg: msg}
+	natsReceiveInstrumenter.StartAndEnd(data.ctx, request, nil, err, data.startTimestamp, time.Now())
+}
+
+//go:linkname afterNatsNextMsg github.com/nats-io/nats.go.afterNatsNextMsg
+func afterNatsNextMsg(call api.CallContext, msg *nats.Msg, err error) {
+	natsEndReceive(call, msg, err)
+}
+
+//go:linkname afterNatsNextMsgWithContext github.com/nats-io/nats.go.afterNatsNextMsgWithContext
+func afterNatsNextMsgWithContext(call api.CallContext, msg *nats.Msg, err error) {
+	natsEndReceive(call, msg, err)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import "github.com/nats-io/nats.go"
+
+type natsPublishReq struct {
+	subject string
+	reply   string
+	header  nats.Header
+	size    int
+}
+
+// natsPublishRes is the ack of a message published to JetStream
+type natsPublishRes struct {
+	sequence uint64
+}
+
+type natsConsumerReq struct {
+	msg *nats.Msg
+}
+
+// natsRequestReq is a request awaiting a reply, seen from the requester or
+// from the subscriber replying to it
+type natsRequestReq struct {
+	subject string
+	header  nats.Header
+	size    int
+	address string
+	queue   string
+}
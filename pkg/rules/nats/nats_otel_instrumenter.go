@@ -0,0 +1,428 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var natsEnabler = natsInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_NATS_ENABLED") != "false"}
+
+var (
+	natsProducerInstrumenter = buildNatsProducerInstrumenter()
+	natsProcessInstrumenter  = buildNatsConsumerInstrumenter(message.PROCESS)
+	natsReceiveInstrumenter  = buildNatsConsumerInstrumenter(message.RECEIVE)
+	natsClientInstrumenter   = buildNatsClientInstrumenter()
+	natsServerInstrumenter   = buildNatsServerInstrumenter()
+)
+
+type natsInnerEnabler struct {
+	enabled bool
+}
+
+func (n natsInnerEnabler) Enable() bool {
+	return n.enabled
+}
+
+const (
+	natsHeaderLine   = "NATS/1.0\r\n"
+	natsStatusHeader = "Status"
+	natsInboxPrefix  = "_INBOX."
+	// natsJetStreamPrefix prefixes the subjects of the JetStream API, the acks
+	// and the flow control, which are not traced
+	natsJetStreamPrefix = "$JS."
+)
+
+// natsHeaderCarrier injects the trace context into the headers of a message
+// and extracts it from them, the keys of nats headers are case-sensitive
+type natsHeaderCarrier struct {
+	header nats.Header
+}
+
+func (carrier natsHeaderCarrier) Get(key string) string {
+	return carrier.header.Get(key)
+}
+
+func (carrier natsHeaderCarrier) Set(key, value string) {
+	if carrier.header != nil {
+		carrier.header.Set(key, value)
+	}
+}
+
+func (carrier natsHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.header))
+	for key := range carrier.header {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// natsDecodeHeader decodes the headers a message is published with, the
+// headers are kept encoded once the message is handed to the connection
+func natsDecodeHeader(hdr []byte) (nats.Header, error) {
+	if len(hdr) == 0 {
+		return nats.Header{}, nil
+	}
+	return nats.DecodeHeadersMsg(hdr)
+}
+
+// natsEncodeHeader encodes the headers the same way nats.Msg does
+func natsEncodeHeader(header nats.Header) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(natsHeaderLine)
+	if err := http.Header(header).Write(&b); err != nil {
+		return nil, err
+	}
+	b.WriteString("\r\n")
+	return b.Bytes(), nil
+}
+
+// natsIsStatus reports whether the message is a status sent by the server,
+// such as a heartbeat or the lack of responders, rather than a message
+func natsIsStatus(msg *nats.Msg) bool {
+	return len(msg.Data) == 0 && msg.Header.Get(natsStatusHeader) != ""
+}
+
+// natsIsRequest reports whether the sender of the message awaits a reply,
+// the reply subject of a JetStream message is where it is acked
+func natsIsRequest(msg *nats.Msg) bool {
+	return msg.Reply != "" && !strings.HasPrefix(msg.Reply, natsJetStreamPrefix)
+}
+
+// natsNested reports whether a request or a JetStream publish is in
+// progress, the messages it sends are already traced by it
+func natsNested(ctx context.Context) bool {
+	return natsIsOutgoing(trace.SpanFromContext(ctx)) || natsIsOutgoing(trace.SpanFromContext(context.Background()))
+}
+
+func natsIsOutgoing(span trace.Span) bool {
+	s, ok := span.(sdktrace.ReadOnlySpan)
+	if !ok || !s.EndTime().IsZero() {
+		return false
+	}
+	name := s.InstrumentationScope().Name
+	return name == utils.NATS_PRODUCER_SCOPE_NAME || name == utils.NATS_CLIENT_SCOPE_NAME
+}
+
+func natsServerAddress(nc *nats.Conn) string {
+	host, _, err := net.SplitHostPort(nc.ConnectedAddr())
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+func natsQueue(msg *nats.Msg) string {
+	if msg.Sub == nil {
+		return ""
+	}
+	return msg.Sub.Queue
+}
+
+type natsSpanStatusExtractor[REQUEST any] struct{}
+
+func (n *natsSpanStatusExtractor[REQUEST]) Extract(span trace.Span, request REQUEST, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type natsProducerAttrsGetter struct{}
+
+func (getter natsProducerAttrsGetter) GetSystem(request natsPublishReq) string {
+	return "nats"
+}
+
+func (getter natsProducerAttrsGetter) GetDestination(request natsPublishReq) string {
+	return request.subject
+}
+
+func (getter natsProducerAttrsGetter) GetDestinationTemplate(request natsPublishReq) string {
+	return ""
+}
+
+// IsTemporaryDestination reports replies, which are published to the inbox
+// of the requester
+func (getter natsProducerAttrsGetter) IsTemporaryDestination(request natsPublishReq) bool {
+	return strings.HasPrefix(request.subject, natsInboxPrefix)
+}
+
+func (getter natsProducerAttrsGetter) IsAnonymousDestination(request natsPublishReq) bool {
+	return false
+}
+
+func (getter natsProducerAttrsGetter) GetConversationId(request natsPublishReq) string {
+	return ""
+}
+
+func (getter natsProducerAttrsGetter) GetMessageBodySize(request natsPublishReq) int64 {
+	return int64(request.size)
+}
+
+func (getter natsProducerAttrsGetter) GetMessageEnvelopSize(request natsPublishReq) int64 {
+	return 0
+}
+
+// GetMessageId returns the sequence of the message in its stream, which is
+// only known for a message published to JetStream
+func (getter natsProducerAttrsGetter) GetMessageId(request natsPublishReq, response any) string {
+	if res, ok := response.(natsPublishRes); ok {
+		return strconv.FormatUint(res.sequence, 10)
+	}
+	return ""
+}
+
+func (getter natsProducerAttrsGetter) GetClientId(request natsPublishReq) string {
+	return ""
+}
+
+func (getter natsProducerAttrsGetter) GetBatchMessageCount(request natsPublishReq, response any) int64 {
+	return 1
+}
+
+func (getter natsProducerAttrsGetter) GetMessageHeader(request natsPublishReq, name string) []string {
+	return request.header.Values(name)
+}
+
+func (getter natsProducerAttrsGetter) GetDestinationPartitionId(request natsPublishReq) string {
+	return ""
+}
+
+type natsConsumerAttrsGetter struct{}
+
+func (getter natsConsumerAttrsGetter) GetSystem(request natsConsumerReq) string {
+	return "nats"
+}
+
+func (getter natsConsumerAttrsGetter) GetDestination(request natsConsumerReq) string {
+	return request.msg.Subject
+}
+
+func (getter natsConsumerAttrsGetter) GetDestinationTemplate(request natsConsumerReq) string {
+	return ""
+}
+
+func (getter natsConsumerAttrsGetter) IsTemporaryDestination(request natsConsumerReq) bool {
+	return strings.HasPrefix(request.msg.Subject, natsInboxPrefix)
+}
+
+func (getter natsConsumerAttrsGetter) IsAnonymousDestination(request natsConsumerReq) bool {
+	return false
+}
+
+func (getter natsConsumerAttrsGetter) GetConversationId(request natsConsumerReq) string {
+	return ""
+}
+
+func (getter natsConsumerAttrsGetter) GetMessageBodySize(request natsConsumerReq) int64 {
+	return int64(len(request.msg.Data))
+}
+
+func (getter natsConsumerAttrsGetter) GetMessageEnvelopSize(request natsConsumerReq) int64 {
+	return 0
+}
+
+func (getter natsConsumerAttrsGetter) GetMessageId(request natsConsumerReq, response any) string {
+	return ""
+}
+
+func (getter natsConsumerAttrsGetter) GetClientId(request natsConsumerReq) string {
+	return ""
+}
+
+func (getter natsConsumerAttrsGetter) GetBatchMessageCount(request natsConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter natsConsumerAttrsGetter) GetMessageHeader(request natsConsumerReq, name string) []string {
+	return request.msg.Header.Values(name)
+}
+
+func (getter natsConsumerAttrsGetter) GetDestinationPartitionId(request natsConsumerReq) string {
+	return ""
+}
+
+// natsConsumerAttrsExtractor records the queue group of the subscription
+// consuming the message
+type natsConsumerAttrsExtractor struct{}
+
+func (extractor *natsConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request natsConsumerReq) ([]attribute.KeyValue, context.Context) {
+	if queue := natsQueue(request.msg); queue != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(queue))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *natsConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request natsConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// natsRequestAttrsGetter models a request and its reply as a call, whose
+// service is the subject the request is sent to
+type natsRequestAttrsGetter struct{}
+
+func (getter natsRequestAttrsGetter) GetSystem(request natsRequestReq) string {
+	return "nats"
+}
+
+func (getter natsRequestAttrsGetter) GetService(request natsRequestReq) string {
+	return request.subject
+}
+
+func (getter natsRequestAttrsGetter) GetMethod(request natsRequestReq) string {
+	return "request"
+}
+
+func (getter natsRequestAttrsGetter) GetServerAddress(request natsRequestReq) string {
+	return request.address
+}
+
+type natsRequestSpanNameExtractor struct{}
+
+func (extractor *natsRequestSpanNameExtractor) Extract(request natsRequestReq) string {
+	return request.subject + " request"
+}
+
+// natsRequestAttrsExtractor records the messaging attributes of a request,
+// both ends of the call are recorded as nats messaging as well
+type natsRequestAttrsExtractor struct{}
+
+func (extractor *natsRequestAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request natsRequestReq) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		semconv.MessagingSystemKey.String("nats"),
+		semconv.MessagingDestinationName(request.subject),
+		semconv.MessagingMessageBodySize(request.size),
+	)
+	if request.queue != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.queue))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *natsRequestAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request natsRequestReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func buildNatsProducerInstrumenter() instrumenter.Instrumenter[natsPublishReq, any] {
+	builder := instrumenter.Builder[natsPublishReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[natsPublishReq, any]{
+			Getter:        natsProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[natsPublishReq]{}).
+		SetSpanStatusExtractor(&natsSpanStatusExtractor[natsPublishReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[natsPublishReq, any, natsProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request natsPublishReq) propagation.TextMapCarrier {
+				return natsHeaderCarrier{header: request.header}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+// a message is processed by the callback of a subscription, or received by
+// polling a synchronous subscription
+func buildNatsConsumerInstrumenter(operation message.MessageOperation) instrumenter.Instrumenter[natsConsumerReq, any] {
+	builder := instrumenter.Builder[natsConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[natsConsumerReq, any]{
+			Getter:        natsConsumerAttrsGetter{},
+			OperationName: operation,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[natsConsumerReq]{}).
+		SetSpanStatusExtractor(&natsSpanStatusExtractor[natsConsumerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[natsConsumerReq, any, natsConsumerAttrsGetter]{
+			Operation: operation,
+		}).
+		AddAttributesExtractor(&natsConsumerAttrsExtractor{}).
+		BuildPropagatingFromUpstreamInstrumenter(
+			func(request natsConsumerReq) propagation.TextMapCarrier {
+				return natsHeaderCarrier{header: request.msg.Header}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func buildNatsClientInstrumenter() instrumenter.Instrumenter[natsRequestReq, any] {
+	builder := instrumenter.Builder[natsRequestReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_CLIENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&natsRequestSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[natsRequestReq]{}).
+		SetSpanStatusExtractor(&natsSpanStatusExtractor[natsRequestReq]{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[natsRequestReq, any, natsRequestAttrsGetter]{}).
+		AddAttributesExtractor(&natsRequestAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request natsRequestReq) propagation.TextMapCarrier {
+				return natsHeaderCarrier{header: request.header}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func buildNatsServerInstrumenter() instrumenter.Instrumenter[natsRequestReq, any] {
+	builder := instrumenter.Builder[natsRequestReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NATS_SERVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&natsRequestSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[natsRequestReq]{}).
+		SetSpanStatusExtractor(&natsSpanStatusExtractor[natsRequestReq]{}).
+		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[natsRequestReq, any, natsRequestAttrsGetter]{}).
+		AddAttributesExtractor(&natsRequestAttrsExtractor{}).
+		BuildPropagatingFromUpstreamInstrumenter(
+			func(request natsRequestReq) propagation.TextMapCarrier {
+				return natsHeaderCarrier{header: request.header}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
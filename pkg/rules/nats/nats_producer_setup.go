@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type natsPublishData struct {
+	ctx     context.Context
+	request natsPublishReq
+}
+
+// natsStartPublish starts the span of a message published by Publish,
+// PublishMsg, PublishRequest or a reply, the headers of the message carrying
+// the trace context are returned
+func natsStartPublish(call api.CallContext, nc *nats.Conn, subj, reply string, hdr, data []byte) ([]byte, bool) {
+	if !natsEnabler.Enable() || nc == nil || subj == "" || strings.HasPrefix(subj, natsJetStreamPrefix) {
+		return nil, false
+	}
+	if natsNested(context.Background()) {
+		return nil, false
+	}
+	header, err := natsDecodeHeader(hdr)
+	if err != nil {
+		return nil, false
+	}
+	request := natsPublishReq{subject: subj, reply: reply, header: header, size: len(data)}
+	ctx := natsProducerInstrumenter.Start(context.Background(), request)
+	call.SetData(natsPublishData{ctx: ctx, request: request})
+	// a server not supporting headers rejects any message having some
+	if !nc.HeadersSupported() {
+		return nil, false
+	}
+	encoded, err := natsEncodeHeader(header)
+	if err != nil {
+		return nil, false
+	}
+	return encoded, true
+}
+
+//go:linkname beforeNatsPublish github.com/nats-io/nats.go.beforeNatsPublish
+func beforeNatsPublish(call api.CallContext, nc *nats.Conn, subj, reply string, hdr, data []byte) {
+	if encoded, ok := natsStartPublish(call, nc, subj, reply, hdr, data); ok {
+		call.SetParam(3, encoded)
+	}
+}
+
+// beforeNatsPublish148 is for v1.48.0 and later, which validate the reply
+// subject of a message published by the application
+//
+//go:linkname beforeNatsPublish148 github.com/nats-io/nats.go.beforeNatsPublish148
+func beforeNatsPublish148(call api.CallContext, nc *nats.Conn, subj, reply string, validateReply bool, hdr, data []byte) {
+	if encoded, ok := natsStartPublish(call, nc, subj, reply, hdr, data); ok {
+		call.SetParam(4, encoded)
+	}
+}
+
+func natsEndPublish(call api.CallContext, err error) {
+	data, ok := call.GetData().(natsPublishData)
+	if !ok {
+		return
+	}
+	natsProducerInstrumenter.End(data.ctx, data.request, nil, err)
+}
+
+//go:linkname afterNatsPublish github.com/nats-io/nats.go.afterNatsPublish
+func afterNatsPublish(call api.CallContext, err error) {
+	natsEndPublish(call, err)
+}
+
+//go:linkname afterNatsPublish148 github.com/nats-io/nats.go.afterNatsPublish148
+func afterNatsPublish148(call api.CallContext, err error) {
+	natsEndPublish(call, err)
+}
+
+// natsStartJetStreamPublish starts the span of a message published to
+// JetStream, which is sent as a request whose reply is the ack of the stream
+func natsStartJetStreamPublish(call api.CallContext, ctx context.Context, m *nats.Msg) context.Context {
+	if !natsEnabler.Enable() || m == nil || m.Subject == "" {
+		return nil
+	}
+	if m.Header == nil {
+		m.Header = nats.Header{}
+	}
+	request := natsPublishReq{subject: m.Subject, reply: m.Reply, header: m.Header, size: len(m.Data)}
+	ctx = natsProducerInstrumenter.Start(ctx, request)
+	call.SetData(natsPublishData{ctx: ctx, request: request})
+	return ctx
+}
+
+func natsEndJetStreamPublish(call api.CallContext, sequence uint64, err error) {
+	data, ok := call.GetData().(natsPublishData)
+	if !ok {
+		return
+	}
+	var response any
+	if err == nil {
+		response = natsPublishRes{sequence: sequence}
+	}
+	natsProducerInstrumenter.End(data.ctx, data.request, response, err)
+}
+
+//go:linkname beforeNatsJsPublishMsg github.com/nats-io/nats.go.beforeNatsJsPublishMsg
+func beforeNatsJsPublishMsg(call api.CallContext, js interface{}, m *nats.Msg, opts ...nats.PubOpt) {
+	natsStartJetStreamPublish(call, context.Background(), m)
+}
+
+//go:linkname afterNatsJsPublishMsg github.com/nats-io/nats.go.afterNatsJsPublishMsg
+func afterNatsJsPublishMsg(call api.CallContext, ack *nats.PubAck, err error) {
+	var sequence uint64
+	if ack != nil {
+		sequence = ack.Sequence
+	}
+	natsEndJetStreamPublish(call, sequence, err)
+}
+
+//go:linkname beforeNatsJetStreamPublishMsg github.com/nats-io/nats.go/jetstream.beforeNatsJetStreamPublishMsg
+func beforeNatsJetStreamPublishMsg(call api.CallContext, js interface{}, ctx context.Context, m *nats.Msg, opts ...jetstream.PublishOpt) {
+	if ctx == nil {
+		return
+	}
+	if ctx = natsStartJetStreamPublish(call, ctx, m); ctx != nil {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname afterNatsJetStreamPublishMsg github.com/nats-io/nats.go/jetstream.afterNatsJetStreamPublishMsg
+func afterNatsJetStreamPublishMsg(call api.CallContext, ack *jetstream.PubAck, err error) {
+	var sequence uint64
+	if ack != nil {
+		sequence = ack.Sequence
+	}
+	natsEndJetStreamPublish(call, sequence, err)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsServe handles a request within the span of the subscriber replying to
+// it, the reply is published as a child of that span
+func natsServe(msg *nats.Msg, cb nats.MsgHandler) {
+	request := natsRequestReq{subject: msg.Subject, header: msg.Header, size: len(msg.Data), queue: natsQueue(msg)}
+	ctx := natsServerInstrumenter.Start(context.Background(), request)
+	cb(msg)
+	natsServerInstrumenter.End(ctx, request, nil, nil)
+}
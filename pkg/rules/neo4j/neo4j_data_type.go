@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4j
+
+type neo4jRequest struct {
+	Operation  string
+	Statement  string
+	Database   string
+	Collection string
+	Addr       string
+	// Role of the cluster members the work is routed to, "read" or "write"
+	Routing string
+}
+
+// neo4jSession is what a session knows about where its work goes, kept from
+// the creation of the session to its close
+type neo4jSession struct {
+	database string
+	addr     string
+	routing  string
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4j
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const neo4jRoutingKey = attribute.Key("db.neo4j.routing")
+
+type neo4jAttrsGetter struct{}
+
+func (n neo4jAttrsGetter) GetSystem(_ neo4jRequest) string {
+	return "neo4j"
+}
+
+func (n neo4jAttrsGetter) GetServerAddress(request neo4jRequest) string {
+	return request.Addr
+}
+
+func (n neo4jAttrsGetter) GetStatement(request neo4jRequest) string {
+	return request.Statement
+}
+
+func (n neo4jAttrsGetter) GetCollection(request neo4jRequest) string {
+	return request.Collection
+}
+
+func (n neo4jAttrsGetter) GetOperation(request neo4jRequest) string {
+	return request.Operation
+}
+
+func (n neo4jAttrsGetter) GetParameters(_ neo4jRequest) []any {
+	// Statements are sanitized, the parameters are never recorded
+	return nil
+}
+
+func (n neo4jAttrsGetter) GetDbNamespace(request neo4jRequest) string {
+	return request.Database
+}
+
+func (n neo4jAttrsGetter) GetBatchSize(_ neo4jRequest) int {
+	return 0
+}
+
+type neo4jRoutingAttrsExtractor struct{}
+
+func (n neo4jRoutingAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request neo4jRequest) ([]attribute.KeyValue, context.Context) {
+	if request.Routing != "" {
+		attributes = append(attributes, neo4jRoutingKey.String(request.Routing))
+	}
+	return attributes, parentContext
+}
+
+func (n neo4jRoutingAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request neo4jRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildNeo4jInstrumenter() instrumenter.Instrumenter[neo4jRequest, interface{}] {
+	builder := instrumenter.Builder[neo4jRequest, interface{}]{}
+	getter := neo4jAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[neo4jRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[neo4jRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[neo4jRequest, any, neo4jAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[neo4jRequest, any, neo4jAttrsGetter]{Getter: getter}}, neo4jRoutingAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NEO4J_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
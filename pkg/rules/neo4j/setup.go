@@ -0,0 +1,224 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4j
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	neo4j "github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type neo4jInnerEnabler struct {
+	enabled bool
+}
+
+func (n neo4jInnerEnabler) Enable() bool {
+	return n.enabled
+}
+
+var neo4jEnabler = neo4jInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_NEO4J_ENABLED") != "false"}
+
+var neo4jInstrumenter = BuildNeo4jInstrumenter()
+
+// neo4jSessions holds what the open sessions know about their work, they are
+// keyed by the sessions returned to the application
+var neo4jSessions sync.Map
+
+// neo4jExecutions holds the requests of the running ExecuteRead and
+// ExecuteWrite calls keyed by their spans. The transaction function is called
+// on the goroutine of the execution, so that the statements it runs find the
+// execution by the span of the goroutine, whatever context they are given.
+var neo4jExecutions sync.Map
+
+var cypherLabelRegexp = regexp.MustCompile("\\(\\s*\\w*\\s*:\\s*`?(\\w+)")
+
+func neo4jOperation(stmt string) string {
+	if fields := strings.Fields(stmt); len(fields) > 0 {
+		return strings.ToUpper(strings.TrimSuffix(fields[0], ";"))
+	}
+	return ""
+}
+
+// neo4jCollection returns the label of the first node pattern of the
+// statement, e.g. Person for "MATCH (p:Person) RETURN p"
+func neo4jCollection(stmt string) string {
+	if match := cypherLabelRegexp.FindStringSubmatch(stmt); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+func neo4jStatementRequest(cypher string, session neo4jSession) neo4jRequest {
+	return neo4jRequest{
+		Operation:  neo4jOperation(cypher),
+		Statement:  db.SanitizeStatement(cypher),
+		Collection: neo4jCollection(cypher),
+		Database:   session.database,
+		Addr:       session.addr,
+		Routing:    session.routing,
+	}
+}
+
+func loadNeo4jSession(s interface{}) neo4jSession {
+	if session, ok := neo4jSessions.Load(s); ok {
+		return session.(neo4jSession)
+	}
+	return neo4jSession{}
+}
+
+func neo4jStart(call api.CallContext, ctx context.Context, request neo4jRequest) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = neo4jInstrumenter.Start(ctx, request)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+	return ctx
+}
+
+func neo4jEnd(call api.CallContext, err error) {
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(neo4jRequest)
+	if !ok {
+		return
+	}
+	neo4jInstrumenter.End(ctx, request, nil, err)
+}
+
+//go:linkname neo4jNewSessionOnEnter github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jNewSessionOnEnter
+func neo4jNewSessionOnEnter(call api.CallContext, d interface{}, ctx context.Context, config neo4j.SessionConfig) {
+	if !neo4jEnabler.Enable() {
+		return
+	}
+	session := neo4jSession{database: config.DatabaseName, routing: "write"}
+	if config.AccessMode == neo4j.AccessModeRead {
+		session.routing = "read"
+	}
+	if driver, ok := d.(neo4j.DriverWithContext); ok {
+		target := driver.Target()
+		session.addr = target.Host
+	}
+	call.SetData(session)
+}
+
+//go:linkname neo4jNewSessionOnExit github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jNewSessionOnExit
+func neo4jNewSessionOnExit(call api.CallContext, s neo4j.SessionWithContext) {
+	if session, ok := call.GetData().(neo4jSession); ok && s != nil {
+		neo4jSessions.Store(s, session)
+	}
+}
+
+//go:linkname neo4jSessionCloseOnEnter github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jSessionCloseOnEnter
+func neo4jSessionCloseOnEnter(call api.CallContext, s interface{}, ctx context.Context) {
+	neo4jSessions.Delete(s)
+}
+
+//go:linkname neo4jSessionRunOnEnter github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jSessionRunOnEnter
+func neo4jSessionRunOnEnter(call api.CallContext, s interface{}, ctx context.Context, cypher string, params map[string]any, configurers ...func(*neo4j.TransactionConfig)) {
+	if !neo4jEnabler.Enable() {
+		return
+	}
+	call.SetParam(1, neo4jStart(call, ctx, neo4jStatementRequest(cypher, loadNeo4jSession(s))))
+}
+
+//go:linkname neo4jSessionRunOnExit github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jSessionRunOnExit
+func neo4jSessionRunOnExit(call api.CallContext, result neo4j.ResultWithContext, err error) {
+	neo4jEnd(call, err)
+}
+
+func neo4jExecuteOnEnter(call api.CallContext, s interface{}, ctx context.Context, operation, routing string) {
+	session := loadNeo4jSession(s)
+	session.routing = routing
+	ctx = neo4jStart(call, ctx, neo4jRequest{
+		Operation: operation,
+		Database:  session.database,
+		Addr:      session.addr,
+		Routing:   routing,
+	})
+	neo4jExecutions.Store(trace.SpanFromContext(ctx), session)
+	call.SetParam(1, ctx)
+}
+
+//go:linkname neo4jExecuteReadOnEnter github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jExecuteReadOnEnter
+func neo4jExecuteReadOnEnter(call api.CallContext, s interface{}, ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) {
+	if !neo4jEnabler.Enable() {
+		return
+	}
+	neo4jExecuteOnEnter(call, s, ctx, "ExecuteRead", "read")
+}
+
+//go:linkname neo4jExecuteWriteOnEnter github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jExecuteWriteOnEnter
+func neo4jExecuteWriteOnEnter(call api.CallContext, s interface{}, ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) {
+	if !neo4jEnabler.Enable() {
+		return
+	}
+	neo4jExecuteOnEnter(call, s, ctx, "ExecuteWrite", "write")
+}
+
+//go:linkname neo4jExecuteOnExit github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jExecuteOnExit
+func neo4jExecuteOnExit(call api.CallContext, result any, err error) {
+	if data, ok := call.GetData().(map[string]interface{}); ok {
+		if ctx, ok := data["ctx"].(context.Context); ok {
+			neo4jExecutions.Delete(trace.SpanFromContext(ctx))
+		}
+	}
+	neo4jEnd(call, err)
+}
+
+// Statements of a transaction function are children of the execution, which
+// routes them, rather than of the context the application passes to them.
+//
+//go:linkname neo4jTxRunOnEnter github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jTxRunOnEnter
+func neo4jTxRunOnEnter(call api.CallContext, tx interface{}, ctx context.Context, cypher string, params map[string]any) {
+	if !neo4jEnabler.Enable() {
+		return
+	}
+	span := sdktrace.SpanFromGLS()
+	if span == nil {
+		return
+	}
+	execution, ok := neo4jExecutions.Load(span)
+	if !ok {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = neo4jStart(call, trace.ContextWithSpan(ctx, span), neo4jStatementRequest(cypher, execution.(neo4jSession)))
+	call.SetParam(1, ctx)
+}
+
+//go:linkname neo4jTxRunOnExit github.com/neo4j/neo4j-go-driver/v5/neo4j.neo4jTxRunOnExit
+func neo4jTxRunOnExit(call api.CallContext, result neo4j.ResultWithContext, err error) {
+	neo4jEnd(call, err)
+}
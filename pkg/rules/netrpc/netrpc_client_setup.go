@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netrpc
+
+import (
+	"context"
+	"net/rpc"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var netRpcClientInstrumenter = BuildNetRpcClientInstrumenter()
+
+// Both Call and Go send the call asynchronously, the reply is read by the
+// goroutine of the client, which marks the call as done. The span is detached
+// from the goroutine sending the call right away as it ends in the other one.
+//
+//go:linkname netRpcClientSendOnEnter net/rpc.netRpcClientSendOnEnter
+func netRpcClientSendOnEnter(call api.CallContext, client *rpc.Client, c *rpc.Call) {
+	if !netRpcEnabler.Enable() || c == nil {
+		return
+	}
+	ctx := netRpcClientInstrumenter.Start(context.Background(), netRpcRequest{
+		serviceMethod: c.ServiceMethod,
+	})
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(ctx))
+	c.OtelContext = ctx
+}
+
+//go:linkname netRpcCallDoneOnEnter net/rpc.netRpcCallDoneOnEnter
+func netRpcCallDoneOnEnter(call api.CallContext, c *rpc.Call) {
+	if c == nil {
+		return
+	}
+	ctx, ok := c.OtelContext.(context.Context)
+	if !ok {
+		return
+	}
+	c.OtelContext = nil
+	netRpcClientInstrumenter.End(ctx, netRpcRequest{
+		serviceMethod: c.ServiceMethod,
+	}, nil, c.Error)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netrpc
+
+import (
+	"context"
+)
+
+type netRpcRequest struct {
+	// serviceMethod is in the form of "Service.Method"
+	serviceMethod string
+}
+
+// netRpcServerCall is the state of a call served by the server, the error is
+// only known when the response is sent.
+type netRpcServerCall struct {
+	ctx     context.Context
+	request netRpcRequest
+	errmsg  string
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netrpc
+
+import (
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type netRpcInnerEnabler struct {
+	enabled bool
+}
+
+func (n netRpcInnerEnabler) Enable() bool {
+	return n.enabled
+}
+
+var netRpcEnabler = netRpcInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_NETRPC_ENABLED") != "false"}
+
+type netRpcAttrsGetter struct {
+}
+
+func (n netRpcAttrsGetter) GetSystem(request netRpcRequest) string {
+	return "net_rpc"
+}
+
+func (n netRpcAttrsGetter) GetService(request netRpcRequest) string {
+	dot := strings.LastIndex(request.serviceMethod, ".")
+	if dot < 0 {
+		return ""
+	}
+	return request.serviceMethod[:dot]
+}
+
+func (n netRpcAttrsGetter) GetMethod(request netRpcRequest) string {
+	return request.serviceMethod[strings.LastIndex(request.serviceMethod, ".")+1:]
+}
+
+func (n netRpcAttrsGetter) GetServerAddress(request netRpcRequest) string {
+	return ""
+}
+
+type netRpcSpanNameExtractor struct {
+}
+
+// Extract returns the name the method is registered with, e.g. "Arith.Multiply".
+func (n netRpcSpanNameExtractor) Extract(request netRpcRequest) string {
+	return request.serviceMethod
+}
+
+func BuildNetRpcClientInstrumenter() instrumenter.Instrumenter[netRpcRequest, any] {
+	builder := instrumenter.Builder[netRpcRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(netRpcSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[netRpcRequest]{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[netRpcRequest, any, netRpcAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NET_RPC_CLIENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func BuildNetRpcServerInstrumenter() instrumenter.Instrumenter[netRpcRequest, any] {
+	builder := instrumenter.Builder[netRpcRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(netRpcSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[netRpcRequest]{}).
+		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[netRpcRequest, any, netRpcAttrsGetter]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.NET_RPC_SERVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
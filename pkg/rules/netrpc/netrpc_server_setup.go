@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netrpc
+
+import (
+	"context"
+	"errors"
+	"net/rpc"
+	"reflect"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+var netRpcServerInstrumenter = BuildNetRpcServerInstrumenter()
+
+// netRpcServerCalls maps the requests being served to their calls, so that
+// the error sent back in the response is recorded on the span of the call.
+var netRpcServerCalls sync.Map
+
+// The requests read by ServeCodec are served by their own goroutines. The
+// protocol of net/rpc carries no metadata, so the span of the server starts
+// a trace of its own unless the goroutine is already traced.
+//
+//go:linkname netRpcServiceCallOnEnter net/rpc.netRpcServiceCallOnEnter
+func netRpcServiceCallOnEnter(call api.CallContext, s interface{}, server *rpc.Server, sending *sync.Mutex, wg *sync.WaitGroup, mtype interface{}, req *rpc.Request, argv, replyv reflect.Value, codec rpc.ServerCodec) {
+	if !netRpcEnabler.Enable() || req == nil {
+		return
+	}
+	request := netRpcRequest{
+		serviceMethod: req.ServiceMethod,
+	}
+	serverCall := &netRpcServerCall{
+		ctx:     netRpcServerInstrumenter.Start(context.Background(), request),
+		request: request,
+	}
+	netRpcServerCalls.Store(req, serverCall)
+	call.SetData(req)
+}
+
+//go:linkname netRpcServiceCallOnExit net/rpc.netRpcServiceCallOnExit
+func netRpcServiceCallOnExit(call api.CallContext) {
+	req, ok := call.GetData().(*rpc.Request)
+	if !ok {
+		return
+	}
+	value, ok := netRpcServerCalls.LoadAndDelete(req)
+	if !ok {
+		return
+	}
+	serverCall := value.(*netRpcServerCall)
+	var err error
+	if serverCall.errmsg != "" {
+		err = errors.New(serverCall.errmsg)
+	}
+	netRpcServerInstrumenter.End(serverCall.ctx, serverCall.request, nil, err)
+}
+
+//go:linkname netRpcSendResponseOnEnter net/rpc.netRpcSendResponseOnEnter
+func netRpcSendResponseOnEnter(call api.CallContext, server *rpc.Server, sending *sync.Mutex, req *rpc.Request, reply interface{}, codec rpc.ServerCodec, errmsg string) {
+	if errmsg == "" {
+		return
+	}
+	if value, ok := netRpcServerCalls.Load(req); ok {
+		value.(*netRpcServerCall).errmsg = errmsg
+	}
+}
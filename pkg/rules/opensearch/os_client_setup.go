@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearch
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	opensearch "github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchtransport"
+)
+
+var osInstrumenter = BuildOpenSearchInstrumenter()
+
+type osInnerEnabler struct {
+	enabled bool
+}
+
+func (g osInnerEnabler) Enable() bool {
+	return g.enabled
+}
+
+var osEnabler = osInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_OPENSEARCH_ENABLED") != "false"}
+
+// opensearch always puts "took" at the head of the response body
+var osTookRegexp = regexp.MustCompile(`^\s*\{\s*"took"\s*:\s*(\d+)`)
+
+const osTookPeekSize = 64
+
+type osResponseBody struct {
+	io.Reader
+	io.Closer
+}
+
+//go:linkname beforeOpenSearchPerform github.com/opensearch-project/opensearch-go/v2.beforeOpenSearchPerform
+func beforeOpenSearchPerform(call api.CallContext, client *opensearch.Client, request *http.Request) {
+	if !osEnabler.Enable() {
+		return
+	}
+	var addresses []string
+	if transport, ok := client.Transport.(*opensearchtransport.Client); ok {
+		for _, u := range transport.URLs() {
+			addresses = append(addresses, u.String())
+		}
+	}
+	op, params := getOsOpAndParams(request)
+	osr := &osRequest{
+		request: request,
+		address: strings.Join(addresses, ","),
+		op:      op,
+		index:   getOsIndex(request),
+		params:  params,
+	}
+	newCtx := osInstrumenter.Start(request.Context(), osr)
+	call.SetKeyData("ctx", newCtx)
+	call.SetKeyData("request", osr)
+}
+
+//go:linkname afterOpenSearchPerform github.com/opensearch-project/opensearch-go/v2.afterOpenSearchPerform
+func afterOpenSearchPerform(call api.CallContext, response *http.Response, err error) {
+	if !osEnabler.Enable() {
+		return
+	}
+	newCtx := call.GetKeyData("ctx").(context.Context)
+	osr := call.GetKeyData("request").(*osRequest)
+	if response != nil {
+		osr.statusCode = response.StatusCode
+	}
+	osr.took = peekOsTook(response)
+	osInstrumenter.End(newCtx, osr, response, err)
+}
+
+func getOsOpAndParams(req *http.Request) (string, []any) {
+	if req == nil || req.URL == nil {
+		return "UNKNOWN", nil
+	}
+	path := req.URL.Path
+	paths := strings.Split(path, "/")
+	if len(paths) <= 1 {
+		return "UNKNOWN", nil
+	}
+	if len(paths) == 2 {
+		return strings.ToLower(req.Method), nil
+	}
+	params := make([]any, len(paths)-2)
+	// path[0] should be the index name
+	for i := 2; i < len(paths); i++ {
+		params[i-2] = paths[i]
+	}
+	return paths[2], params
+}
+
+func getOsIndex(req *http.Request) string {
+	if req == nil || req.URL == nil {
+		return ""
+	}
+	paths := strings.Split(req.URL.Path, "/")
+	if len(paths) <= 1 || paths[1] == "" || strings.HasPrefix(paths[1], "_") {
+		return ""
+	}
+	return paths[1]
+}
+
+// peekOsTook reads the took-time of the response without consuming the body,
+// it returns -1 if the response does not carry one
+func peekOsTook(response *http.Response) int64 {
+	if response == nil || response.Body == nil || response.Body == http.NoBody {
+		return -1
+	}
+	reader := bufio.NewReader(response.Body)
+	response.Body = &osResponseBody{Reader: reader, Closer: response.Body}
+	head, _ := reader.Peek(osTookPeekSize)
+	matches := osTookRegexp.FindSubmatch(head)
+	if matches == nil {
+		return -1
+	}
+	took, err := strconv.ParseInt(string(matches[1]), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return took
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearch
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/http"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+type openSearchGetter struct {
+}
+
+func (e openSearchGetter) GetSystem(request *osRequest) string {
+	return "opensearch"
+}
+
+func (e openSearchGetter) GetServerAddress(request *osRequest) string {
+	return request.address
+}
+
+func (e openSearchGetter) GetStatement(request *osRequest) string {
+	return request.request.URL.Path
+}
+
+func (e openSearchGetter) GetOperation(request *osRequest) string {
+	return request.op
+}
+
+func (e openSearchGetter) GetCollection(request *osRequest) string {
+	return request.index
+}
+
+func (e openSearchGetter) GetParameters(request *osRequest) []any {
+	return request.params
+}
+
+func (e openSearchGetter) GetDbNamespace(request *osRequest) string {
+	return ""
+}
+
+func (e openSearchGetter) GetBatchSize(request *osRequest) int {
+	return 0
+}
+
+type osAttrsExtractor struct {
+}
+
+func (e osAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *osRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.HTTPRequestMethodKey.String(request.request.Method))
+	return attributes, parentContext
+}
+
+func (e osAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *osRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if request.statusCode > 0 {
+		attributes = append(attributes, semconv.DBResponseStatusCodeKey.String(strconv.Itoa(request.statusCode)))
+	}
+	if request.took >= 0 {
+		attributes = append(attributes, attribute.Int64("opensearch.took", request.took))
+	}
+	return attributes, context
+}
+
+func BuildOpenSearchInstrumenter() instrumenter.Instrumenter[*osRequest, interface{}] {
+	builder := instrumenter.Builder[*osRequest, any]{}
+	getter := openSearchGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[*osRequest]{Getter: openSearchGetter{}}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[*osRequest]{}).
+		AddOperationListeners(http.HttpServerMetrics("opensearch.client")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.OPENSEARCH_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[*osRequest, any, db.DbClientAttrsGetter[*osRequest]]{Base: db.DbClientCommonAttrsExtractor[*osRequest, any, db.DbClientAttrsGetter[*osRequest]]{Getter: getter}}, osAttrsExtractor{}).
+		BuildInstrumenter()
+}
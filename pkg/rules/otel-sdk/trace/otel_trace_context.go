@@ -157,6 +157,20 @@ func traceContextDelSpan(span trace.Span) {
 	ctx.del(span)
 }
 
+// DetachSpanFromGLS removes the span from the current goroutine without ending
+// it, so that the span ends in another goroutine, e.g. once a message sent
+// asynchronously is acknowledged, and is no longer the parent of later spans
+// of the current goroutine
+func DetachSpanFromGLS(span trace.Span) {
+	traceContextDelSpan(span)
+}
+
+// AttachSpanToGLS makes a span started in another goroutine the parent of the
+// later spans of the current goroutine, until it is detached
+func AttachSpanToGLS(span trace.Span) {
+	traceContextAddSpan(span)
+}
+
 func clearTraceContext() {
 	getOrInitTraceContext().clear()
 }
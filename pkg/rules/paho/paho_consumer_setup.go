@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paho
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// pahoTracedHandler runs the handler of a message within a span of its own,
+// there is no trace context delivered with the message to continue
+func pahoTracedHandler(handler mqtt.MessageHandler) mqtt.MessageHandler {
+	return func(client mqtt.Client, msg mqtt.Message) {
+		if !pahoEnabler.Enable() || msg == nil {
+			handler(client, msg)
+			return
+		}
+		request := pahoConsumerReq{msg: msg}
+		if client != nil {
+			request.clientID = pahoClientID(client)
+		}
+		ctx := pahoConsumerInstrumenter.Start(context.Background(), request)
+		defer pahoConsumerInstrumenter.End(ctx, request, nil, nil)
+		handler(client, msg)
+	}
+}
+
+// beforePahoAddRoute covers the handlers of Subscribe, SubscribeMultiple and
+// AddRoute, which are all routed by the router of the client
+//
+//go:linkname beforePahoAddRoute github.com/eclipse/paho.mqtt.golang.beforePahoAddRoute
+func beforePahoAddRoute(call api.CallContext, r interface{}, topic string, callback mqtt.MessageHandler) {
+	if callback != nil {
+		call.SetParam(2, pahoTracedHandler(callback))
+	}
+}
+
+// beforePahoSetDefaultHandler covers the default publish handler, which
+// handles the messages matching none of the routes
+//
+//go:linkname beforePahoSetDefaultHandler github.com/eclipse/paho.mqtt.golang.beforePahoSetDefaultHandler
+func beforePahoSetDefaultHandler(call api.CallContext, r interface{}, handler mqtt.MessageHandler) {
+	if handler != nil {
+		call.SetParam(1, pahoTracedHandler(handler))
+	}
+}
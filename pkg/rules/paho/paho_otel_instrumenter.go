@@ -0,0 +1,247 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paho
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	pahoQosKey       = attribute.Key("messaging.mqtt.qos")
+	pahoRetainedKey  = attribute.Key("messaging.mqtt.retained")
+	pahoDuplicateKey = attribute.Key("messaging.mqtt.duplicate")
+)
+
+var pahoEnabler = pahoInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_PAHO_ENABLED") != "false"}
+
+var (
+	pahoProducerInstrumenter = buildPahoProducerInstrumenter()
+	pahoConsumerInstrumenter = buildPahoConsumerInstrumenter()
+)
+
+type pahoInnerEnabler struct {
+	enabled bool
+}
+
+func (p pahoInnerEnabler) Enable() bool {
+	return p.enabled
+}
+
+type pahoSpanStatusExtractor[REQUEST any] struct{}
+
+func (p *pahoSpanStatusExtractor[REQUEST]) Extract(span trace.Span, request REQUEST, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type pahoProducerAttrsGetter struct{}
+
+func (getter pahoProducerAttrsGetter) GetSystem(request pahoPublishReq) string {
+	return "mqtt"
+}
+
+func (getter pahoProducerAttrsGetter) GetDestination(request pahoPublishReq) string {
+	return request.topic
+}
+
+func (getter pahoProducerAttrsGetter) GetDestinationTemplate(request pahoPublishReq) string {
+	return ""
+}
+
+func (getter pahoProducerAttrsGetter) IsTemporaryDestination(request pahoPublishReq) bool {
+	return false
+}
+
+func (getter pahoProducerAttrsGetter) IsAnonymousDestination(request pahoPublishReq) bool {
+	return false
+}
+
+func (getter pahoProducerAttrsGetter) GetConversationId(request pahoPublishReq) string {
+	return ""
+}
+
+func (getter pahoProducerAttrsGetter) GetMessageBodySize(request pahoPublishReq) int64 {
+	return int64(request.size)
+}
+
+func (getter pahoProducerAttrsGetter) GetMessageEnvelopSize(request pahoPublishReq) int64 {
+	return 0
+}
+
+func (getter pahoProducerAttrsGetter) GetMessageId(request pahoPublishReq, response any) string {
+	return ""
+}
+
+func (getter pahoProducerAttrsGetter) GetClientId(request pahoPublishReq) string {
+	return request.clientID
+}
+
+func (getter pahoProducerAttrsGetter) GetBatchMessageCount(request pahoPublishReq, response any) int64 {
+	return 1
+}
+
+func (getter pahoProducerAttrsGetter) GetMessageHeader(request pahoPublishReq, name string) []string {
+	return nil
+}
+
+func (getter pahoProducerAttrsGetter) GetDestinationPartitionId(request pahoPublishReq) string {
+	return ""
+}
+
+type pahoConsumerAttrsGetter struct{}
+
+func (getter pahoConsumerAttrsGetter) GetSystem(request pahoConsumerReq) string {
+	return "mqtt"
+}
+
+func (getter pahoConsumerAttrsGetter) GetDestination(request pahoConsumerReq) string {
+	return request.msg.Topic()
+}
+
+func (getter pahoConsumerAttrsGetter) GetDestinationTemplate(request pahoConsumerReq) string {
+	return ""
+}
+
+func (getter pahoConsumerAttrsGetter) IsTemporaryDestination(request pahoConsumerReq) bool {
+	return false
+}
+
+func (getter pahoConsumerAttrsGetter) IsAnonymousDestination(request pahoConsumerReq) bool {
+	return false
+}
+
+func (getter pahoConsumerAttrsGetter) GetConversationId(request pahoConsumerReq) string {
+	return ""
+}
+
+func (getter pahoConsumerAttrsGetter) GetMessageBodySize(request pahoConsumerReq) int64 {
+	return int64(len(request.msg.Payload()))
+}
+
+func (getter pahoConsumerAttrsGetter) GetMessageEnvelopSize(request pahoConsumerReq) int64 {
+	return 0
+}
+
+// GetMessageId returns the packet identifier, which is only assigned to the
+// messages delivered with a QoS above 0
+func (getter pahoConsumerAttrsGetter) GetMessageId(request pahoConsumerReq, response any) string {
+	if request.msg.Qos() == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(request.msg.MessageID()), 10)
+}
+
+func (getter pahoConsumerAttrsGetter) GetClientId(request pahoConsumerReq) string {
+	return request.clientID
+}
+
+func (getter pahoConsumerAttrsGetter) GetBatchMessageCount(request pahoConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter pahoConsumerAttrsGetter) GetMessageHeader(request pahoConsumerReq, name string) []string {
+	return nil
+}
+
+func (getter pahoConsumerAttrsGetter) GetDestinationPartitionId(request pahoConsumerReq) string {
+	return ""
+}
+
+// pahoPublishAttrsExtractor records the delivery options of a message
+type pahoPublishAttrsExtractor struct{}
+
+func (extractor *pahoPublishAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request pahoPublishReq) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		pahoQosKey.Int(int(request.qos)),
+		pahoRetainedKey.Bool(request.retained),
+	)
+	return attributes, parentContext
+}
+
+func (extractor *pahoPublishAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request pahoPublishReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// pahoConsumerAttrsExtractor records the delivery options of a message, and
+// whether the broker is redelivering it
+type pahoConsumerAttrsExtractor struct{}
+
+func (extractor *pahoConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request pahoConsumerReq) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		pahoQosKey.Int(int(request.msg.Qos())),
+		pahoRetainedKey.Bool(request.msg.Retained()),
+		pahoDuplicateKey.Bool(request.msg.Duplicate()),
+	)
+	return attributes, parentContext
+}
+
+func (extractor *pahoConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request pahoConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// MQTT 3.1.1, which is the protocol of paho.mqtt.golang, has no properties to
+// carry the trace context with the message, so that neither instrumenter
+// propagates it
+func buildPahoProducerInstrumenter() instrumenter.Instrumenter[pahoPublishReq, any] {
+	builder := instrumenter.Builder[pahoPublishReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.PAHO_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[pahoPublishReq, any]{
+			Getter:        pahoProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[pahoPublishReq]{}).
+		SetSpanStatusExtractor(&pahoSpanStatusExtractor[pahoPublishReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[pahoPublishReq, any, pahoProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&pahoPublishAttrsExtractor{}).
+		BuildInstrumenter()
+}
+
+func buildPahoConsumerInstrumenter() instrumenter.Instrumenter[pahoConsumerReq, any] {
+	builder := instrumenter.Builder[pahoConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.PAHO_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[pahoConsumerReq, any]{
+			Getter:        pahoConsumerAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[pahoConsumerReq]{}).
+		SetSpanStatusExtractor(&pahoSpanStatusExtractor[pahoConsumerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[pahoConsumerReq, any, pahoConsumerAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&pahoConsumerAttrsExtractor{}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paho
+
+import (
+	"bytes"
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+type pahoPublishData struct {
+	ctx     context.Context
+	request pahoPublishReq
+}
+
+//go:linkname beforePahoPublish github.com/eclipse/paho.mqtt.golang.beforePahoPublish
+func beforePahoPublish(call api.CallContext, c interface{}, topic string, qos byte, retained bool, payload interface{}) {
+	if !pahoEnabler.Enable() {
+		return
+	}
+	request := pahoPublishReq{topic: topic, qos: qos, retained: retained, size: pahoPayloadSize(payload)}
+	if client, ok := c.(mqtt.Client); ok {
+		request.clientID = pahoClientID(client)
+	}
+	ctx := pahoProducerInstrumenter.Start(context.Background(), request)
+	call.SetData(pahoPublishData{ctx: ctx, request: request})
+}
+
+// afterPahoPublish ends the span once the publish is complete, which is when
+// the message is sent for a QoS of 0, or acknowledged by the broker otherwise
+//
+//go:linkname afterPahoPublish github.com/eclipse/paho.mqtt.golang.afterPahoPublish
+func afterPahoPublish(call api.CallContext, token mqtt.Token) {
+	data, ok := call.GetData().(pahoPublishData)
+	if !ok {
+		return
+	}
+	if token == nil {
+		pahoProducerInstrumenter.End(data.ctx, data.request, nil, nil)
+		return
+	}
+	select {
+	case <-token.Done():
+		pahoProducerInstrumenter.End(data.ctx, data.request, nil, token.Error())
+	default:
+		go func() {
+			<-token.Done()
+			pahoProducerInstrumenter.End(data.ctx, data.request, nil, token.Error())
+		}()
+	}
+}
+
+func pahoClientID(client mqtt.Client) string {
+	reader := client.OptionsReader()
+	return reader.ClientID()
+}
+
+// pahoPayloadSize returns the size of the payloads that paho accepts
+func pahoPayloadSize(payload interface{}) int {
+	switch p := payload.(type) {
+	case string:
+		return len(p)
+	case []byte:
+		return len(p)
+	case bytes.Buffer:
+		return p.Len()
+	case *bytes.Buffer:
+		return p.Len()
+	}
+	return 0
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgx
+
+type pgxRequest struct {
+	Operation  string
+	Statement  string
+	Addr       string
+	DbName     string
+	Collection string
+	Parameters []any
+	BatchSize  int
+	// PoolWait is the nanoseconds the connection waited in pgxpool before
+	// being acquired, zero if it is not acquired from a pool
+	PoolWait int64
+}
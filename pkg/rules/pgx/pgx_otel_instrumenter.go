@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgx
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const pgxPoolWaitDurationKey = attribute.Key("pgxpool.wait_duration")
+
+type pgxAttrsGetter struct{}
+
+func (p pgxAttrsGetter) GetSystem(_ pgxRequest) string {
+	return "postgresql"
+}
+
+func (p pgxAttrsGetter) GetServerAddress(request pgxRequest) string {
+	return request.Addr
+}
+
+func (p pgxAttrsGetter) GetStatement(request pgxRequest) string {
+	return request.Statement
+}
+
+func (p pgxAttrsGetter) GetCollection(request pgxRequest) string {
+	return request.Collection
+}
+
+func (p pgxAttrsGetter) GetOperation(request pgxRequest) string {
+	return request.Operation
+}
+
+func (p pgxAttrsGetter) GetParameters(request pgxRequest) []any {
+	return request.Parameters
+}
+
+func (p pgxAttrsGetter) GetDbNamespace(request pgxRequest) string {
+	return request.DbName
+}
+
+func (p pgxAttrsGetter) GetBatchSize(request pgxRequest) int {
+	return request.BatchSize
+}
+
+type pgxPoolAttrsExtractor struct{}
+
+func (p pgxPoolAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request pgxRequest) ([]attribute.KeyValue, context.Context) {
+	if request.PoolWait > 0 {
+		attributes = append(attributes, pgxPoolWaitDurationKey.Float64(time.Duration(request.PoolWait).Seconds()))
+	}
+	return attributes, parentContext
+}
+
+func (p pgxPoolAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request pgxRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildPgxInstrumenter() instrumenter.Instrumenter[pgxRequest, interface{}] {
+	builder := instrumenter.Builder[pgxRequest, interface{}]{}
+	getter := pgxAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[pgxRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[pgxRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[pgxRequest, any, pgxAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[pgxRequest, any, pgxAttrsGetter]{Getter: getter}}, pgxPoolAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.PGX_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgx
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:linkname pgxPoolAcquireOnEnter github.com/jackc/pgx/v5/pgxpool.pgxPoolAcquireOnEnter
+func pgxPoolAcquireOnEnter(call api.CallContext, p *pgxpool.Pool, ctx context.Context) {
+	if !pgxEnabler.Enable() {
+		return
+	}
+	call.SetData(time.Now())
+}
+
+//go:linkname pgxPoolAcquireOnExit github.com/jackc/pgx/v5/pgxpool.pgxPoolAcquireOnExit
+func pgxPoolAcquireOnExit(call api.CallContext, c *pgxpool.Conn, err error) {
+	if !pgxEnabler.Enable() || c == nil || err != nil {
+		return
+	}
+	start, ok := call.GetData().(time.Time)
+	if !ok {
+		return
+	}
+	// The connection is held exclusively by the acquirer, the wait duration is
+	// reported on the span of the next operation on it
+	if conn := c.Conn(); conn != nil {
+		conn.PoolWait = int64(time.Since(start))
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgx
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type pgxInnerEnabler struct {
+	enabled bool
+}
+
+func (p pgxInnerEnabler) Enable() bool {
+	return p.enabled
+}
+
+var pgxEnabler = pgxInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_PGX_ENABLED") != "false"}
+
+var pgxInstrumenter = BuildPgxInstrumenter()
+
+func pgxStart(call api.CallContext, c *pgx.Conn, ctx context.Context, request pgxRequest) (context.Context, bool) {
+	if !pgxEnabler.Enable() || c == nil {
+		return nil, false
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request.Addr = c.Endpoint
+	request.DbName = c.DbName
+	// The wait duration belongs to the first operation after the acquisition
+	request.PoolWait = c.PoolWait
+	c.PoolWait = 0
+	ctx = pgxInstrumenter.Start(ctx, request)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+	return ctx, true
+}
+
+func pgxEnd(call api.CallContext, err error) {
+	if !pgxEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(pgxRequest)
+	if !ok {
+		return
+	}
+	pgxInstrumenter.End(ctx, request, nil, err)
+}
+
+func pgxOperation(sql string) string {
+	if fields := strings.Fields(sql); len(fields) > 0 {
+		return strings.ToUpper(strings.TrimSuffix(fields[0], ";"))
+	}
+	return ""
+}
+
+//go:linkname pgxConnectOnEnter github.com/jackc/pgx/v5.pgxConnectOnEnter
+func pgxConnectOnEnter(call api.CallContext, ctx context.Context, config *pgx.ConnConfig) {
+	if !pgxEnabler.Enable() || config == nil {
+		return
+	}
+	call.SetData(config)
+}
+
+//go:linkname pgxConnectOnExit github.com/jackc/pgx/v5.pgxConnectOnExit
+func pgxConnectOnExit(call api.CallContext, c *pgx.Conn, err error) {
+	if !pgxEnabler.Enable() || c == nil {
+		return
+	}
+	config, ok := call.GetData().(*pgx.ConnConfig)
+	if !ok || config == nil {
+		return
+	}
+	c.Endpoint = net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port)))
+	c.DbName = config.Database
+}
+
+//go:linkname pgxQueryOnEnter github.com/jackc/pgx/v5.pgxQueryOnEnter
+func pgxQueryOnEnter(call api.CallContext, c *pgx.Conn, ctx context.Context, sql string, args ...any) {
+	if ctx, ok := pgxStart(call, c, ctx, pgxRequest{
+		Operation:  pgxOperation(sql),
+		Statement:  sql,
+		Parameters: args,
+	}); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname pgxQueryOnExit github.com/jackc/pgx/v5.pgxQueryOnExit
+func pgxQueryOnExit(call api.CallContext, rows pgx.Rows, err error) {
+	pgxEnd(call, err)
+}
+
+//go:linkname pgxExecOnEnter github.com/jackc/pgx/v5.pgxExecOnEnter
+func pgxExecOnEnter(call api.CallContext, c *pgx.Conn, ctx context.Context, sql string, arguments ...any) {
+	if ctx, ok := pgxStart(call, c, ctx, pgxRequest{
+		Operation:  pgxOperation(sql),
+		Statement:  sql,
+		Parameters: arguments,
+	}); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname pgxExecOnExit github.com/jackc/pgx/v5.pgxExecOnExit
+func pgxExecOnExit(call api.CallContext, tag pgconn.CommandTag, err error) {
+	pgxEnd(call, err)
+}
+
+//go:linkname pgxSendBatchOnEnter github.com/jackc/pgx/v5.pgxSendBatchOnEnter
+func pgxSendBatchOnEnter(call api.CallContext, c *pgx.Conn, ctx context.Context, b *pgx.Batch) {
+	if b == nil {
+		return
+	}
+	if ctx, ok := pgxStart(call, c, ctx, pgxRequest{
+		Operation: "BATCH",
+		BatchSize: b.Len(),
+	}); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname pgxSendBatchOnExit github.com/jackc/pgx/v5.pgxSendBatchOnExit
+func pgxSendBatchOnExit(call api.CallContext, br pgx.BatchResults) {
+	// Errors of the queued queries are only known when the results are read
+	pgxEnd(call, nil)
+}
+
+//go:linkname pgxCopyFromOnEnter github.com/jackc/pgx/v5.pgxCopyFromOnEnter
+func pgxCopyFromOnEnter(call api.CallContext, c *pgx.Conn, ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) {
+	collection := tableName.Sanitize()
+	if ctx, ok := pgxStart(call, c, ctx, pgxRequest{
+		Operation:  "COPY",
+		Statement:  "COPY " + collection + " FROM STDIN",
+		Collection: collection,
+	}); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname pgxCopyFromOnExit github.com/jackc/pgx/v5.pgxCopyFromOnExit
+func pgxCopyFromOnExit(call api.CallContext, rows int64, err error) {
+	pgxEnd(call, err)
+}
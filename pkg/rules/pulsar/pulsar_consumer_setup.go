@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+type pulsarReceiveData struct {
+	subscription   string
+	ctx            context.Context
+	startTimestamp time.Time
+}
+
+func beforeReceive(call api.CallContext, c interface{}, ctx context.Context) {
+	if !pulsarEnabler.Enable() {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	data := pulsarReceiveData{ctx: ctx, startTimestamp: time.Now()}
+	if consumer, ok := c.(interface{ Subscription() string }); ok {
+		data.subscription = consumer.Subscription()
+	}
+	call.SetData(data)
+}
+
+// afterReceive records a span for the received message, whose parent is the
+// span of the producer carried by the message properties, a receive without
+// message, such as one interrupted by the context or by closing the consumer,
+// is not recorded
+func afterReceive(call api.CallContext, message pulsar.Message, err error) {
+	data, ok := call.GetData().(pulsarReceiveData)
+	if !ok || message == nil {
+		return
+	}
+	request := pulsarConsumerReq{subscription: data.subscription, msg: message}
+	pulsarConsumerInstrumenter.StartAndEndWithOptions(data.ctx, request, nil, err, data.startTimestamp, time.Now(), nil, nil)
+}
+
+// the consumers of a single topic, of many topics and of a topic pattern
+// each implement Receive, a hook function can only be declared once per
+// package so each of them gets its own pair of hooks
+
+//go:linkname beforePulsarReceive github.com/apache/pulsar-client-go/pulsar.beforePulsarReceive
+func beforePulsarReceive(call api.CallContext, c interface{}, ctx context.Context) {
+	beforeReceive(call, c, ctx)
+}
+
+//go:linkname afterPulsarReceive github.com/apache/pulsar-client-go/pulsar.afterPulsarReceive
+func afterPulsarReceive(call api.CallContext, message pulsar.Message, err error) {
+	afterReceive(call, message, err)
+}
+
+//go:linkname beforePulsarMultiTopicReceive github.com/apache/pulsar-client-go/pulsar.beforePulsarMultiTopicReceive
+func beforePulsarMultiTopicReceive(call api.CallContext, c interface{}, ctx context.Context) {
+	beforeReceive(call, c, ctx)
+}
+
+//go:linkname afterPulsarMultiTopicReceive github.com/apache/pulsar-client-go/pulsar.afterPulsarMultiTopicReceive
+func afterPulsarMultiTopicReceive(call api.CallContext, message pulsar.Message, err error) {
+	afterReceive(call, message, err)
+}
+
+//go:linkname beforePulsarRegexReceive github.com/apache/pulsar-client-go/pulsar.beforePulsarRegexReceive
+func beforePulsarRegexReceive(call api.CallContext, c interface{}, ctx context.Context) {
+	beforeReceive(call, c, ctx)
+}
+
+//go:linkname afterPulsarRegexReceive github.com/apache/pulsar-client-go/pulsar.afterPulsarRegexReceive
+func afterPulsarRegexReceive(call api.CallContext, message pulsar.Message, err error) {
+	afterReceive(call, message, err)
+}
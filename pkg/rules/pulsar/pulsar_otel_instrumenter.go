@@ -0,0 +1,273 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var pulsarEnabler = pulsarInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_PULSAR_ENABLED") != "false"}
+
+var (
+	pulsarProducerInstrumenter = buildPulsarProducerInstrumenter()
+	pulsarConsumerInstrumenter = buildPulsarConsumerInstrumenter()
+)
+
+type pulsarInnerEnabler struct {
+	enabled bool
+}
+
+func (p pulsarInnerEnabler) Enable() bool {
+	return p.enabled
+}
+
+type pulsarSpanStatusExtractor[REQUEST any, RESPONSE any] struct{}
+
+func (p *pulsarSpanStatusExtractor[REQUEST, RESPONSE]) Extract(span trace.Span, request REQUEST, response RESPONSE, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// pulsarMessageID formats a message id as ledger:entry:partition:batch, the
+// partition and batch index are -1 when the topic is not partitioned or the
+// message is not batched
+func pulsarMessageID(id pulsar.MessageID) string {
+	if id == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d:%d:%d:%d", id.LedgerID(), id.EntryID(), id.PartitionIdx(), id.BatchIdx())
+}
+
+func pulsarPartitionID(id pulsar.MessageID) string {
+	if id == nil || id.PartitionIdx() < 0 {
+		return ""
+	}
+	return strconv.Itoa(int(id.PartitionIdx()))
+}
+
+type pulsarProducerAttrsGetter struct{}
+
+func (getter pulsarProducerAttrsGetter) GetSystem(request pulsarProducerReq) string {
+	return "pulsar"
+}
+
+func (getter pulsarProducerAttrsGetter) GetDestination(request pulsarProducerReq) string {
+	return request.topic
+}
+
+func (getter pulsarProducerAttrsGetter) GetDestinationTemplate(request pulsarProducerReq) string {
+	return ""
+}
+
+func (getter pulsarProducerAttrsGetter) IsTemporaryDestination(request pulsarProducerReq) bool {
+	return false
+}
+
+func (getter pulsarProducerAttrsGetter) IsAnonymousDestination(request pulsarProducerReq) bool {
+	return false
+}
+
+func (getter pulsarProducerAttrsGetter) GetConversationId(request pulsarProducerReq) string {
+	return ""
+}
+
+func (getter pulsarProducerAttrsGetter) GetMessageBodySize(request pulsarProducerReq) int64 {
+	return int64(len(request.msg.Payload))
+}
+
+func (getter pulsarProducerAttrsGetter) GetMessageEnvelopSize(request pulsarProducerReq) int64 {
+	return 0
+}
+
+func (getter pulsarProducerAttrsGetter) GetMessageId(request pulsarProducerReq, response pulsar.MessageID) string {
+	return pulsarMessageID(response)
+}
+
+func (getter pulsarProducerAttrsGetter) GetClientId(request pulsarProducerReq) string {
+	return ""
+}
+
+func (getter pulsarProducerAttrsGetter) GetBatchMessageCount(request pulsarProducerReq, response pulsar.MessageID) int64 {
+	return 1
+}
+
+func (getter pulsarProducerAttrsGetter) GetMessageHeader(request pulsarProducerReq, name string) []string {
+	if value, ok := request.msg.Properties[name]; ok {
+		return []string{value}
+	}
+	return nil
+}
+
+// GetDestinationPartitionId returns nothing as the partition of a sent
+// message is only known once it is acked, see pulsarProducerAttrsExtractor
+func (getter pulsarProducerAttrsGetter) GetDestinationPartitionId(request pulsarProducerReq) string {
+	return ""
+}
+
+type pulsarConsumerAttrsGetter struct{}
+
+func (getter pulsarConsumerAttrsGetter) GetSystem(request pulsarConsumerReq) string {
+	return "pulsar"
+}
+
+func (getter pulsarConsumerAttrsGetter) GetDestination(request pulsarConsumerReq) string {
+	return request.msg.Topic()
+}
+
+func (getter pulsarConsumerAttrsGetter) GetDestinationTemplate(request pulsarConsumerReq) string {
+	return ""
+}
+
+func (getter pulsarConsumerAttrsGetter) IsTemporaryDestination(request pulsarConsumerReq) bool {
+	return false
+}
+
+func (getter pulsarConsumerAttrsGetter) IsAnonymousDestination(request pulsarConsumerReq) bool {
+	return false
+}
+
+func (getter pulsarConsumerAttrsGetter) GetConversationId(request pulsarConsumerReq) string {
+	return ""
+}
+
+func (getter pulsarConsumerAttrsGetter) GetMessageBodySize(request pulsarConsumerReq) int64 {
+	return int64(len(request.msg.Payload()))
+}
+
+func (getter pulsarConsumerAttrsGetter) GetMessageEnvelopSize(request pulsarConsumerReq) int64 {
+	return 0
+}
+
+func (getter pulsarConsumerAttrsGetter) GetMessageId(request pulsarConsumerReq, response any) string {
+	return pulsarMessageID(request.msg.ID())
+}
+
+func (getter pulsarConsumerAttrsGetter) GetClientId(request pulsarConsumerReq) string {
+	return ""
+}
+
+func (getter pulsarConsumerAttrsGetter) GetBatchMessageCount(request pulsarConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter pulsarConsumerAttrsGetter) GetMessageHeader(request pulsarConsumerReq, name string) []string {
+	if value, ok := request.msg.Properties()[name]; ok {
+		return []string{value}
+	}
+	return nil
+}
+
+func (getter pulsarConsumerAttrsGetter) GetDestinationPartitionId(request pulsarConsumerReq) string {
+	return pulsarPartitionID(request.msg.ID())
+}
+
+// pulsarProducerAttrsExtractor records the partition a message is sent to,
+// which is assigned by the producer router
+type pulsarProducerAttrsExtractor struct{}
+
+func (extractor *pulsarProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request pulsarProducerReq) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (extractor *pulsarProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request pulsarProducerReq, response pulsar.MessageID, err error) ([]attribute.KeyValue, context.Context) {
+	if partition := pulsarPartitionID(response); partition != "" {
+		attributes = append(attributes, semconv.MessagingDestinationPartitionID(partition))
+	}
+	return attributes, ctx
+}
+
+// pulsarConsumerAttrsExtractor records the subscription a message is
+// received from
+type pulsarConsumerAttrsExtractor struct{}
+
+func (extractor *pulsarConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request pulsarConsumerReq) ([]attribute.KeyValue, context.Context) {
+	if request.subscription != "" {
+		attributes = append(attributes, semconv.MessagingDestinationSubscriptionName(request.subscription))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *pulsarConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request pulsarConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// the trace context is carried by the message properties, a message without
+// properties is given an empty map when it is sent
+func buildPulsarProducerInstrumenter() instrumenter.Instrumenter[pulsarProducerReq, pulsar.MessageID] {
+	builder := instrumenter.Builder[pulsarProducerReq, pulsar.MessageID]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.PULSAR_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[pulsarProducerReq, pulsar.MessageID]{
+			Getter:        pulsarProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[pulsarProducerReq]{}).
+		SetSpanStatusExtractor(&pulsarSpanStatusExtractor[pulsarProducerReq, pulsar.MessageID]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[pulsarProducerReq, pulsar.MessageID, pulsarProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&pulsarProducerAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request pulsarProducerReq) propagation.TextMapCarrier {
+				return propagation.MapCarrier(request.msg.Properties)
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+func buildPulsarConsumerInstrumenter() instrumenter.Instrumenter[pulsarConsumerReq, any] {
+	builder := instrumenter.Builder[pulsarConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.PULSAR_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[pulsarConsumerReq, any]{
+			Getter:        pulsarConsumerAttrsGetter{},
+			OperationName: message.RECEIVE,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[pulsarConsumerReq]{}).
+		SetSpanStatusExtractor(&pulsarSpanStatusExtractor[pulsarConsumerReq, any]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[pulsarConsumerReq, any, pulsarConsumerAttrsGetter]{
+			Operation: message.RECEIVE,
+		}).
+		AddAttributesExtractor(&pulsarConsumerAttrsExtractor{}).
+		BuildPropagatingFromUpstreamInstrumenter(
+			func(request pulsarConsumerReq) propagation.TextMapCarrier {
+				return propagation.MapCarrier(request.msg.Properties())
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/apache/pulsar-client-go/pulsar"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type pulsarSendData struct {
+	ctx     context.Context
+	request pulsarProducerReq
+}
+
+// newPulsarProducerReq gives the message an empty properties map if it has
+// none so that the trace context can be injected into it
+func newPulsarProducerReq(p interface{}, msg *pulsar.ProducerMessage) pulsarProducerReq {
+	request := pulsarProducerReq{msg: msg}
+	if producer, ok := p.(interface{ Topic() string }); ok {
+		request.topic = producer.Topic()
+	}
+	if msg.Properties == nil {
+		msg.Properties = make(map[string]string)
+	}
+	return request
+}
+
+// beforePulsarSend only covers the producer returned by CreateProducer, the
+// producers of the partitions it routes messages to are not traced
+//
+//go:linkname beforePulsarSend github.com/apache/pulsar-client-go/pulsar.beforePulsarSend
+func beforePulsarSend(call api.CallContext, p interface{}, ctx context.Context, msg *pulsar.ProducerMessage) {
+	if !pulsarEnabler.Enable() || msg == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := newPulsarProducerReq(p, msg)
+	call.SetData(pulsarSendData{
+		ctx:     pulsarProducerInstrumenter.Start(ctx, request),
+		request: request,
+	})
+}
+
+//go:linkname afterPulsarSend github.com/apache/pulsar-client-go/pulsar.afterPulsarSend
+func afterPulsarSend(call api.CallContext, id pulsar.MessageID, err error) {
+	data, ok := call.GetData().(pulsarSendData)
+	if !ok {
+		return
+	}
+	pulsarProducerInstrumenter.End(data.ctx, data.request, id, err)
+}
+
+// beforePulsarSendAsync ends the span of a message in its callback, which is
+// called by the event loop of the partition producer once the broker acks the
+// message or it fails, so the span is detached from the calling goroutine
+//
+//go:linkname beforePulsarSendAsync github.com/apache/pulsar-client-go/pulsar.beforePulsarSendAsync
+func beforePulsarSendAsync(call api.CallContext, p interface{}, ctx context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+	if !pulsarEnabler.Enable() || msg == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := newPulsarProducerReq(p, msg)
+	spanContext := pulsarProducerInstrumenter.Start(ctx, request)
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(spanContext))
+	call.SetParam(3, func(id pulsar.MessageID, message *pulsar.ProducerMessage, err error) {
+		pulsarProducerInstrumenter.End(spanContext, request, id, err)
+		if callback != nil {
+			callback(id, message, err)
+		}
+	})
+}
@@ -46,7 +46,7 @@ func onBeforeDialContext(call api.CallContext, ctx context.Context, network, add
 
 //go:linkname onExitDialContext github.com/gomodule/redigo/redis.onExitDialContext
 func onExitDialContext(call api.CallContext, conn redis.Conn, err error) {
-	if !redigoEnabler.Enable() {
+	if !redigoEnabler.Enable() || err != nil || conn == nil {
 		return
 	}
 	d := call.GetData()
@@ -70,5 +70,5 @@ func onExitDialContext(call api.CallContext, conn redis.Conn, err error) {
 	if !ok {
 		return
 	}
-	call.SetReturnVal(0, &armsConn{conn, endpoint, ctx})
+	call.SetReturnVal(0, newArmsConn(conn, endpoint, ctx))
 }
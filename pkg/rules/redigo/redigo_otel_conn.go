@@ -17,24 +17,31 @@ package redigo
 import (
 	"container/list"
 	"context"
-	"github.com/gomodule/redigo/redis"
 	"os"
 	"strconv"
+	"sync"
 	"time"
+
+	"github.com/gomodule/redigo/redis"
 )
 
 const max_queue_length = 2048
 
 var configuredQueueLength int
 
-var commandQueue = list.New()
-
 var redigoInstrumenter = BuildRedigoInstrumenter()
 
 type armsConn struct {
 	redis.Conn
 	endpoint string
 	ctx      context.Context
+	// commands that are sent but whose replies are not received yet
+	pending *list.List
+	mu      sync.Mutex
+}
+
+func newArmsConn(conn redis.Conn, endpoint string, ctx context.Context) *armsConn {
+	return &armsConn{Conn: conn, endpoint: endpoint, ctx: ctx, pending: list.New()}
 }
 
 func (a *armsConn) Close() error {
@@ -46,19 +53,41 @@ func (a *armsConn) Err() error {
 }
 
 func (a *armsConn) Do(commandName string, args ...interface{}) (reply interface{}, err error) {
+	return a.do(a.getCtx(), commandName, args, func() (interface{}, error) {
+		return a.Conn.Do(commandName, args...)
+	})
+}
+
+func (a *armsConn) DoContext(ctx context.Context, commandName string, args ...interface{}) (reply interface{}, err error) {
+	return a.do(ctx, commandName, args, func() (interface{}, error) {
+		return redis.DoContext(a.Conn, ctx, commandName, args...)
+	})
+}
+
+func (a *armsConn) DoWithTimeout(timeout time.Duration, commandName string, args ...interface{}) (reply interface{}, err error) {
+	return a.do(a.getCtx(), commandName, args, func() (interface{}, error) {
+		return redis.DoWithTimeout(a.Conn, timeout, commandName, args...)
+	})
+}
+
+// do flushes the pending commands and receives their replies before the
+// reply of the command itself, so the pending commands are ended as well.
+// An empty command name only flushes and receives the pending replies.
+func (a *armsConn) do(ctx context.Context, commandName string, args []interface{}, doFunc func() (interface{}, error)) (reply interface{}, err error) {
 	req := &redigoRequest{
 		args:     args,
 		endpoint: a.endpoint,
 		cmd:      commandName,
 	}
-	ctx := a.ctx
-	if ctx == nil {
-		ctx = context.Background()
-	}
 	startTime := time.Now()
-	reply, err = a.Conn.Do(commandName, args...)
+	reply, err = doFunc()
 	endTime := time.Now()
-	redigoInstrumenter.StartAndEnd(ctx, req, nil, err, startTime, endTime)
+	for pendingReq := a.pop(); pendingReq != nil; pendingReq = a.pop() {
+		redigoInstrumenter.StartAndEnd(pendingReq.ctx, pendingReq, nil, err, pendingReq.startTime, endTime)
+	}
+	if commandName != "" {
+		redigoInstrumenter.StartAndEnd(ctx, req, nil, err, startTime, endTime)
+	}
 	return
 }
 
@@ -69,40 +98,79 @@ func (a *armsConn) Send(commandName string, args ...interface{}) error {
 		endpoint:  a.endpoint,
 		cmd:       commandName,
 		startTime: now,
+		ctx:       a.getCtx(),
 	}
-	ctx := a.ctx
-	if ctx == nil {
-		ctx = context.Background()
+	err := a.Conn.Send(commandName, args...)
+	if err == nil {
+		a.push(req)
+	} else {
+		redigoInstrumenter.StartAndEnd(req.ctx, req, nil, err, now, time.Now())
 	}
-	req.ctx = ctx
-	push(req)
-	return a.Conn.Send(commandName, args...)
+	return err
 }
 
 func (a *armsConn) Flush() error {
-	return a.Conn.Flush()
+	err := a.Conn.Flush()
+	if err != nil {
+		// none of the pending commands reaches the server
+		now := time.Now()
+		for req := a.pop(); req != nil; req = a.pop() {
+			redigoInstrumenter.StartAndEnd(req.ctx, req, nil, err, req.startTime, now)
+		}
+	}
+	return err
 }
 
 func (a *armsConn) Receive() (reply interface{}, err error) {
 	reply, err = a.Conn.Receive()
-	req := pop()
+	a.endReceived(err)
+	return
+}
+
+func (a *armsConn) ReceiveContext(ctx context.Context) (reply interface{}, err error) {
+	reply, err = redis.ReceiveContext(a.Conn, ctx)
+	a.endReceived(err)
+	return
+}
+
+func (a *armsConn) ReceiveWithTimeout(timeout time.Duration) (reply interface{}, err error) {
+	reply, err = redis.ReceiveWithTimeout(a.Conn, timeout)
+	a.endReceived(err)
+	return
+}
+
+func (a *armsConn) endReceived(err error) {
+	// replies of pubsub connections do not match any sent command
+	req := a.pop()
 	if req != nil {
-		now := time.Now()
-		redigoInstrumenter.StartAndEnd(req.ctx, req, nil, err, req.startTime, now)
+		redigoInstrumenter.StartAndEnd(req.ctx, req, nil, err, req.startTime, time.Now())
 	}
-	return
 }
 
-func push(request *redigoRequest) {
-	if commandQueue != nil && commandQueue.Len() > getMaxQueueLength() {
+func (a *armsConn) getCtx() context.Context {
+	if a.ctx == nil {
+		return context.Background()
+	}
+	return a.ctx
+}
+
+func (a *armsConn) push(request *redigoRequest) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.pending.Len() >= getMaxQueueLength() {
 		return
 	}
-	commandQueue.PushBack(request)
+	a.pending.PushBack(request)
 }
 
-func pop() *redigoRequest {
-	front := commandQueue.Front()
-	commandQueue.Remove(front)
+func (a *armsConn) pop() *redigoRequest {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	front := a.pending.Front()
+	if front == nil {
+		return nil
+	}
+	a.pending.Remove(front)
 	p, ok := front.Value.(*redigoRequest)
 	if ok {
 		return p
@@ -114,8 +182,9 @@ func getMaxQueueLength() int {
 	if configuredQueueLength == 0 {
 		var e = os.Getenv("MAX_REDIGO_QUEUE_LENGTH")
 		if e != "" {
-			configuredQueueLength, _ = strconv.Atoi(os.Getenv(e))
-		} else {
+			configuredQueueLength, _ = strconv.Atoi(e)
+		}
+		if configuredQueueLength <= 0 {
 			configuredQueueLength = max_queue_length
 		}
 	}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redigo
+
+import (
+	"context"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/gomodule/redigo/redis"
+)
+
+// the pool itself knows nothing about the server, it is named after the
+// endpoint of the connections it dials
+var redigoPoolNames sync.Map
+
+const redigoDefaultPoolName = "redigo"
+
+type redigoPoolGet struct {
+	ctx   context.Context
+	pool  *redis.Pool
+	start time.Time
+}
+
+//go:linkname onBeforePoolDial github.com/gomodule/redigo/redis.onBeforePoolDial
+func onBeforePoolDial(call api.CallContext, pool *redis.Pool, ctx context.Context) {
+	if !redigoEnabler.Enable() {
+		return
+	}
+	call.SetData(pool)
+}
+
+//go:linkname onExitPoolDial github.com/gomodule/redigo/redis.onExitPoolDial
+func onExitPoolDial(call api.CallContext, conn redis.Conn, err error) {
+	if !redigoEnabler.Enable() || err != nil {
+		return
+	}
+	pool, ok := call.GetData().(*redis.Pool)
+	if !ok {
+		return
+	}
+	if c, ok := conn.(*armsConn); ok && c.endpoint != "" {
+		redigoPoolNames.Store(pool, c.endpoint)
+	}
+}
+
+//go:linkname onBeforePoolGetContext github.com/gomodule/redigo/redis.onBeforePoolGetContext
+func onBeforePoolGetContext(call api.CallContext, pool *redis.Pool, ctx context.Context) {
+	if !redigoEnabler.Enable() {
+		return
+	}
+	call.SetData(&redigoPoolGet{ctx: ctx, pool: pool, start: time.Now()})
+}
+
+//go:linkname onExitPoolGetContext github.com/gomodule/redigo/redis.onExitPoolGetContext
+func onExitPoolGetContext(call api.CallContext, conn redis.Conn, err error) {
+	if !redigoEnabler.Enable() {
+		return
+	}
+	get, ok := call.GetData().(*redigoPoolGet)
+	if !ok {
+		return
+	}
+	poolName := redigoDefaultPoolName
+	if name, ok := redigoPoolNames.Load(get.pool); ok {
+		poolName = name.(string)
+	}
+	ctx := get.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	db.RecordDbClientConnectionWaitTime(ctx, "redis", poolName, time.Since(get.start))
+}
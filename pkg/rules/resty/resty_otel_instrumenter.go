@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resty
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/http"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/net"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	restyTraceDNSLookupKey    = attribute.Key("resty.trace.dns_lookup")
+	restyTraceConnTimeKey     = attribute.Key("resty.trace.conn_time")
+	restyTraceTCPConnTimeKey  = attribute.Key("resty.trace.tcp_conn_time")
+	restyTraceTLSHandshakeKey = attribute.Key("resty.trace.tls_handshake")
+	restyTraceServerTimeKey   = attribute.Key("resty.trace.server_time")
+	restyTraceResponseTimeKey = attribute.Key("resty.trace.response_time")
+	restyTraceTotalTimeKey    = attribute.Key("resty.trace.total_time")
+	restyTraceConnReusedKey   = attribute.Key("resty.trace.is_conn_reused")
+)
+
+type restyInnerEnabler struct {
+	enabled bool
+}
+
+func (r restyInnerEnabler) Enable() bool {
+	return r.enabled
+}
+
+var restyEnabler = restyInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_RESTY_ENABLED") != "false"}
+
+// restyTraceEnabled turns on the request tracing of resty, whose timings are
+// otherwise only recorded for requests that enable it themselves.
+var restyTraceEnabled = os.Getenv("OTEL_INSTRUMENTATION_RESTY_TRACE_ENABLED") == "true"
+
+type restyClientAttrsGetter struct {
+}
+
+func (r restyClientAttrsGetter) GetRequestMethod(request restyRequest) string {
+	return request.method
+}
+
+func (r restyClientAttrsGetter) GetHttpRequestHeader(request restyRequest, name string) []string {
+	return request.header.Values(name)
+}
+
+func (r restyClientAttrsGetter) GetHttpResponseStatusCode(request restyRequest, response restyResponse, err error) int {
+	return response.statusCode
+}
+
+func (r restyClientAttrsGetter) GetHttpResponseHeader(request restyRequest, response restyResponse, name string) []string {
+	return response.header.Values(name)
+}
+
+func (r restyClientAttrsGetter) GetErrorType(request restyRequest, response restyResponse, err error) string {
+	return ""
+}
+
+func (r restyClientAttrsGetter) GetNetworkType(request restyRequest, response restyResponse) string {
+	return "ipv4"
+}
+
+func (r restyClientAttrsGetter) GetNetworkTransport(request restyRequest, response restyResponse) string {
+	return "tcp"
+}
+
+func (r restyClientAttrsGetter) GetNetworkProtocolName(request restyRequest, response restyResponse) string {
+	return "http"
+}
+
+func (r restyClientAttrsGetter) GetNetworkProtocolVersion(request restyRequest, response restyResponse) string {
+	return response.version
+}
+
+func (r restyClientAttrsGetter) GetNetworkLocalInetAddress(request restyRequest, response restyResponse) string {
+	return ""
+}
+
+func (r restyClientAttrsGetter) GetNetworkLocalPort(request restyRequest, response restyResponse) int {
+	return 0
+}
+
+func (r restyClientAttrsGetter) GetNetworkPeerInetAddress(request restyRequest, response restyResponse) string {
+	return r.GetServerAddress(request)
+}
+
+func (r restyClientAttrsGetter) GetNetworkPeerPort(request restyRequest, response restyResponse) int {
+	return r.GetServerPort(request)
+}
+
+func (r restyClientAttrsGetter) GetUrlFull(request restyRequest) string {
+	if request.url == nil {
+		return ""
+	}
+	return request.url.String()
+}
+
+func (r restyClientAttrsGetter) GetServerAddress(request restyRequest) string {
+	if request.url == nil {
+		return ""
+	}
+	return request.url.Hostname()
+}
+
+func (r restyClientAttrsGetter) GetServerPort(request restyRequest) int {
+	if request.url == nil {
+		return 0
+	}
+	port, err := strconv.Atoi(request.url.Port())
+	if err != nil {
+		return 0
+	}
+	return port
+}
+
+// restyAttrsExtractor adds what resty knows beyond a single round trip: the
+// retries made by its own retry loop and, if tracing is enabled, the timings
+// of the last attempt.
+type restyAttrsExtractor struct {
+}
+
+func (r restyAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request restyRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (r restyAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request restyRequest, response restyResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.attempts > 1 {
+		attributes = append(attributes, semconv.HTTPRequestResendCount(response.attempts-1))
+	}
+	if info := response.traceInfo; info.TotalTime > 0 {
+		attributes = append(attributes,
+			restyTraceDNSLookupKey.Float64(info.DNSLookup.Seconds()),
+			restyTraceConnTimeKey.Float64(info.ConnTime.Seconds()),
+			restyTraceTCPConnTimeKey.Float64(info.TCPConnTime.Seconds()),
+			restyTraceTLSHandshakeKey.Float64(info.TLSHandshake.Seconds()),
+			restyTraceServerTimeKey.Float64(info.ServerTime.Seconds()),
+			restyTraceResponseTimeKey.Float64(info.ResponseTime.Seconds()),
+			restyTraceTotalTimeKey.Float64(info.TotalTime.Seconds()),
+			restyTraceConnReusedKey.Bool(info.IsConnReused),
+		)
+	}
+	return attributes, context
+}
+
+func BuildRestyClientOtelInstrumenter() *instrumenter.PropagatingToDownstreamInstrumenter[restyRequest, restyResponse] {
+	builder := instrumenter.Builder[restyRequest, restyResponse]{}
+	clientGetter := restyClientAttrsGetter{}
+	commonExtractor := http.HttpCommonAttrsExtractor[restyRequest, restyResponse, restyClientAttrsGetter, restyClientAttrsGetter]{HttpGetter: clientGetter, NetGetter: clientGetter}
+	networkExtractor := net.NetworkAttrsExtractor[restyRequest, restyResponse, restyClientAttrsGetter]{Getter: clientGetter}
+	return builder.Init().SetSpanStatusExtractor(http.HttpClientSpanStatusExtractor[restyRequest, restyResponse]{Getter: clientGetter}).SetSpanNameExtractor(&http.HttpClientSpanNameExtractor[restyRequest, restyResponse]{Getter: clientGetter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[restyRequest]{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.RESTY_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		AddAttributesExtractor(&http.HttpClientAttrsExtractor[restyRequest, restyResponse, restyClientAttrsGetter, restyClientAttrsGetter]{Base: commonExtractor, NetworkExtractor: networkExtractor}, restyAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(func(r restyRequest) propagation.TextMapCarrier {
+			return propagation.HeaderCarrier(r.header)
+		}, otel.GetTextMapPropagator())
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resty
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/go-resty/resty/v2"
+)
+
+var restyClientInstrumenter = BuildRestyClientOtelInstrumenter()
+
+//go:linkname restyExecuteOnEnter github.com/go-resty/resty/v2.restyExecuteOnEnter
+func restyExecuteOnEnter(call api.CallContext, r *resty.Request, method, rawURL string) {
+	if !restyEnabler.Enable() {
+		return
+	}
+	if r == nil {
+		return
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return
+	}
+	if restyTraceEnabled {
+		r.EnableTrace()
+	}
+	request := restyRequest{
+		method: method,
+		url:    u,
+		header: r.Header,
+	}
+	parentCtx := r.Context()
+	// the http client spans of the attempts and redirects become children of
+	// the resty span through the context of the request
+	ctx := restyClientInstrumenter.Start(parentCtx, request)
+	r.SetContext(ctx)
+	data := make(map[string]interface{}, 4)
+	data["ctx"] = ctx
+	data["parentCtx"] = parentCtx
+	data["request"] = request
+	data["r"] = r
+	call.SetData(data)
+}
+
+//go:linkname restyExecuteOnExit github.com/go-resty/resty/v2.restyExecuteOnExit
+func restyExecuteOnExit(call api.CallContext, resp *resty.Response, err error) {
+	if !restyEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil || data["ctx"] == nil {
+		return
+	}
+	ctx := data["ctx"].(context.Context)
+	request := data["request"].(restyRequest)
+	r := data["r"].(*resty.Request)
+	response := restyResponse{
+		attempts:  r.Attempt,
+		traceInfo: r.TraceInfo(),
+	}
+	if resp != nil && resp.RawResponse != nil {
+		response.statusCode = resp.StatusCode()
+		response.header = resp.Header()
+		response.version = getProtocolVersion(resp.RawResponse.ProtoMajor, resp.RawResponse.ProtoMinor)
+	}
+	restyClientInstrumenter.End(ctx, request, response, err)
+	r.SetContext(data["parentCtx"].(context.Context))
+}
+
+func getProtocolVersion(majorVersion, minorVersion int) string {
+	if majorVersion >= 2 {
+		return strconv.Itoa(majorVersion)
+	}
+	return strconv.Itoa(majorVersion) + "." + strconv.Itoa(minorVersion)
+}
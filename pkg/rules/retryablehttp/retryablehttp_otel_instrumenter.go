@@ -0,0 +1,134 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retryablehttp
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	retryableHttpRetryMaxKey = attribute.Key("retryablehttp.retry_max")
+	retryableHttpAttemptKey  = attribute.Key("retryablehttp.attempt")
+	retryableHttpBackoffKey  = attribute.Key("retryablehttp.backoff")
+)
+
+type retryableHttpInnerEnabler struct {
+	enabled bool
+}
+
+func (r retryableHttpInnerEnabler) Enable() bool {
+	return r.enabled
+}
+
+var retryableHttpEnabler = retryableHttpInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_RETRYABLEHTTP_ENABLED") != "false"}
+
+type retryableHttpSpanNameExtractor struct{}
+
+// Extract returns "retryablehttp {method}", e.g. "retryablehttp GET", so that
+// the logical span is not mistaken for the http client span of an attempt.
+func (r retryableHttpSpanNameExtractor) Extract(request retryableHttpRequest) string {
+	return "retryablehttp " + request.method
+}
+
+// retryableHttpAttrsExtractor does not provide the span key of http clients on
+// purpose, otherwise the http client spans of the attempts would be suppressed.
+type retryableHttpAttrsExtractor struct{}
+
+func (r retryableHttpAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request retryableHttpRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		semconv.HTTPRequestMethodKey.String(request.method),
+		retryableHttpRetryMaxKey.Int(request.retryMax),
+	)
+	if request.url != nil {
+		attributes = append(attributes,
+			semconv.URLFull(request.url.Redacted()),
+			semconv.ServerAddress(request.url.Hostname()),
+		)
+		if port, err := strconv.Atoi(request.url.Port()); err == nil {
+			attributes = append(attributes, semconv.ServerPort(port))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (r retryableHttpAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request retryableHttpRequest, response retryableHttpResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.statusCode > 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	if response.attempts > 1 {
+		attributes = append(attributes, semconv.HTTPRequestResendCount(response.attempts-1))
+	}
+	return attributes, context
+}
+
+type retryableHttpAttemptSpanNameExtractor struct{}
+
+// Extract returns "retryablehttp attempt {attempt}", e.g. "retryablehttp attempt 2".
+func (r retryableHttpAttemptSpanNameExtractor) Extract(request retryableHttpAttempt) string {
+	return "retryablehttp attempt " + strconv.Itoa(request.attempt)
+}
+
+type retryableHttpAttemptAttrsExtractor struct{}
+
+func (r retryableHttpAttemptAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request retryableHttpAttempt) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, retryableHttpAttemptKey.Int(request.attempt))
+	if request.attempt > 1 {
+		attributes = append(attributes,
+			semconv.HTTPRequestResendCount(request.attempt-1),
+			retryableHttpBackoffKey.Float64(request.backoff.Seconds()),
+		)
+	}
+	return attributes, parentContext
+}
+
+func (r retryableHttpAttemptAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request retryableHttpAttempt, response retryableHttpAttemptResult, err error) ([]attribute.KeyValue, context.Context) {
+	if response.statusCode > 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	return attributes, context
+}
+
+func BuildRetryableHttpClientInstrumenter() instrumenter.Instrumenter[retryableHttpRequest, retryableHttpResponse] {
+	builder := instrumenter.Builder[retryableHttpRequest, retryableHttpResponse]{}
+	return builder.Init().SetSpanNameExtractor(retryableHttpSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[retryableHttpRequest]{}).
+		AddAttributesExtractor(retryableHttpAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.RETRYABLE_HTTP_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func BuildRetryableHttpAttemptInstrumenter() instrumenter.Instrumenter[retryableHttpAttempt, retryableHttpAttemptResult] {
+	builder := instrumenter.Builder[retryableHttpAttempt, retryableHttpAttemptResult]{}
+	return builder.Init().SetSpanNameExtractor(retryableHttpAttemptSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[retryableHttpAttempt]{}).
+		AddAttributesExtractor(retryableHttpAttemptAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.RETRYABLE_HTTP_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retryablehttp
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/hashicorp/go-retryablehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var retryableHttpClientInstrumenter = BuildRetryableHttpClientInstrumenter()
+
+var retryableHttpAttemptInstrumenter = BuildRetryableHttpAttemptInstrumenter()
+
+// retryTrackers maps the span ids of the logical span and the attempt spans of
+// running calls to their tracker, so that backoff hooks, which are not given
+// the request, can find the tracker through the span of the current goroutine.
+var retryTrackers sync.Map
+
+func storeRetryTracker(ctx context.Context, tracker *retryTracker) {
+	if spanId := trace.SpanContextFromContext(ctx).SpanID(); spanId.IsValid() {
+		retryTrackers.Store(spanId, tracker)
+	}
+}
+
+func deleteRetryTracker(ctx context.Context) {
+	retryTrackers.Delete(trace.SpanContextFromContext(ctx).SpanID())
+}
+
+type retryTrackerKey struct{}
+
+// retryTracker follows the attempts of one retryablehttp.Client.Do call.
+type retryTracker struct {
+	mu       sync.Mutex
+	request  retryableHttpRequest
+	ctx      context.Context
+	attempts int
+	// attempt is the context of the running attempt, nil between attempts
+	attempt context.Context
+	current retryableHttpAttempt
+}
+
+// begin starts the span of the next attempt, which is sent once the backoff
+// has elapsed.
+func (t *retryTracker) begin(backoff time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.endLocked(nil, nil)
+	t.attempts++
+	t.current = retryableHttpAttempt{attempt: t.attempts, backoff: backoff}
+	t.attempt = retryableHttpAttemptInstrumenter.Start(t.ctx, t.current,
+		trace.WithTimestamp(time.Now().Add(backoff)))
+	storeRetryTracker(t.attempt, t)
+}
+
+// end ends the span of the running attempt, if any.
+func (t *retryTracker) end(resp *http.Response, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.endLocked(resp, err)
+}
+
+func (t *retryTracker) endLocked(resp *http.Response, err error) {
+	if t.attempt == nil {
+		return
+	}
+	result := retryableHttpAttemptResult{}
+	if resp != nil {
+		result.statusCode = resp.StatusCode
+	}
+	deleteRetryTracker(t.attempt)
+	retryableHttpAttemptInstrumenter.End(t.attempt, t.current, result, err)
+	t.attempt = nil
+}
+
+func (t *retryTracker) context() context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.attempt != nil {
+		return t.attempt
+	}
+	return t.ctx
+}
+
+// attemptContext resolves values through the running attempt, as
+// retryablehttp sends every attempt with the same request context, so that
+// the http client span of each attempt becomes a child of its attempt span.
+type attemptContext struct {
+	context.Context
+	tracker *retryTracker
+}
+
+func (a *attemptContext) Value(key any) any {
+	if _, ok := key.(retryTrackerKey); ok {
+		return a.tracker
+	}
+	return a.tracker.context().Value(key)
+}
+
+//go:linkname retryableHttpClientDoOnEnter github.com/hashicorp/go-retryablehttp.retryableHttpClientDoOnEnter
+func retryableHttpClientDoOnEnter(call api.CallContext, c *retryablehttp.Client, req *retryablehttp.Request) {
+	if !retryableHttpEnabler.Enable() {
+		return
+	}
+	if c == nil || req == nil || req.Request == nil {
+		return
+	}
+	request := retryableHttpRequest{
+		method:   req.Method,
+		url:      req.URL,
+		retryMax: c.RetryMax,
+	}
+	tracker := &retryTracker{request: request}
+	tracker.ctx = retryableHttpClientInstrumenter.Start(req.Context(), request)
+	storeRetryTracker(tracker.ctx, tracker)
+	tracker.begin(0)
+	req.Request = req.Request.WithContext(&attemptContext{Context: tracker.ctx, tracker: tracker})
+	call.SetData(tracker)
+}
+
+//go:linkname retryableHttpClientDoOnExit github.com/hashicorp/go-retryablehttp.retryableHttpClientDoOnExit
+func retryableHttpClientDoOnExit(call api.CallContext, resp *http.Response, err error) {
+	if !retryableHttpEnabler.Enable() {
+		return
+	}
+	tracker, ok := call.GetData().(*retryTracker)
+	if !ok || tracker == nil {
+		return
+	}
+	tracker.end(resp, err)
+	deleteRetryTracker(tracker.ctx)
+	response := retryableHttpResponse{attempts: tracker.attempts}
+	if resp != nil {
+		response.statusCode = resp.StatusCode
+	}
+	retryableHttpClientInstrumenter.End(tracker.ctx, tracker.request, response, err)
+}
+
+//go:linkname retryableHttpRetryPolicyOnExit github.com/hashicorp/go-retryablehttp.retryableHttpRetryPolicyOnExit
+func retryableHttpRetryPolicyOnExit(call api.CallContext, shouldRetry bool, err error) {
+	if !retryableHttpEnabler.Enable() {
+		return
+	}
+	ctx, ok := call.GetParam(0).(context.Context)
+	if !ok || ctx == nil {
+		return
+	}
+	tracker, ok := ctx.Value(retryTrackerKey{}).(*retryTracker)
+	if !ok {
+		return
+	}
+	resp, _ := call.GetParam(1).(*http.Response)
+	attemptErr, _ := call.GetParam(2).(error)
+	tracker.end(resp, attemptErr)
+}
+
+//go:linkname retryableHttpBackoffOnExit github.com/hashicorp/go-retryablehttp.retryableHttpBackoffOnExit
+func retryableHttpBackoffOnExit(call api.CallContext, wait time.Duration) {
+	if !retryableHttpEnabler.Enable() {
+		return
+	}
+	span := sdktrace.SpanFromGLS()
+	if span == nil {
+		return
+	}
+	tracker, ok := retryTrackers.Load(span.SpanContext().SpanID())
+	if !ok {
+		return
+	}
+	resp, _ := call.GetParam(3).(*http.Response)
+	tracker.(*retryTracker).end(resp, nil)
+	tracker.(*retryTracker).begin(wait)
+}
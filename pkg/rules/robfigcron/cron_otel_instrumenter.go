@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package robfigcron
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const (
+	cronSpecKey    = attribute.Key("cron.spec")
+	cronJobNameKey = attribute.Key("cron.job.name")
+)
+
+type cronInnerEnabler struct {
+	enabled bool
+}
+
+func (c cronInnerEnabler) Enable() bool {
+	return c.enabled
+}
+
+var cronEnabler = cronInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ROBFIGCRON_ENABLED") != "false"}
+
+type cronSpanNameExtractor struct{}
+
+// Extract returns "cron {cron.job.name}", e.g. "cron main.cleanup".
+func (c cronSpanNameExtractor) Extract(request cronJobRequest) string {
+	return "cron " + request.name
+}
+
+type cronAttrsExtractor struct{}
+
+func (c cronAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request cronJobRequest) ([]attribute.KeyValue, context.Context) {
+	return append(attributes,
+		cronSpecKey.String(request.spec),
+		cronJobNameKey.String(request.name),
+	), parentContext
+}
+
+func (c cronAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request cronJobRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildCronJobInstrumenter() instrumenter.Instrumenter[cronJobRequest, any] {
+	builder := instrumenter.Builder[cronJobRequest, any]{}
+	return builder.Init().SetSpanNameExtractor(cronSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[cronJobRequest]{}).
+		AddAttributesExtractor(cronAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ROBFIG_CRON_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package robfigcron
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var cronJobInstrumenter = BuildCronJobInstrumenter()
+
+//go:linkname cronAddJobOnEnter github.com/robfig/cron/v3.cronAddJobOnEnter
+func cronAddJobOnEnter(call api.CallContext, c *cron.Cron, spec string, cmd cron.Job) {
+	if !cronEnabler.Enable() {
+		return
+	}
+	// only functions are wrapped, as wrapping other jobs would change the type
+	// of Entry.Job that callers may rely on
+	f, ok := cmd.(cron.FuncJob)
+	if !ok || f == nil {
+		return
+	}
+	call.SetParam(2, traceFuncJob(f, cronJobRequest{spec: spec, name: funcJobName(f)}))
+}
+
+// traceFuncJob wraps the function so that every run of it is recorded as a
+// root span, which is ended with an error if the function panics.
+func traceFuncJob(f cron.FuncJob, request cronJobRequest) cron.FuncJob {
+	return func() {
+		ctx := cronJobInstrumenter.Start(context.Background(), request, trace.WithNewRoot())
+		defer func() {
+			if r := recover(); r != nil {
+				cronJobInstrumenter.End(ctx, request, nil, fmt.Errorf("panic: %v", r))
+				panic(r)
+			}
+			cronJobInstrumenter.End(ctx, request, nil, nil)
+		}()
+		f()
+	}
+}
+
+// funcJobName names the job by the function that implements it, e.g.
+// "main.cleanup", or "main.main" for a function literal declared in main.
+func funcJobName(f cron.FuncJob) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = strings.TrimSuffix(name, "-fm")
+	parts := strings.Split(name, ".")
+	for len(parts) > 2 && isClosureSuffix(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, ".")
+}
+
+// isClosureSuffix reports whether the part of a function name is added by the
+// compiler for closures, e.g. "func1" or "2" of "main.func1.2".
+func isClosureSuffix(part string) bool {
+	part = strings.TrimPrefix(part, "func")
+	if part == "" {
+		return false
+	}
+	for _, c := range part {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
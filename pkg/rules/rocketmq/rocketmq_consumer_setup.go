@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocketmq
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type rocketmqConsumeData struct {
+	ctx     context.Context
+	request rocketmqConsumerReq
+}
+
+// rocketmqConsumeParent returns the context of the producer of a single
+// message as the parent of the consume span, the consume span of many
+// messages is linked to the distinct contexts of their producers instead
+func rocketmqConsumeParent(ctx context.Context, msgs []*primitive.MessageExt) (context.Context, []trace.SpanStartOption) {
+	propagator := otel.GetTextMapPropagator()
+	if len(msgs) == 1 {
+		return propagator.Extract(ctx, rocketmqMessageCarrier{msgs: []*primitive.Message{&msgs[0].Message}}), nil
+	}
+	var links []trace.Link
+	seen := make(map[trace.SpanID]struct{})
+	for _, msg := range msgs {
+		carrier := rocketmqMessageCarrier{msgs: []*primitive.Message{&msg.Message}}
+		sc := trace.SpanContextFromContext(propagator.Extract(context.Background(), carrier))
+		if !sc.IsValid() {
+			continue
+		}
+		if _, ok := seen[sc.SpanID()]; ok {
+			continue
+		}
+		seen[sc.SpanID()] = struct{}{}
+		links = append(links, trace.Link{SpanContext: sc})
+	}
+	return ctx, []trace.SpanStartOption{trace.WithLinks(links...)}
+}
+
+// beforeRocketmqConsume traces the consume function registered by Subscribe
+// of a push consumer, the context passed to it carries the consume span so
+// that the spans of the application are its children
+//
+//go:linkname beforeRocketmqConsume github.com/apache/rocketmq-client-go/v2/consumer.beforeRocketmqConsume
+func beforeRocketmqConsume(call api.CallContext, pc interface{}, ctx context.Context, subMsgs []*primitive.MessageExt) {
+	if !rocketmqEnabler.Enable() || len(subMsgs) == 0 || subMsgs[0] == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := rocketmqConsumerReq{msgs: subMsgs}
+	if consumeCtx, ok := primitive.GetConsumerCtx(ctx); ok {
+		request.group = consumeCtx.ConsumerGroup
+	}
+	parentContext, options := rocketmqConsumeParent(ctx, subMsgs)
+	spanContext := rocketmqConsumerInstrumenter.Start(parentContext, request, options...)
+	call.SetParam(1, spanContext)
+	call.SetData(rocketmqConsumeData{ctx: spanContext, request: request})
+}
+
+//go:linkname afterRocketmqConsume github.com/apache/rocketmq-client-go/v2/consumer.afterRocketmqConsume
+func afterRocketmqConsume(call api.CallContext, result consumer.ConsumeResult, err error) {
+	data, ok := call.GetData().(rocketmqConsumeData)
+	if !ok {
+		return
+	}
+	rocketmqConsumerInstrumenter.End(data.ctx, data.request, result, err)
+}
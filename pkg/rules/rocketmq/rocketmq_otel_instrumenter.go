@@ -0,0 +1,344 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocketmq
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var rocketmqEnabler = rocketmqInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_ROCKETMQ_ENABLED") != "false"}
+
+var (
+	rocketmqProducerInstrumenter = buildRocketmqProducerInstrumenter()
+	rocketmqConsumerInstrumenter = buildRocketmqConsumerInstrumenter()
+)
+
+var errRocketmqRetryLater = errors.New("consume retry later")
+
+type rocketmqInnerEnabler struct {
+	enabled bool
+}
+
+func (r rocketmqInnerEnabler) Enable() bool {
+	return r.enabled
+}
+
+// rocketmqMessageCarrier injects the trace context into the properties of
+// all the messages of a send and extracts it from the properties of the first
+// one
+type rocketmqMessageCarrier struct {
+	msgs []*primitive.Message
+}
+
+func (carrier rocketmqMessageCarrier) Get(key string) string {
+	if len(carrier.msgs) == 0 {
+		return ""
+	}
+	return carrier.msgs[0].GetProperty(key)
+}
+
+func (carrier rocketmqMessageCarrier) Set(key, value string) {
+	for _, msg := range carrier.msgs {
+		msg.WithProperty(key, value)
+	}
+}
+
+func (carrier rocketmqMessageCarrier) Keys() []string {
+	if len(carrier.msgs) == 0 {
+		return nil
+	}
+	properties := carrier.msgs[0].GetProperties()
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+type rocketmqProducerStatusExtractor struct{}
+
+func (r *rocketmqProducerStatusExtractor) Extract(span trace.Span, request rocketmqProducerReq, response *primitive.SendResult, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// rocketmqConsumerStatusExtractor also marks a consume asking for the
+// messages to be redelivered later as failed
+type rocketmqConsumerStatusExtractor struct{}
+
+func (r *rocketmqConsumerStatusExtractor) Extract(span trace.Span, request rocketmqConsumerReq, response consumer.ConsumeResult, err error) {
+	if err == nil && response == consumer.ConsumeRetryLater {
+		err = errRocketmqRetryLater
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type rocketmqProducerAttrsGetter struct{}
+
+func (getter rocketmqProducerAttrsGetter) GetSystem(request rocketmqProducerReq) string {
+	return "rocketmq"
+}
+
+func (getter rocketmqProducerAttrsGetter) GetDestination(request rocketmqProducerReq) string {
+	return request.msgs[0].Topic
+}
+
+func (getter rocketmqProducerAttrsGetter) GetDestinationTemplate(request rocketmqProducerReq) string {
+	return ""
+}
+
+func (getter rocketmqProducerAttrsGetter) IsTemporaryDestination(request rocketmqProducerReq) bool {
+	return false
+}
+
+func (getter rocketmqProducerAttrsGetter) IsAnonymousDestination(request rocketmqProducerReq) bool {
+	return false
+}
+
+func (getter rocketmqProducerAttrsGetter) GetConversationId(request rocketmqProducerReq) string {
+	return ""
+}
+
+func (getter rocketmqProducerAttrsGetter) GetMessageBodySize(request rocketmqProducerReq) int64 {
+	var size int64
+	for _, msg := range request.msgs {
+		size += int64(len(msg.Body))
+	}
+	return size
+}
+
+func (getter rocketmqProducerAttrsGetter) GetMessageEnvelopSize(request rocketmqProducerReq) int64 {
+	return 0
+}
+
+func (getter rocketmqProducerAttrsGetter) GetMessageId(request rocketmqProducerReq, response *primitive.SendResult) string {
+	if response == nil {
+		return ""
+	}
+	return response.MsgID
+}
+
+func (getter rocketmqProducerAttrsGetter) GetClientId(request rocketmqProducerReq) string {
+	return ""
+}
+
+func (getter rocketmqProducerAttrsGetter) GetBatchMessageCount(request rocketmqProducerReq, response *primitive.SendResult) int64 {
+	return int64(len(request.msgs))
+}
+
+func (getter rocketmqProducerAttrsGetter) GetMessageHeader(request rocketmqProducerReq, name string) []string {
+	var values []string
+	for _, msg := range request.msgs {
+		if value := msg.GetProperty(name); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// GetDestinationPartitionId returns nothing as the queue of a sent message is
+// only selected when it is sent, see rocketmqProducerAttrsExtractor
+func (getter rocketmqProducerAttrsGetter) GetDestinationPartitionId(request rocketmqProducerReq) string {
+	return ""
+}
+
+type rocketmqConsumerAttrsGetter struct{}
+
+func (getter rocketmqConsumerAttrsGetter) GetSystem(request rocketmqConsumerReq) string {
+	return "rocketmq"
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetDestination(request rocketmqConsumerReq) string {
+	return request.msgs[0].Topic
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetDestinationTemplate(request rocketmqConsumerReq) string {
+	return ""
+}
+
+func (getter rocketmqConsumerAttrsGetter) IsTemporaryDestination(request rocketmqConsumerReq) bool {
+	return false
+}
+
+func (getter rocketmqConsumerAttrsGetter) IsAnonymousDestination(request rocketmqConsumerReq) bool {
+	return false
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetConversationId(request rocketmqConsumerReq) string {
+	return ""
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetMessageBodySize(request rocketmqConsumerReq) int64 {
+	var size int64
+	for _, msg := range request.msgs {
+		size += int64(len(msg.Body))
+	}
+	return size
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetMessageEnvelopSize(request rocketmqConsumerReq) int64 {
+	return 0
+}
+
+// GetMessageId returns the id of the message only for a consume of a single
+// message
+func (getter rocketmqConsumerAttrsGetter) GetMessageId(request rocketmqConsumerReq, response consumer.ConsumeResult) string {
+	if len(request.msgs) != 1 {
+		return ""
+	}
+	return request.msgs[0].MsgId
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetClientId(request rocketmqConsumerReq) string {
+	return ""
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetBatchMessageCount(request rocketmqConsumerReq, response consumer.ConsumeResult) int64 {
+	return int64(len(request.msgs))
+}
+
+func (getter rocketmqConsumerAttrsGetter) GetMessageHeader(request rocketmqConsumerReq, name string) []string {
+	var values []string
+	for _, msg := range request.msgs {
+		if value := msg.GetProperty(name); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// GetDestinationPartitionId returns the queue of the messages, which are
+// always pulled from a single queue
+func (getter rocketmqConsumerAttrsGetter) GetDestinationPartitionId(request rocketmqConsumerReq) string {
+	if request.msgs[0].Queue == nil {
+		return ""
+	}
+	return strconv.Itoa(request.msgs[0].Queue.QueueId)
+}
+
+// rocketmqMessageAttrs records the tag and keys of a message
+func rocketmqMessageAttrs(attributes []attribute.KeyValue, msg *primitive.Message) []attribute.KeyValue {
+	if tag := msg.GetTags(); tag != "" {
+		attributes = append(attributes, semconv.MessagingRocketmqMessageTag(tag))
+	}
+	if keys := strings.Fields(msg.GetKeys()); len(keys) > 0 {
+		attributes = append(attributes, semconv.MessagingRocketmqMessageKeys(keys...))
+	}
+	return attributes
+}
+
+// rocketmqProducerAttrsExtractor records the tag and keys of a sent message
+// and the queue it is sent to, the tag and keys of a batch are not recorded
+type rocketmqProducerAttrsExtractor struct{}
+
+func (extractor *rocketmqProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request rocketmqProducerReq) ([]attribute.KeyValue, context.Context) {
+	if len(request.msgs) == 1 {
+		attributes = rocketmqMessageAttrs(attributes, request.msgs[0])
+	}
+	return attributes, parentContext
+}
+
+func (extractor *rocketmqProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request rocketmqProducerReq, response *primitive.SendResult, err error) ([]attribute.KeyValue, context.Context) {
+	if err != nil || response == nil || response.MessageQueue == nil {
+		return attributes, ctx
+	}
+	return append(attributes, semconv.MessagingDestinationPartitionID(strconv.Itoa(response.MessageQueue.QueueId))), ctx
+}
+
+// rocketmqConsumerAttrsExtractor records the consumer group of the consumed
+// messages, the tag and keys are only recorded for a single message
+type rocketmqConsumerAttrsExtractor struct{}
+
+func (extractor *rocketmqConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request rocketmqConsumerReq) ([]attribute.KeyValue, context.Context) {
+	if request.group != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.group))
+	}
+	if len(request.msgs) == 1 {
+		attributes = rocketmqMessageAttrs(attributes, &request.msgs[0].Message)
+	}
+	return attributes, parentContext
+}
+
+func (extractor *rocketmqConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request rocketmqConsumerReq, response consumer.ConsumeResult, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func buildRocketmqProducerInstrumenter() instrumenter.Instrumenter[rocketmqProducerReq, *primitive.SendResult] {
+	builder := instrumenter.Builder[rocketmqProducerReq, *primitive.SendResult]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ROCKETMQ_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[rocketmqProducerReq, *primitive.SendResult]{
+			Getter:        rocketmqProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[rocketmqProducerReq]{}).
+		SetSpanStatusExtractor(&rocketmqProducerStatusExtractor{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[rocketmqProducerReq, *primitive.SendResult, rocketmqProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&rocketmqProducerAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request rocketmqProducerReq) propagation.TextMapCarrier {
+				return rocketmqMessageCarrier{msgs: request.msgs}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+// the parent of a consume span is extracted by the hook as the messages of
+// a batch may come from many producers, see rocketmqConsumeParent
+func buildRocketmqConsumerInstrumenter() instrumenter.Instrumenter[rocketmqConsumerReq, consumer.ConsumeResult] {
+	builder := instrumenter.Builder[rocketmqConsumerReq, consumer.ConsumeResult]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.ROCKETMQ_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[rocketmqConsumerReq, consumer.ConsumeResult]{
+			Getter:        rocketmqConsumerAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[rocketmqConsumerReq]{}).
+		SetSpanStatusExtractor(&rocketmqConsumerStatusExtractor{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[rocketmqConsumerReq, consumer.ConsumeResult, rocketmqConsumerAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&rocketmqConsumerAttrsExtractor{}).
+		BuildInstrumenter()
+}
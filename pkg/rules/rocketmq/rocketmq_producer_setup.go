@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocketmq
+
+import (
+	"context"
+	"sync"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type rocketmqSendData struct {
+	ctx     context.Context
+	request rocketmqProducerReq
+}
+
+//go:linkname beforeRocketmqSendSync github.com/apache/rocketmq-client-go/v2/producer.beforeRocketmqSendSync
+func beforeRocketmqSendSync(call api.CallContext, p interface{}, ctx context.Context, msgs ...*primitive.Message) {
+	if !rocketmqEnabler.Enable() || len(msgs) == 0 || msgs[0] == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := rocketmqProducerReq{msgs: msgs}
+	call.SetData(rocketmqSendData{
+		ctx:     rocketmqProducerInstrumenter.Start(ctx, request),
+		request: request,
+	})
+}
+
+//go:linkname afterRocketmqSendSync github.com/apache/rocketmq-client-go/v2/producer.afterRocketmqSendSync
+func afterRocketmqSendSync(call api.CallContext, result *primitive.SendResult, err error) {
+	data, ok := call.GetData().(rocketmqSendData)
+	if !ok {
+		return
+	}
+	rocketmqProducerInstrumenter.End(data.ctx, data.request, result, err)
+}
+
+// rocketmqAsyncSend ends the span of an asynchronous send exactly once, either
+// in the callback or when the send fails before the request is written
+type rocketmqAsyncSend struct {
+	rocketmqSendData
+	once sync.Once
+}
+
+func (s *rocketmqAsyncSend) end(result *primitive.SendResult, err error) {
+	s.once.Do(func() {
+		rocketmqProducerInstrumenter.End(s.ctx, s.request, result, err)
+	})
+}
+
+// beforeRocketmqSendAsync ends the span in the callback, which is called by
+// the goroutine reading the response of the broker, so the span is detached
+// from the calling goroutine
+//
+//go:linkname beforeRocketmqSendAsync github.com/apache/rocketmq-client-go/v2/producer.beforeRocketmqSendAsync
+func beforeRocketmqSendAsync(call api.CallContext, p interface{}, ctx context.Context, f func(context.Context, *primitive.SendResult, error), msgs ...*primitive.Message) {
+	if !rocketmqEnabler.Enable() || len(msgs) == 0 || msgs[0] == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := rocketmqProducerReq{msgs: msgs}
+	spanContext := rocketmqProducerInstrumenter.Start(ctx, request)
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(spanContext))
+	send := &rocketmqAsyncSend{rocketmqSendData: rocketmqSendData{ctx: spanContext, request: request}}
+	call.SetParam(2, func(ctx context.Context, result *primitive.SendResult, err error) {
+		send.end(result, err)
+		if f != nil {
+			f(ctx, result, err)
+		}
+	})
+	call.SetData(send)
+}
+
+//go:linkname afterRocketmqSendAsync github.com/apache/rocketmq-client-go/v2/producer.afterRocketmqSendAsync
+func afterRocketmqSendAsync(call api.CallContext, err error) {
+	send, ok := call.GetData().(*rocketmqAsyncSend)
+	if !ok || err == nil {
+		return
+	}
+	send.end(nil, err)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rueidis
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type rueidisAttrsGetter struct {
+}
+
+func (r rueidisAttrsGetter) GetSystem(request *rueidisRequest) string {
+	return "redis"
+}
+
+func (r rueidisAttrsGetter) GetServerAddress(request *rueidisRequest) string {
+	return request.endpoint
+}
+
+func (r rueidisAttrsGetter) GetStatement(request *rueidisRequest) string {
+	return request.statement
+}
+
+func (r rueidisAttrsGetter) GetOperation(request *rueidisRequest) string {
+	return request.operation
+}
+
+func (r rueidisAttrsGetter) GetCollection(request *rueidisRequest) string {
+	return ""
+}
+
+func (r rueidisAttrsGetter) GetParameters(request *rueidisRequest) []any {
+	return nil
+}
+
+func (r rueidisAttrsGetter) GetDbNamespace(request *rueidisRequest) string {
+	return ""
+}
+
+func (r rueidisAttrsGetter) GetBatchSize(request *rueidisRequest) int {
+	return request.batchSize
+}
+
+type rueidisAttrsExtractor struct {
+}
+
+func (r rueidisAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request *rueidisRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, attribute.Int("rueidis.key.count", request.keyCount))
+	return attributes, parentContext
+}
+
+func (r rueidisAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request *rueidisRequest, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if !request.cached {
+		return attributes, context
+	}
+	if request.batchSize > 0 {
+		attributes = append(attributes, attribute.Int("rueidis.cache.hit.count", request.cacheHits))
+	} else {
+		attributes = append(attributes, attribute.Bool("rueidis.cache.hit", request.cacheHits > 0))
+	}
+	return attributes, context
+}
+
+func BuildRueidisOtelInstrumenter() instrumenter.Instrumenter[*rueidisRequest, any] {
+	builder := instrumenter.Builder[*rueidisRequest, any]{}
+	getter := rueidisAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[*rueidisRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[*rueidisRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[*rueidisRequest, any, db.DbClientAttrsGetter[*rueidisRequest]]{Base: db.DbClientCommonAttrsExtractor[*rueidisRequest, any, db.DbClientAttrsGetter[*rueidisRequest]]{Getter: getter}}, rueidisAttrsExtractor{}).
+		AddOperationListeners(db.DbClientMetrics("nosql.rueidis")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.RUEIDIS_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
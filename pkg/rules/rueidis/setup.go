@@ -0,0 +1,350 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rueidis
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/redis/rueidis"
+)
+
+var rueidisInstrumenter = BuildRueidisOtelInstrumenter()
+
+type rueidisInnerEnabler struct {
+	enabled bool
+}
+
+func (r rueidisInnerEnabler) Enable() bool {
+	return r.enabled
+}
+
+var rueidisEnabler = rueidisInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_RUEIDIS_ENABLED") != "false"}
+
+// Nodes() of the cluster client allocates a new map on every call, so the
+// endpoint of each client is only resolved once
+var rueidisEndpoints sync.Map
+
+// only the first few command names of a pipeline are put into the statement
+const rueidisMaxPipelineCommands = 10
+
+type rueidisNodes interface {
+	Nodes() map[string]rueidis.Client
+}
+
+func getRueidisEndpoint(client interface{}) string {
+	if endpoint, ok := rueidisEndpoints.Load(client); ok {
+		return endpoint.(string)
+	}
+	nodes, ok := client.(rueidisNodes)
+	if !ok {
+		return ""
+	}
+	addrs := make([]string, 0)
+	for addr := range nodes.Nodes() {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	endpoint := strings.Join(addrs, ",")
+	rueidisEndpoints.Store(client, endpoint)
+	return endpoint
+}
+
+// getRueidisKeyCount guesses how many keys a command touches from its
+// arguments, commands that are unknown are considered as single-key ones
+func getRueidisKeyCount(args []string) int {
+	if len(args) == 0 {
+		return 0
+	}
+	switch strings.ToUpper(args[0]) {
+	case "PING", "ECHO", "AUTH", "HELLO", "SELECT", "INFO", "CLIENT", "CLUSTER", "CONFIG",
+		"DBSIZE", "FLUSHALL", "FLUSHDB", "MULTI", "EXEC", "DISCARD", "UNWATCH", "SCAN",
+		"PUBLISH", "SPUBLISH", "SUBSCRIBE", "SSUBSCRIBE", "PSUBSCRIBE", "UNSUBSCRIBE",
+		"SUNSUBSCRIBE", "PUNSUBSCRIBE", "SCRIPT", "FUNCTION", "TIME", "QUIT", "KEYS", "RANDOMKEY":
+		return 0
+	case "MGET", "DEL", "UNLINK", "EXISTS", "TOUCH", "WATCH", "SINTER", "SUNION", "SDIFF",
+		"PFCOUNT", "PFMERGE":
+		return len(args) - 1
+	case "MSET", "MSETNX":
+		return (len(args) - 1) / 2
+	case "EVAL", "EVALSHA", "EVAL_RO", "EVALSHA_RO", "FCALL", "FCALL_RO":
+		if len(args) < 3 {
+			return 0
+		}
+		numKeys, err := strconv.Atoi(args[2])
+		if err != nil || numKeys < 0 {
+			return 0
+		}
+		return numKeys
+	}
+	if len(args) < 2 {
+		return 0
+	}
+	return 1
+}
+
+func newRueidisCommandRequest(client interface{}, args []string) *rueidisRequest {
+	request := &rueidisRequest{
+		statement: strings.Join(args, " "),
+		endpoint:  getRueidisEndpoint(client),
+		keyCount:  getRueidisKeyCount(args),
+	}
+	if len(args) > 0 {
+		request.operation = strings.ToLower(args[0])
+	}
+	return request
+}
+
+func newRueidisPipelineRequest(client interface{}, commands [][]string) *rueidisRequest {
+	request := &rueidisRequest{
+		operation: "pipeline",
+		endpoint:  getRueidisEndpoint(client),
+		batchSize: len(commands),
+	}
+	names := make([]string, 0, rueidisMaxPipelineCommands)
+	for i, args := range commands {
+		request.keyCount += getRueidisKeyCount(args)
+		if i < rueidisMaxPipelineCommands && len(args) > 0 {
+			names = append(names, strings.ToLower(args[0]))
+		}
+	}
+	request.statement = strings.Join(names, "/")
+	return request
+}
+
+// the commands are recycled once they are sent, their arguments must be
+// copied before executing
+func copyRueidisArgs(args []string) []string {
+	return append(make([]string, 0, len(args)), args...)
+}
+
+func getRueidisError(result rueidis.RedisResult) error {
+	err := result.Error()
+	if err == nil || rueidis.IsRedisNil(err) {
+		return nil
+	}
+	return err
+}
+
+func startRueidis(call api.CallContext, ctx context.Context, request *rueidisRequest) {
+	newCtx := rueidisInstrumenter.Start(ctx, request)
+	call.SetKeyData("ctx", newCtx)
+	call.SetKeyData("request", request)
+}
+
+func endRueidis(call api.CallContext, results []rueidis.RedisResult) {
+	newCtx, ok := call.GetKeyData("ctx").(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := call.GetKeyData("request").(*rueidisRequest)
+	if !ok {
+		return
+	}
+	var err error
+	for _, result := range results {
+		if request.cached && result.IsCacheHit() {
+			request.cacheHits++
+		}
+		if err == nil {
+			err = getRueidisError(result)
+		}
+	}
+	rueidisInstrumenter.End(newCtx, request, nil, err)
+}
+
+func beforeRueidisDo(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Completed) {
+	if !rueidisEnabler.Enable() {
+		return
+	}
+	startRueidis(call, ctx, newRueidisCommandRequest(client, copyRueidisArgs(cmd.Commands())))
+}
+
+func beforeRueidisDoMulti(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.Completed) {
+	if !rueidisEnabler.Enable() || len(multi) == 0 {
+		return
+	}
+	commands := make([][]string, len(multi))
+	for i := range multi {
+		commands[i] = copyRueidisArgs(multi[i].Commands())
+	}
+	startRueidis(call, ctx, newRueidisPipelineRequest(client, commands))
+}
+
+func beforeRueidisDoCache(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Cacheable) {
+	if !rueidisEnabler.Enable() {
+		return
+	}
+	request := newRueidisCommandRequest(client, copyRueidisArgs(cmd.Commands()))
+	request.cached = true
+	startRueidis(call, ctx, request)
+}
+
+func beforeRueidisDoMultiCache(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.CacheableTTL) {
+	if !rueidisEnabler.Enable() || len(multi) == 0 {
+		return
+	}
+	commands := make([][]string, len(multi))
+	for i := range multi {
+		commands[i] = copyRueidisArgs(multi[i].Cmd.Commands())
+	}
+	request := newRueidisPipelineRequest(client, commands)
+	request.cached = true
+	startRueidis(call, ctx, request)
+}
+
+func afterRueidisDo(call api.CallContext, resp rueidis.RedisResult) {
+	if !rueidisEnabler.Enable() {
+		return
+	}
+	endRueidis(call, []rueidis.RedisResult{resp})
+}
+
+func afterRueidisDoMulti(call api.CallContext, resps []rueidis.RedisResult) {
+	if !rueidisEnabler.Enable() {
+		return
+	}
+	endRueidis(call, resps)
+}
+
+// The hooks below are bound to the single, cluster and sentinel clients
+// respectively, hook names must be unique within the rueidis package.
+
+//go:linkname beforeRueidisSingleDo github.com/redis/rueidis.beforeRueidisSingleDo
+func beforeRueidisSingleDo(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Completed) {
+	beforeRueidisDo(call, client, ctx, cmd)
+}
+
+//go:linkname afterRueidisSingleDo github.com/redis/rueidis.afterRueidisSingleDo
+func afterRueidisSingleDo(call api.CallContext, resp rueidis.RedisResult) {
+	afterRueidisDo(call, resp)
+}
+
+//go:linkname beforeRueidisSingleDoMulti github.com/redis/rueidis.beforeRueidisSingleDoMulti
+func beforeRueidisSingleDoMulti(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.Completed) {
+	beforeRueidisDoMulti(call, client, ctx, multi...)
+}
+
+//go:linkname afterRueidisSingleDoMulti github.com/redis/rueidis.afterRueidisSingleDoMulti
+func afterRueidisSingleDoMulti(call api.CallContext, resps []rueidis.RedisResult) {
+	afterRueidisDoMulti(call, resps)
+}
+
+//go:linkname beforeRueidisSingleDoCache github.com/redis/rueidis.beforeRueidisSingleDoCache
+func beforeRueidisSingleDoCache(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Cacheable, ttl time.Duration) {
+	beforeRueidisDoCache(call, client, ctx, cmd)
+}
+
+//go:linkname afterRueidisSingleDoCache github.com/redis/rueidis.afterRueidisSingleDoCache
+func afterRueidisSingleDoCache(call api.CallContext, resp rueidis.RedisResult) {
+	afterRueidisDo(call, resp)
+}
+
+//go:linkname beforeRueidisSingleDoMultiCache github.com/redis/rueidis.beforeRueidisSingleDoMultiCache
+func beforeRueidisSingleDoMultiCache(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.CacheableTTL) {
+	beforeRueidisDoMultiCache(call, client, ctx, multi...)
+}
+
+//go:linkname afterRueidisSingleDoMultiCache github.com/redis/rueidis.afterRueidisSingleDoMultiCache
+func afterRueidisSingleDoMultiCache(call api.CallContext, resps []rueidis.RedisResult) {
+	afterRueidisDoMulti(call, resps)
+}
+
+//go:linkname beforeRueidisClusterDo github.com/redis/rueidis.beforeRueidisClusterDo
+func beforeRueidisClusterDo(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Completed) {
+	beforeRueidisDo(call, client, ctx, cmd)
+}
+
+//go:linkname afterRueidisClusterDo github.com/redis/rueidis.afterRueidisClusterDo
+func afterRueidisClusterDo(call api.CallContext, resp rueidis.RedisResult) {
+	afterRueidisDo(call, resp)
+}
+
+//go:linkname beforeRueidisClusterDoMulti github.com/redis/rueidis.beforeRueidisClusterDoMulti
+func beforeRueidisClusterDoMulti(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.Completed) {
+	beforeRueidisDoMulti(call, client, ctx, multi...)
+}
+
+//go:linkname afterRueidisClusterDoMulti github.com/redis/rueidis.afterRueidisClusterDoMulti
+func afterRueidisClusterDoMulti(call api.CallContext, resps []rueidis.RedisResult) {
+	afterRueidisDoMulti(call, resps)
+}
+
+//go:linkname beforeRueidisClusterDoCache github.com/redis/rueidis.beforeRueidisClusterDoCache
+func beforeRueidisClusterDoCache(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Cacheable, ttl time.Duration) {
+	beforeRueidisDoCache(call, client, ctx, cmd)
+}
+
+//go:linkname afterRueidisClusterDoCache github.com/redis/rueidis.afterRueidisClusterDoCache
+func afterRueidisClusterDoCache(call api.CallContext, resp rueidis.RedisResult) {
+	afterRueidisDo(call, resp)
+}
+
+//go:linkname beforeRueidisClusterDoMultiCache github.com/redis/rueidis.beforeRueidisClusterDoMultiCache
+func beforeRueidisClusterDoMultiCache(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.CacheableTTL) {
+	beforeRueidisDoMultiCache(call, client, ctx, multi...)
+}
+
+//go:linkname afterRueidisClusterDoMultiCache github.com/redis/rueidis.afterRueidisClusterDoMultiCache
+func afterRueidisClusterDoMultiCache(call api.CallContext, resps []rueidis.RedisResult) {
+	afterRueidisDoMulti(call, resps)
+}
+
+//go:linkname beforeRueidisSentinelDo github.com/redis/rueidis.beforeRueidisSentinelDo
+func beforeRueidisSentinelDo(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Completed) {
+	beforeRueidisDo(call, client, ctx, cmd)
+}
+
+//go:linkname afterRueidisSentinelDo github.com/redis/rueidis.afterRueidisSentinelDo
+func afterRueidisSentinelDo(call api.CallContext, resp rueidis.RedisResult) {
+	afterRueidisDo(call, resp)
+}
+
+//go:linkname beforeRueidisSentinelDoMulti github.com/redis/rueidis.beforeRueidisSentinelDoMulti
+func beforeRueidisSentinelDoMulti(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.Completed) {
+	beforeRueidisDoMulti(call, client, ctx, multi...)
+}
+
+//go:linkname afterRueidisSentinelDoMulti github.com/redis/rueidis.afterRueidisSentinelDoMulti
+func afterRueidisSentinelDoMulti(call api.CallContext, resps []rueidis.RedisResult) {
+	afterRueidisDoMulti(call, resps)
+}
+
+//go:linkname beforeRueidisSentinelDoCache github.com/redis/rueidis.beforeRueidisSentinelDoCache
+func beforeRueidisSentinelDoCache(call api.CallContext, client interface{}, ctx context.Context, cmd rueidis.Cacheable, ttl time.Duration) {
+	beforeRueidisDoCache(call, client, ctx, cmd)
+}
+
+//go:linkname afterRueidisSentinelDoCache github.com/redis/rueidis.afterRueidisSentinelDoCache
+func afterRueidisSentinelDoCache(call api.CallContext, resp rueidis.RedisResult) {
+	afterRueidisDo(call, resp)
+}
+
+//go:linkname beforeRueidisSentinelDoMultiCache github.com/redis/rueidis.beforeRueidisSentinelDoMultiCache
+func beforeRueidisSentinelDoMultiCache(call api.CallContext, client interface{}, ctx context.Context, multi ...rueidis.CacheableTTL) {
+	beforeRueidisDoMultiCache(call, client, ctx, multi...)
+}
+
+//go:linkname afterRueidisSentinelDoMultiCache github.com/redis/rueidis.afterRueidisSentinelDoMultiCache
+func afterRueidisSentinelDoMultiCache(call api.CallContext, resps []rueidis.RedisResult) {
+	afterRueidisDoMulti(call, resps)
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarama
+
+import (
+	"context"
+	"sync"
+	_ "unsafe"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+// saramaConsumerGroups maps a ConsumerGroup to its group id
+var saramaConsumerGroups sync.Map
+
+// saramaConsumerGroupHandler hands a traced claim to the handler of the user
+type saramaConsumerGroupHandler struct {
+	sarama.ConsumerGroupHandler
+	groupID string
+}
+
+func (h *saramaConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	tracedClaim := newSaramaConsumerGroupClaim(session, claim, h.groupID)
+	// the goroutine of the handler sees the span of the message it processes
+	span := &saramaClaimSpan{claim: tracedClaim}
+	sdktrace.AttachSpanToGLS(span)
+	defer sdktrace.DetachSpanFromGLS(span)
+	defer tracedClaim.stop()
+	return h.ConsumerGroupHandler.ConsumeClaim(session, tracedClaim)
+}
+
+// saramaConsumerGroupClaim forwards the messages of a claim to the handler,
+// a message is processed from the moment the handler receives it until it
+// asks for the next one or returns from ConsumeClaim.
+//
+// A receive from a channel can not be hooked, the span of a message is
+// therefore started by the forwarder once the handler received it, and the
+// handler asks for the next message either by receiving it or by calling
+// Messages() again, as a handler selecting on the messages does. A message
+// the forwarder holds when the handler returns is not delivered, it is left
+// unmarked like the messages sarama buffered for the claim, so the group
+// consumes it again.
+type saramaConsumerGroupClaim struct {
+	sarama.ConsumerGroupClaim
+	ctx      context.Context
+	groupID  string
+	messages chan *sarama.ConsumerMessage
+	done     chan struct{}
+	stopped  chan struct{}
+
+	mu sync.Mutex
+	// the span of the message being processed
+	current context.Context
+	request saramaConsumerReq
+}
+
+func newSaramaConsumerGroupClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim, groupID string) *saramaConsumerGroupClaim {
+	c := &saramaConsumerGroupClaim{
+		ConsumerGroupClaim: claim,
+		ctx:                session.Context(),
+		groupID:            groupID,
+		messages:           make(chan *sarama.ConsumerMessage),
+		done:               make(chan struct{}),
+		stopped:            make(chan struct{}),
+	}
+	go c.forward()
+	return c
+}
+
+func (c *saramaConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage {
+	c.end()
+	return c.messages
+}
+
+func (c *saramaConsumerGroupClaim) forward() {
+	defer close(c.stopped)
+	defer close(c.messages)
+	upstream := c.ConsumerGroupClaim.Messages()
+	for {
+		select {
+		case msg, ok := <-upstream:
+			if !ok {
+				return
+			}
+			select {
+			case c.messages <- msg:
+				c.start(msg)
+			case <-c.done:
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// start ends the span of the previous message and starts the one of the
+// message the handler received
+func (c *saramaConsumerGroupClaim) start(msg *sarama.ConsumerMessage) {
+	parentContext := c.ctx
+	var startOptions []trace.SpanStartOption
+	if !trace.SpanContextFromContext(parentContext).IsValid() {
+		startOptions = append(startOptions, trace.WithNewRoot())
+	}
+	producerContext := otel.GetTextMapPropagator().Extract(context.Background(), saramaConsumerCarrier{msg: msg})
+	if sc := trace.SpanContextFromContext(producerContext); sc.IsValid() {
+		startOptions = append(startOptions, trace.WithLinks(trace.Link{SpanContext: sc}))
+	}
+	request := saramaConsumerReq{msg: msg, groupID: c.groupID}
+	ctx := saramaConsumerInstrumenter.Start(parentContext, request, startOptions...)
+	// the span is current in the goroutine of the handler, not the forwarder
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(ctx))
+	c.mu.Lock()
+	prevContext, prevRequest := c.current, c.request
+	c.current, c.request = ctx, request
+	c.mu.Unlock()
+	if prevContext != nil {
+		saramaConsumerInstrumenter.End(prevContext, prevRequest, nil, nil)
+	}
+}
+
+// end ends the span of the message being processed, if any
+func (c *saramaConsumerGroupClaim) end() {
+	c.mu.Lock()
+	ctx, request := c.current, c.request
+	c.current = nil
+	c.mu.Unlock()
+	if ctx != nil {
+		saramaConsumerInstrumenter.End(ctx, request, nil, nil)
+	}
+}
+
+// span returns the span of the message being processed, or an invalid span
+// while the handler waits for a message
+func (c *saramaConsumerGroupClaim) span() trace.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return trace.SpanFromContext(c.current)
+}
+
+// stop is called once the handler returns, which ends the processing of the
+// last message it received
+func (c *saramaConsumerGroupClaim) stop() {
+	close(c.done)
+	<-c.stopped
+	c.end()
+}
+
+// saramaClaimSpan stands for the span of the message the handler processes
+// in the GLS of its goroutine, so that the spans the handler starts are the
+// children of the message. It is never ended by the handler, the claim ends
+// the span of every message.
+type saramaClaimSpan struct {
+	embedded.Span
+	claim *saramaConsumerGroupClaim
+}
+
+func (s *saramaClaimSpan) End(options ...trace.SpanEndOption) {}
+
+func (s *saramaClaimSpan) AddEvent(name string, options ...trace.EventOption) {
+	s.claim.span().AddEvent(name, options...)
+}
+
+func (s *saramaClaimSpan) AddLink(link trace.Link) {
+	s.claim.span().AddLink(link)
+}
+
+func (s *saramaClaimSpan) IsRecording() bool {
+	return s.claim.span().IsRecording()
+}
+
+func (s *saramaClaimSpan) RecordError(err error, options ...trace.EventOption) {
+	s.claim.span().RecordError(err, options...)
+}
+
+func (s *saramaClaimSpan) SpanContext() trace.SpanContext {
+	return s.claim.span().SpanContext()
+}
+
+func (s *saramaClaimSpan) SetStatus(code codes.Code, description string) {
+	s.claim.span().SetStatus(code, description)
+}
+
+func (s *saramaClaimSpan) SetName(name string) {
+	s.claim.span().SetName(name)
+}
+
+func (s *saramaClaimSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.claim.span().SetAttributes(kv...)
+}
+
+func (s *saramaClaimSpan) TracerProvider() trace.TracerProvider {
+	return s.claim.span().TracerProvider()
+}
+
+//go:linkname beforeSaramaNewConsumerGroup github.com/IBM/sarama.beforeSaramaNewConsumerGroup
+func beforeSaramaNewConsumerGroup(call api.CallContext, groupID string, client sarama.Client) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	call.SetData(groupID)
+}
+
+//go:linkname afterSaramaNewConsumerGroup github.com/IBM/sarama.afterSaramaNewConsumerGroup
+func afterSaramaNewConsumerGroup(call api.CallContext, group sarama.ConsumerGroup, err error) {
+	groupID, ok := call.GetData().(string)
+	if !ok || err != nil || group == nil {
+		return
+	}
+	saramaConsumerGroups.Store(group, groupID)
+}
+
+//go:linkname beforeSaramaConsumerGroupClose github.com/IBM/sarama.beforeSaramaConsumerGroupClose
+func beforeSaramaConsumerGroupClose(call api.CallContext, group interface{}) {
+	saramaConsumerGroups.Delete(group)
+}
+
+//go:linkname beforeSaramaConsumerGroupConsume github.com/IBM/sarama.beforeSaramaConsumerGroupConsume
+func beforeSaramaConsumerGroupConsume(call api.CallContext, group interface{}, ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) {
+	if !saramaEnabler.Enable() || handler == nil {
+		return
+	}
+	if _, ok := handler.(*saramaConsumerGroupHandler); ok {
+		return
+	}
+	tracedHandler := &saramaConsumerGroupHandler{ConsumerGroupHandler: handler}
+	if groupID, ok := saramaConsumerGroups.Load(group); ok {
+		tracedHandler.groupID = groupID.(string)
+	}
+	call.SetParam(3, sarama.ConsumerGroupHandler(tracedHandler))
+}
@@ -0,0 +1,350 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarama
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/message"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var saramaEnabler = saramaInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_SARAMA_ENABLED") != "false"}
+
+var (
+	saramaProducerInstrumenter = buildSaramaProducerInstrumenter()
+	saramaConsumerInstrumenter = buildSaramaConsumerInstrumenter()
+)
+
+type saramaInnerEnabler struct {
+	enabled bool
+}
+
+func (s saramaInnerEnabler) Enable() bool {
+	return s.enabled
+}
+
+// saramaProducerCarrier injects the trace context into the headers of a
+// message, an existing header with the same key is overwritten
+type saramaProducerCarrier struct {
+	msg *sarama.ProducerMessage
+}
+
+func (carrier saramaProducerCarrier) Get(key string) string {
+	for _, header := range carrier.msg.Headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (carrier saramaProducerCarrier) Set(key, value string) {
+	for i, header := range carrier.msg.Headers {
+		if string(header.Key) == key {
+			carrier.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	carrier.msg.Headers = append(carrier.msg.Headers, sarama.RecordHeader{
+		Key:   []byte(key),
+		Value: []byte(value),
+	})
+}
+
+func (carrier saramaProducerCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.msg.Headers))
+	for _, header := range carrier.msg.Headers {
+		keys = append(keys, string(header.Key))
+	}
+	return keys
+}
+
+// saramaConsumerCarrier extracts the trace context of the producer from the
+// headers of a consumed message
+type saramaConsumerCarrier struct {
+	msg *sarama.ConsumerMessage
+}
+
+func (carrier saramaConsumerCarrier) Get(key string) string {
+	for _, header := range carrier.msg.Headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (carrier saramaConsumerCarrier) Set(key, value string) {
+	// Consumer carrier doesn't need to implement Set method
+}
+
+func (carrier saramaConsumerCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.msg.Headers))
+	for _, header := range carrier.msg.Headers {
+		if header != nil {
+			keys = append(keys, string(header.Key))
+		}
+	}
+	return keys
+}
+
+type saramaSpanStatusExtractor[REQUEST any] struct{}
+
+func (s *saramaSpanStatusExtractor[REQUEST]) Extract(span trace.Span, request REQUEST, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type saramaProducerAttrsGetter struct{}
+
+func (getter saramaProducerAttrsGetter) GetSystem(request saramaProducerReq) string {
+	return "kafka"
+}
+
+func (getter saramaProducerAttrsGetter) GetDestination(request saramaProducerReq) string {
+	return request.msg.Topic
+}
+
+func (getter saramaProducerAttrsGetter) GetDestinationTemplate(request saramaProducerReq) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) IsTemporaryDestination(request saramaProducerReq) bool {
+	return false
+}
+
+func (getter saramaProducerAttrsGetter) IsAnonymousDestination(request saramaProducerReq) bool {
+	return false
+}
+
+func (getter saramaProducerAttrsGetter) GetConversationId(request saramaProducerReq) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageBodySize(request saramaProducerReq) int64 {
+	if request.msg.Value == nil {
+		return 0
+	}
+	return int64(request.msg.Value.Length())
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageEnvelopSize(request saramaProducerReq) int64 {
+	return 0
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageId(request saramaProducerReq, response any) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) GetClientId(request saramaProducerReq) string {
+	return ""
+}
+
+func (getter saramaProducerAttrsGetter) GetBatchMessageCount(request saramaProducerReq, response any) int64 {
+	return 1
+}
+
+func (getter saramaProducerAttrsGetter) GetMessageHeader(request saramaProducerReq, name string) []string {
+	var values []string
+	for _, header := range request.msg.Headers {
+		if string(header.Key) == name {
+			values = append(values, string(header.Value))
+		}
+	}
+	return values
+}
+
+// GetDestinationPartitionId returns nothing as the partition of a produced
+// message is only known once it is sent, see saramaProducerAttrsExtractor
+func (getter saramaProducerAttrsGetter) GetDestinationPartitionId(request saramaProducerReq) string {
+	return ""
+}
+
+type saramaConsumerAttrsGetter struct{}
+
+func (getter saramaConsumerAttrsGetter) GetSystem(request saramaConsumerReq) string {
+	return "kafka"
+}
+
+func (getter saramaConsumerAttrsGetter) GetDestination(request saramaConsumerReq) string {
+	return request.msg.Topic
+}
+
+func (getter saramaConsumerAttrsGetter) GetDestinationTemplate(request saramaConsumerReq) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) IsTemporaryDestination(request saramaConsumerReq) bool {
+	return false
+}
+
+func (getter saramaConsumerAttrsGetter) IsAnonymousDestination(request saramaConsumerReq) bool {
+	return false
+}
+
+func (getter saramaConsumerAttrsGetter) GetConversationId(request saramaConsumerReq) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageBodySize(request saramaConsumerReq) int64 {
+	return int64(len(request.msg.Value))
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageEnvelopSize(request saramaConsumerReq) int64 {
+	return 0
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageId(request saramaConsumerReq, response any) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) GetClientId(request saramaConsumerReq) string {
+	return ""
+}
+
+func (getter saramaConsumerAttrsGetter) GetBatchMessageCount(request saramaConsumerReq, response any) int64 {
+	return 1
+}
+
+func (getter saramaConsumerAttrsGetter) GetMessageHeader(request saramaConsumerReq, name string) []string {
+	var values []string
+	for _, header := range request.msg.Headers {
+		if header != nil && string(header.Key) == name {
+			values = append(values, string(header.Value))
+		}
+	}
+	return values
+}
+
+func (getter saramaConsumerAttrsGetter) GetDestinationPartitionId(request saramaConsumerReq) string {
+	return strconv.Itoa(int(request.msg.Partition))
+}
+
+// saramaProducerAttrsExtractor records the kafka specific attributes of a
+// produced message, the partition and offset are assigned by the broker
+type saramaProducerAttrsExtractor struct{}
+
+func (extractor *saramaProducerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request saramaProducerReq) ([]attribute.KeyValue, context.Context) {
+	if key, ok := saramaMessageKey(request.msg.Key); ok {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(key))
+	}
+	if request.msg.Value == nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageTombstone(true))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *saramaProducerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request saramaProducerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	if err != nil {
+		return attributes, ctx
+	}
+	return append(attributes,
+		semconv.MessagingDestinationPartitionID(strconv.Itoa(int(request.msg.Partition))),
+		semconv.MessagingKafkaOffset(int(request.msg.Offset)),
+	), ctx
+}
+
+type saramaConsumerAttrsExtractor struct{}
+
+func (extractor *saramaConsumerAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request saramaConsumerReq) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.MessagingKafkaOffset(int(request.msg.Offset)))
+	if request.groupID != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.groupID))
+	}
+	if request.msg.Key != nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(request.msg.Key)))
+	}
+	if request.msg.Value == nil {
+		attributes = append(attributes, semconv.MessagingKafkaMessageTombstone(true))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *saramaConsumerAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request saramaConsumerReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+// saramaMessageKey only encodes the keys of the builtin encoders, as a custom
+// encoder may be expensive or have side effects
+func saramaMessageKey(key sarama.Encoder) (string, bool) {
+	switch k := key.(type) {
+	case sarama.StringEncoder:
+		return string(k), true
+	case sarama.ByteEncoder:
+		return string(k), true
+	}
+	return "", false
+}
+
+func buildSaramaProducerInstrumenter() instrumenter.Instrumenter[saramaProducerReq, any] {
+	builder := instrumenter.Builder[saramaProducerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.SARAMA_PRODUCER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[saramaProducerReq, any]{
+			Getter:        saramaProducerAttrsGetter{},
+			OperationName: message.PUBLISH,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysProducerExtractor[saramaProducerReq]{}).
+		SetSpanStatusExtractor(&saramaSpanStatusExtractor[saramaProducerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[saramaProducerReq, any, saramaProducerAttrsGetter]{
+			Operation: message.PUBLISH,
+		}).
+		AddAttributesExtractor(&saramaProducerAttrsExtractor{}).
+		BuildPropagatingToDownstreamInstrumenter(
+			func(request saramaProducerReq) propagation.TextMapCarrier {
+				return saramaProducerCarrier{msg: request.msg}
+			},
+			otel.GetTextMapPropagator(),
+		)
+}
+
+// the consumer spans are not children of the producer spans, they are linked
+// to them instead, see saramaConsumerGroupClaim
+func buildSaramaConsumerInstrumenter() instrumenter.Instrumenter[saramaConsumerReq, any] {
+	builder := instrumenter.Builder[saramaConsumerReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.SARAMA_CONSUMER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&message.MessageSpanNameExtractor[saramaConsumerReq, any]{
+			Getter:        saramaConsumerAttrsGetter{},
+			OperationName: message.PROCESS,
+		}).
+		SetSpanKindExtractor(&instrumenter.AlwaysConsumerExtractor[saramaConsumerReq]{}).
+		SetSpanStatusExtractor(&saramaSpanStatusExtractor[saramaConsumerReq]{}).
+		AddAttributesExtractor(&message.MessageAttrsExtractor[saramaConsumerReq, any, saramaConsumerAttrsGetter]{
+			Operation: message.PROCESS,
+		}).
+		AddAttributesExtractor(&saramaConsumerAttrsExtractor{}).
+		BuildInstrumenter()
+}
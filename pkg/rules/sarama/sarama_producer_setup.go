@@ -0,0 +1,246 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarama
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	_ "unsafe"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// saramaSyncMessages holds the messages being sent by a SyncProducer, they are
+// traced by the SendMessage(s) hooks and skipped by the input forwarder
+var saramaSyncMessages sync.Map
+
+// saramaAsyncMessages maps a message sent through the input channel of an
+// AsyncProducer to the context of its span, which ends once sarama returns the
+// message as a success or an error
+var saramaAsyncMessages sync.Map
+
+// saramaProducerInputs maps an AsyncProducer to the input handed to the user
+var saramaProducerInputs sync.Map
+
+type saramaProducerData struct {
+	ctx context.Context
+	msg *sarama.ProducerMessage
+}
+
+// saramaProducerInput replaces the input channel of an AsyncProducer, every
+// message is traced by the forwarder before being forwarded to the channel of
+// sarama, so that the trace context is injected into its headers in time.
+//
+// The forwarder is the first code to see a message sent asynchronously: the
+// send on the channel can not be hooked, and the only code running in the
+// goroutine of the caller is Input(). Input() therefore records the span
+// current in the caller, which is the parent of the next message forwarded,
+// e.g. for the usual producer.Input() <- msg. A channel kept by the caller and
+// used for several messages only parents the first one of them.
+type saramaProducerInput struct {
+	input     chan *sarama.ProducerMessage
+	parent    atomic.Pointer[trace.Span]
+	startOnce sync.Once
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func (in *saramaProducerInput) start(target chan<- *sarama.ProducerMessage) {
+	in.startOnce.Do(func() {
+		in.input = make(chan *sarama.ProducerMessage, cap(target))
+		in.done = make(chan struct{})
+		go in.forward(target)
+	})
+}
+
+func (in *saramaProducerInput) forward(target chan<- *sarama.ProducerMessage) {
+	defer close(in.done)
+	for msg := range in.input {
+		if msg != nil {
+			// the messages of a SyncProducer are queued by Input() as well
+			parentContext, options := in.parentContext()
+			if _, ok := saramaSyncMessages.Load(msg); !ok {
+				ctx := startSaramaProducer(parentContext, msg, options...)
+				// The span ends in the goroutine returning the message
+				sdktrace.DetachSpanFromGLS(trace.SpanFromContext(ctx))
+				saramaAsyncMessages.Store(msg, ctx)
+			}
+		}
+		target <- msg
+	}
+}
+
+// queue records the span current in the goroutine asking for the input
+func (in *saramaProducerInput) queue(parent trace.Span) {
+	if parent == nil || !parent.SpanContext().IsValid() {
+		in.parent.Store(nil)
+		return
+	}
+	in.parent.Store(&parent)
+}
+
+// parentContext returns the context of the caller which queued the message,
+// the forwarder inherits the span of the goroutine calling Input() first,
+// which is unrelated to the message
+func (in *saramaProducerInput) parentContext() (context.Context, []trace.SpanStartOption) {
+	parent := in.parent.Swap(nil)
+	if parent == nil {
+		return context.Background(), []trace.SpanStartOption{trace.WithNewRoot()}
+	}
+	return trace.ContextWithSpan(context.Background(), *parent), nil
+}
+
+// close drains the pending messages, sarama closes its own input channel
+// once the producer is shut down
+func (in *saramaProducerInput) close() {
+	in.closeOnce.Do(func() {
+		close(in.input)
+	})
+	<-in.done
+}
+
+// startSaramaProducer starts the span of a message, the trace context carried
+// by the message takes precedence over the given parent
+func startSaramaProducer(parentContext context.Context, msg *sarama.ProducerMessage, options ...trace.SpanStartOption) context.Context {
+	carrier := saramaProducerCarrier{msg: msg}
+	parentContext = otel.GetTextMapPropagator().Extract(parentContext, carrier)
+	if trace.SpanContextFromContext(parentContext).IsRemote() {
+		options = nil
+	}
+	return saramaProducerInstrumenter.Start(parentContext, saramaProducerReq{msg: msg}, options...)
+}
+
+func endSaramaProducer(ctx context.Context, msg *sarama.ProducerMessage, err error) {
+	saramaProducerInstrumenter.End(ctx, saramaProducerReq{msg: msg}, nil, err)
+}
+
+//go:linkname beforeSaramaSendMessage github.com/IBM/sarama.beforeSaramaSendMessage
+func beforeSaramaSendMessage(call api.CallContext, _ interface{}, msg *sarama.ProducerMessage) {
+	if !saramaEnabler.Enable() || msg == nil {
+		return
+	}
+	saramaSyncMessages.Store(msg, struct{}{})
+	call.SetData(saramaProducerData{ctx: startSaramaProducer(context.Background(), msg), msg: msg})
+}
+
+//go:linkname afterSaramaSendMessage github.com/IBM/sarama.afterSaramaSendMessage
+func afterSaramaSendMessage(call api.CallContext, partition int32, offset int64, err error) {
+	data, ok := call.GetData().(saramaProducerData)
+	if !ok {
+		return
+	}
+	saramaSyncMessages.Delete(data.msg)
+	endSaramaProducer(data.ctx, data.msg, err)
+}
+
+//go:linkname beforeSaramaSendMessages github.com/IBM/sarama.beforeSaramaSendMessages
+func beforeSaramaSendMessages(call api.CallContext, _ interface{}, msgs []*sarama.ProducerMessage) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	// the messages are siblings, rather than children of the previous ones
+	parentContext := context.Background()
+	var options []trace.SpanStartOption
+	if span := sdktrace.SpanFromGLS(); span != nil && span.SpanContext().IsValid() {
+		parentContext = trace.ContextWithSpan(parentContext, span)
+	} else {
+		options = append(options, trace.WithNewRoot())
+	}
+	data := make([]saramaProducerData, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg == nil {
+			continue
+		}
+		saramaSyncMessages.Store(msg, struct{}{})
+		data = append(data, saramaProducerData{ctx: startSaramaProducer(parentContext, msg, options...), msg: msg})
+	}
+	call.SetData(data)
+}
+
+//go:linkname afterSaramaSendMessages github.com/IBM/sarama.afterSaramaSendMessages
+func afterSaramaSendMessages(call api.CallContext, err error) {
+	data, ok := call.GetData().([]saramaProducerData)
+	if !ok {
+		return
+	}
+	// the error of SendMessages aggregates the failed messages only
+	var producerErrs sarama.ProducerErrors
+	failed := make(map[*sarama.ProducerMessage]error)
+	if errors.As(err, &producerErrs) {
+		for _, producerErr := range producerErrs {
+			if producerErr != nil {
+				failed[producerErr.Msg] = producerErr.Err
+			}
+		}
+	}
+	for _, d := range data {
+		saramaSyncMessages.Delete(d.msg)
+		msgErr, ok := failed[d.msg]
+		if !ok && len(producerErrs) == 0 {
+			msgErr = err
+		}
+		endSaramaProducer(d.ctx, d.msg, msgErr)
+	}
+}
+
+//go:linkname beforeSaramaAsyncProducerInput github.com/IBM/sarama.beforeSaramaAsyncProducerInput
+func beforeSaramaAsyncProducerInput(call api.CallContext, producer interface{}) {
+	if !saramaEnabler.Enable() {
+		return
+	}
+	call.SetData(producer)
+}
+
+//go:linkname afterSaramaAsyncProducerInput github.com/IBM/sarama.afterSaramaAsyncProducerInput
+func afterSaramaAsyncProducerInput(call api.CallContext, input chan<- *sarama.ProducerMessage) {
+	producer := call.GetData()
+	if producer == nil || input == nil {
+		return
+	}
+	v, _ := saramaProducerInputs.LoadOrStore(producer, &saramaProducerInput{})
+	in := v.(*saramaProducerInput)
+	in.start(input)
+	in.queue(sdktrace.SpanFromGLS())
+	call.SetReturnVal(0, (chan<- *sarama.ProducerMessage)(in.input))
+}
+
+//go:linkname beforeSaramaAsyncProducerAsyncClose github.com/IBM/sarama.beforeSaramaAsyncProducerAsyncClose
+func beforeSaramaAsyncProducerAsyncClose(call api.CallContext, producer interface{}) {
+	if v, ok := saramaProducerInputs.LoadAndDelete(producer); ok {
+		v.(*saramaProducerInput).close()
+	}
+}
+
+//go:linkname beforeSaramaAsyncProducerReturnSuccesses github.com/IBM/sarama.beforeSaramaAsyncProducerReturnSuccesses
+func beforeSaramaAsyncProducerReturnSuccesses(call api.CallContext, _ interface{}, batch []*sarama.ProducerMessage) {
+	for _, msg := range batch {
+		if v, ok := saramaAsyncMessages.LoadAndDelete(msg); ok {
+			endSaramaProducer(v.(context.Context), msg, nil)
+		}
+	}
+}
+
+//go:linkname beforeSaramaAsyncProducerReturnError github.com/IBM/sarama.beforeSaramaAsyncProducerReturnError
+func beforeSaramaAsyncProducerReturnError(call api.CallContext, _ interface{}, msg *sarama.ProducerMessage, err error) {
+	if v, ok := saramaAsyncMessages.LoadAndDelete(msg); ok {
+		endSaramaProducer(v.(context.Context), msg, err)
+	}
+}
@@ -18,12 +18,13 @@ import (
 	"context"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
 	"github.com/segmentio/kafka-go"
+	"reflect"
 	"time"
 	_ "unsafe"
 )
 
-//go:linkname consumerReadMessageOnEnter github.com/segmentio/kafka-go.consumerReadMessageOnEnter
-func consumerReadMessageOnEnter(call api.CallContext, _ interface{}, ctx context.Context) {
+//go:linkname consumerFetchMessageOnEnter github.com/segmentio/kafka-go.consumerFetchMessageOnEnter
+func consumerFetchMessageOnEnter(call api.CallContext, reader *kafka.Reader, ctx context.Context) {
 	if !kafkaEnabler.Enable() {
 		return
 	}
@@ -31,12 +32,13 @@ func consumerReadMessageOnEnter(call api.CallContext, _ interface{}, ctx context
 	instrumentationData := map[string]interface{}{
 		"parentContext":  ctx,
 		"startTimestamp": time.Now(),
+		"reader":         reader,
 	}
 	call.SetData(instrumentationData)
 }
 
-//go:linkname consumerReadMessageOnExit github.com/segmentio/kafka-go.consumerReadMessageOnExit
-func consumerReadMessageOnExit(call api.CallContext, message kafka.Message, err error) {
+//go:linkname consumerFetchMessageOnExit github.com/segmentio/kafka-go.consumerFetchMessageOnExit
+func consumerFetchMessageOnExit(call api.CallContext, message kafka.Message, err error) {
 	if !kafkaEnabler.Enable() {
 		return
 	}
@@ -48,9 +50,14 @@ func consumerReadMessageOnExit(call api.CallContext, message kafka.Message, err
 
 	parentContext := instrumentationData["parentContext"].(context.Context)
 	startTimestamp := instrumentationData["startTimestamp"].(time.Time)
+	reader := instrumentationData["reader"].(*kafka.Reader)
 	endTimestamp := time.Now()
 
-	consumerRequest := kafkaConsumerReq{msg: message}
+	consumerRequest := kafkaConsumerReq{
+		msg:     message,
+		groupID: reader.Config().GroupID,
+		lag:     getKafkaConsumerLag(reader, message),
+	}
 	consumerInstrumenter.StartAndEnd(
 		parentContext,
 		consumerRequest,
@@ -60,3 +67,15 @@ func consumerReadMessageOnExit(call api.CallContext, message kafka.Message, err
 		endTimestamp,
 	)
 }
+
+// getKafkaConsumerLag prefers the high watermark of the message, which is
+// only available since v0.4.21, as the reader only tracks the lag when it is
+// not backed by a consumer group
+func getKafkaConsumerLag(reader *kafka.Reader, message kafka.Message) int64 {
+	if field := reflect.ValueOf(message).FieldByName("HighWaterMark"); field.IsValid() && field.Kind() == reflect.Int64 {
+		if highWaterMark := field.Int(); highWaterMark > 0 {
+			return highWaterMark - message.Offset - 1
+		}
+	}
+	return reader.Lag()
+}
@@ -27,5 +27,8 @@ type kafkaProducerReq struct {
 }
 
 type kafkaConsumerReq struct {
-	msg kafka.Message
+	msg     kafka.Message
+	groupID string
+	// lag is the number of messages behind the high watermark, or -1 if unknown
+	lag int64
 }
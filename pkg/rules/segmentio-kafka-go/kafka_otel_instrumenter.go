@@ -29,8 +29,11 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 	"os"
+	"strconv"
 )
 
+const kafkaConsumerLagKey = "messaging.kafka.consumer.lag"
+
 // Instrumentation enabler controller
 var kafkaEnabler = kafkaInnerEnabler{os.Getenv("OTEL_SEGMENTIO_KAFKA_ENABLED") != "false"}
 
@@ -59,11 +62,24 @@ func (carrier kafkaProducerCarrier) Get(key string) string {
 
 func (carrier kafkaProducerCarrier) Set(key, value string) {
 	for _, message := range carrier.messages {
-		message.Headers = append(message.Headers, kafka.Header{
-			Key:   key,
-			Value: []byte(value),
-		})
+		setKafkaHeader(message, key, value)
+	}
+}
+
+// setKafkaHeader overwrites the header with the same key, so that a message
+// forwarded by the application doesn't carry the trace context of its
+// previous producer anymore
+func setKafkaHeader(message *kafka.Message, key, value string) {
+	for i, header := range message.Headers {
+		if header.Key == key {
+			message.Headers[i].Value = []byte(value)
+			return
+		}
 	}
+	message.Headers = append(message.Headers, kafka.Header{
+		Key:   key,
+		Value: []byte(value),
+	})
 }
 
 func (carrier kafkaProducerCarrier) Keys() []string {
@@ -184,7 +200,7 @@ func (getter kafkaMessageConsumerAttrsGetter) IsAnonymousDestination(request kaf
 }
 
 func (getter kafkaMessageConsumerAttrsGetter) GetDestinationPartitionId(request kafkaConsumerReq) string {
-	return ""
+	return strconv.Itoa(request.msg.Partition)
 }
 
 func (getter kafkaMessageConsumerAttrsGetter) GetSystem(request kafkaConsumerReq) string {
@@ -267,6 +283,16 @@ type kafkaConsumerAttributesExtractor struct {
 }
 
 func (extractor *kafkaConsumerAttributesExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request kafkaConsumerReq) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, semconv.MessagingKafkaOffset(int(request.msg.Offset)))
+	if len(request.msg.Key) > 0 {
+		attributes = append(attributes, semconv.MessagingKafkaMessageKey(string(request.msg.Key)))
+	}
+	if request.groupID != "" {
+		attributes = append(attributes, semconv.MessagingConsumerGroupName(request.groupID))
+	}
+	if request.lag >= 0 {
+		attributes = append(attributes, attribute.Int64(kafkaConsumerLagKey, request.lag))
+	}
 	return attributes, parentContext
 }
 
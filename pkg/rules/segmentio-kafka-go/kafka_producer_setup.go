@@ -18,6 +18,8 @@ import (
 	"context"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 	_ "unsafe"
 )
 
@@ -43,8 +45,9 @@ func producerWriteMessagesOnEnter(call api.CallContext, writer *kafka.Writer, ct
 		msgs:  messagePointers,
 	}
 
-	// Start instrumentation and get instrumented context
-	instrumentedContext := producerInstrumenter.Start(ctx, producerRequest)
+	// Start instrumentation and get instrumented context, the span of the batch
+	// is linked to the spans having produced its messages before, if any
+	instrumentedContext := producerInstrumenter.Start(ctx, producerRequest, kafkaBatchLinks(messagePointers)...)
 
 	// Store data for later use in exit hook
 	instrumentationData := map[string]interface{}{
@@ -63,6 +66,28 @@ func producerWriteMessagesOnEnter(call api.CallContext, writer *kafka.Writer, ct
 	call.SetParam(2, messageCopies)
 }
 
+// kafkaBatchLinks links to the distinct trace contexts carried by the messages
+func kafkaBatchLinks(messages []*kafka.Message) []trace.SpanStartOption {
+	var links []trace.Link
+	seen := make(map[trace.SpanID]struct{})
+	for _, message := range messages {
+		carrier := kafkaConsumerCarrier{message: *message}
+		sc := trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(context.Background(), carrier))
+		if !sc.IsValid() {
+			continue
+		}
+		if _, ok := seen[sc.SpanID()]; ok {
+			continue
+		}
+		seen[sc.SpanID()] = struct{}{}
+		links = append(links, trace.Link{SpanContext: sc})
+	}
+	if len(links) == 0 {
+		return nil
+	}
+	return []trace.SpanStartOption{trace.WithLinks(links...)}
+}
+
 //go:linkname producerWriteMessagesOnExit github.com/segmentio/kafka-go.producerWriteMessagesOnExit
 func producerWriteMessagesOnExit(call api.CallContext, err error) {
 	if !kafkaEnabler.Enable() {
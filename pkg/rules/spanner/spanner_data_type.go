@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanner
+
+import (
+	"context"
+	"sync"
+)
+
+// spannerReq is a statement, a transaction or the taking of a session from
+// the session pool of a client
+type spannerReq struct {
+	operation string
+	statement string
+	batchSize int
+}
+
+// spannerQueryState is the span of a query kept by its row iterator, as the
+// query is only executed while the rows are iterated
+type spannerQueryState struct {
+	ctx     context.Context
+	request spannerReq
+	once    sync.Once
+}
+
+func (s *spannerQueryState) end(err error) {
+	s.once.Do(func() {
+		spannerInstrumenter.End(s.ctx, s.request, nil, err)
+	})
+}
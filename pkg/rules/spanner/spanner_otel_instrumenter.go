@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanner
+
+import (
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var spannerEnabler = spannerInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_SPANNER_ENABLED") != "false"}
+
+var spannerInstrumenter = buildSpannerInstrumenter()
+
+type spannerInnerEnabler struct {
+	enabled bool
+}
+
+func (s spannerInnerEnabler) Enable() bool {
+	return s.enabled
+}
+
+// spannerOperation returns the leading keyword of a statement, such as
+// SELECT or UPDATE
+func spannerOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToUpper(fields[0])
+}
+
+type spannerAttrsGetter struct{}
+
+func (s spannerAttrsGetter) GetSystem(request spannerReq) string {
+	return "gcp.spanner"
+}
+
+func (s spannerAttrsGetter) GetServerAddress(request spannerReq) string {
+	return ""
+}
+
+func (s spannerAttrsGetter) GetStatement(request spannerReq) string {
+	return request.statement
+}
+
+func (s spannerAttrsGetter) GetOperation(request spannerReq) string {
+	return request.operation
+}
+
+func (s spannerAttrsGetter) GetCollection(request spannerReq) string {
+	return ""
+}
+
+func (s spannerAttrsGetter) GetParameters(request spannerReq) []any {
+	// the parameters of a statement are bound separately, they are never
+	// recorded
+	return nil
+}
+
+func (s spannerAttrsGetter) GetDbNamespace(request spannerReq) string {
+	return ""
+}
+
+func (s spannerAttrsGetter) GetBatchSize(request spannerReq) int {
+	return request.batchSize
+}
+
+type spannerSpanStatusExtractor struct{}
+
+func (s *spannerSpanStatusExtractor) Extract(span trace.Span, request spannerReq, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+func buildSpannerInstrumenter() instrumenter.Instrumenter[spannerReq, any] {
+	builder := instrumenter.Builder[spannerReq, any]{}
+	getter := spannerAttrsGetter{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.SPANNER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&db.DBSpanNameExtractor[spannerReq]{Getter: getter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[spannerReq]{}).
+		SetSpanStatusExtractor(&spannerSpanStatusExtractor{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[spannerReq, any, spannerAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[spannerReq, any, spannerAttrsGetter]{Getter: getter}}).
+		BuildInstrumenter()
+}
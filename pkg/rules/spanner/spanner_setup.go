@@ -0,0 +1,235 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanner
+
+import (
+	"context"
+	"strings"
+	"time"
+	_ "unsafe"
+
+	"cloud.google.com/go/spanner"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/api/iterator"
+)
+
+// spannerStatementKey marks the context of a traced statement, so that a
+// statement method delegating to another one is traced once
+type spannerStatementKey struct{}
+
+type spannerCallData struct {
+	ctx     context.Context
+	request spannerReq
+}
+
+func spannerStart(call api.CallContext, ctxIdx int, ctx context.Context, request spannerReq) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = spannerInstrumenter.Start(ctx, request)
+	call.SetParam(ctxIdx, ctx)
+	call.SetData(spannerCallData{ctx: ctx, request: request})
+}
+
+func spannerEnd(call api.CallContext, err error) {
+	data, ok := call.GetData().(spannerCallData)
+	if !ok {
+		return
+	}
+	spannerInstrumenter.End(data.ctx, data.request, nil, err)
+}
+
+// spannerStartStatement starts the span of a statement unless it is run by
+// another traced statement method
+func spannerStartStatement(call api.CallContext, ctx context.Context, request spannerReq) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if ctx.Value(spannerStatementKey{}) != nil {
+		return
+	}
+	spannerStart(call, 1, context.WithValue(ctx, spannerStatementKey{}, true), request)
+}
+
+//go:linkname beforeSpannerReadWriteTransaction cloud.google.com/go/spanner.beforeSpannerReadWriteTransaction
+func beforeSpannerReadWriteTransaction(call api.CallContext, c *spanner.Client, ctx context.Context, f func(context.Context, *spanner.ReadWriteTransaction) error) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStart(call, 1, ctx, spannerReq{operation: "ReadWriteTransaction"})
+}
+
+//go:linkname afterSpannerReadWriteTransaction cloud.google.com/go/spanner.afterSpannerReadWriteTransaction
+func afterSpannerReadWriteTransaction(call api.CallContext, commitTimestamp time.Time, err error) {
+	spannerEnd(call, err)
+}
+
+//go:linkname beforeSpannerReadWriteTransactionWithOptions cloud.google.com/go/spanner.beforeSpannerReadWriteTransactionWithOptions
+func beforeSpannerReadWriteTransactionWithOptions(call api.CallContext, c *spanner.Client, ctx context.Context, f func(context.Context, *spanner.ReadWriteTransaction) error, options spanner.TransactionOptions) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStart(call, 1, ctx, spannerReq{operation: "ReadWriteTransaction"})
+}
+
+//go:linkname afterSpannerReadWriteTransactionWithOptions cloud.google.com/go/spanner.afterSpannerReadWriteTransactionWithOptions
+func afterSpannerReadWriteTransactionWithOptions(call api.CallContext, resp spanner.CommitResponse, err error) {
+	spannerEnd(call, err)
+}
+
+//go:linkname beforeSpannerApply cloud.google.com/go/spanner.beforeSpannerApply
+func beforeSpannerApply(call api.CallContext, c *spanner.Client, ctx context.Context, ms []*spanner.Mutation, opts ...spanner.ApplyOption) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStart(call, 1, ctx, spannerReq{operation: "Apply", batchSize: len(ms)})
+}
+
+//go:linkname afterSpannerApply cloud.google.com/go/spanner.afterSpannerApply
+func afterSpannerApply(call api.CallContext, commitTimestamp time.Time, err error) {
+	spannerEnd(call, err)
+}
+
+// beforeSpannerTakeSession traces the wait for a session of the pool, which
+// is taken once a statement or a transaction is first executed
+//
+//go:linkname beforeSpannerTakeSession cloud.google.com/go/spanner.beforeSpannerTakeSession
+func beforeSpannerTakeSession(call api.CallContext, p interface{}, ctx context.Context) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStart(call, 1, ctx, spannerReq{operation: "TakeSession"})
+}
+
+//go:linkname afterSpannerTakeSession cloud.google.com/go/spanner.afterSpannerTakeSession
+func afterSpannerTakeSession(call api.CallContext, sh interface{}, err error) {
+	spannerEnd(call, err)
+}
+
+//go:linkname beforeSpannerQuery cloud.google.com/go/spanner.beforeSpannerQuery
+func beforeSpannerQuery(call api.CallContext, t interface{}, ctx context.Context, statement spanner.Statement) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStartStatement(call, ctx, spannerReq{operation: spannerOperation(statement.SQL), statement: statement.SQL})
+}
+
+//go:linkname beforeSpannerQueryWithOptions cloud.google.com/go/spanner.beforeSpannerQueryWithOptions
+func beforeSpannerQueryWithOptions(call api.CallContext, t interface{}, ctx context.Context, statement spanner.Statement, opts spanner.QueryOptions) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStartStatement(call, ctx, spannerReq{operation: spannerOperation(statement.SQL), statement: statement.SQL})
+}
+
+// afterSpannerQuery hands the span over to the returned row iterator, the
+// span ends once the rows are exhausted or the iterator is stopped, which may
+// happen on another goroutine, so the span is detached from this one
+//
+//go:linkname afterSpannerQuery cloud.google.com/go/spanner.afterSpannerQuery
+func afterSpannerQuery(call api.CallContext, iter *spanner.RowIterator) {
+	spannerKeepQuery(call, iter)
+}
+
+//go:linkname afterSpannerQueryWithOptions cloud.google.com/go/spanner.afterSpannerQueryWithOptions
+func afterSpannerQueryWithOptions(call api.CallContext, iter *spanner.RowIterator) {
+	spannerKeepQuery(call, iter)
+}
+
+func spannerKeepQuery(call api.CallContext, iter *spanner.RowIterator) {
+	data, ok := call.GetData().(spannerCallData)
+	if !ok {
+		return
+	}
+	if iter == nil {
+		spannerInstrumenter.End(data.ctx, data.request, nil, nil)
+		return
+	}
+	sdktrace.DetachSpanFromGLS(trace.SpanFromContext(data.ctx))
+	iter.OtelQueryState = &spannerQueryState{ctx: data.ctx, request: data.request}
+}
+
+//go:linkname afterSpannerRowIteratorNext cloud.google.com/go/spanner.afterSpannerRowIteratorNext
+func afterSpannerRowIteratorNext(call api.CallContext, row *spanner.Row, err error) {
+	if err == nil {
+		return
+	}
+	iter, ok := call.GetParam(0).(*spanner.RowIterator)
+	if !ok || iter == nil {
+		return
+	}
+	if state, ok := iter.OtelQueryState.(*spannerQueryState); ok {
+		if err == iterator.Done {
+			err = nil
+		}
+		state.end(err)
+	}
+}
+
+//go:linkname beforeSpannerRowIteratorStop cloud.google.com/go/spanner.beforeSpannerRowIteratorStop
+func beforeSpannerRowIteratorStop(call api.CallContext, iter *spanner.RowIterator) {
+	if iter == nil {
+		return
+	}
+	if state, ok := iter.OtelQueryState.(*spannerQueryState); ok {
+		state.end(nil)
+	}
+}
+
+//go:linkname beforeSpannerUpdate cloud.google.com/go/spanner.beforeSpannerUpdate
+func beforeSpannerUpdate(call api.CallContext, t *spanner.ReadWriteTransaction, ctx context.Context, stmt spanner.Statement) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStartStatement(call, ctx, spannerReq{operation: spannerOperation(stmt.SQL), statement: stmt.SQL})
+}
+
+//go:linkname beforeSpannerUpdateWithOptions cloud.google.com/go/spanner.beforeSpannerUpdateWithOptions
+func beforeSpannerUpdateWithOptions(call api.CallContext, t *spanner.ReadWriteTransaction, ctx context.Context, stmt spanner.Statement, opts spanner.QueryOptions) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	spannerStartStatement(call, ctx, spannerReq{operation: spannerOperation(stmt.SQL), statement: stmt.SQL})
+}
+
+//go:linkname afterSpannerUpdate cloud.google.com/go/spanner.afterSpannerUpdate
+func afterSpannerUpdate(call api.CallContext, rowCount int64, err error) {
+	spannerEnd(call, err)
+}
+
+//go:linkname afterSpannerUpdateWithOptions cloud.google.com/go/spanner.afterSpannerUpdateWithOptions
+func afterSpannerUpdateWithOptions(call api.CallContext, rowCount int64, err error) {
+	spannerEnd(call, err)
+}
+
+//go:linkname beforeSpannerBatchUpdate cloud.google.com/go/spanner.beforeSpannerBatchUpdate
+func beforeSpannerBatchUpdate(call api.CallContext, t *spanner.ReadWriteTransaction, ctx context.Context, stmts []spanner.Statement, opts spanner.QueryOptions) {
+	if !spannerEnabler.Enable() {
+		return
+	}
+	sqls := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		sqls = append(sqls, stmt.SQL)
+	}
+	request := spannerReq{operation: "BATCH", statement: strings.Join(sqls, "; "), batchSize: len(stmts)}
+	spannerStartStatement(call, ctx, request)
+}
+
+//go:linkname afterSpannerBatchUpdate cloud.google.com/go/spanner.afterSpannerBatchUpdate
+func afterSpannerBatchUpdate(call api.CallContext, rowCounts []int64, err error) {
+	spannerEnd(call, err)
+}
@@ -0,0 +1,360 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/jmoiron/sqlx"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type sqlxInnerEnabler struct {
+	enabled bool
+}
+
+func (s sqlxInnerEnabler) Enable() bool {
+	return s.enabled
+}
+
+var sqlxEnabler = sqlxInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_SQLX_ENABLED") != "false"}
+
+var sqlxInstrumenter = BuildSqlxInstrumenter()
+
+type sqlxNamedBinder interface {
+	BindNamed(string, interface{}) (string, []interface{}, error)
+}
+
+// sqlxTraced reports whether ctx is already within a span of sqlx, the helpers
+// such as Get and NamedQuery call Queryx and QueryRowx internally, and only
+// the outermost call is traced.
+func sqlxTraced(ctx context.Context) bool {
+	span, ok := trace.SpanFromContext(ctx).(sdktrace.ReadOnlySpan)
+	return ok && span.InstrumentationScope().Name == utils.SQLX_SCOPE_NAME
+}
+
+func sqlxStart(call api.CallContext, ctx context.Context, e interface{}, query string, argsCount int) (context.Context, bool) {
+	if !sqlxEnabler.Enable() {
+		return nil, false
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if sqlxTraced(ctx) {
+		return nil, false
+	}
+	addr, driverName := sqlxDbInfo(e)
+	request := sqlxRequest{
+		System:    sqlxDbSystem(driverName),
+		Operation: sqlxOperation(query),
+		Statement: query,
+		Addr:      addr,
+		ArgsCount: argsCount,
+	}
+	ctx = sqlxInstrumenter.Start(ctx, request)
+	data := make(map[string]interface{}, 2)
+	data["ctx"] = ctx
+	data["request"] = request
+	call.SetData(data)
+	return ctx, true
+}
+
+// sqlxStartNamed binds the named query ahead of sqlx so that the span carries
+// the statement actually sent to the database.
+func sqlxStartNamed(call api.CallContext, ctx context.Context, e sqlxNamedBinder, query string, arg interface{}) (context.Context, bool) {
+	if !sqlxEnabler.Enable() || e == nil {
+		return nil, false
+	}
+	argsCount := 0
+	if bound, args, err := e.BindNamed(query, arg); err == nil {
+		query = bound
+		argsCount = len(args)
+	}
+	return sqlxStart(call, ctx, e, query, argsCount)
+}
+
+func sqlxEnd(call api.CallContext, err error) {
+	if !sqlxEnabler.Enable() {
+		return
+	}
+	data, ok := call.GetData().(map[string]interface{})
+	if !ok || data == nil {
+		return
+	}
+	ctx, ok := data["ctx"].(context.Context)
+	if !ok {
+		return
+	}
+	request, ok := data["request"].(sqlxRequest)
+	if !ok {
+		return
+	}
+	sqlxInstrumenter.End(ctx, request, nil, err)
+}
+
+func sqlxRowErr(row *sqlx.Row) error {
+	if row == nil {
+		return nil
+	}
+	return row.Err()
+}
+
+func sqlxDbInfo(e interface{}) (string, string) {
+	switch v := e.(type) {
+	case *sqlx.DB:
+		if v != nil && v.DB != nil {
+			return v.DB.Endpoint, v.DriverName()
+		}
+	case *sqlx.Tx:
+		if v != nil && v.Tx != nil {
+			return v.Tx.Endpoint, v.DriverName()
+		}
+	case *sqlx.Conn:
+		if v != nil && v.Conn != nil {
+			return v.Conn.Endpoint, v.Conn.DriverName
+		}
+	}
+	return "", ""
+}
+
+func sqlxOperation(query string) string {
+	if fields := strings.Fields(query); len(fields) > 0 {
+		return strings.ToUpper(fields[0])
+	}
+	return ""
+}
+
+func sqlxDbSystem(driverName string) string {
+	switch driverName {
+	case "mysql":
+		return "mysql"
+	case "postgres", "postgresql", "pgx":
+		return "postgresql"
+	case "sqlite", "sqlite3":
+		return "sqlite"
+	case "sqlserver", "mssql":
+		return "mssql"
+	case "":
+		return ""
+	}
+	return "other_sql"
+}
+
+//go:linkname sqlxGetOnEnter github.com/jmoiron/sqlx.sqlxGetOnEnter
+func sqlxGetOnEnter(call api.CallContext, q sqlx.Queryer, dest interface{}, query string, args ...interface{}) {
+	sqlxStart(call, nil, q, query, len(args))
+}
+
+//go:linkname sqlxGetOnExit github.com/jmoiron/sqlx.sqlxGetOnExit
+func sqlxGetOnExit(call api.CallContext, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxSelectOnEnter github.com/jmoiron/sqlx.sqlxSelectOnEnter
+func sqlxSelectOnEnter(call api.CallContext, q sqlx.Queryer, dest interface{}, query string, args ...interface{}) {
+	sqlxStart(call, nil, q, query, len(args))
+}
+
+//go:linkname sqlxSelectOnExit github.com/jmoiron/sqlx.sqlxSelectOnExit
+func sqlxSelectOnExit(call api.CallContext, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxGetContextOnEnter github.com/jmoiron/sqlx.sqlxGetContextOnEnter
+func sqlxGetContextOnEnter(call api.CallContext, ctx context.Context, q sqlx.QueryerContext, dest interface{}, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, q, query, len(args)); ok {
+		call.SetParam(0, ctx)
+	}
+}
+
+//go:linkname sqlxGetContextOnExit github.com/jmoiron/sqlx.sqlxGetContextOnExit
+func sqlxGetContextOnExit(call api.CallContext, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxSelectContextOnEnter github.com/jmoiron/sqlx.sqlxSelectContextOnEnter
+func sqlxSelectContextOnEnter(call api.CallContext, ctx context.Context, q sqlx.QueryerContext, dest interface{}, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, q, query, len(args)); ok {
+		call.SetParam(0, ctx)
+	}
+}
+
+//go:linkname sqlxSelectContextOnExit github.com/jmoiron/sqlx.sqlxSelectContextOnExit
+func sqlxSelectContextOnExit(call api.CallContext, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxNamedQueryOnEnter github.com/jmoiron/sqlx.sqlxNamedQueryOnEnter
+func sqlxNamedQueryOnEnter(call api.CallContext, e sqlx.Ext, query string, arg interface{}) {
+	sqlxStartNamed(call, nil, e, query, arg)
+}
+
+//go:linkname sqlxNamedQueryOnExit github.com/jmoiron/sqlx.sqlxNamedQueryOnExit
+func sqlxNamedQueryOnExit(call api.CallContext, rows *sqlx.Rows, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxNamedExecOnEnter github.com/jmoiron/sqlx.sqlxNamedExecOnEnter
+func sqlxNamedExecOnEnter(call api.CallContext, e sqlx.Ext, query string, arg interface{}) {
+	sqlxStartNamed(call, nil, e, query, arg)
+}
+
+//go:linkname sqlxNamedExecOnExit github.com/jmoiron/sqlx.sqlxNamedExecOnExit
+func sqlxNamedExecOnExit(call api.CallContext, result sql.Result, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxNamedQueryContextOnEnter github.com/jmoiron/sqlx.sqlxNamedQueryContextOnEnter
+func sqlxNamedQueryContextOnEnter(call api.CallContext, ctx context.Context, e sqlx.ExtContext, query string, arg interface{}) {
+	if ctx, ok := sqlxStartNamed(call, ctx, e, query, arg); ok {
+		call.SetParam(0, ctx)
+	}
+}
+
+//go:linkname sqlxNamedQueryContextOnExit github.com/jmoiron/sqlx.sqlxNamedQueryContextOnExit
+func sqlxNamedQueryContextOnExit(call api.CallContext, rows *sqlx.Rows, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxNamedExecContextOnEnter github.com/jmoiron/sqlx.sqlxNamedExecContextOnEnter
+func sqlxNamedExecContextOnEnter(call api.CallContext, ctx context.Context, e sqlx.ExtContext, query string, arg interface{}) {
+	if ctx, ok := sqlxStartNamed(call, ctx, e, query, arg); ok {
+		call.SetParam(0, ctx)
+	}
+}
+
+//go:linkname sqlxNamedExecContextOnExit github.com/jmoiron/sqlx.sqlxNamedExecContextOnExit
+func sqlxNamedExecContextOnExit(call api.CallContext, result sql.Result, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxDBQueryxOnEnter github.com/jmoiron/sqlx.sqlxDBQueryxOnEnter
+func sqlxDBQueryxOnEnter(call api.CallContext, db *sqlx.DB, query string, args ...interface{}) {
+	sqlxStart(call, nil, db, query, len(args))
+}
+
+//go:linkname sqlxDBQueryxOnExit github.com/jmoiron/sqlx.sqlxDBQueryxOnExit
+func sqlxDBQueryxOnExit(call api.CallContext, rows *sqlx.Rows, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxDBQueryRowxOnEnter github.com/jmoiron/sqlx.sqlxDBQueryRowxOnEnter
+func sqlxDBQueryRowxOnEnter(call api.CallContext, db *sqlx.DB, query string, args ...interface{}) {
+	sqlxStart(call, nil, db, query, len(args))
+}
+
+//go:linkname sqlxDBQueryRowxOnExit github.com/jmoiron/sqlx.sqlxDBQueryRowxOnExit
+func sqlxDBQueryRowxOnExit(call api.CallContext, row *sqlx.Row) {
+	sqlxEnd(call, sqlxRowErr(row))
+}
+
+//go:linkname sqlxDBQueryxContextOnEnter github.com/jmoiron/sqlx.sqlxDBQueryxContextOnEnter
+func sqlxDBQueryxContextOnEnter(call api.CallContext, db *sqlx.DB, ctx context.Context, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, db, query, len(args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname sqlxDBQueryxContextOnExit github.com/jmoiron/sqlx.sqlxDBQueryxContextOnExit
+func sqlxDBQueryxContextOnExit(call api.CallContext, rows *sqlx.Rows, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxDBQueryRowxContextOnEnter github.com/jmoiron/sqlx.sqlxDBQueryRowxContextOnEnter
+func sqlxDBQueryRowxContextOnEnter(call api.CallContext, db *sqlx.DB, ctx context.Context, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, db, query, len(args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname sqlxDBQueryRowxContextOnExit github.com/jmoiron/sqlx.sqlxDBQueryRowxContextOnExit
+func sqlxDBQueryRowxContextOnExit(call api.CallContext, row *sqlx.Row) {
+	sqlxEnd(call, sqlxRowErr(row))
+}
+
+//go:linkname sqlxTxQueryxOnEnter github.com/jmoiron/sqlx.sqlxTxQueryxOnEnter
+func sqlxTxQueryxOnEnter(call api.CallContext, tx *sqlx.Tx, query string, args ...interface{}) {
+	sqlxStart(call, nil, tx, query, len(args))
+}
+
+//go:linkname sqlxTxQueryxOnExit github.com/jmoiron/sqlx.sqlxTxQueryxOnExit
+func sqlxTxQueryxOnExit(call api.CallContext, rows *sqlx.Rows, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxTxQueryRowxOnEnter github.com/jmoiron/sqlx.sqlxTxQueryRowxOnEnter
+func sqlxTxQueryRowxOnEnter(call api.CallContext, tx *sqlx.Tx, query string, args ...interface{}) {
+	sqlxStart(call, nil, tx, query, len(args))
+}
+
+//go:linkname sqlxTxQueryRowxOnExit github.com/jmoiron/sqlx.sqlxTxQueryRowxOnExit
+func sqlxTxQueryRowxOnExit(call api.CallContext, row *sqlx.Row) {
+	sqlxEnd(call, sqlxRowErr(row))
+}
+
+//go:linkname sqlxTxQueryxContextOnEnter github.com/jmoiron/sqlx.sqlxTxQueryxContextOnEnter
+func sqlxTxQueryxContextOnEnter(call api.CallContext, tx *sqlx.Tx, ctx context.Context, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, tx, query, len(args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname sqlxTxQueryxContextOnExit github.com/jmoiron/sqlx.sqlxTxQueryxContextOnExit
+func sqlxTxQueryxContextOnExit(call api.CallContext, rows *sqlx.Rows, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxTxQueryRowxContextOnEnter github.com/jmoiron/sqlx.sqlxTxQueryRowxContextOnEnter
+func sqlxTxQueryRowxContextOnEnter(call api.CallContext, tx *sqlx.Tx, ctx context.Context, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, tx, query, len(args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname sqlxTxQueryRowxContextOnExit github.com/jmoiron/sqlx.sqlxTxQueryRowxContextOnExit
+func sqlxTxQueryRowxContextOnExit(call api.CallContext, row *sqlx.Row) {
+	sqlxEnd(call, sqlxRowErr(row))
+}
+
+//go:linkname sqlxConnQueryxContextOnEnter github.com/jmoiron/sqlx.sqlxConnQueryxContextOnEnter
+func sqlxConnQueryxContextOnEnter(call api.CallContext, c *sqlx.Conn, ctx context.Context, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, c, query, len(args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname sqlxConnQueryxContextOnExit github.com/jmoiron/sqlx.sqlxConnQueryxContextOnExit
+func sqlxConnQueryxContextOnExit(call api.CallContext, rows *sqlx.Rows, err error) {
+	sqlxEnd(call, err)
+}
+
+//go:linkname sqlxConnQueryRowxContextOnEnter github.com/jmoiron/sqlx.sqlxConnQueryRowxContextOnEnter
+func sqlxConnQueryRowxContextOnEnter(call api.CallContext, c *sqlx.Conn, ctx context.Context, query string, args ...interface{}) {
+	if ctx, ok := sqlxStart(call, ctx, c, query, len(args)); ok {
+		call.SetParam(1, ctx)
+	}
+}
+
+//go:linkname sqlxConnQueryRowxContextOnExit github.com/jmoiron/sqlx.sqlxConnQueryRowxContextOnExit
+func sqlxConnQueryRowxContextOnExit(call api.CallContext, row *sqlx.Row) {
+	sqlxEnd(call, sqlxRowErr(row))
+}
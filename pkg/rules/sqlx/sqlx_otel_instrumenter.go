@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlx
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/db"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const sqlxArgsCountKey = attribute.Key("sqlx.args_count")
+
+type sqlxAttrsGetter struct{}
+
+func (s sqlxAttrsGetter) GetSystem(request sqlxRequest) string {
+	return request.System
+}
+
+func (s sqlxAttrsGetter) GetServerAddress(request sqlxRequest) string {
+	return request.Addr
+}
+
+func (s sqlxAttrsGetter) GetStatement(request sqlxRequest) string {
+	return request.Statement
+}
+
+func (s sqlxAttrsGetter) GetCollection(_ sqlxRequest) string {
+	// Tables are parsed from the statements by the spans of database/sql
+	return ""
+}
+
+func (s sqlxAttrsGetter) GetOperation(request sqlxRequest) string {
+	return request.Operation
+}
+
+func (s sqlxAttrsGetter) GetParameters(_ sqlxRequest) []any {
+	return nil
+}
+
+func (s sqlxAttrsGetter) GetDbNamespace(_ sqlxRequest) string {
+	return ""
+}
+
+func (s sqlxAttrsGetter) GetBatchSize(_ sqlxRequest) int {
+	return 0
+}
+
+type sqlxArgsAttrsExtractor struct{}
+
+func (s sqlxArgsAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request sqlxRequest) ([]attribute.KeyValue, context.Context) {
+	return append(attributes, sqlxArgsCountKey.Int(request.ArgsCount)), parentContext
+}
+
+func (s sqlxArgsAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request sqlxRequest, response interface{}, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, context
+}
+
+func BuildSqlxInstrumenter() instrumenter.Instrumenter[sqlxRequest, interface{}] {
+	builder := instrumenter.Builder[sqlxRequest, interface{}]{}
+	getter := sqlxAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&db.DBSpanNameExtractor[sqlxRequest]{Getter: getter}).SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[sqlxRequest]{}).
+		AddAttributesExtractor(&db.DbClientAttrsExtractor[sqlxRequest, any, sqlxAttrsGetter]{Base: db.DbClientCommonAttrsExtractor[sqlxRequest, any, sqlxAttrsGetter]{Getter: getter}}, sqlxArgsAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.SQLX_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
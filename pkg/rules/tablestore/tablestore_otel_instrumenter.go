@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablestore
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tablestoreEnabler = tablestoreInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_TABLESTORE_ENABLED") != "false"}
+
+var tablestoreInstrumenter = buildTablestoreInstrumenter()
+
+type tablestoreInnerEnabler struct {
+	enabled bool
+}
+
+func (t tablestoreInnerEnabler) Enable() bool {
+	return t.enabled
+}
+
+type tablestoreSpanStatusExtractor struct{}
+
+func (t *tablestoreSpanStatusExtractor) Extract(span trace.Span, request tablestoreReq, response tablestoreRes, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+type tablestoreRpcAttrsGetter struct{}
+
+func (getter tablestoreRpcAttrsGetter) GetSystem(request tablestoreReq) string {
+	return "aliyun"
+}
+
+func (getter tablestoreRpcAttrsGetter) GetService(request tablestoreReq) string {
+	return "TableStore"
+}
+
+func (getter tablestoreRpcAttrsGetter) GetMethod(request tablestoreReq) string {
+	return request.operation
+}
+
+func (getter tablestoreRpcAttrsGetter) GetServerAddress(request tablestoreReq) string {
+	return ""
+}
+
+// tablestoreAttrsExtractor records the table of a request together with the
+// request id TableStore assigns to it
+type tablestoreAttrsExtractor struct{}
+
+func (extractor *tablestoreAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request tablestoreReq) ([]attribute.KeyValue, context.Context) {
+	if request.table != "" {
+		attributes = append(attributes, attribute.String("aliyun.tablestore.table", request.table))
+	}
+	return attributes, parentContext
+}
+
+func (extractor *tablestoreAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request tablestoreReq, response tablestoreRes, err error) ([]attribute.KeyValue, context.Context) {
+	if response.requestID != "" {
+		attributes = append(attributes, attribute.String("aliyun.request_id", response.requestID))
+	}
+	return attributes, ctx
+}
+
+func buildTablestoreInstrumenter() instrumenter.Instrumenter[tablestoreReq, tablestoreRes] {
+	builder := instrumenter.Builder[tablestoreReq, tablestoreRes]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.TABLESTORE_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[tablestoreReq]{Getter: tablestoreRpcAttrsGetter{}}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[tablestoreReq]{}).
+		SetSpanStatusExtractor(&tablestoreSpanStatusExtractor{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[tablestoreReq, tablestoreRes, tablestoreRpcAttrsGetter]{}).
+		AddAttributesExtractor(&tablestoreAttrsExtractor{}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablestore
+
+import (
+	"context"
+	"strings"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+)
+
+type tablestoreData struct {
+	ctx     context.Context
+	request tablestoreReq
+}
+
+// tablestoreTableRequest is implemented by the protobuf requests addressing a
+// single table
+type tablestoreTableRequest interface {
+	GetTableName() string
+}
+
+// every api of the client sends its protobuf request through
+// doRequestWithRetry, the uri of the request is the name of the api, e.g.
+// /GetRow
+//
+//go:linkname beforeTablestoreDoRequestWithRetry github.com/aliyun/aliyun-tablestore-go-sdk/tablestore.beforeTablestoreDoRequestWithRetry
+func beforeTablestoreDoRequestWithRetry(call api.CallContext, client *tablestore.TableStoreClient, uri string, req, resp interface{}, responseInfo *tablestore.ResponseInfo) {
+	if !tablestoreEnabler.Enable() {
+		return
+	}
+	request := tablestoreReq{operation: strings.TrimPrefix(uri, "/")}
+	if tableRequest, ok := req.(tablestoreTableRequest); ok {
+		request.table = tableRequest.GetTableName()
+	}
+	ctx := tablestoreInstrumenter.Start(context.Background(), request)
+	call.SetData(tablestoreData{ctx: ctx, request: request})
+}
+
+//go:linkname afterTablestoreDoRequestWithRetry github.com/aliyun/aliyun-tablestore-go-sdk/tablestore.afterTablestoreDoRequestWithRetry
+func afterTablestoreDoRequestWithRetry(call api.CallContext, err error) {
+	data, ok := call.GetData().(tablestoreData)
+	if !ok {
+		return
+	}
+	response := tablestoreRes{}
+	if responseInfo, ok := call.GetParam(4).(*tablestore.ResponseInfo); ok && responseInfo != nil {
+		response.requestID = responseInfo.RequestId
+	}
+	tablestoreInstrumenter.End(data.ctx, data.request, response, err)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package temporal
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
+)
+
+// temporalInterceptor is both a client and a worker interceptor, so workers
+// created from an instrumented client trace workflows and activities as well
+var temporalInterceptor = interceptor.NewTracingInterceptor(&temporalTracer{})
+
+// withTemporalInterceptor returns the options with the tracing interceptor
+// appended, it is added only once as Dial delegates to DialContext
+func withTemporalInterceptor(options client.Options) (client.Options, bool) {
+	for _, i := range options.Interceptors {
+		if i == temporalInterceptor {
+			return options, false
+		}
+	}
+	interceptors := make([]interceptor.ClientInterceptor, 0, len(options.Interceptors)+1)
+	interceptors = append(interceptors, options.Interceptors...)
+	options.Interceptors = append(interceptors, temporalInterceptor)
+	return options, true
+}
+
+//go:linkname beforeTemporalDial go.temporal.io/sdk/client.beforeTemporalDial
+func beforeTemporalDial(call api.CallContext, options client.Options) {
+	if !temporalEnabler.Enable() {
+		return
+	}
+	if options, ok := withTemporalInterceptor(options); ok {
+		call.SetParam(0, options)
+	}
+}
+
+//go:linkname beforeTemporalDialContext go.temporal.io/sdk/client.beforeTemporalDialContext
+func beforeTemporalDialContext(call api.CallContext, ctx context.Context, options client.Options) {
+	if !temporalEnabler.Enable() {
+		return
+	}
+	if options, ok := withTemporalInterceptor(options); ok {
+		call.SetParam(1, options)
+	}
+}
+
+//go:linkname beforeTemporalNewLazyClient go.temporal.io/sdk/client.beforeTemporalNewLazyClient
+func beforeTemporalNewLazyClient(call api.CallContext, options client.Options) {
+	if !temporalEnabler.Enable() {
+		return
+	}
+	if options, ok := withTemporalInterceptor(options); ok {
+		call.SetParam(0, options)
+	}
+}
+
+//go:linkname beforeTemporalNewClientFromExisting go.temporal.io/sdk/client.beforeTemporalNewClientFromExisting
+func beforeTemporalNewClientFromExisting(call api.CallContext, existingClient client.Client, options client.Options) {
+	if !temporalEnabler.Enable() {
+		return
+	}
+	if options, ok := withTemporalInterceptor(options); ok {
+		call.SetParam(1, options)
+	}
+}
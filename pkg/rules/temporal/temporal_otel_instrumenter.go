@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package temporal
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var temporalEnabler = temporalInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_TEMPORAL_ENABLED") != "false"}
+
+var temporalInstrumenter = buildTemporalInstrumenter()
+
+type temporalInnerEnabler struct {
+	enabled bool
+}
+
+func (t temporalInnerEnabler) Enable() bool {
+	return t.enabled
+}
+
+// temporalSpanNameExtractor names a span as the official tracing interceptor
+// of temporal does, e.g. RunActivity:SayHello
+type temporalSpanNameExtractor struct{}
+
+func (t *temporalSpanNameExtractor) Extract(request temporalReq) string {
+	return request.operation + ":" + request.name
+}
+
+// temporalSpanKindExtractor tells the spans of the code run by a worker, such
+// as RunWorkflow and HandleSignal, from the spans of the calls to the server
+type temporalSpanKindExtractor struct{}
+
+func (t *temporalSpanKindExtractor) Extract(request temporalReq) trace.SpanKind {
+	if strings.HasPrefix(request.operation, "Run") || strings.HasPrefix(request.operation, "Handle") ||
+		strings.HasPrefix(request.operation, "Validate") {
+		return trace.SpanKindServer
+	}
+	return trace.SpanKindClient
+}
+
+type temporalSpanStatusExtractor struct{}
+
+func (t *temporalSpanStatusExtractor) Extract(span trace.Span, request temporalReq, response any, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// temporalAttrsExtractor records the tags of the tracing interceptor, which
+// are the ids of the workflow, run and activity of the span
+type temporalAttrsExtractor struct{}
+
+func (t *temporalAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request temporalReq) ([]attribute.KeyValue, context.Context) {
+	for key, value := range request.tags {
+		attributes = append(attributes, attribute.String(key, value))
+	}
+	return attributes, parentContext
+}
+
+func (t *temporalAttrsExtractor) OnEnd(attributes []attribute.KeyValue, ctx context.Context, request temporalReq, response any, err error) ([]attribute.KeyValue, context.Context) {
+	return attributes, ctx
+}
+
+func buildTemporalInstrumenter() instrumenter.Instrumenter[temporalReq, any] {
+	builder := instrumenter.Builder[temporalReq, any]{}
+	return builder.Init().
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.TEMPORAL_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		SetSpanNameExtractor(&temporalSpanNameExtractor{}).
+		SetSpanKindExtractor(&temporalSpanKindExtractor{}).
+		SetSpanStatusExtractor(&temporalSpanStatusExtractor{}).
+		AddAttributesExtractor(&temporalAttrsExtractor{}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package temporal
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/sdk/interceptor"
+)
+
+// temporalTracer bridges the tracing interceptor of temporal to the
+// instrumenter, the interceptor itself takes care of the headers and of not
+// producing spans while a workflow is being replayed
+type temporalTracer struct {
+	interceptor.BaseTracer
+}
+
+type temporalSpanContextKey struct{}
+
+// temporalSpan is a span started by the tracer, ctx holds the otel span
+type temporalSpan struct {
+	ctx     context.Context
+	request temporalReq
+}
+
+// temporalSpanRef is the span context read from the temporal headers
+type temporalSpanRef struct {
+	spanContext trace.SpanContext
+}
+
+func (s *temporalSpan) Finish(opts *interceptor.TracerFinishSpanOptions) {
+	temporalInstrumenter.End(s.ctx, s.request, nil, opts.Error)
+}
+
+func (t *temporalTracer) Options() interceptor.TracerOptions {
+	return interceptor.TracerOptions{
+		SpanContextKey: temporalSpanContextKey{},
+		HeaderKey:      "_tracer-data",
+	}
+}
+
+func (t *temporalTracer) UnmarshalSpan(data map[string]string) (interceptor.TracerSpanRef, error) {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(data))
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil, fmt.Errorf("failed extracting span context from temporal header")
+	}
+	return &temporalSpanRef{spanContext: spanContext}, nil
+}
+
+func (t *temporalTracer) MarshalSpan(span interceptor.TracerSpan) (map[string]string, error) {
+	s, ok := span.(*temporalSpan)
+	if !ok {
+		return nil, nil
+	}
+	data := map[string]string{}
+	otel.GetTextMapPropagator().Inject(s.ctx, propagation.MapCarrier(data))
+	return data, nil
+}
+
+func (t *temporalTracer) SpanFromContext(ctx context.Context) interceptor.TracerSpan {
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return nil
+	}
+	return &temporalSpan{ctx: trace.ContextWithSpan(context.Background(), span)}
+}
+
+func (t *temporalTracer) ContextWithSpan(ctx context.Context, span interceptor.TracerSpan) context.Context {
+	s, ok := span.(*temporalSpan)
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithSpan(ctx, trace.SpanFromContext(s.ctx))
+}
+
+func (t *temporalTracer) StartSpan(opts *interceptor.TracerStartSpanOptions) (interceptor.TracerSpan, error) {
+	ctx := context.Background()
+	switch parent := opts.Parent.(type) {
+	case nil:
+	case *temporalSpan:
+		ctx = trace.ContextWithSpan(ctx, trace.SpanFromContext(parent.ctx))
+	case *temporalSpanRef:
+		if opts.FromHeader {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, parent.spanContext)
+		} else {
+			ctx = trace.ContextWithSpanContext(ctx, parent.spanContext)
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized parent type %T", parent)
+	}
+	request := temporalReq{
+		operation: opts.Operation,
+		name:      opts.Name,
+		tags:      opts.Tags,
+	}
+	var startOpts []trace.SpanStartOption
+	if !opts.Time.IsZero() {
+		startOpts = append(startOpts, trace.WithTimestamp(opts.Time))
+	}
+	ctx = temporalInstrumenter.Start(ctx, request, startOpts...)
+	return &temporalSpan{ctx: ctx, request: request}, nil
+}
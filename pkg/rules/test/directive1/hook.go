@@ -0,0 +1,46 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directive1
+
+import (
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+//go:linkname onEnterGreet directive/lib.onEnterGreet
+func onEnterGreet(call api.CallContext, name string) {
+	call.SetParam(0, "directive")
+}
+
+//go:linkname onExitGreet directive/lib.onExitGreet
+func onExitGreet(call api.CallContext, ret string) {
+	call.SetReturnVal(0, ret+"!")
+}
+
+//go:linkname onEnterSum directive/lib.onEnterSum
+func onEnterSum(call api.CallContext, a, b int) {
+	call.SetParam(1, 40)
+}
+
+//go:linkname onEnterNanotime directive/lib.onEnterNanotime
+func onEnterNanotime(call api.CallContext) {
+	panic("function declared by linkname should never be hooked")
+}
+
+//go:linkname onEnterDouble directive/lib.onEnterDouble
+func onEnterDouble(call api.CallContext, x int) {
+	panic("function implemented in assembly should never be hooked")
+}
@@ -0,0 +1,5 @@
+module github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/rules/error20
+
+go 1.23.0
+
+require github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg v0.0.0-20250613015359-8313b2644a4a
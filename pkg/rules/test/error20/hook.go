@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package error20
+
+import (
+	"strconv"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+// Parameters of exit-only hooks are indexed along with the receiver as well
+//
+//go:linkname onExitTestExitOnlyRecv errorstest/auxiliary.onExitTestExitOnlyRecv
+func onExitTestExitOnlyRecv(call api.CallContext, ret int) {
+	if call.GetParam(0) == nil {
+		panic("no receiver")
+	}
+	println("exitonly" + strconv.Itoa(call.GetParam(1).(int)))
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package twirp
+
+import (
+	"context"
+	"net/http"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var twirpClientInstrumenter = BuildTwirpClientInstrumenter()
+
+func clientInterceptor(next twirp.Method) twirp.Method {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		request := newTwirpRequest(ctx)
+		ctx = twirpClientInstrumenter.Start(ctx, request)
+		// The generated client sends the headers carried by the context,
+		// reserved headers are never among them so this can not fail.
+		header := http.Header{}
+		if h, ok := twirp.HTTPRequestHeaders(ctx); ok {
+			header = h.Clone()
+		}
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+		if newCtx, err := twirp.WithHTTPRequestHeaders(ctx, header); err == nil {
+			ctx = newCtx
+		}
+		resp, err := next(ctx, req)
+		twirpClientInstrumenter.End(ctx, request, twirpResponse{}, err)
+		return resp, err
+	}
+}
+
+// The generated client constructor reads its options before it chains the
+// interceptors, the first read is where the client interceptor is installed.
+//
+//go:linkname clientReadOptOnEnter github.com/twitchtv/twirp.clientReadOptOnEnter
+func clientReadOptOnEnter(call api.CallContext, opts *twirp.ClientOptions, key string, out interface{}) {
+	if !twirpEnabler.Enable() || opts == nil || opts.OtelIntercepted {
+		return
+	}
+	opts.OtelIntercepted = true
+	opts.Interceptors = append([]twirp.Interceptor{clientInterceptor}, opts.Interceptors...)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package twirp
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api-semconv/instrumenter/rpc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+const twirpErrorCodeKey = attribute.Key("rpc.twirp.error_code")
+
+type twirpInnerEnabler struct {
+	enabled bool
+}
+
+func (t twirpInnerEnabler) Enable() bool {
+	return t.enabled
+}
+
+var twirpEnabler = twirpInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_TWIRP_ENABLED") != "false"}
+
+type twirpAttrsGetter struct {
+}
+
+func (t twirpAttrsGetter) GetSystem(request twirpRequest) string {
+	return "twirp"
+}
+
+func (t twirpAttrsGetter) GetService(request twirpRequest) string {
+	if request.packageName != "" {
+		return request.packageName + "." + request.serviceName
+	}
+	return request.serviceName
+}
+
+func (t twirpAttrsGetter) GetMethod(request twirpRequest) string {
+	return request.methodName
+}
+
+func (t twirpAttrsGetter) GetServerAddress(request twirpRequest) string {
+	return ""
+}
+
+type twirpErrorCodeExtractor struct{}
+
+func (t twirpErrorCodeExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request twirpRequest) ([]attribute.KeyValue, context.Context) {
+	return attributes, parentContext
+}
+
+func (t twirpErrorCodeExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request twirpRequest, response twirpResponse, err error) ([]attribute.KeyValue, context.Context) {
+	var twerr twirp.Error
+	if errors.As(err, &twerr) {
+		attributes = append(attributes, twirpErrorCodeKey.String(string(twerr.Code())))
+	}
+	return attributes, context
+}
+
+func BuildTwirpClientInstrumenter() instrumenter.Instrumenter[twirpRequest, twirpResponse] {
+	builder := instrumenter.Builder[twirpRequest, twirpResponse]{}
+	clientGetter := twirpAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[twirpRequest]{Getter: clientGetter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[twirpRequest]{}).
+		AddAttributesExtractor(&rpc.ClientRpcAttrsExtractor[twirpRequest, twirpResponse, twirpAttrsGetter]{Base: rpc.RpcAttrsExtractor[twirpRequest, twirpResponse, twirpAttrsGetter]{Getter: clientGetter}}, twirpErrorCodeExtractor{}).
+		AddOperationListeners(rpc.RpcClientMetrics("twirp.client")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.TWIRP_CLIENT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func BuildTwirpServerInstrumenter() instrumenter.Instrumenter[twirpRequest, twirpResponse] {
+	builder := instrumenter.Builder[twirpRequest, twirpResponse]{}
+	serverGetter := twirpAttrsGetter{}
+	return builder.Init().SetSpanNameExtractor(&rpc.RpcSpanNameExtractor[twirpRequest]{Getter: serverGetter}).
+		SetSpanKindExtractor(&instrumenter.AlwaysServerExtractor[twirpRequest]{}).
+		AddAttributesExtractor(&rpc.ServerRpcAttrsExtractor[twirpRequest, twirpResponse, twirpAttrsGetter]{Base: rpc.RpcAttrsExtractor[twirpRequest, twirpResponse, twirpAttrsGetter]{Getter: serverGetter}}, twirpErrorCodeExtractor{}).
+		AddOperationListeners(rpc.RpcServerMetrics("twirp.server")).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.TWIRP_SERVER_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+func newTwirpRequest(ctx context.Context) twirpRequest {
+	packageName, _ := twirp.PackageName(ctx)
+	serviceName, _ := twirp.ServiceName(ctx)
+	methodName, _ := twirp.MethodName(ctx)
+	return twirpRequest{
+		packageName: packageName,
+		serviceName: serviceName,
+		methodName:  methodName,
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package twirp
+
+import (
+	"context"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	"github.com/twitchtv/twirp"
+)
+
+var twirpServerInstrumenter = BuildTwirpServerInstrumenter()
+
+// serverInterceptor starts the server span from the request context, which
+// already carries the upstream context extracted by net/http.
+func serverInterceptor(next twirp.Method) twirp.Method {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		request := newTwirpRequest(ctx)
+		ctx = twirpServerInstrumenter.Start(ctx, request)
+		resp, err := next(ctx, req)
+		twirpServerInstrumenter.End(ctx, request, twirpResponse{}, err)
+		return resp, err
+	}
+}
+
+//go:linkname serverReadOptOnEnter github.com/twitchtv/twirp.serverReadOptOnEnter
+func serverReadOptOnEnter(call api.CallContext, opts *twirp.ServerOptions, key string, out interface{}) {
+	if !twirpEnabler.Enable() || opts == nil || opts.OtelIntercepted {
+		return
+	}
+	opts.OtelIntercepted = true
+	opts.Interceptors = append([]twirp.Interceptor{serverInterceptor}, opts.Interceptors...)
+}
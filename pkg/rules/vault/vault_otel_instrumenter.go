@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/instrumenter"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/utils"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/inst-api/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+)
+
+const (
+	vaultOperationKey     = attribute.Key("vault.operation")
+	vaultMountKey         = attribute.Key("vault.mount")
+	vaultNamespaceKey     = attribute.Key("vault.namespace")
+	vaultRenewKindKey     = attribute.Key("vault.renew.kind")
+	vaultLeaseDurationKey = attribute.Key("vault.lease.duration")
+)
+
+type vaultInnerEnabler struct {
+	enabled bool
+}
+
+func (v vaultInnerEnabler) Enable() bool {
+	return v.enabled
+}
+
+var vaultEnabler = vaultInnerEnabler{os.Getenv("OTEL_INSTRUMENTATION_VAULT_ENABLED") != "false"}
+
+type vaultSpanNameExtractor struct{}
+
+// Extract returns "vault {vault.operation} {vault.mount}", e.g.
+// "vault read secret". Neither the rest of the path nor the data read or
+// written is recorded, as they may hold secrets.
+func (v vaultSpanNameExtractor) Extract(request vaultRequest) string {
+	if request.mount == "" {
+		return "vault " + request.operation
+	}
+	return "vault " + request.operation + " " + request.mount
+}
+
+type vaultAttrsExtractor struct{}
+
+func (v vaultAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request vaultRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes,
+		vaultOperationKey.String(request.operation),
+		semconv.HTTPRequestMethodKey.String(request.method),
+	)
+	if request.mount != "" {
+		attributes = append(attributes, vaultMountKey.String(request.mount))
+	}
+	if request.namespace != "" {
+		attributes = append(attributes, vaultNamespaceKey.String(request.namespace))
+	}
+	if request.url != nil {
+		attributes = append(attributes, semconv.ServerAddress(request.url.Hostname()))
+		if port, err := strconv.Atoi(request.url.Port()); err == nil {
+			attributes = append(attributes, semconv.ServerPort(port))
+		}
+	}
+	return attributes, parentContext
+}
+
+func (v vaultAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request vaultRequest, response vaultResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if response.statusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(response.statusCode))
+	}
+	return attributes, context
+}
+
+func BuildVaultClientInstrumenter() instrumenter.Instrumenter[vaultRequest, vaultResponse] {
+	builder := instrumenter.Builder[vaultRequest, vaultResponse]{}
+	return builder.Init().SetSpanNameExtractor(vaultSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysClientExtractor[vaultRequest]{}).
+		AddAttributesExtractor(vaultAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.VAULT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
+
+type vaultRenewSpanNameExtractor struct{}
+
+// Extract returns "vault renew {vault.renew.kind}", e.g. "vault renew token".
+func (v vaultRenewSpanNameExtractor) Extract(request vaultRenewRequest) string {
+	return "vault renew " + vaultRenewKind(request)
+}
+
+type vaultRenewAttrsExtractor struct{}
+
+func (v vaultRenewAttrsExtractor) OnStart(attributes []attribute.KeyValue, parentContext context.Context, request vaultRenewRequest) ([]attribute.KeyValue, context.Context) {
+	attributes = append(attributes, vaultRenewKindKey.String(vaultRenewKind(request)))
+	return attributes, parentContext
+}
+
+func (v vaultRenewAttrsExtractor) OnEnd(attributes []attribute.KeyValue, context context.Context, request vaultRenewRequest, response vaultRenewResponse, err error) ([]attribute.KeyValue, context.Context) {
+	if err == nil {
+		attributes = append(attributes, vaultLeaseDurationKey.Int(response.leaseDuration))
+	}
+	return attributes, context
+}
+
+func vaultRenewKind(request vaultRenewRequest) string {
+	if request.tokenMode {
+		return "token"
+	}
+	return "lease"
+}
+
+func BuildVaultRenewInstrumenter() instrumenter.Instrumenter[vaultRenewRequest, vaultRenewResponse] {
+	builder := instrumenter.Builder[vaultRenewRequest, vaultRenewResponse]{}
+	return builder.Init().SetSpanNameExtractor(vaultRenewSpanNameExtractor{}).
+		SetSpanKindExtractor(&instrumenter.AlwaysInternalExtractor[vaultRenewRequest]{}).
+		AddAttributesExtractor(vaultRenewAttrsExtractor{}).
+		SetInstrumentationScope(instrumentation.Scope{
+			Name:    utils.VAULT_SCOPE_NAME,
+			Version: version.Tag,
+		}).
+		BuildInstrumenter()
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+	_ "unsafe"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+	vault "github.com/hashicorp/vault/api"
+)
+
+var vaultInstrumenter = BuildVaultClientInstrumenter()
+
+var vaultRenewInstrumenter = BuildVaultRenewInstrumenter()
+
+type vaultCall struct {
+	ctx     context.Context
+	request vaultRequest
+}
+
+//go:linkname vaultRawRequestOnEnter github.com/hashicorp/vault/api.vaultRawRequestOnEnter
+func vaultRawRequestOnEnter(call api.CallContext, c *vault.Client, ctx context.Context, r *vault.Request) {
+	if !vaultEnabler.Enable() || c == nil || r == nil || r.URL == nil {
+		return
+	}
+	request := newVaultRequest(r)
+	request.namespace = c.Namespace()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	newCtx := vaultInstrumenter.Start(ctx, request)
+	call.SetParam(1, newCtx)
+	call.SetData(&vaultCall{ctx: newCtx, request: request})
+}
+
+//go:linkname vaultRawRequestOnExit github.com/hashicorp/vault/api.vaultRawRequestOnExit
+func vaultRawRequestOnExit(call api.CallContext, resp *vault.Response, err error) {
+	if !vaultEnabler.Enable() {
+		return
+	}
+	c, ok := call.GetData().(*vaultCall)
+	if !ok || c == nil {
+		return
+	}
+	response := vaultResponse{}
+	if resp != nil && resp.Response != nil {
+		response.statusCode = resp.StatusCode
+	}
+	vaultInstrumenter.End(c.ctx, c.request, response, err)
+}
+
+// vaultDoRenewOnEnter wraps the renewal made by the lifetime watcher in the
+// background, so that each of them gets a span of its own. The renewal is of
+// the unexported type renewFunc, hence it is rebuilt through reflection.
+//
+//go:linkname vaultDoRenewOnEnter github.com/hashicorp/vault/api.vaultDoRenewOnEnter
+func vaultDoRenewOnEnter(call api.CallContext, w *vault.LifetimeWatcher, tokenMode bool, nonRenewable bool, initLeaseDuration int, credString string, renew interface{}, initialRetryInterval time.Duration) {
+	if !vaultEnabler.Enable() || nonRenewable {
+		return
+	}
+	fn := reflect.ValueOf(renew)
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return
+	}
+	request := vaultRenewRequest{tokenMode: tokenMode}
+	wrapped := reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		ctx := vaultRenewInstrumenter.Start(context.Background(), request)
+		results := fn.Call(args)
+		response := vaultRenewResponse{}
+		if secret, ok := results[0].Interface().(*vault.Secret); ok && secret != nil {
+			response.leaseDuration = secret.LeaseDuration
+			if tokenMode && secret.Auth != nil {
+				response.leaseDuration = secret.Auth.LeaseDuration
+			}
+		}
+		err, _ := results[1].Interface().(error)
+		vaultRenewInstrumenter.End(ctx, request, response, err)
+		return results
+	})
+	call.SetParam(5, wrapped.Interface())
+}
+
+func newVaultRequest(r *vault.Request) vaultRequest {
+	request := vaultRequest{method: r.Method, url: r.URL}
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")
+	segments := strings.Split(path, "/")
+	switch {
+	case segments[0] == "auth" && len(segments) > 1:
+		request.mount = "auth/" + segments[1]
+	case segments[0] == "sys":
+		request.mount = "sys"
+	default:
+		// the mount may be nested, which cannot be told from the path
+		request.mount = segments[0]
+	}
+	switch {
+	case path == "auth/token/renew-self" || path == "auth/token/renew" ||
+		path == "sys/renew" || strings.HasPrefix(path, "sys/leases/renew"):
+		request.operation = "renew"
+	case segments[0] == "auth" && (segments[len(segments)-1] == "login" ||
+		(len(segments) > 2 && segments[2] == "login")):
+		request.operation = "login"
+	case r.Method == "LIST" || r.Params.Get("list") == "true":
+		request.operation = "list"
+	case r.Method == http.MethodGet:
+		request.operation = "read"
+	case r.Method == http.MethodPut || r.Method == http.MethodPost:
+		request.operation = "write"
+	case r.Method == http.MethodDelete:
+		request.operation = "delete"
+	default:
+		request.operation = strings.ToLower(r.Method)
+	}
+	return request
+}
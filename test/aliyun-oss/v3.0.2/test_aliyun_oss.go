@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	bucketName = "otel-bucket"
+	objectKey  = "otel-object"
+	requestID  = "5C06A3B67B8B5A3DA422299D"
+)
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	// the server stands in for OSS, it accepts every request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Oss-Request-Id", requestID)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := oss.New(server.URL, "ak", "sk")
+	if err != nil {
+		panic(err)
+	}
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		panic(err)
+	}
+	if err = bucket.PutObject(objectKey, strings.NewReader("hello")); err != nil {
+		panic(err)
+	}
+	if err = bucket.DeleteObject(objectKey); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		put, ok := findSpan(stubs, "OSS/PUT")
+		verifier.Assert(ok, "Expect a span putting the object")
+		verifier.Assert(put.SpanKind == trace.SpanKindClient, "Expect a client span, got %s", put.SpanKind)
+		system := verifier.GetAttribute(put.Attributes, "rpc.system").AsString()
+		verifier.Assert(system == "aliyun", "Expect rpc.system to be aliyun, got %s", system)
+		bucket := verifier.GetAttribute(put.Attributes, "aliyun.oss.bucket").AsString()
+		verifier.Assert(bucket == bucketName, "Expect aliyun.oss.bucket to be %s, got %s", bucketName, bucket)
+		object := verifier.GetAttribute(put.Attributes, "aliyun.oss.object").AsString()
+		verifier.Assert(object == objectKey, "Expect aliyun.oss.object to be %s, got %s", objectKey, object)
+		id := verifier.GetAttribute(put.Attributes, "aliyun.request_id").AsString()
+		verifier.Assert(id == requestID, "Expect aliyun.request_id to be %s, got %s", requestID, id)
+
+		_, ok = findSpan(stubs, "OSS/DELETE")
+		verifier.Assert(ok, "Expect a span deleting the object")
+	}, 2)
+}
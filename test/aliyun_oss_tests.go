@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const aliyun_oss_dependency_name = "github.com/aliyun/aliyun-oss-go-sdk"
+const aliyun_oss_module_name = "aliyun-oss"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("aliyun-oss-3.0.2-test", aliyun_oss_module_name, "v3.0.2", "", "1.18", "", TestAliyunOss),
+		NewMuzzleTestCase("aliyun-oss-muzzle-test", aliyun_oss_dependency_name, aliyun_oss_module_name, "v3.0.2", "", "1.18", "", []string{"go", "build", "test_aliyun_oss.go"}),
+		NewLatestDepthTestCase("aliyun-oss-latest-depth-test", aliyun_oss_dependency_name, aliyun_oss_module_name, "v3.0.2", "", "1.18", "", TestAliyunOss),
+	)
+}
+
+func TestAliyunOss(t *testing.T, env ...string) {
+	UseApp("aliyun-oss/v3.0.2")
+	RunGoBuild(t, "go", "build", "test_aliyun_oss.go")
+	RunApp(t, "test_aliyun_oss", env...)
+}
@@ -29,7 +29,8 @@ const rabbitmq_module_name = "amqp091"
 func init() {
 	TestCases = append(TestCases,
 		NewGeneralTestCase("rabbitmq_cascading-1.10.0-test", rabbitmq_module_name, "1.10.0", "1.10.0", "1.22.0", "", TestRabbitMQCascading),
-		NewGeneralTestCase("rabbitmq_no_cascading-1.10.0-test", rabbitmq_module_name, "1.10.0", "1.10.0", "1.22.0", "", TestRabbitMQNOCascading),
+		NewGeneralTestCase("rabbitmq_nil_headers-1.10.0-test", rabbitmq_module_name, "1.10.0", "1.10.0", "1.22.0", "", TestRabbitMQNilHeaders),
+		NewGeneralTestCase("rabbitmq_get-1.10.0-test", rabbitmq_module_name, "1.10.0", "1.10.0", "1.22.0", "", TestRabbitMQGet),
 	)
 
 }
@@ -40,12 +41,19 @@ func TestRabbitMQCascading(t *testing.T, env ...string) {
 	env = append(env, "RabbitMQ_PORT="+port.Port())
 	RunApp(t, "test_mq_cascading", env...)
 }
-func TestRabbitMQNOCascading(t *testing.T, env ...string) {
+func TestRabbitMQNilHeaders(t *testing.T, env ...string) {
 	_, port := initRabbitMQContainer()
 	UseApp("amqp091/v1.10.0")
-	RunGoBuild(t, "go", "build", "test_mq_no_cascading.go", "base.go")
+	RunGoBuild(t, "go", "build", "test_mq_nil_headers.go", "base.go")
 	env = append(env, "RabbitMQ_PORT="+port.Port())
-	RunApp(t, "test_mq_no_cascading", env...)
+	RunApp(t, "test_mq_nil_headers", env...)
+}
+func TestRabbitMQGet(t *testing.T, env ...string) {
+	_, port := initRabbitMQContainer()
+	UseApp("amqp091/v1.10.0")
+	RunGoBuild(t, "go", "build", "test_mq_get.go", "base.go")
+	env = append(env, "RabbitMQ_PORT="+port.Port())
+	RunApp(t, "test_mq_get", env...)
 }
 func initRabbitMQContainer() (testcontainers.Container, nat.Port) {
 	req := testcontainers.ContainerRequest{
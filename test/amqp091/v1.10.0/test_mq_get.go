@@ -15,7 +15,7 @@
 package main
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
 	"github.com/rabbitmq/amqp091-go"
@@ -32,37 +32,27 @@ func main() {
 	var nack = make(chan uint64)
 	channel.NotifyConfirm(ack, nack)
 
-	_, err = channel.PublishWithDeferredConfirm(exchange, routingKey, true, false,
+	err = channel.PublishWithContext(context.Background(), exchange, routingKey, true, false,
 		amqp091.Publishing{Body: []byte("aabbcc"), DeliveryMode: 2})
 	if err != nil {
 		panic(err)
 	}
 	select {
 	case <-ack:
-		fmt.Println(true)
 	case <-nack:
-		fmt.Println(false)
+		panic("message is not confirmed")
 	}
 
-	msgChanl, err := channel.Consume(
-		queueName,
-		"",
-		false,
-		false,
-		false,
-		false,
-		nil,
-	)
-	if err != nil {
-		panic(err)
-	}
-	if msg, ok := <-msgChanl; ok {
-		msg.Ack(true)
+	msg, ok, err := channel.Get(queueName, false)
+	if err != nil || !ok {
+		panic("no message is received")
 	}
+	msg.Ack(false)
 
 	destination := exchange + ":" + routingKey
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
 		verifier.VerifyMQPublishAttributes(stubs[0][0], exchange, routingKey, queueName, "publish", destination, "rabbitmq")
-		verifier.VerifyMQConsumeAttributes(stubs[1][0], exchange, routingKey, queueName, "receive", destination, "rabbitmq")
-	}, 2)
+		verifier.VerifyMQConsumeAttributes(stubs[0][1], exchange, routingKey, queueName, "receive", destination, "rabbitmq")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Except the received message to be a child of the published one")
+	}, 1)
 }
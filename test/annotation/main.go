@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"annotation/order"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+//otel:instrument checkout
+func checkout(ctx context.Context) {
+	svc := &order.Service{}
+	fmt.Println(svc.Process(ctx, "42"))
+	fmt.Println(svc.Process(ctx, ""))
+}
+
+func main() {
+	checkout(context.Background())
+	fmt.Println(order.First([]string{"first"}))
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		spans := stubs[0]
+		verifier.Assert(len(spans) == 4, "Expect 4 spans, but got %d", len(spans))
+		root := spans[0]
+		verifier.Assert(root.Name == "checkout", "Expect root span checkout, but got %s", root.Name)
+		verifier.Assert(verifier.GetAttribute(root.Attributes, "code.namespace").AsString() == "main", "Expect code.namespace to be main")
+		process := spans[1]
+		verifier.Assert(process.Name == "process-order", "Expect span process-order, but got %s", process.Name)
+		verifier.Assert(process.Parent.SpanID() == root.SpanContext.SpanID(), "Expect process-order to be child of checkout")
+		verifier.Assert(verifier.GetAttribute(process.Attributes, "code.namespace").AsString() == "annotation/order", "Expect code.namespace to be annotation/order")
+		verifier.Assert(verifier.GetAttribute(process.Attributes, "code.function.name").AsString() == "Service.Process", "Expect code.function.name to be Service.Process")
+		verifier.Assert(verifier.GetAttribute(process.Attributes, "team").AsString() == "payment", "Expect team to be payment")
+		verifier.Assert(verifier.GetAttribute(process.Attributes, "tier").AsString() == "gold", "Expect tier to be gold")
+		load := spans[2]
+		verifier.Assert(load.Name == "order.load", "Expect span order.load, but got %s", load.Name)
+		verifier.Assert(load.Parent.SpanID() == process.SpanContext.SpanID(), "Expect order.load to be child of process-order")
+		failed := spans[3]
+		verifier.Assert(failed.Name == "process-order", "Expect span process-order, but got %s", failed.Name)
+		verifier.Assert(failed.Status.Code == codes.Error, "Expect process-order to be failed")
+	}, 1)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package order
+
+import (
+	"context"
+	"errors"
+)
+
+type Service struct{}
+
+// Process processes the order
+//
+//otel:instrument process-order team=payment tier=gold
+func (s *Service) Process(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("empty order id")
+	}
+	load(id)
+	return nil
+}
+
+//otel:instrument
+func load(id string) string {
+	return "order " + id
+}
+
+// Generic functions are not instrumented
+//
+//otel:instrument
+func First[T any](items []T) T {
+	return items[0]
+}
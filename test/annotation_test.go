@@ -0,0 +1,29 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const AnnotationAppName = "annotation"
+
+func TestRunAnnotation(t *testing.T) {
+	UseApp(AnnotationAppName)
+
+	RunGoBuild(t, "go", "build")
+	stdout, _ := RunApp(t, AnnotationAppName)
+	ExpectContains(t, stdout, "<nil>\nempty order id\nfirst\n")
+	ExpectDebugLogContains(t, "Skip annotation of annotation/order.First: generic function is not supported")
+	ExpectDebugLogContains(t, "Found annotation annotation/order.Service.Process with span name \"process-order\"")
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	queueName = "critical"
+	taskType  = "email:deliver"
+)
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	redis := asynq.RedisClientOpt{Addr: "localhost:" + os.Getenv("REDIS_PORT")}
+	processed := make(chan trace.SpanContext, 1)
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(taskType, func(ctx context.Context, task *asynq.Task) error {
+		processed <- trace.SpanContextFromContext(ctx)
+		return nil
+	})
+	srv := asynq.NewServer(redis, asynq.Config{Concurrency: 1, Queues: map[string]int{queueName: 1}})
+	if err := srv.Start(mux); err != nil {
+		panic(err)
+	}
+
+	client := asynq.NewClient(redis)
+	info, err := client.EnqueueContext(context.Background(), asynq.NewTask(taskType, []byte(`{"to":"otel"}`)), asynq.Queue(queueName))
+	if err != nil {
+		panic(err)
+	}
+	handlerSpan := <-processed
+	_ = client.Close()
+	srv.Shutdown()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		publish, ok := findSpan(stubs, queueName+" publish")
+		verifier.Assert(ok, "Expect a span enqueueing the task")
+		verifier.VerifyMQPublishAttributes(publish, "", "", "", "publish", queueName, "asynq")
+		messageID := verifier.GetAttribute(publish.Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == info.ID, "Expect messaging.message.id to be %s, got %s", info.ID, messageID)
+		typ := verifier.GetAttribute(publish.Attributes, "messaging.asynq.task.type").AsString()
+		verifier.Assert(typ == taskType, "Expect messaging.asynq.task.type to be %s, got %s", taskType, typ)
+
+		process, ok := findSpan(stubs, queueName+" process")
+		verifier.Assert(ok, "Expect a span processing the task")
+		verifier.VerifyMQConsumeAttributes(process, "", "", "", "process", queueName, "asynq")
+		verifier.Assert(handlerSpan.SpanID() == process.SpanContext.SpanID(), "Expect the handler to be passed the process span")
+		retryCount := verifier.GetAttribute(process.Attributes, "messaging.asynq.task.retry_count").AsInt64()
+		verifier.Assert(retryCount == 0, "Expect messaging.asynq.task.retry_count to be 0, got %d", retryCount)
+		verifier.Assert(len(process.Links) == 1 && process.Links[0].SpanContext.SpanID() == publish.SpanContext.SpanID(),
+			"Expect the process span to be linked to the enqueueing span, got %v", process.Links)
+	}, 2)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const asynq_dependency_name = "github.com/hibiken/asynq"
+const asynq_module_name = "asynq"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("asynq-0.25.0-test", asynq_module_name, "v0.25.0", "v0.26.0", "1.18", "", TestAsynq),
+		NewMuzzleTestCase("asynq-muzzle-test", asynq_dependency_name, asynq_module_name, "v0.25.0", "v0.26.0", "1.18", "", []string{"go", "build", "test_asynq.go"}),
+		NewLatestDepthTestCase("asynq-latest-depth-test", asynq_dependency_name, asynq_module_name, "v0.25.0", "v0.26.0", "1.18", "", TestAsynq),
+	)
+}
+
+func TestAsynq(t *testing.T, env ...string) {
+	_, redisPort := initRedisContainer()
+	UseApp("asynq/v0.25.0")
+	RunGoBuild(t, "go", "build", "test_asynq.go")
+	env = append(env, "REDIS_PORT="+redisPort.Port())
+	RunApp(t, "test_asynq", env...)
+}
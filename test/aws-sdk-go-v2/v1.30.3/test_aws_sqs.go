@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const queueName = "otel-queue"
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	ctx := context.Background()
+	cfg := aws.Config{
+		Region:      "us-east-1",
+		Credentials: credentials.NewStaticCredentialsProvider("test", "test", ""),
+	}
+	client := sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		o.BaseEndpoint = aws.String("http://localhost:" + os.Getenv("LOCALSTACK_PORT"))
+	})
+	queue, err := client.CreateQueue(ctx, &sqs.CreateQueueInput{QueueName: aws.String(queueName)})
+	if err != nil {
+		panic(err)
+	}
+	sent, err := client.SendMessage(ctx, &sqs.SendMessageInput{QueueUrl: queue.QueueUrl, MessageBody: aws.String("hello")})
+	if err != nil {
+		panic(err)
+	}
+	received, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{QueueUrl: queue.QueueUrl, WaitTimeSeconds: 5})
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(len(received.Messages) == 1, "Expect a message to be received, got %d", len(received.Messages))
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		create, ok := findSpan(stubs, "SQS/CreateQueue")
+		verifier.Assert(ok, "Expect a span creating the queue")
+		verifier.Assert(create.SpanKind == trace.SpanKindClient, "Expect a client span, got %s", create.SpanKind)
+		system := verifier.GetAttribute(create.Attributes, "rpc.system").AsString()
+		verifier.Assert(system == "aws-api", "Expect rpc.system to be aws-api, got %s", system)
+		region := verifier.GetAttribute(create.Attributes, "cloud.region").AsString()
+		verifier.Assert(region == "us-east-1", "Expect cloud.region to be us-east-1, got %s", region)
+		requestID := verifier.GetAttribute(create.Attributes, "aws.request_id").AsString()
+		verifier.Assert(requestID != "", "Expect aws.request_id to be recorded")
+
+		publish, ok := findSpan(stubs, queueName+" publish")
+		verifier.Assert(ok, "Expect a span sending the message")
+		verifier.VerifyMQPublishAttributes(publish, "", "", "", "publish", queueName, "aws_sqs")
+		messageID := verifier.GetAttribute(publish.Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == *sent.MessageId, "Expect messaging.message.id to be %s, got %s", *sent.MessageId, messageID)
+
+		receive, ok := findSpan(stubs, queueName+" receive")
+		verifier.Assert(ok, "Expect a span receiving the message")
+		verifier.VerifyMQConsumeAttributes(receive, "", "", "", "receive", queueName, "aws_sqs")
+		verifier.Assert(len(receive.Links) == 1 && receive.Links[0].SpanContext.SpanID() == publish.SpanContext.SpanID(),
+			"Expect the receive span to be linked to the sending span, got %v", receive.Links)
+	}, 3)
+}
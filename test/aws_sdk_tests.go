@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const aws_sdk_dependency_name = "github.com/aws/aws-sdk-go-v2"
+const aws_sdk_module_name = "aws-sdk-go-v2"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("aws-sdk-go-v2-1.30.3-test", aws_sdk_module_name, "v1.30.3", "", "1.20", "", TestAwsSdkSqs),
+		NewMuzzleTestCase("aws-sdk-go-v2-muzzle-test", aws_sdk_dependency_name, aws_sdk_module_name, "v1.30.3", "", "1.20", "", []string{"go", "build", "test_aws_sqs.go"}),
+		NewLatestDepthTestCase("aws-sdk-go-v2-latest-depth-test", aws_sdk_dependency_name, aws_sdk_module_name, "v1.30.3", "", "1.20", "", TestAwsSdkSqs),
+	)
+}
+
+func TestAwsSdkSqs(t *testing.T, env ...string) {
+	_, localstackPort := initLocalstackContainer()
+	UseApp("aws-sdk-go-v2/v1.30.3")
+	RunGoBuild(t, "go", "build", "test_aws_sqs.go")
+	env = append(env, "LOCALSTACK_PORT="+localstackPort.Port())
+	RunApp(t, "test_aws_sqs", env...)
+}
+
+func initLocalstackContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "localstack/localstack:3.8",
+		Env:          map[string]string{"SERVICES": "sqs,sns"},
+		ExposedPorts: []string{"4566/tcp"},
+		WaitingFor:   wait.ForLog("Ready."),
+	}
+	localstackC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := localstackC.MappedPort(context.Background(), "4566")
+	if err != nil {
+		panic(err)
+	}
+	return localstackC, port
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const containerName = "otel-container"
+
+// the well known account of azurite
+const connectionString = "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;" +
+	"AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;"
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	ctx := context.Background()
+	endpoint := "BlobEndpoint=http://127.0.0.1:" + os.Getenv("AZURITE_PORT") + "/devstoreaccount1;"
+	client, err := azblob.NewClientFromConnectionString(connectionString+endpoint, nil)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = client.CreateContainer(ctx, containerName, nil); err != nil {
+		panic(err)
+	}
+	pager := client.NewListBlobsFlatPager(containerName, nil)
+	if _, err = pager.NextPage(ctx); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		create, ok := findSpan(stubs, "azblob/PUT")
+		verifier.Assert(ok, "Expect a span creating the container")
+		verifier.Assert(create.SpanKind == trace.SpanKindClient, "Expect a client span, got %s", create.SpanKind)
+		system := verifier.GetAttribute(create.Attributes, "rpc.system").AsString()
+		verifier.Assert(system == "azure", "Expect rpc.system to be azure, got %s", system)
+		service := verifier.GetAttribute(create.Attributes, "rpc.service").AsString()
+		verifier.Assert(service == "azblob", "Expect rpc.service to be azblob, got %s", service)
+		requestID := verifier.GetAttribute(create.Attributes, "az.service_request_id").AsString()
+		verifier.Assert(requestID != "", "Expect az.service_request_id to be recorded")
+
+		list, ok := findSpan(stubs, "azblob/GET list")
+		verifier.Assert(ok, "Expect a span listing the blobs")
+		method := verifier.GetAttribute(list.Attributes, "rpc.method").AsString()
+		verifier.Assert(method == "GET list", "Expect rpc.method to be GET list, got %s", method)
+	}, 2)
+}
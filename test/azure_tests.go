@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const azure_dependency_name = "github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+const azure_module_name = "azure"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("azure-azblob-1.4.0-test", azure_module_name, "v1.4.0", "", "1.18", "", TestAzureBlob),
+		NewMuzzleTestCase("azure-azblob-muzzle-test", azure_dependency_name, azure_module_name, "v1.4.0", "", "1.18", "", []string{"go", "build", "test_azure_blob.go"}),
+		NewLatestDepthTestCase("azure-azblob-latest-depth-test", azure_dependency_name, azure_module_name, "v1.4.0", "", "1.18", "", TestAzureBlob),
+	)
+}
+
+func TestAzureBlob(t *testing.T, env ...string) {
+	_, azuritePort := initAzuriteContainer()
+	UseApp("azure/v1.4.0")
+	RunGoBuild(t, "go", "build", "test_azure_blob.go")
+	env = append(env, "AZURITE_PORT="+azuritePort.Port())
+	RunApp(t, "test_azure_blob", env...)
+}
+
+func initAzuriteContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "mcr.microsoft.com/azure-storage/azurite:latest",
+		Cmd:          []string{"azurite-blob", "--blobHost", "0.0.0.0", "--skipApiVersionCheck"},
+		ExposedPorts: []string{"10000/tcp"},
+		WaitingFor:   wait.ForLog("Azurite Blob service successfully listens"),
+	}
+	azuriteC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := azuriteC.MappedPort(context.Background(), "10000")
+	if err != nil {
+		panic(err)
+	}
+	return azuriteC, port
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/beego/beego/v2/client/orm"
+	_ "github.com/mattn/go-sqlite3"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type User struct {
+	Id   int
+	Name string
+}
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStubs, bool) {
+	for _, stub := range stubs {
+		if len(stub) > 0 && stub[0].Name == name {
+			return stub, true
+		}
+	}
+	return nil, false
+}
+
+func verifyOrmSpan(stubs []tracetest.SpanStubs, name, operation, statement string) tracetest.SpanStub {
+	stub, ok := findSpan(stubs, name)
+	verifier.Assert(ok, "Expect span %s", name)
+	verifier.VerifyDbAttributes(stub[0], name, "sqlite", "", "", operation, "user", nil)
+	// Statements are recorded by the child spans of database/sql
+	found := false
+	for _, child := range stub[1:] {
+		text := verifier.GetAttribute(child.Attributes, "db.query.text").AsString()
+		if child.Parent.SpanID() == stub[0].SpanContext.SpanID() && strings.Contains(text, statement) {
+			found = true
+		}
+	}
+	verifier.Assert(found, "Expect child span of %s with statement %s", name, statement)
+	return stub[0]
+}
+
+func main() {
+	dir, err := os.MkdirTemp("", "beego-orm")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	orm.RegisterModel(new(User))
+	if err = orm.RegisterDataBase("default", "sqlite3", filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	if err = orm.RunSyncdb("default", false, false); err != nil {
+		panic(err)
+	}
+
+	o := orm.NewOrm()
+	user := &User{Name: "opentelemetry"}
+	if _, err = o.Insert(user); err != nil {
+		panic(err)
+	}
+	if err = o.Read(user); err != nil {
+		panic(err)
+	}
+	user.Name = "beego"
+	if _, err = o.Update(user); err != nil {
+		panic(err)
+	}
+	if _, err = o.Delete(user); err != nil {
+		panic(err)
+	}
+	if err = o.Read(&User{Id: 100}); err != orm.ErrNoRows {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifyOrmSpan(stubs, "Insert user", "Insert", "INSERT")
+		verifyOrmSpan(stubs, "Update user", "Update", "UPDATE")
+		verifyOrmSpan(stubs, "Delete user", "Delete", "DELETE")
+		reads := 0
+		for _, stub := range stubs {
+			if stub[0].Name == "Read user" {
+				verifier.VerifyDbAttributes(stub[0], "Read user", "sqlite", "", "", "Read", "user", nil)
+				if stub[0].Status.Code == codes.Error {
+					reads++
+				}
+			}
+		}
+		verifier.Assert(reads == 1, "Expect one failed read, got %d", reads)
+	}, 5)
+}
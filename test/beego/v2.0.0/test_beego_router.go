@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/beego/beego/v2/server/web"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type UserController struct {
+	web.Controller
+}
+
+func (c *UserController) Get() {
+	c.Ctx.WriteString("user " + c.Ctx.Input.Param(":id"))
+}
+
+func main() {
+	web.Router("/user/:id", &UserController{})
+	go web.Run("127.0.0.1:8080")
+	time.Sleep(5 * time.Second)
+	resp, err := http.Get("http://127.0.0.1:8080/user/1")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.ReadAll(resp.Body)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "/user/:id", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/user/1", "", "/user/:id", 200)
+	}, 1)
+}
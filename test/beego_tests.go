@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const beego_dependency_name = "github.com/beego/beego/v2"
+const beego_module_name = "beego"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("beego-router-test", beego_module_name, "v2.0.0", "", "1.18", "", TestBeegoRouter),
+		NewGeneralTestCase("beego-orm-test", beego_module_name, "v2.0.0", "", "1.18", "", TestBeegoOrm),
+		NewMuzzleTestCase("beego-muzzle-test", beego_dependency_name, beego_module_name, "v2.0.0", "", "1.18", "", []string{"go", "build", "test_beego_router.go"}),
+		NewLatestDepthTestCase("beego-latestdepth-test", beego_dependency_name, beego_module_name, "v2.0.0", "", "1.18", "", TestBeegoRouter),
+	)
+}
+
+func TestBeegoRouter(t *testing.T, env ...string) {
+	UseApp("beego/v2.0.0")
+	RunGoBuild(t, "go", "build", "test_beego_router.go")
+	RunApp(t, "test_beego_router", env...)
+}
+
+func TestBeegoOrm(t *testing.T, env ...string) {
+	UseApp("beego/v2.0.0")
+	RunGoBuild(t, "go", "build", "test_beego_orm.go")
+	RunApp(t, "test_beego_orm", env...)
+}
@@ -54,6 +54,17 @@ func TestBuildProject4(t *testing.T) {
 	RunGoBuild(t, "go", "build", "m1")
 }
 
+func TestBuildProjectMergeRules(t *testing.T) {
+	const AppName = "build"
+	UseApp(AppName)
+
+	RunSet(t, "-disabledefault=false", "-rule=../../tool/data/rules/base.json",
+		"-conflict=merge")
+	RunGoBuild(t, "go", "build", "m1") // duplicated default rules are merged
+	ExpectDebugLogContains(t, "Merge duplicated rule")
+	RunSet(t, "-conflict=error")
+}
+
 func TestBuildProject5(t *testing.T) {
 	const AppName = "build"
 	UseApp(AppName)
@@ -77,6 +88,21 @@ func TestBuildProject6(t *testing.T) {
 	ExpectDebugLogNotContains(t, "github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/rules/http")
 }
 
+func TestBuildProject7(t *testing.T) {
+	const AppName = "build"
+	UseApp(AppName)
+
+	RunSet(t, "-disabledefault=false", "-disablerules=net/http.client,gorm,base,nonexist")
+	RunGoBuild(t, "go", "build", "m1")
+	// only the client side of net/http is disabled, runtime and otel SDK rules
+	// in base.json are always kept
+	ExpectDebugLogContains(t, "Disable rule http.clientOnEnter by net/http.client")
+	ExpectDebugLogNotContains(t, "Disable rule http.serverOnEnter")
+	ExpectDebugLogContains(t, "by gorm")
+	ExpectDebugLogContains(t, "is fundamental, can not be disabled by base")
+	ExpectDebugLogContains(t, "No default rule is disabled by nonexist")
+}
+
 func TestGoInstall(t *testing.T) {
 	const AppName = "build"
 	UseApp(AppName)
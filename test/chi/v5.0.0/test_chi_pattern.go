@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func order(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(chi.URLParam(r, "id") + "/" + chi.URLParam(r, "order")))
+}
+
+func setupPattern() {
+	r := chi.NewRouter()
+	// Middlewares replace the request context before the route is found
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Recoverer)
+	r.Get("/users/{id}/orders/{order}", order)
+	http.ListenAndServe(":8080", r)
+}
+
+func main() {
+	go setupPattern()
+	time.Sleep(5 * time.Second)
+	resp, err := http.Get("http://127.0.0.1:8080/users/1/orders/2")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	fmt.Println(string(body))
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpClientAttributes(stubs[0][0], "GET", "GET", "http://127.0.0.1:8080/users/1/orders/2", "http", "1.1", "tcp", "ipv4", "", "127.0.0.1:8080", 200, 0, 8080)
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "GET /users/{id}/orders/{order}", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/users/1/orders/2", "", "/users/{id}/orders/{order}", 200)
+	}, 1)
+}
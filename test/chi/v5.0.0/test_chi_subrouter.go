@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func item(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(chi.URLParam(r, "id")))
+}
+
+func stats(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(chi.URLParam(r, "day")))
+}
+
+func setupSubrouter() {
+	r := chi.NewRouter()
+	r.Route("/api", func(r chi.Router) {
+		r.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Api", "true")
+				next.ServeHTTP(w, r)
+			})
+		})
+		r.Get("/items/{id}", item)
+	})
+	admin := chi.NewRouter()
+	admin.Get("/stats/{day}", stats)
+	r.Mount("/admin", admin)
+	http.ListenAndServe(":8080", r)
+}
+
+func get(url string) {
+	resp, err := http.Get(url)
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	fmt.Println(string(body))
+}
+
+func main() {
+	go setupSubrouter()
+	time.Sleep(5 * time.Second)
+	get("http://127.0.0.1:8080/api/items/3")
+	get("http://127.0.0.1:8080/admin/stats/mon")
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "GET /api/items/{id}", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/api/items/3", "", "/api/items/{id}", 200)
+		verifier.VerifyHttpServerAttributes(stubs[1][1], "GET /admin/stats/{day}", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/admin/stats/mon", "", "/admin/stats/{day}", 200)
+	}, 2)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	ctx := context.Background()
+	conn, err := clickhouse.Open(&clickhouse.Options{
+		Addr: []string{"127.0.0.1:" + os.Getenv("CLICKHOUSE_PORT")},
+		Auth: clickhouse.Auth{
+			Database: "default",
+			Username: "default",
+			Password: "clickhouse",
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+	if err = conn.Exec(ctx, "CREATE TABLE IF NOT EXISTS users (id UInt32, name String) ENGINE = Memory"); err != nil {
+		log.Fatal(err)
+	}
+	batch, err := conn.PrepareBatch(ctx, "INSERT INTO users")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i, name := range []string{"opentelemetry", "clickhouse"} {
+		if err = batch.Append(uint32(i+1), name); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err = batch.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if err = batch.Append(uint32(3), "batch"); err != nil {
+		log.Fatal(err)
+	}
+	if err = batch.Send(); err != nil {
+		log.Fatal(err)
+	}
+	var count uint64
+	if err = conn.QueryRow(ctx, "SELECT count() FROM users").Scan(&count); err != nil {
+		log.Fatal(err)
+	}
+	rows, err := conn.Query(ctx, "SELECT name FROM users WHERE id = ?", 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+	rows.Close()
+	if err = conn.Exec(ctx, "DROP TABLE users"); err != nil {
+		log.Fatal(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "CREATE", "clickhouse", "127.0.0.1", "CREATE TABLE IF NOT EXISTS users (id UInt32, name String) ENGINE = Memory", "CREATE", "", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "PREPARE users", "clickhouse", "127.0.0.1", "INSERT INTO users", "PREPARE", "users", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "INSERT users", "clickhouse", "127.0.0.1", "INSERT INTO users", "INSERT", "users", nil)
+		batchRows := verifier.GetAttribute(stubs[2][0].Attributes, "clickhouse.batch.rows").AsInt64()
+		verifier.Assert(batchRows == 3, "Expect batch rows to be 3, got %d", batchRows)
+		batchBlocks := verifier.GetAttribute(stubs[2][0].Attributes, "clickhouse.batch.blocks").AsInt64()
+		verifier.Assert(batchBlocks == 2, "Expect batch blocks to be 2, got %d", batchBlocks)
+		verifier.VerifyDbAttributes(stubs[3][0], "SELECT users", "clickhouse", "127.0.0.1", "SELECT count() FROM users", "SELECT", "users", nil)
+		verifier.VerifyDbAttributes(stubs[4][0], "SELECT users", "clickhouse", "127.0.0.1", "SELECT name FROM users WHERE id = ?", "SELECT", "users", nil)
+		verifier.VerifyDbAttributes(stubs[5][0], "DROP", "clickhouse", "127.0.0.1", "DROP TABLE users", "DROP", "", nil)
+		for _, stub := range stubs {
+			namespace := verifier.GetAttribute(stub[0].Attributes, "db.namespace").AsString()
+			verifier.Assert(namespace == "default", "Expect db namespace to be default, got %s", namespace)
+		}
+	}, 6)
+}
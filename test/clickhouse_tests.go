@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const clickhouse_dependency_name = "github.com/ClickHouse/clickhouse-go/v2"
+const clickhouse_module_name = "clickhouse"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("clickhouse-test", clickhouse_module_name, "v2.30.0", "v2.48.0", "1.21", "", TestClickhouse),
+		NewMuzzleTestCase("clickhouse-muzzle-test", clickhouse_dependency_name, clickhouse_module_name, "v2.30.0", "v2.48.0", "1.21", "", []string{"go", "build", "test_clickhouse.go"}),
+		NewLatestDepthTestCase("clickhouse-latestdepth-test", clickhouse_dependency_name, clickhouse_module_name, "v2.30.0", "v2.48.0", "1.21", "", TestClickhouse),
+	)
+}
+
+func TestClickhouse(t *testing.T, env ...string) {
+	_, clickhousePort := initClickhouseContainer()
+	UseApp("clickhouse/v2.30.0")
+	RunGoBuild(t, "go", "build", "test_clickhouse.go")
+	env = append(env, "CLICKHOUSE_PORT="+clickhousePort.Port())
+	RunApp(t, "test_clickhouse", env...)
+}
+
+func initClickhouseContainer() (testcontainers.Container, nat.Port) {
+	containerReqeust := testcontainers.ContainerRequest{
+		Image:        "clickhouse/clickhouse-server:latest",
+		ExposedPorts: []string{"9000/tcp"},
+		Env: map[string]string{
+			"CLICKHOUSE_USER":     "default",
+			"CLICKHOUSE_PASSWORD": "clickhouse",
+		},
+		WaitingFor: wait.ForListeningPort("9000/tcp")}
+	clickhouseC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{ContainerRequest: containerReqeust, Started: true})
+	if err != nil {
+		panic(err)
+	}
+	port, err := clickhouseC.MappedPort(context.Background(), "9000")
+	if err != nil {
+		panic(err)
+	}
+	return clickhouseC, port
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	pod      = `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"demo","namespace":"default","resourceVersion":"1"}}`
+	podList  = `{"kind":"PodList","apiVersion":"v1","metadata":{"resourceVersion":"1"},"items":[` + pod + `]}`
+	notFound = `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`
+)
+
+// apiServer serves the pods of the default namespace, of which there is only
+// one, and keeps the watches open until they are closed by the client.
+func apiServer(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/api/v1/namespaces/default/pods/demo":
+		w.Write([]byte(pod))
+	case r.URL.Path == "/api/v1/namespaces/default/pods" && r.URL.Query().Get("watch") == "true":
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	case r.URL.Path == "/api/v1/namespaces/default/pods":
+		w.Write([]byte(podList))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(notFound))
+	}
+}
+
+func main() {
+	// the informer is expected to list and then watch the pods
+	os.Setenv("KUBE_FEATURE_WatchListClient", "false")
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go http.Serve(ln, http.HandlerFunc(apiServer))
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: "http://" + ln.Addr().String()})
+	if err != nil {
+		panic(err)
+	}
+	pods := clientset.CoreV1().Pods("default")
+	if _, err := pods.Get(context.Background(), "demo", metav1.GetOptions{}); err != nil {
+		panic(err)
+	}
+	_, err = pods.Get(context.Background(), "missing", metav1.GetOptions{})
+	verifier.Assert(apierrors.IsNotFound(err), "Expect the missing pod to be not found, got %v", err)
+
+	stop := make(chan struct{})
+	factory := informers.NewSharedInformerFactoryWithOptions(clientset, 0, informers.WithNamespace("default"))
+	informer := factory.Core().V1().Pods().Informer()
+	factory.Start(stop)
+	verifier.Assert(cache.WaitForCacheSync(stop, informer.HasSynced), "Expect the informer to sync")
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		spans := map[string]tracetest.SpanStub{}
+		var list, reflector tracetest.SpanStub
+		for _, stub := range stubs {
+			for _, span := range stub {
+				switch {
+				case strings.HasPrefix(span.Name, "reflector list"):
+					reflector = span
+				case span.Name == "list pods":
+					list = span
+				case span.Name == "get pods" || span.Name == "watch pods":
+					name := span.Name
+					if verifier.GetAttribute(span.Attributes, "k8s.api.resource_name").AsString() == "missing" {
+						name += " missing"
+					}
+					spans[name] = span
+				}
+			}
+		}
+		get := spans["get pods"]
+		verifier.Assert(get.SpanKind == trace.SpanKindClient, "Expect the get to be a client span, got %v", get.SpanKind)
+		verifier.Assert(verifier.GetAttribute(get.Attributes, "k8s.api.verb").AsString() == "get", "Expect k8s.api.verb to be get")
+		verifier.Assert(verifier.GetAttribute(get.Attributes, "k8s.api.resource").AsString() == "pods", "Expect k8s.api.resource to be pods")
+		verifier.Assert(verifier.GetAttribute(get.Attributes, "k8s.namespace.name").AsString() == "default", "Expect k8s.namespace.name to be default")
+		verifier.Assert(verifier.GetAttribute(get.Attributes, "k8s.api.resource_name").AsString() == "demo", "Expect k8s.api.resource_name to be demo")
+		verifier.Assert(verifier.GetAttribute(get.Attributes, "http.response.status_code").AsInt64() == 200, "Expect status 200 for the get")
+		missing := spans["get pods missing"]
+		verifier.Assert(verifier.GetAttribute(missing.Attributes, "http.response.status_code").AsInt64() == 404, "Expect status 404 for the missing pod")
+		verifier.Assert(missing.Status.Code == codes.Error, "Expect the get of the missing pod to fail")
+		verifier.Assert(verifier.GetAttribute(reflector.Attributes, "k8s.reflector.type").AsString() == "*v1.Pod", "Expect k8s.reflector.type to be *v1.Pod, got %s", verifier.GetAttribute(reflector.Attributes, "k8s.reflector.type").AsString())
+		verifier.Assert(list.Parent.SpanID() == reflector.SpanContext.SpanID(), "Expect the list of pods to be made by the reflector")
+		watch := spans["watch pods"]
+		verifier.Assert(verifier.GetAttribute(watch.Attributes, "k8s.api.verb").AsString() == "watch", "Expect k8s.api.verb to be watch")
+		verifier.Assert(verifier.GetAttribute(watch.Attributes, "http.response.status_code").AsInt64() == 200, "Expect status 200 for the watch")
+	}, 4)
+	close(stop)
+	factory.Shutdown()
+}
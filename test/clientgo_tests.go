@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const clientgo_dependency_name = "k8s.io/client-go"
+const clientgo_module_name = "clientgo"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("clientgo-test", clientgo_module_name, "v0.32.3", "v0.34.1", "1.23", "", TestClientgo),
+		NewMuzzleTestCase("clientgo-muzzle-test", clientgo_dependency_name, clientgo_module_name, "v0.32.3", "v0.34.1", "1.23", "", []string{"go", "build", "test_clientgo.go"}),
+		NewLatestDepthTestCase("clientgo-latestdepth-test", clientgo_dependency_name, clientgo_module_name, "v0.32.3", "v0.34.1", "1.23", "", TestClientgo),
+	)
+}
+
+func TestClientgo(t *testing.T, env ...string) {
+	UseApp("clientgo/v0.32.3")
+	RunGoBuild(t, "go", "build", "test_clientgo.go")
+	RunApp(t, "test_clientgo", env...)
+}
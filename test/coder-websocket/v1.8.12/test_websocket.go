@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/coder/websocket"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func echo(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.CloseNow()
+	for {
+		messageType, p, err := conn.Read(r.Context())
+		if err != nil {
+			return
+		}
+		if err = conn.Write(r.Context(), messageType, p); err != nil {
+			return
+		}
+	}
+}
+
+func main() {
+	http.HandleFunc("/echo", echo)
+	go http.ListenAndServe("127.0.0.1:8080", nil)
+	time.Sleep(3 * time.Second)
+	ctx := context.Background()
+	conn, _, err := websocket.Dial(ctx, "ws://127.0.0.1:8080/echo", nil)
+	if err != nil {
+		panic(err)
+	}
+	if err = conn.Write(ctx, websocket.MessageText, []byte("hello")); err != nil {
+		panic(err)
+	}
+	_, p, err := conn.Read(ctx)
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(string(p) == "hello", "Expect echoed message hello, got %s", string(p))
+	conn.Close(websocket.StatusNormalClosure, "")
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 4, "Expect 4 spans, got %d", len(stubs[0]))
+		client, handshake, server, conn := stubs[0][0], stubs[0][1], stubs[0][2], stubs[0][3]
+		verifier.Assert(client.SpanKind == trace.SpanKindClient, "Expect client span, got %v", client.SpanKind)
+		verifier.Assert(client.Name == "WebSocket /echo", "Expect span name WebSocket /echo, got %s", client.Name)
+		verifier.Assert(handshake.Parent.SpanID() == client.SpanContext.SpanID(), "Expect the handshake to be the child of the websocket client span")
+		verifier.Assert(server.Parent.SpanID() == handshake.SpanContext.SpanID(), "Expect the http server span to be the child of the handshake")
+		verifier.Assert(conn.SpanKind == trace.SpanKindServer, "Expect server span, got %v", conn.SpanKind)
+		verifier.Assert(conn.Parent.SpanID() == server.SpanContext.SpanID(), "Expect the websocket server span to be the child of the http server span")
+		verifyMessageEvents(client, 1, 1)
+		verifyMessageEvents(conn, 1, 1)
+	}, 1)
+}
+
+func verifyMessageEvents(span tracetest.SpanStub, sent, received int) {
+	var s, r int
+	for _, event := range span.Events {
+		verifier.Assert(event.Name == "message", "Expect message event, got %s", event.Name)
+		attrs := attribute.NewSet(event.Attributes...)
+		size, _ := attrs.Value("rpc.message.uncompressed_size")
+		verifier.Assert(size.AsInt64() == 5, "Expect message size 5, got %d", size.AsInt64())
+		switch v, _ := attrs.Value("rpc.message.type"); v.AsString() {
+		case "SENT":
+			s++
+		case "RECEIVED":
+			r++
+		}
+	}
+	verifier.Assert(s == sent && r == received, "Expect %d sent and %d received messages, got %d and %d", sent, received, s, r)
+}
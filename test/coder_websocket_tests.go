@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const coder_websocket_dependency_name = "github.com/coder/websocket"
+const coder_websocket_module_name = "coder-websocket"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("coder-websocket-test", coder_websocket_module_name, "v1.8.12", "", "1.18", "", TestCoderWebsocket),
+		NewMuzzleTestCase("coder-websocket-muzzle-test", coder_websocket_dependency_name, coder_websocket_module_name, "v1.8.12", "", "1.18", "", []string{"go", "build", "test_websocket.go"}),
+		NewLatestDepthTestCase("coder-websocket-latestdepth-test", coder_websocket_dependency_name, coder_websocket_module_name, "v1.8.12", "", "1.18", "", TestCoderWebsocket),
+	)
+}
+
+func TestCoderWebsocket(t *testing.T, env ...string) {
+	UseApp("coder-websocket/v1.8.12")
+	RunGoBuild(t, "go", "build", "test_websocket.go")
+	RunApp(t, "test_websocket", env...)
+}
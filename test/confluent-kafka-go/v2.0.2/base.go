@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+const (
+	topicName = "confluent-topic"
+	groupName = "confluent-group"
+)
+
+// getKafkaAddress returns Kafka broker address from environment or default
+func getKafkaAddress() string {
+	if addr := os.Getenv("KAFKA_ADDR"); addr != "" {
+		return addr
+	}
+	return "127.0.0.1:9092"
+}
+
+func newProducer() *kafka.Producer {
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": getKafkaAddress(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return producer
+}
+
+func newConsumer() *kafka.Consumer {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": getKafkaAddress(),
+		"group.id":          groupName,
+		"auto.offset.reset": "earliest",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return consumer
+}
+
+func getHeader(headers []kafka.Header, key string) string {
+	for _, header := range headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
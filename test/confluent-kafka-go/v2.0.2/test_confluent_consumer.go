@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	producer := newProducer()
+	topic := topicName
+	if err := producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+		Key:            []byte("key1"),
+		Value:          []byte("hello consumer"),
+	}, nil); err != nil {
+		panic(err)
+	}
+	producer.Flush(10000)
+	producer.Close()
+
+	consumer := newConsumer()
+	if err := consumer.SubscribeTopics([]string{topicName}, nil); err != nil {
+		panic(err)
+	}
+	if _, err := consumer.ReadMessage(30 * time.Second); err != nil {
+		panic(err)
+	}
+	if err := consumer.Close(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "kafka")
+		consumerSpan := stubs[0][1]
+		verifier.VerifyMQConsumeAttributes(consumerSpan, "", "", "", "process", topicName, "kafka")
+		verifier.Assert(consumerSpan.Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Expect the consumer span to be a child of the producer span")
+		group := verifier.GetAttribute(consumerSpan.Attributes, "messaging.consumer.group.name").AsString()
+		verifier.Assert(group == groupName, "Expect messaging.consumer.group.name to be %s, got %s", groupName, group)
+		key := verifier.GetAttribute(consumerSpan.Attributes, "messaging.kafka.message.key").AsString()
+		verifier.Assert(key == "key1", "Expect messaging.kafka.message.key to be key1, got %s", key)
+	}, 1)
+}
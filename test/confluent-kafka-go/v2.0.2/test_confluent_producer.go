@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	producer := newProducer()
+	defer producer.Close()
+
+	topic := topicName
+	// the delivery report is sent to the given channel, with the opaque of the application
+	deliveryChan := make(chan kafka.Event, 1)
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+		Key:            []byte("key1"),
+		Value:          []byte("hello world1"),
+		Opaque:         "opaque1",
+	}
+	if err := producer.Produce(msg, deliveryChan); err != nil {
+		panic(err)
+	}
+	verifier.Assert(msg.Opaque == "opaque1", "Expect the opaque of the message to be kept, got %v", msg.Opaque)
+	verifier.Assert(getHeader(msg.Headers, "traceparent") != "", "Expect the message to carry the trace context")
+	report := (<-deliveryChan).(*kafka.Message)
+	if report.TopicPartition.Error != nil {
+		panic(report.TopicPartition.Error)
+	}
+	verifier.Assert(report.Opaque == "opaque1", "Expect the opaque of the delivery report to be opaque1, got %v", report.Opaque)
+
+	// the delivery report is sent to the events channel of the producer
+	if err := producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+		Value:          []byte("hello world2"),
+	}, nil); err != nil {
+		panic(err)
+	}
+	for e := range producer.Events() {
+		if report, ok := e.(*kafka.Message); ok {
+			if report.TopicPartition.Error != nil {
+				panic(report.TopicPartition.Error)
+			}
+			verifier.Assert(report.Opaque == nil, "Expect the delivery report to have no opaque, got %v", report.Opaque)
+			break
+		}
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for i, stub := range stubs {
+			span := stub[0]
+			verifier.VerifyMQPublishAttributes(span, "", "", "", "publish", topicName, "kafka")
+			partition := verifier.GetAttribute(span.Attributes, "messaging.destination.partition.id").AsString()
+			verifier.Assert(partition == "0", "Expect messaging.destination.partition.id to be 0, got %s", partition)
+			offset := verifier.GetAttribute(span.Attributes, "messaging.kafka.offset").AsInt64()
+			verifier.Assert(offset == int64(i), "Expect messaging.kafka.offset to be %d, got %d", i, offset)
+		}
+		key := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.kafka.message.key").AsString()
+		verifier.Assert(key == "key1", "Expect messaging.kafka.message.key to be key1, got %s", key)
+	}, 2)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+)
+
+const confluent_kafka_dependency_name = "github.com/confluentinc/confluent-kafka-go/v2"
+const confluent_kafka_module_name = "confluent-kafka-go"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("confluent-kafka-go-2.0.2-producer-test", confluent_kafka_module_name, "v2.0.2", "v2.15.1", "1.18", "", TestConfluentKafkaProducer),
+		NewGeneralTestCase("confluent-kafka-go-2.0.2-consumer-test", confluent_kafka_module_name, "v2.0.2", "v2.15.1", "1.18", "", TestConfluentKafkaConsumer),
+		NewMuzzleTestCase("confluent-kafka-go-muzzle-test", confluent_kafka_dependency_name, confluent_kafka_module_name, "v2.0.2", "v2.15.1", "1.18", "", []string{"go", "build", "test_confluent_producer.go", "base.go"}),
+		NewLatestDepthTestCase("confluent-kafka-go-latest-depth-test", confluent_kafka_dependency_name, confluent_kafka_module_name, "v2.0.2", "v2.15.1", "1.18", "", TestConfluentKafkaConsumer),
+	)
+}
+
+func TestConfluentKafkaProducer(t *testing.T, env ...string) {
+	containers := initKafkaContainer(t)
+	defer containers.CleanupContainers(context.Background())
+	UseApp("confluent-kafka-go/v2.0.2")
+	RunGoBuild(t, "go", "build", "test_confluent_producer.go", "base.go")
+	env = append(env, "KAFKA_ADDR="+containers.KafkaAddress)
+	RunApp(t, "test_confluent_producer", env...)
+}
+
+func TestConfluentKafkaConsumer(t *testing.T, env ...string) {
+	containers := initKafkaContainer(t)
+	defer containers.CleanupContainers(context.Background())
+	UseApp("confluent-kafka-go/v2.0.2")
+	RunGoBuild(t, "go", "build", "test_confluent_consumer.go", "base.go")
+	env = append(env, "KAFKA_ADDR="+containers.KafkaAddress)
+	RunApp(t, "test_confluent_consumer", env...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	greetProcedure       = "/greet.v1.GreetService/Greet"
+	greetStreamProcedure = "/greet.v1.GreetService/GreetStream"
+)
+
+type GreetRequest struct {
+	Name string `json:"name"`
+}
+
+type GreetResponse struct {
+	Greeting string `json:"greeting"`
+}
+
+// jsonCodec lets the test exchange plain structs instead of generated
+// protobuf messages.
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func setupServer() {
+	mux := http.NewServeMux()
+	mux.Handle(greetProcedure, connect.NewUnaryHandler(greetProcedure,
+		func(ctx context.Context, req *connect.Request[GreetRequest]) (*connect.Response[GreetResponse], error) {
+			return connect.NewResponse(&GreetResponse{Greeting: "hello " + req.Msg.Name}), nil
+		}, connect.WithCodec(jsonCodec{})))
+	mux.Handle(greetStreamProcedure, connect.NewServerStreamHandler(greetStreamProcedure,
+		func(ctx context.Context, req *connect.Request[GreetRequest], stream *connect.ServerStream[GreetResponse]) error {
+			for i := 0; i < 2; i++ {
+				if err := stream.Send(&GreetResponse{Greeting: "hello " + req.Msg.Name}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, connect.WithCodec(jsonCodec{})))
+	go http.ListenAndServe("127.0.0.1:8080", mux)
+	time.Sleep(3 * time.Second)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	setupServer()
+	client := connect.NewClient[GreetRequest, GreetResponse](http.DefaultClient, "http://127.0.0.1:8080"+greetStreamProcedure, connect.WithCodec(jsonCodec{}))
+	stream, err := client.CallServerStream(context.Background(), connect.NewRequest(&GreetRequest{Name: "otel"}))
+	if err != nil {
+		panic(err)
+	}
+	received := 0
+	for stream.Receive() {
+		received++
+	}
+	if err = stream.Err(); err != nil {
+		panic(err)
+	}
+	stream.Close()
+	verifier.Assert(received == 2, "Expect 2 messages, got %d", received)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 4, "Expect 4 spans, got %d", len(stubs[0]))
+		verifier.VerifyRpcClientAttributes(stubs[0][0], "greet.v1.GreetService/GreetStream", "connect_rpc", "greet.v1.GreetService", "GreetStream")
+		verifier.VerifyRpcServerAttributes(stubs[0][3], "greet.v1.GreetService/GreetStream", "connect_rpc", "greet.v1.GreetService", "GreetStream")
+		for i := 1; i < 4; i++ {
+			verifier.Assert(stubs[0][i].Parent.SpanID() == stubs[0][i-1].SpanContext.SpanID(), "Expect span %d to be the child of span %d", i, i-1)
+		}
+		verifier.Assert(len(stubs[0][0].Events) == 3, "Expect 1 sent and 2 received messages on the client, got %d", len(stubs[0][0].Events))
+		verifier.Assert(len(stubs[0][3].Events) == 3, "Expect 1 received and 2 sent messages on the server, got %d", len(stubs[0][3].Events))
+	}, 1)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	setupServer()
+	client := connect.NewClient[GreetRequest, GreetResponse](http.DefaultClient, "http://127.0.0.1:8080"+greetProcedure, connect.WithCodec(jsonCodec{}))
+	resp, err := client.CallUnary(context.Background(), connect.NewRequest(&GreetRequest{Name: "otel"}))
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(resp.Msg.Greeting == "hello otel", "Expect greeting hello otel, got %s", resp.Msg.Greeting)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 4, "Expect 4 spans, got %d", len(stubs[0]))
+		verifier.VerifyRpcClientAttributes(stubs[0][0], "greet.v1.GreetService/Greet", "connect_rpc", "greet.v1.GreetService", "Greet")
+		verifier.VerifyRpcServerAttributes(stubs[0][3], "greet.v1.GreetService/Greet", "connect_rpc", "greet.v1.GreetService", "Greet")
+		for i := 1; i < 4; i++ {
+			verifier.Assert(stubs[0][i].Parent.SpanID() == stubs[0][i-1].SpanContext.SpanID(), "Expect span %d to be the child of span %d", i, i-1)
+		}
+	}, 1)
+}
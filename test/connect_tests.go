@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const connect_dependency_name = "connectrpc.com/connect"
+const connect_module_name = "connect"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("connect-unary-test", connect_module_name, "v1.11.0", "", "1.19", "", TestConnectUnary),
+		NewGeneralTestCase("connect-stream-test", connect_module_name, "v1.11.0", "", "1.19", "", TestConnectStream),
+		NewMuzzleTestCase("connect-muzzle-test", connect_dependency_name, connect_module_name, "v1.11.0", "", "1.19", "", []string{"go", "build", "test_connect_unary.go", "greet.go"}),
+		NewLatestDepthTestCase("connect-latestdepth-test", connect_dependency_name, connect_module_name, "v1.11.0", "", "1.19", "", TestConnectUnary),
+	)
+}
+
+func TestConnectUnary(t *testing.T, env ...string) {
+	UseApp("connect/v1.11.0")
+	RunGoBuild(t, "go", "build", "test_connect_unary.go", "greet.go")
+	RunApp(t, "test_connect_unary", env...)
+}
+
+func TestConnectStream(t *testing.T, env ...string) {
+	UseApp("connect/v1.11.0")
+	RunGoBuild(t, "go", "build", "test_connect_stream.go", "greet.go")
+	RunApp(t, "test_connect_stream", env...)
+}
@@ -73,12 +73,13 @@ func dbTransaction() {
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
 		verifier.VerifyDbAttributes(stubs[0][0], "DROP", "mysql", "127.0.0.1", "DROP TABLE IF EXISTS users", "DROP", "", nil)
 		verifier.VerifyDbAttributes(stubs[1][0], "CREATE", "mysql", "127.0.0.1", "CREATE TABLE IF NOT EXISTS users (id char(255), name VARCHAR(255), age INTEGER)", "CREATE", "", nil)
-		verifier.VerifyDbAttributes(stubs[2][0], "INSERT users", "mysql", "127.0.0.1", "INSERT INTO users (id, name, age) VALUES ( ?, ?, ?)", "INSERT", "users", []any{"1", "bar", 11})
-		verifier.VerifyDbAttributes(stubs[3][0], "START", "mysql", "127.0.0.1", "START TRANSACTION", "START", "", nil)
-		verifier.VerifyDbAttributes(stubs[4][0], "INSERT users", "mysql", "127.0.0.1", "INSERT INTO users (id, name, age) VALUES ( ?, ?, ? )", "INSERT", "users", []any{"2", "foobar", 24})
-		verifier.VerifyDbAttributes(stubs[5][0], "UPDATE users", "mysql", "127.0.0.1", "UPDATE users SET name = ? WHERE id = ?", "UPDATE", "users", []any{"foobar", "0"})
-		verifier.VerifyDbAttributes(stubs[6][0], "COMMIT", "mysql", "127.0.0.1", "COMMIT", "COMMIT", "", nil)
-	}, 7)
+		verifier.VerifyDbAttributes(stubs[2][0], "PREPARE users", "mysql", "127.0.0.1", "INSERT INTO users (id, name, age) VALUES ( ?, ?, ?)", "PREPARE", "users", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "INSERT users", "mysql", "127.0.0.1", "INSERT INTO users (id, name, age) VALUES ( ?, ?, ?)", "INSERT", "users", []any{"1", "bar", 11})
+		verifier.VerifyDbAttributes(stubs[4][0], "START", "mysql", "127.0.0.1", "START TRANSACTION", "START", "", nil)
+		verifier.VerifyDbAttributes(stubs[5][0], "INSERT users", "mysql", "127.0.0.1", "INSERT INTO users (id, name, age) VALUES ( ?, ?, ? )", "INSERT", "users", []any{"2", "foobar", 24})
+		verifier.VerifyDbAttributes(stubs[6][0], "UPDATE users", "mysql", "127.0.0.1", "UPDATE users SET name = ? WHERE id = ?", "UPDATE", "users", []any{"foobar", "0"})
+		verifier.VerifyDbAttributes(stubs[7][0], "COMMIT", "mysql", "127.0.0.1", "COMMIT", "COMMIT", "", nil)
+	}, 8)
 }
 
 func main() {
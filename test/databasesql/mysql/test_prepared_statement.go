@@ -64,6 +64,7 @@ func dbPrepared() {
 		verifier.VerifyDbAttributes(stubs[0][0], "DROP", "mysql", "127.0.0.1", "DROP TABLE IF EXISTS users", "DROP", "", nil)
 		verifier.VerifyDbAttributes(stubs[1][0], "CREATE", "mysql", "127.0.0.1", "CREATE TABLE IF NOT EXISTS users (id char(255), name VARCHAR(255), age INTEGER)", "CREATE", "", nil)
 		verifier.VerifyDbAttributes(stubs[2][0], "INSERT users", "mysql", "127.0.0.1", "INSERT INTO users (id, name, age) VALUES ( ?, ?, ?)", "INSERT", "users", []any{"0", "foo", 10})
-		verifier.VerifyDbAttributes(stubs[3][0], "select users", "mysql", "127.0.0.1", "select id, name from users where id = ?", "select", "users", []any{1})
-	}, 4)
+		verifier.VerifyDbAttributes(stubs[3][0], "PREPARE users", "mysql", "127.0.0.1", "select id, name from users where id = ?", "PREPARE", "users", nil)
+		verifier.VerifyDbAttributes(stubs[4][0], "select users", "mysql", "127.0.0.1", "select id, name from users where id = ?", "select", "users", []any{1})
+	}, 5)
 }
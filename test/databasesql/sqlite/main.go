@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	_ "github.com/glebarez/go-sqlite"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const driverScopeName = "pkg/rules/databasesql/driver_setup.go"
+
+// connector opens the database without a registered driver name, so that the
+// database system can only be inferred from the driver itself
+type connector struct {
+	driver driver.Driver
+	dsn    string
+}
+
+func (c connector) Connect(_ context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c connector) Driver() driver.Driver {
+	return c.driver
+}
+
+func verifyDriverSpan(stub tracetest.SpanStubs, name, statement, operation, collection string, driverLevel bool) {
+	verifier.Assert(len(stub) == 1, "Expect one span in the trace of %s, got %d", name, len(stub))
+	verifier.VerifyDbAttributes(stub[0], name, "sqlite", "", statement, operation, collection, nil)
+	atDriver := stub[0].InstrumentationScope.Name == driverScopeName
+	verifier.Assert(atDriver == driverLevel, "Expect span %s to be traced at the driver level: %v", name, driverLevel)
+}
+
+func main() {
+	dir, err := os.MkdirTemp("", "databasesql")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dsn := filepath.Join(dir, "test.db")
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	if _, err = db.ExecContext(ctx, "CREATE TABLE users (id INTEGER, name TEXT)"); err != nil {
+		log.Fatal(err)
+	}
+	stmt, err := db.PrepareContext(ctx, "INSERT INTO users (id, name) VALUES (?, ?)")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer stmt.Close()
+	if _, err = stmt.ExecContext(ctx, 1, "foo"); err != nil {
+		log.Fatal(err)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err = tx.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "bar", 1); err != nil {
+		log.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		log.Fatal(err)
+	}
+	connectorDB := sql.OpenDB(connector{driver: db.Driver(), dsn: dsn})
+	defer connectorDB.Close()
+	var count int
+	if err = connectorDB.QueryRowContext(ctx, "SELECT count(*) FROM users").Scan(&count); err != nil {
+		log.Fatal(err)
+	}
+
+	// Statements are traced by database/sql, and only preparing them is traced
+	// at the driver level unless database/sql is disabled
+	driverOnly := os.Getenv("OTEL_INSTRUMENTATION_DATABASESQL_ENABLED") == "false"
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifyDriverSpan(stubs[0], "CREATE", "CREATE TABLE users (id INTEGER, name TEXT)", "CREATE", "", driverOnly)
+		verifyDriverSpan(stubs[1], "PREPARE users", "INSERT INTO users (id, name) VALUES (?, ?)", "PREPARE", "users", true)
+		if driverOnly {
+			verifyDriverSpan(stubs[2], "EXECUTE", "", "EXECUTE", "", true)
+		} else {
+			verifyDriverSpan(stubs[2], "INSERT users", "INSERT INTO users (id, name) VALUES (?, ?)", "INSERT", "users", false)
+		}
+		verifyDriverSpan(stubs[3], "START", "START TRANSACTION", "START", "", driverOnly)
+		verifyDriverSpan(stubs[4], "UPDATE users", "UPDATE users SET name = ? WHERE id = ?", "UPDATE", "users", driverOnly)
+		verifyDriverSpan(stubs[5], "COMMIT", "COMMIT", "COMMIT", "", driverOnly)
+		verifyDriverSpan(stubs[6], "SELECT users", "SELECT count(*) FROM users", "SELECT", "users", driverOnly)
+	}, 7)
+}
@@ -27,6 +27,8 @@ func init() {
 	TestCases = append(TestCases,
 		NewGeneralTestCase("databasesql-mysql-8x", "databasesql", "", "", "1.18", "", TestMySql8x),
 		NewGeneralTestCase("databasesql-mysql-5x", "databasesql", "", "", "1.18", "", TestMySql5x),
+		NewGeneralTestCase("databasesql-sqlite", "databasesql", "", "", "1.18", "", TestSqlite),
+		NewGeneralTestCase("databasesql-sqlite-driver", "databasesql", "", "", "1.18", "", TestSqliteDriver),
 	)
 }
 
@@ -50,6 +52,19 @@ func TestMySql8x(t *testing.T, env ...string) {
 	RunApp(t, "mysql", env...)
 }
 
+func TestSqlite(t *testing.T, env ...string) {
+	UseApp("databasesql/sqlite")
+	RunGoBuild(t, "go", "build")
+	RunApp(t, "sqlite", env...)
+}
+
+func TestSqliteDriver(t *testing.T, env ...string) {
+	UseApp("databasesql/sqlite")
+	RunGoBuild(t, "go", "build")
+	env = append(env, "OTEL_INSTRUMENTATION_DATABASESQL_ENABLED=false")
+	RunApp(t, "sqlite", env...)
+}
+
 func init5xMySqlContainer() (testcontainers.Container, nat.Port) {
 	ctx := context.Background()
 	mysqlContainer, err := mysql.Run(ctx, "mysql:5.6")
@@ -0,0 +1,9 @@
+module directive
+
+go 1.23.0
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation => ../../
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg => ../../pkg
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier => ../../test/verifier
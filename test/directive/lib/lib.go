@@ -0,0 +1,86 @@
+//go:build !nodirective
+// +build !nodirective
+
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate echo generating lib
+
+// Package lib is full of compiler directives, all of them should be retained
+// in the instrumented copy of this file.
+package lib
+
+import (
+	"embed"
+	_ "unsafe"
+)
+
+// Hello is the content of hello.txt
+//
+//go:embed hello.txt
+var Hello string
+
+var (
+	//go:embed static
+	static embed.FS
+
+	//go:embed static/asset.txt
+	asset []byte
+)
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+
+// Greet is instrumented with a trampoline hook
+//
+//go:noinline
+func Greet(name string) string {
+	//line greet.go:1
+	return "hello " + name
+}
+//go:embed hello.txt
+var greeting string
+
+// Asset is instrumented with a raw hook
+//
+//go:nosplit
+func Asset() string {
+	bs, err := static.ReadFile("static/asset.txt")
+	if err != nil {
+		panic(err)
+	}
+	if string(bs) != string(asset) {
+		panic("mismatched asset")
+	}
+	return string(bs)
+}
+
+//go:noinline
+//go:norace
+func Sum(a, b int) int {
+	url := "http://line.example.com //line is not a directive"
+	_ = url
+	return a + b
+}
+
+func Nanotime() int64 {
+	return nanotime()
+}
+
+// Greeting returns the embedded greeting, which is declared right after an
+// instrumented function
+func Greeting() string { return greeting + "/" + trailing }
+
+//go:embed hello.txt
+var trailing string
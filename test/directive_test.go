@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const DirectiveAppName = "directive"
+
+func TestBuildDirective(t *testing.T) {
+	UseApp(DirectiveAppName)
+
+	RunSet(t, UseTestRules("test_directive.json"))
+	RunGoBuild(t, "go", "build")
+	stdout, stderr := RunApp(t, DirectiveAppName)
+	ExpectContains(t, stdout, "hello directive!")
+	ExpectContains(t, stdout, "hello from embed\nstatic asset\n41\ntrue\n")
+	ExpectContains(t, stdout, "hello from embed/hello from embed")
+	ExpectContains(t, stderr, "raw asset")
+	ExpectContains(t, stderr, "raw nanotime")
+	ExpectContains(t, stdout, "\n42\n")
+	// Functions without body are skipped instead of being hooked
+	ExpectDebugLogContains(t, "for directive/lib.Double: function is implemented in assembly")
+	ExpectDebugLogContains(t, "for directive/lib.nanotime: function is declared by //go:linkname")
+
+	text := ReadInstrumentLog(t, filepath.Join("lib", "lib.go"))
+	// All kinds of directives are kept in place
+	for _, directive := range []string{
+		"//go:build !nodirective\n// +build !nodirective\n",
+		"//go:generate echo generating lib\n",
+		"//\n//go:embed hello.txt\nvar Hello string\n",
+		"\t//go:embed static\n\tstatic embed.FS\n",
+		"\t//go:embed static/asset.txt\n\tasset []byte\n",
+		"//go:linkname nanotime runtime.nanotime\nfunc nanotime() int64\n",
+		"//go:noinline\nfunc Greet(",
+		"//go:nosplit\nfunc Asset(",
+		"//go:noinline\n//go:norace\nfunc Sum(",
+		"}\n\n//go:embed hello.txt\nvar greeting string\n",
+		"//go:embed hello.txt\nvar trailing string\n",
+	} {
+		ExpectContains(t, text, directive)
+	}
+	// Generated line directives start at the beginning of the line, while
+	// indented //line comments and string literals are left untouched
+	ExpectContains(t, text, "\n//line <generated>:1\n")
+	ExpectContains(t, text, "\n\t//line greet.go:1\n")
+	ExpectContains(t, text, "\turl := \"http://line.example.com //line is not a directive\"\n")
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "//otel:line") {
+			t.Fatalf("unexpected line directive tag: %s", line)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// engine serves the Docker Engine API for a single container, c0ffee, with a
+// single exec, e1.
+func engine(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.TrimPrefix(r.URL.Path, "/v1.41")
+	switch path {
+	case "/containers/create":
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"Id":"c0ffee","Warnings":[]}`))
+	case "/containers/c0ffee/start", "/exec/e1/start":
+		w.WriteHeader(http.StatusNoContent)
+	case "/images/create":
+		w.Write([]byte(`{"status":"Pulling from library/alpine","id":"3.20"}` + "\n"))
+	case "/containers/c0ffee/exec":
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"Id":"e1"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"No such container"}`))
+	}
+}
+
+func main() {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go http.Serve(ln, http.HandlerFunc(engine))
+
+	cli, err := client.NewClientWithOpts(client.WithHost("tcp://"+ln.Addr().String()), client.WithVersion("1.41"))
+	if err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	pull, err := cli.ImagePull(ctx, "alpine:3.20", image.PullOptions{})
+	if err != nil {
+		panic(err)
+	}
+	io.Copy(io.Discard, pull)
+	pull.Close()
+	created, err := cli.ContainerCreate(ctx, &container.Config{Image: "alpine:3.20"}, nil, nil, nil, "web")
+	if err != nil {
+		panic(err)
+	}
+	if err := cli.ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+		panic(err)
+	}
+	err = cli.ContainerStart(ctx, "missing", container.StartOptions{})
+	verifier.Assert(err != nil, "Expect the missing container not to start")
+	exec, err := cli.ContainerExecCreate(ctx, created.ID, container.ExecOptions{Cmd: []string{"true"}})
+	if err != nil {
+		panic(err)
+	}
+	if err := cli.ContainerExecStart(ctx, exec.ID, container.ExecStartOptions{Detach: true}); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		spans := map[string]tracetest.SpanStub{}
+		for _, stub := range stubs {
+			for _, span := range stub {
+				if !strings.HasPrefix(span.Name, "docker ") {
+					continue
+				}
+				name := span.Name
+				if verifier.GetAttribute(span.Attributes, "container.id").AsString() == "missing" {
+					name += " missing"
+				}
+				spans[name] = span
+			}
+		}
+		pull := spans["docker ImagePull"]
+		verifier.Assert(pull.SpanKind == trace.SpanKindClient, "Expect ImagePull to be a client span, got %v", pull.SpanKind)
+		verifier.Assert(verifier.GetAttribute(pull.Attributes, "docker.operation").AsString() == "ImagePull", "Expect docker.operation to be ImagePull")
+		verifier.Assert(verifier.GetAttribute(pull.Attributes, "container.image.name").AsString() == "alpine:3.20", "Expect container.image.name to be alpine:3.20")
+		create := spans["docker ContainerCreate"]
+		verifier.Assert(verifier.GetAttribute(create.Attributes, "container.name").AsString() == "web", "Expect container.name to be web")
+		verifier.Assert(verifier.GetAttribute(create.Attributes, "container.image.name").AsString() == "alpine:3.20", "Expect container.image.name to be alpine:3.20")
+		verifier.Assert(verifier.GetAttribute(create.Attributes, "container.id").AsString() == "c0ffee", "Expect container.id of the created container to be c0ffee")
+		start := spans["docker ContainerStart"]
+		verifier.Assert(verifier.GetAttribute(start.Attributes, "container.id").AsString() == "c0ffee", "Expect container.id to be c0ffee")
+		verifier.Assert(start.Status.Code != codes.Error, "Expect the start of c0ffee to succeed")
+		missing := spans["docker ContainerStart missing"]
+		verifier.Assert(missing.Status.Code == codes.Error, "Expect the start of the missing container to fail")
+		execCreate := spans["docker ContainerExecCreate"]
+		verifier.Assert(verifier.GetAttribute(execCreate.Attributes, "container.id").AsString() == "c0ffee", "Expect container.id to be c0ffee")
+		verifier.Assert(verifier.GetAttribute(execCreate.Attributes, "docker.exec.id").AsString() == "e1", "Expect docker.exec.id of the created exec to be e1")
+		execStart := spans["docker ContainerExecStart"]
+		verifier.Assert(verifier.GetAttribute(execStart.Attributes, "docker.exec.id").AsString() == "e1", "Expect docker.exec.id to be e1")
+	}, 6)
+}
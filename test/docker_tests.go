@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const docker_dependency_name = "github.com/docker/docker"
+const docker_module_name = "docker"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("docker-test", docker_module_name, "v28.0.1", "v28.5.2", "1.23", "", TestDocker),
+		NewMuzzleTestCase("docker-muzzle-test", docker_dependency_name, docker_module_name, "v28.0.1", "v28.5.2", "1.23", "", []string{"go", "build", "test_docker.go"}),
+		NewLatestDepthTestCase("docker-latestdepth-test", docker_dependency_name, docker_module_name, "v28.0.1", "v28.5.2", "1.23", "", TestDocker),
+	)
+}
+
+func TestDocker(t *testing.T, env ...string) {
+	UseApp("docker/v28.0.1")
+	RunGoBuild(t, "go", "build", "test_docker.go")
+	RunApp(t, "test_docker", env...)
+}
@@ -32,5 +32,8 @@ func main() {
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
 		verifier.VerifyRpcClientAttributes(stubs[0][0], "greet.GreetService/Greet", "apache_dubbo", "greet.GreetService", "Greet")
 		verifier.VerifyRpcServerAttributes(stubs[0][1], "greet.GreetService/Greet", "apache_dubbo", "greet.GreetService", "Greet")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Expect the server span to be a child of the client span")
+		protocol := verifier.GetAttribute(stubs[0][0].Attributes, "network.protocol.name").AsString()
+		verifier.Assert(protocol == "tri", "Expect network.protocol.name to be tri, got %s", protocol)
 	}, 1)
 }
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
 	"github.com/elastic/go-elasticsearch/v8"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"log"
 	"os"
@@ -86,12 +87,18 @@ func main() {
 		log.Printf("failed to delete index %v\n", err)
 	}
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
-		verifier.VerifyDbAttributes(stubs[0][0], "put", "elasticsearch", "127.0.0.1", "/my_index", "put", "", nil)
-		verifier.VerifyDbAttributes(stubs[1][0], "_doc", "elasticsearch", "127.0.0.1", "/my_index/_doc", "_doc", "", nil)
-		verifier.VerifyDbAttributes(stubs[2][0], "_doc", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "", nil)
-		verifier.VerifyDbAttributes(stubs[3][0], "_search", "elasticsearch", "127.0.0.1", "/my_index/_search", "_search", "", nil)
-		verifier.VerifyDbAttributes(stubs[4][0], "_update", "elasticsearch", "127.0.0.1", "/my_index/_update/id", "_update", "", nil)
-		verifier.VerifyDbAttributes(stubs[5][0], "_doc", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "", nil)
-		verifier.VerifyDbAttributes(stubs[6][0], "delete", "elasticsearch", "127.0.0.1", "/my_index", "delete", "", nil)
+		verifier.VerifyDbAttributes(stubs[0][0], "put my_index", "elasticsearch", "127.0.0.1", "/my_index", "put", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "_doc my_index", "elasticsearch", "127.0.0.1", "/my_index/_doc", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "_doc my_index", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "_search my_index", "elasticsearch", "127.0.0.1", "/my_index/_search", "_search", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[4][0], "_update my_index", "elasticsearch", "127.0.0.1", "/my_index/_update/id", "_update", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[5][0], "_doc my_index", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[6][0], "delete my_index", "elasticsearch", "127.0.0.1", "/my_index", "delete", "my_index", nil)
+		method := verifier.GetAttribute(stubs[3][0].Attributes, "http.request.method").AsString()
+		verifier.Assert(method == "POST", "Expect http.request.method to be POST, got %s", method)
+		statusCode := verifier.GetAttribute(stubs[3][0].Attributes, "db.response.status_code").AsString()
+		verifier.Assert(statusCode == "200", "Expect db.response.status_code to be 200, got %s", statusCode)
+		took := verifier.GetAttribute(stubs[3][0].Attributes, "elasticsearch.took")
+		verifier.Assert(took.Type() == attribute.INT64 && took.AsInt64() >= 0, "Expect elasticsearch.took to be recorded, got %v", took)
 	}, 1)
 }
@@ -91,11 +91,11 @@ func main() {
 		log.Printf("failed to delete index %v\n", err)
 	}
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
-		verifier.VerifyDbAttributes(stubs[0][0], "put", "elasticsearch", "127.0.0.1", "/my_index", "put", "", nil)
-		verifier.VerifyDbAttributes(stubs[1][0], "_doc", "elasticsearch", "127.0.0.1", "/my_index/_doc", "_doc", "", nil)
-		verifier.VerifyDbAttributes(stubs[2][0], "_doc", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "", nil)
-		verifier.VerifyDbAttributes(stubs[3][0], "_search", "elasticsearch", "127.0.0.1", "/my_index/_search", "_search", "", nil)
-		verifier.VerifyDbAttributes(stubs[4][0], "_doc", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "", nil)
-		verifier.VerifyDbAttributes(stubs[5][0], "delete", "elasticsearch", "127.0.0.1", "/my_index", "delete", "", nil)
+		verifier.VerifyDbAttributes(stubs[0][0], "put my_index", "elasticsearch", "127.0.0.1", "/my_index", "put", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "_doc my_index", "elasticsearch", "127.0.0.1", "/my_index/_doc", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "_doc my_index", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "_search my_index", "elasticsearch", "127.0.0.1", "/my_index/_search", "_search", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[4][0], "_doc my_index", "elasticsearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[5][0], "delete my_index", "elasticsearch", "127.0.0.1", "/my_index", "delete", "my_index", nil)
 	}, 1)
 }
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	_ "github.com/glebarez/go-sqlite"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const entScopeName = "pkg/rules/ent/setup.go"
+
+var userID = &sqlgraph.FieldSpec{Column: "id", Type: field.TypeInt}
+
+// The generated clients of ent build the specs below and hand them to sqlgraph,
+// the schema is written by hand here to keep the test free of generated code.
+func userQuerySpec() *sqlgraph.QuerySpec {
+	var id, age int
+	var name string
+	return &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{Table: "users", Columns: []string{"id", "name", "age"}, ID: userID},
+		ScanValues: func(columns []string) ([]any, error) {
+			return []any{&id, &name, &age}, nil
+		},
+		Assign: func(columns []string, values []any) error {
+			return nil
+		},
+	}
+}
+
+// txDriver runs the operations within the transaction like the one generated
+// by ent for the clients of transactions.
+type txDriver struct {
+	dialect.ExecQuerier
+	drv dialect.Driver
+}
+
+func (t txDriver) Tx(context.Context) (dialect.Tx, error) { return dialect.NopTx(t), nil }
+func (t txDriver) Dialect() string                        { return t.drv.Dialect() }
+func (t txDriver) Close() error                           { return nil }
+
+func findSpan(stub tracetest.SpanStubs, name string) tracetest.SpanStub {
+	for _, span := range stub {
+		if span.Name == name && span.InstrumentationScope.Name == entScopeName {
+			return span
+		}
+	}
+	panic("no span of " + name)
+}
+
+func verifyEntSpan(span tracetest.SpanStub, name, statement, operation, collection, entOperation string) {
+	verifier.VerifyDbAttributes(span, name, "sqlite", "", statement, operation, collection, nil)
+	actualOperation := verifier.GetAttribute(span.Attributes, "ent.operation").AsString()
+	verifier.Assert(actualOperation == entOperation, "Expect ent operation to be %s, got %s", entOperation, actualOperation)
+}
+
+func verifyChildQuery(stub tracetest.SpanStubs, parent tracetest.SpanStub) {
+	for _, span := range stub {
+		if span.Parent.SpanID() == parent.SpanContext.SpanID() && span.InstrumentationScope.Name != entScopeName {
+			return
+		}
+	}
+	panic("no span of database/sql under " + parent.Name)
+}
+
+func verifyTransaction(span tracetest.SpanStub, outcome string) {
+	verifier.Assert(span.SpanKind == trace.SpanKindInternal, "Expect transaction to be internal span, got %d", span.SpanKind)
+	actualOutcome := verifier.GetAttribute(span.Attributes, "db.transaction.outcome").AsString()
+	verifier.Assert(actualOutcome == outcome, "Expect transaction outcome to be %s, got %s", outcome, actualOutcome)
+}
+
+func main() {
+	dir, err := os.MkdirTemp("", "ent")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := sql.Open("sqlite", filepath.Join(dir, "test.db"))
+	if err != nil {
+		panic(err)
+	}
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	defer drv.Close()
+	ctx := context.Background()
+	if err = drv.Exec(ctx, "CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT, age INTEGER)", []any{}, nil); err != nil {
+		panic(err)
+	}
+	if err = sqlgraph.CreateNode(ctx, drv, &sqlgraph.CreateSpec{
+		Table: "users",
+		ID:    userID,
+		Fields: []*sqlgraph.FieldSpec{
+			{Column: "name", Type: field.TypeString, Value: "ent"},
+			{Column: "age", Type: field.TypeInt, Value: 20},
+		},
+	}); err != nil {
+		panic(err)
+	}
+	if err = sqlgraph.QueryNodes(ctx, drv, userQuerySpec()); err != nil {
+		panic(err)
+	}
+	if _, err = sqlgraph.CountNodes(ctx, drv, &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{Table: "users", ID: userID},
+	}); err != nil {
+		panic(err)
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = sqlgraph.UpdateNodes(ctx, txDriver{tx, drv}, &sqlgraph.UpdateSpec{
+		Node:   &sqlgraph.NodeSpec{Table: "users", Columns: []string{"id", "name", "age"}, ID: userID},
+		Fields: sqlgraph.FieldMut{Set: []*sqlgraph.FieldSpec{{Column: "age", Type: field.TypeInt, Value: 21}}},
+	}); err != nil {
+		panic(err)
+	}
+	if err = tx.Commit(); err != nil {
+		panic(err)
+	}
+	tx, err = drv.Tx(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = sqlgraph.DeleteNodes(ctx, txDriver{tx, drv}, &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{Table: "users", ID: userID},
+	}); err != nil {
+		panic(err)
+	}
+	if err = tx.Rollback(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifyEntSpan(stubs[0][0], "CREATE", "CREATE TABLE users", "CREATE", "", "")
+		verifyChildQuery(stubs[0], stubs[0][0])
+		verifyEntSpan(stubs[1][0], "INSERT users", "INSERT INTO `users` (`name`, `age`) VALUES (?, ?)", "INSERT", "users", "create")
+		verifyChildQuery(stubs[1], stubs[1][0])
+		verifyEntSpan(stubs[2][0], "SELECT users", "SELECT `users`.`id`, `users`.`name`, `users`.`age` FROM `users`", "SELECT", "users", "query")
+		verifyChildQuery(stubs[2], stubs[2][0])
+		verifyEntSpan(stubs[3][0], "SELECT users", "SELECT COUNT(", "SELECT", "users", "count")
+		verifyChildQuery(stubs[3], stubs[3][0])
+
+		// the queries are issued with the context of the caller, yet they
+		// belong to the transaction
+		verifyTransaction(stubs[4][0], "commit")
+		update := findSpan(stubs[4], "UPDATE users")
+		verifyEntSpan(update, "UPDATE users", "UPDATE `users` SET `age` = ?", "UPDATE", "users", "update")
+		verifier.Assert(update.Parent.SpanID() == stubs[4][0].SpanContext.SpanID(), "Expect update to be the child of the transaction")
+		verifyChildQuery(stubs[4], update)
+		verifyTransaction(stubs[5][0], "rollback")
+		del := findSpan(stubs[5], "DELETE users")
+		verifyEntSpan(del, "DELETE users", "DELETE FROM `users`", "DELETE", "users", "delete")
+		verifier.Assert(del.Parent.SpanID() == stubs[5][0].SpanContext.SpanID(), "Expect delete to be the child of the transaction")
+	}, 6)
+}
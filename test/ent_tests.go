@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const ent_dependency_name = "entgo.io/ent"
+const ent_module_name = "ent"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("ent-test", ent_module_name, "v0.12.0", "", "1.20", "", TestEnt),
+		NewMuzzleTestCase("ent-muzzle-test", ent_dependency_name, ent_module_name, "v0.12.0", "", "1.20", "", []string{"go", "build", "test_ent.go"}),
+		NewLatestDepthTestCase("ent-latestdepth-test", ent_dependency_name, ent_module_name, "v0.12.0", "", "1.20", "", TestEnt),
+	)
+}
+
+func TestEnt(t *testing.T, env ...string) {
+	UseApp("ent/v0.12.0")
+	RunGoBuild(t, "go", "build", "test_ent.go")
+	RunApp(t, "test_ent", env...)
+}
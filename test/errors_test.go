@@ -37,6 +37,7 @@ func TestRunErrors(t *testing.T) {
 	ExpectContains(t, stdout, "0.001")
 	ExpectContains(t, stderr, "2024 shanghai")
 	ExpectContains(t, stdout, "2033 hangzhou")
+	ExpectContains(t, stderr, "exitonly2077")
 	ExpectNotContains(t, stderr, "failed to exec")
 	ExpectNotContains(t, stderr, "baddep")
 	ExpectContains(t, stderr, "gooddep")
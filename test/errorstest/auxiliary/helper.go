@@ -45,6 +45,11 @@ type Recv struct{ X int }
 func (t *Recv) TestGetSetRecv(arg1 int, arg2 float64) (int, float64) {
 	return arg1, arg2
 }
+
+func (t *Recv) TestExitOnlyRecv(arg int) int {
+	return arg + t.X
+}
+
 func OnlyRet() (int, string) {
 	return 1024, "gansu"
 }
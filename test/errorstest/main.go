@@ -35,6 +35,7 @@ func main() {
 	recv := &auxiliary.Recv{}
 	a, b := recv.TestGetSetRecv(1, 3.14)
 	fmt.Printf("recv%v %v %v\n", recv, a, b)
+	recv.TestExitOnlyRecv(2077)
 	auxiliary.OnlyArgs(1, "jiangsu")
 	c, d := auxiliary.OnlyRet()
 	fmt.Printf("onlyret%v %v\n", c, d)
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{"127.0.0.1:" + os.Getenv("ETCD_PORT")},
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	if _, err = client.Put(ctx, "/services/foo/1", "bar"); err != nil {
+		panic(err)
+	}
+	if _, err = client.Get(ctx, "/services/", clientv3.WithPrefix()); err != nil {
+		panic(err)
+	}
+	if _, err = client.Delete(ctx, "/services/foo/1"); err != nil {
+		panic(err)
+	}
+	_, err = client.Txn(ctx).
+		If(clientv3.Compare(clientv3.Version("/services/foo/2"), "=", 0)).
+		Then(clientv3.OpPut("/services/foo/2", "bar")).
+		Else(clientv3.OpGet("/services/foo/2")).
+		Commit()
+	if err != nil {
+		panic(err)
+	}
+	lease, err := client.Grant(ctx, 60)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = client.Revoke(ctx, lease.ID); err != nil {
+		panic(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "put", "etcd", "127.0.0.1", "put /services/foo/", "put", "", nil)
+		prefix := verifier.GetAttribute(stubs[0][0].Attributes, "etcd.key.prefix").AsString()
+		verifier.Assert(prefix == "/services/foo/", "Expect key prefix to be /services/foo/, got %s", prefix)
+		verifier.VerifyDbAttributes(stubs[1][0], "get", "etcd", "127.0.0.1", "get /services/", "get", "", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "delete", "etcd", "127.0.0.1", "delete /services/foo/", "delete", "", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "txn", "etcd", "127.0.0.1", "txn /services/foo/", "txn", "", nil)
+		batchSize := verifier.GetAttribute(stubs[3][0].Attributes, "db.operation.batch.size").AsInt64()
+		verifier.Assert(batchSize == 2, "Expect batch size to be 2, got %d", batchSize)
+		verifier.VerifyDbAttributes(stubs[4][0], "lease_grant", "etcd", "127.0.0.1", "lease_grant", "lease_grant", "", nil)
+		leaseID := verifier.GetAttribute(stubs[4][0].Attributes, "etcd.lease.id").AsInt64()
+		verifier.Assert(leaseID == int64(lease.ID), "Expect lease id to be %d, got %d", lease.ID, leaseID)
+		verifier.VerifyDbAttributes(stubs[5][0], "lease_revoke", "etcd", "127.0.0.1", "lease_revoke", "lease_revoke", "", nil)
+		leaseID = verifier.GetAttribute(stubs[5][0].Attributes, "etcd.lease.id").AsInt64()
+		verifier.Assert(leaseID == int64(lease.ID), "Expect lease id to be %d, got %d", lease.ID, leaseID)
+	}, 6)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{"127.0.0.1:" + os.Getenv("ETCD_PORT")},
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watchChan := client.Watch(ctx, "/watched/", clientv3.WithPrefix())
+	if _, err = client.Put(ctx, "/watched/foo", "bar"); err != nil {
+		panic(err)
+	}
+	if _, err = client.Delete(ctx, "/watched/foo"); err != nil {
+		panic(err)
+	}
+	received := 0
+	for received < 2 {
+		resp := <-watchChan
+		received += len(resp.Events)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "watch", "etcd", "127.0.0.1", "watch /watched/", "watch", "", nil)
+	}, 3)
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"etcd.client.watch.events": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No etcd.client.watch.events metrics received!")
+			}
+			sum := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+			total := int64(0)
+			for _, point := range sum.DataPoints {
+				prefix, _ := point.Attributes.Value("etcd.key.prefix")
+				verifier.Assert(prefix.AsString() == "/watched/", "Expect key prefix to be /watched/, got %s", prefix.AsString())
+				total += point.Value
+			}
+			if total != 2 {
+				panic("etcd.client.watch.events is not 2, actually " + strconv.Itoa(int(total)))
+			}
+		},
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const etcd_dependency_name = "go.etcd.io/etcd/client/v3"
+const etcd_module_name = "etcd"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("etcd-3.5.0-kv-test", etcd_module_name, "v3.5.0", "v3.6.15", "1.18", "", TestEtcdKV),
+		NewGeneralTestCase("etcd-3.5.0-watch-test", etcd_module_name, "v3.5.0", "v3.6.15", "1.18", "", TestEtcdWatch),
+		NewMuzzleTestCase("etcd-muzzle-test", etcd_dependency_name, etcd_module_name, "v3.5.0", "v3.6.15", "1.18", "", []string{"go", "build", "test_etcd.go"}),
+		NewLatestDepthTestCase("etcd-latest-depth-test", etcd_dependency_name, etcd_module_name, "v3.5.0", "v3.6.15", "1.18", "", TestEtcdKV),
+	)
+}
+
+func TestEtcdKV(t *testing.T, env ...string) {
+	_, etcdPort := initEtcdContainer()
+	UseApp("etcd/v3.5.0")
+	RunGoBuild(t, "go", "build", "test_etcd.go")
+	env = append(env, "ETCD_PORT="+etcdPort.Port())
+	RunApp(t, "test_etcd", env...)
+}
+
+func TestEtcdWatch(t *testing.T, env ...string) {
+	_, etcdPort := initEtcdContainer()
+	UseApp("etcd/v3.5.0")
+	RunGoBuild(t, "go", "build", "test_etcd_watch.go")
+	env = append(env, "ETCD_PORT="+etcdPort.Port())
+	RunApp(t, "test_etcd_watch", env...)
+}
+
+func initEtcdContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "bitnami/etcd:3.5",
+		ExposedPorts: []string{"2379/tcp"},
+		Env: map[string]string{
+			"ALLOW_NONE_AUTHENTICATION": "yes",
+		},
+		WaitingFor: wait.ForListeningPort("2379/tcp"),
+	}
+	etcdC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := etcdC.MappedPort(context.Background(), "2379")
+	if err != nil {
+		panic(err)
+	}
+	return etcdC, port
+}
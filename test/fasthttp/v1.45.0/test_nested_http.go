@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func proxy(ctx *fasthttp.RequestCtx) {
+	status, body, err := fasthttp.Get(nil, "http://localhost:8081/")
+	if err != nil {
+		ctx.Error(err.Error(), fasthttp.StatusBadGateway)
+		return
+	}
+	ctx.SetStatusCode(status)
+	ctx.Write(body)
+}
+
+func main() {
+	ln, err := net.Listen("tcp", ":8081")
+	if err != nil {
+		panic(err)
+	}
+	go func() {
+		s := &fasthttp.Server{Handler: hello}
+		s.Serve(ln)
+	}()
+	go func() {
+		fasthttp.ListenAndServe(":8080", proxy)
+	}()
+	time.Sleep(5 * time.Second)
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer func() {
+		fasthttp.ReleaseRequest(req)
+		fasthttp.ReleaseResponse(resp)
+	}()
+	req.SetRequestURI("http://localhost:8080")
+	req.Header.SetMethod(fasthttp.MethodGet)
+	if err = fasthttp.Do(req, resp); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpClientAttributes(stubs[0][0], "GET", "GET", "http://localhost:8080/", "http", "", "tcp", "ipv4", "", "localhost:8080", 200, 0, 8080)
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "GET /", "GET", "http", "tcp", "ipv4", "", "localhost:8080", "fasthttp", "http", "/", "", "/", 200)
+		verifier.VerifyHttpClientAttributes(stubs[0][2], "GET", "GET", "http://localhost:8081/", "http", "", "tcp", "ipv4", "", "localhost:8081", 200, 0, 8081)
+		verifier.VerifyHttpServerAttributes(stubs[0][3], "GET /", "GET", "http", "tcp", "ipv4", "", "localhost:8081", "fasthttp", "http", "/", "", "/", 200)
+		for i := 1; i < 4; i++ {
+			verifier.Assert(stubs[0][i].Parent.SpanID() == stubs[0][i-1].SpanContext.SpanID(), "Expect span %d to be the child of span %d", i, i-1)
+		}
+	}, 1)
+}
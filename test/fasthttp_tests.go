@@ -23,6 +23,7 @@ func init() {
 	TestCases = append(TestCases,
 		NewGeneralTestCase("basic-fasthttp-test", fasthttp_module_name, "", "", "1.18", "", TestBasicFastHttp),
 		NewGeneralTestCase("basic-fasthttps-test", fasthttp_module_name, "", "", "1.18", "", TestBasicFastHttps),
+		NewGeneralTestCase("nested-fasthttp-test", fasthttp_module_name, "", "", "1.18", "", TestNestedFastHttp),
 		NewLatestDepthTestCase("fasthttp-latestdepth", fasthttp_dependency_name, fasthttp_module_name, "v1.45.0", "", "1.18", "", TestBasicFastHttp),
 		NewMuzzleTestCase("fasthttp-muzzle", fasthttp_dependency_name, fasthttp_module_name, "v1.45.0", "", "1.18", "", []string{"go", "build", "test_basic_http.go", "server.go"}))
 }
@@ -38,3 +39,9 @@ func TestBasicFastHttps(t *testing.T, env ...string) {
 	RunGoBuild(t, "go", "build", "test_basic_https.go", "server.go")
 	RunApp(t, "test_basic_https", env...)
 }
+
+func TestNestedFastHttp(t *testing.T, env ...string) {
+	UseApp("fasthttp/v1.45.0")
+	RunGoBuild(t, "go", "build", "test_nested_http.go", "server.go")
+	RunApp(t, "test_nested_http", env...)
+}
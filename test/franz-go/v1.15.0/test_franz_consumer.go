@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	producer := newClient()
+	if err := producer.ProduceSync(context.Background(),
+		&kgo.Record{Topic: topicName, Key: []byte("key1"), Value: []byte("hello consumer")},
+		&kgo.Record{Topic: topicName, Key: []byte("key1"), Value: []byte("hello again")},
+	).FirstErr(); err != nil {
+		panic(err)
+	}
+	producer.Close()
+
+	consumer := newClient(
+		kgo.ConsumerGroup(groupName),
+		kgo.ConsumeTopics(topicName),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	fetches := consumer.PollFetches(ctx)
+	if err := fetches.Err(); err != nil {
+		panic(err)
+	}
+	verifier.Assert(fetches.NumRecords() == 2, "Expect to poll 2 records, got %d", fetches.NumRecords())
+	consumer.Close()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "kafka")
+		verifier.VerifyMQPublishAttributes(stubs[1][0], "", "", "", "publish", topicName, "kafka")
+		consumerSpan := stubs[2][0]
+		verifier.VerifyMQConsumeAttributes(consumerSpan, "", "", "", "receive", topicName, "kafka")
+		group := verifier.GetAttribute(consumerSpan.Attributes, "messaging.consumer.group.name").AsString()
+		verifier.Assert(group == groupName, "Expect messaging.consumer.group.name to be %s, got %s", groupName, group)
+		count := verifier.GetAttribute(consumerSpan.Attributes, "messaging.batch.message_count").AsInt64()
+		verifier.Assert(count == 2, "Expect messaging.batch.message_count to be 2, got %d", count)
+		verifier.Assert(len(consumerSpan.Links) == 2, "Expect the consumer span to have 2 links, got %d", len(consumerSpan.Links))
+		for i, link := range consumerSpan.Links {
+			producer := stubs[i][0].SpanContext.SpanID()
+			verifier.Assert(link.SpanContext.SpanID() == producer, "Expect the consumer span to link to the producer span %s, got %s", producer, link.SpanContext.SpanID())
+		}
+	}, 3)
+}
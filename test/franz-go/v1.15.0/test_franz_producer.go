@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	client := newClient(kgo.DefaultProduceTopic(topicName))
+	ctx := context.Background()
+
+	// produce a record synchronously
+	record := &kgo.Record{Topic: topicName, Key: []byte("sync-key"), Value: []byte("hello sync")}
+	if err := client.ProduceSync(ctx, record).FirstErr(); err != nil {
+		panic(err)
+	}
+	verifier.Assert(getHeader(record.Headers, "traceparent") != "", "Expect the trace context to be injected into the record headers")
+
+	// produce a record asynchronously to the default topic
+	done := make(chan *kgo.Record, 1)
+	client.Produce(ctx, &kgo.Record{Value: []byte("hello async")}, func(r *kgo.Record, err error) {
+		if err != nil {
+			panic(err)
+		}
+		done <- r
+	})
+	asyncRecord := <-done
+	verifier.Assert(getHeader(asyncRecord.Headers, "traceparent") != "", "Expect the trace context to be injected into the record headers")
+	client.Close()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "kafka")
+		key := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.kafka.message.key").AsString()
+		verifier.Assert(key == "sync-key", "Expect messaging.kafka.message.key to be sync-key, got %s", key)
+		partitionId := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.destination.partition.id").AsString()
+		verifier.Assert(partitionId == strconv.Itoa(int(record.Partition)), "Expect messaging.destination.partition.id to be %d, got %s", record.Partition, partitionId)
+		offset := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.kafka.offset").AsInt64()
+		verifier.Assert(offset == record.Offset, "Expect messaging.kafka.offset to be %d, got %d", record.Offset, offset)
+		verifier.VerifyMQPublishAttributes(stubs[1][0], "", "", "", "publish", topicName, "kafka")
+		asyncOffset := verifier.GetAttribute(stubs[1][0].Attributes, "messaging.kafka.offset").AsInt64()
+		verifier.Assert(asyncOffset == asyncRecord.Offset, "Expect messaging.kafka.offset to be %d, got %d", asyncRecord.Offset, asyncOffset)
+	}, 2)
+}
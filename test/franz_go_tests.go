@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+)
+
+const franz_go_dependency_name = "github.com/twmb/franz-go"
+const franz_go_module_name = "franz-go"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("franz-go-1.15.0-producer-test", franz_go_module_name, "v1.15.0", "v1.22.1", "1.18", "", TestFranzGoProducer),
+		NewGeneralTestCase("franz-go-1.15.0-consumer-test", franz_go_module_name, "v1.15.0", "v1.22.1", "1.18", "", TestFranzGoConsumer),
+		NewMuzzleTestCase("franz-go-muzzle-test", franz_go_dependency_name, franz_go_module_name, "v1.15.0", "v1.22.1", "1.18", "", []string{"go", "build", "test_franz_producer.go", "base.go"}),
+		NewLatestDepthTestCase("franz-go-latest-depth-test", franz_go_dependency_name, franz_go_module_name, "v1.15.0", "v1.22.1", "1.18", "", TestFranzGoConsumer),
+	)
+}
+
+func TestFranzGoProducer(t *testing.T, env ...string) {
+	containers := initKafkaContainer(t)
+	defer containers.CleanupContainers(context.Background())
+	UseApp("franz-go/v1.15.0")
+	RunGoBuild(t, "go", "build", "test_franz_producer.go", "base.go")
+	env = append(env, "KAFKA_ADDR="+containers.KafkaAddress)
+	RunApp(t, "test_franz_producer", env...)
+}
+
+func TestFranzGoConsumer(t *testing.T, env ...string) {
+	containers := initKafkaContainer(t)
+	defer containers.CleanupContainers(context.Background())
+	UseApp("franz-go/v1.15.0")
+	RunGoBuild(t, "go", "build", "test_franz_consumer.go", "base.go")
+	env = append(env, "KAFKA_ADDR="+containers.KafkaAddress)
+	RunApp(t, "test_franz_consumer", env...)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"funcmetrics/worker"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func attr(set attribute.Set, key string) string {
+	v, _ := set.Value(attribute.Key(key))
+	return v.AsString()
+}
+
+func main() {
+	fmt.Println(worker.Work("job"))
+	pool := &worker.Pool{Name: "pool"}
+	for _, n := range []int{1, -1, 0} {
+		fmt.Println(pool.Run(n))
+	}
+	// Metrics-only rules allocate nothing per call
+	allocs := testing.AllocsPerRun(100, func() {
+		worker.Hash(7)
+	})
+	fmt.Printf("%v allocs of Hash\n", allocs)
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"function.call.duration": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No function.call.duration metrics received!")
+			}
+			points := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64]).DataPoints
+			counts := map[string]uint64{}
+			for _, point := range points {
+				if attr(point.Attributes, "code.namespace") != "funcmetrics/worker" {
+					panic("unexpected code.namespace " + attr(point.Attributes, "code.namespace"))
+				}
+				key := attr(point.Attributes, "code.function.name") + "/" +
+					attr(point.Attributes, "error.type") + "/" +
+					attr(point.Attributes, "team")
+				counts[key] += point.Count
+				if key == "Work//payment" && point.Sum < 10 {
+					panic(fmt.Sprintf("unexpected duration of Work %v", point.Sum))
+				}
+			}
+			expected := map[string]uint64{
+				"Work//payment":                 1,
+				"Pool.Run//":                    2,
+				"Pool.Run/*errors.errorString/": 1,
+			}
+			if fmt.Sprint(counts) != fmt.Sprint(expected) {
+				panic(fmt.Sprintf("unexpected function.call.duration %v", counts))
+			}
+		},
+		"function.calls": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No function.calls metrics received!")
+			}
+			points := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64]).DataPoints
+			calls := map[string]int64{}
+			for _, point := range points {
+				calls[attr(point.Attributes, "code.function.name")] += point.Value
+			}
+			if calls["Work"] != 1 || calls["Pool.Run"] != 3 || calls["Hash"] != 101 {
+				panic(fmt.Sprintf("unexpected function.calls %v", calls))
+			}
+		},
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"errors"
+	"time"
+)
+
+type Pool struct {
+	Name string
+}
+
+func Work(name string) string {
+	time.Sleep(10 * time.Millisecond)
+	return "done " + name
+}
+
+func (p *Pool) Run(n int) (int, error) {
+	if n < 0 {
+		return 0, errors.New("negative input")
+	}
+	return n * 2, nil
+}
+
+// Hash is called on the hot path, it is measured by a counter only
+func Hash(n int) int {
+	return n*31 + 7
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const FuncMetricsAppName = "funcmetrics"
+
+func TestRunFuncMetrics(t *testing.T) {
+	UseApp(FuncMetricsAppName)
+
+	RunSet(t, UseTestRules("test_funcmetrics.json"))
+	RunGoBuild(t, "go", "build")
+	// Exemplars of the SDK look up the current span, which allocates, turn it
+	// off so that metrics-only rules allocate nothing at all
+	stdout, _ := RunApp(t, FuncMetricsAppName, "OTEL_METRICS_EXEMPLAR_FILTER=always_off")
+	ExpectContains(t, stdout, "done job")
+	ExpectContains(t, stdout, "0 negative input")
+	ExpectContains(t, stdout, "0 <nil>")
+	ExpectContains(t, stdout, "0 allocs of Hash")
+}
+
+func TestRunFuncMetricsYAML(t *testing.T) {
+	UseApp(FuncMetricsAppName)
+
+	RunSet(t, UseTestRules("test_funcmetrics.yaml"))
+	RunGoBuild(t, "go", "build")
+	stdout, _ := RunApp(t, FuncMetricsAppName)
+	ExpectContains(t, stdout, "done job")
+	ExpectContains(t, stdout, "0 negative input")
+	ExpectContains(t, stdout, "0 <nil>")
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	topicID        = "otel-topic"
+	subscriptionID = "otel-subscription"
+)
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, "otel-project")
+	if err != nil {
+		panic(err)
+	}
+	topic, err := client.CreateTopic(ctx, topicID)
+	if err != nil {
+		panic(err)
+	}
+	topic.EnableMessageOrdering = true
+	sub, err := client.CreateSubscription(ctx, subscriptionID, pubsub.SubscriptionConfig{
+		Topic:                 topic,
+		AckDeadline:           20 * time.Second,
+		EnableMessageOrdering: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	id, err := topic.Publish(ctx, &pubsub.Message{Data: []byte("hello"), OrderingKey: "otel"}).Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	topic.Stop()
+
+	processed := make(chan trace.SpanContext, 1)
+	receiveCtx, cancel := context.WithCancel(ctx)
+	err = sub.Receive(receiveCtx, func(ctx context.Context, msg *pubsub.Message) {
+		msg.Ack()
+		select {
+		case processed <- trace.SpanContextFromContext(ctx):
+		default:
+		}
+		cancel()
+	})
+	if err != nil {
+		panic(err)
+	}
+	handlerSpan := <-processed
+	_ = client.Close()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		publish, ok := findSpan(stubs, topicID+" publish")
+		verifier.Assert(ok, "Expect a span publishing the message")
+		verifier.VerifyMQPublishAttributes(publish, "", "", "", "publish", topicID, "gcp_pubsub")
+		messageID := verifier.GetAttribute(publish.Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == id, "Expect messaging.message.id to be %s, got %s", id, messageID)
+		orderingKey := verifier.GetAttribute(publish.Attributes, "messaging.gcp_pubsub.message.ordering_key").AsString()
+		verifier.Assert(orderingKey == "otel", "Expect the ordering key to be otel, got %s", orderingKey)
+
+		process, ok := findSpan(stubs, subscriptionID+" process")
+		verifier.Assert(ok, "Expect a span processing the message")
+		verifier.VerifyMQConsumeAttributes(process, "", "", "", "process", subscriptionID, "gcp_pubsub")
+		verifier.Assert(handlerSpan.SpanID() == process.SpanContext.SpanID(), "Expect the handler to be passed the process span")
+		verifier.Assert(process.Parent.SpanID() == publish.SpanContext.SpanID(), "Expect the message to be processed under the publishing span")
+		ackDeadline := verifier.GetAttribute(process.Attributes, "messaging.gcp_pubsub.message.ack_deadline").AsInt64()
+		verifier.Assert(ackDeadline > 0, "Expect an ack deadline to be recorded, got %d", ackDeadline)
+	}, 1)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const gcp_pubsub_dependency_name = "cloud.google.com/go/pubsub"
+const gcp_pubsub_module_name = "gcp-pubsub"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("gcp-pubsub-1.33.0-test", gcp_pubsub_module_name, "v1.33.0", "v1.50.1", "1.19", "", TestGcpPubsub),
+		NewMuzzleTestCase("gcp-pubsub-muzzle-test", gcp_pubsub_dependency_name, gcp_pubsub_module_name, "v1.33.0", "v1.50.1", "1.19", "", []string{"go", "build", "test_gcp_pubsub.go"}),
+		NewLatestDepthTestCase("gcp-pubsub-latest-depth-test", gcp_pubsub_dependency_name, gcp_pubsub_module_name, "v1.33.0", "v1.50.1", "1.19", "", TestGcpPubsub),
+	)
+}
+
+func TestGcpPubsub(t *testing.T, env ...string) {
+	_, emulatorPort := initPubsubEmulatorContainer()
+	UseApp("gcp-pubsub/v1.33.0")
+	RunGoBuild(t, "go", "build", "test_gcp_pubsub.go")
+	env = append(env, "PUBSUB_EMULATOR_HOST=localhost:"+emulatorPort.Port())
+	RunApp(t, "test_gcp_pubsub", env...)
+}
+
+func initPubsubEmulatorContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "gcr.io/google.com/cloudsdktool/google-cloud-cli:emulators",
+		Cmd:          []string{"gcloud", "beta", "emulators", "pubsub", "start", "--host-port=0.0.0.0:8085"},
+		ExposedPorts: []string{"8085/tcp"},
+		WaitingFor:   wait.ForLog("Server started"),
+	}
+	emulatorC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := emulatorC.MappedPort(context.Background(), "8085")
+	if err != nil {
+		panic(err)
+	}
+	return emulatorC, port
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/couchbase/gocb/v2"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	cluster, err := gocb.Connect("couchbase://127.0.0.1", gocb.ClusterOptions{
+		Authenticator: gocb.PasswordAuthenticator{Username: "Administrator", Password: "password"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer cluster.Close(nil)
+	bucket := cluster.Bucket("test")
+	if err = bucket.WaitUntilReady(30*time.Second, nil); err != nil {
+		panic(err)
+	}
+	collection := bucket.DefaultCollection()
+
+	_, err = collection.Upsert("user::1", map[string]string{"name": "alice"}, &gocb.UpsertOptions{DurabilityLevel: gocb.DurabilityLevelMajority})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = collection.Get("user::1", nil); err != nil {
+		panic(err)
+	}
+	rows, err := cluster.Query("SELECT 1 AS one", nil)
+	if err != nil {
+		panic(err)
+	}
+	for rows.Next() {
+	}
+	if err = rows.Err(); err != nil {
+		panic(err)
+	}
+	_, err = cluster.Transactions().Run(func(ctx *gocb.TransactionAttemptContext) error {
+		_, err := ctx.Get(collection, "user::1")
+		return err
+	}, &gocb.TransactionOptions{DurabilityLevel: gocb.DurabilityLevelMajority})
+	if err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		spans := make(map[string]tracetest.SpanStub)
+		for _, stub := range stubs {
+			for _, span := range stub {
+				spans[span.Name] = span
+			}
+		}
+		upsert, ok := spans["upsert _default"]
+		verifier.Assert(ok, "Expect the upsert to be traced")
+		verifier.VerifyDbAttributes(upsert, "upsert _default", "couchbase", "127.0.0.1", "", "upsert", "_default", nil)
+		namespace := verifier.GetAttribute(upsert.Attributes, "db.namespace").AsString()
+		verifier.Assert(namespace == "test", "Expect db.namespace to be the bucket test, got %s", namespace)
+		scope := verifier.GetAttribute(upsert.Attributes, "db.couchbase.scope").AsString()
+		verifier.Assert(scope == "_default", "Expect db.couchbase.scope to be _default, got %s", scope)
+		durability := verifier.GetAttribute(upsert.Attributes, "db.couchbase.durability").AsString()
+		verifier.Assert(durability != "", "Expect db.couchbase.durability of the upsert to be set")
+
+		get, ok := spans["get _default"]
+		verifier.Assert(ok, "Expect the get to be traced")
+		verifier.VerifyDbAttributes(get, "get _default", "couchbase", "127.0.0.1", "", "get", "_default", nil)
+
+		query, ok := spans["query"]
+		verifier.Assert(ok, "Expect the query to be traced")
+		verifier.VerifyDbAttributes(query, "query", "couchbase", "127.0.0.1", "SELECT 1 AS one", "query", "", nil)
+
+		transaction, ok := spans["transaction"]
+		verifier.Assert(ok, "Expect the transaction to be traced")
+		durability = verifier.GetAttribute(transaction.Attributes, "db.couchbase.durability").AsString()
+		verifier.Assert(durability == "majority", "Expect db.couchbase.durability of the transaction to be majority, got %s", durability)
+	}, 4)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const gocb_dependency_name = "github.com/couchbase/gocb/v2"
+const gocb_module_name = "gocb"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("gocb-2.5.0-test", gocb_module_name, "v2.5.0", "v2.11.1", "1.19", "", TestGocb),
+		NewMuzzleTestCase("gocb-muzzle-test", gocb_dependency_name, gocb_module_name, "v2.5.0", "v2.11.1", "1.19", "", []string{"go", "build", "test_gocb.go"}),
+		NewLatestDepthTestCase("gocb-latestdepth-test", gocb_dependency_name, gocb_module_name, "v2.5.0", "v2.11.1", "1.19", "", TestGocb),
+	)
+}
+
+func TestGocb(t *testing.T, env ...string) {
+	initCouchbaseContainer()
+	UseApp("gocb/v2.5.0")
+	RunGoBuild(t, "go", "build", "test_gocb.go")
+	RunApp(t, "test_gocb", env...)
+}
+
+// initCouchbaseContainer starts a single node cluster with a "test" bucket.
+// The ports are bound to the same ones of the host, as the SDK connects to
+// the addresses the cluster advertises.
+func initCouchbaseContainer() testcontainers.Container {
+	ports := []string{"8091", "8092", "8093", "8094", "8095", "8096", "11210"}
+	exposed := make([]string, 0, len(ports))
+	for _, port := range ports {
+		exposed = append(exposed, port+":"+port+"/tcp")
+	}
+	req := testcontainers.ContainerRequest{
+		Image:        "couchbase/server:community-7.2.4",
+		ExposedPorts: exposed,
+		WaitingFor:   wait.ForHTTP("/ui/index.html").WithPort("8091/tcp"),
+	}
+	couchbaseC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	postCouchbase("/clusterInit", url.Values{
+		"hostname":         {"127.0.0.1"},
+		"services":         {"kv,n1ql,index"},
+		"username":         {"Administrator"},
+		"password":         {"password"},
+		"port":             {"SAME"},
+		"memoryQuota":      {"512"},
+		"indexMemoryQuota": {"256"},
+		"clusterName":      {"otel"},
+		"sendStats":        {"false"},
+	})
+	postCouchbase("/pools/default/buckets", url.Values{
+		"name":          {"test"},
+		"ramQuota":      {"128"},
+		"bucketType":    {"couchbase"},
+		"replicaNumber": {"0"},
+	})
+	return couchbaseC
+}
+
+func postCouchbase(path string, form url.Values) {
+	req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:8091"+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		panic(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("Administrator", "password")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		panic("couchbase " + path + ": " + resp.Status)
+	}
+}
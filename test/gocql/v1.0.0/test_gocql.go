@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/gocql/gocql"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	port, err := strconv.Atoi(os.Getenv("CASSANDRA_PORT"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	cluster := gocql.NewCluster("127.0.0.1")
+	cluster.Port = port
+	cluster.Consistency = gocql.One
+	cluster.DisableInitialHostLookup = true
+	session, err := cluster.CreateSession()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer session.Close()
+	if err = session.Query("CREATE KEYSPACE IF NOT EXISTS otel WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1}").Exec(); err != nil {
+		log.Fatal(err)
+	}
+	if err = session.Query("CREATE TABLE IF NOT EXISTS otel.users (id int PRIMARY KEY, name text)").Exec(); err != nil {
+		log.Fatal(err)
+	}
+	if err = session.Query("INSERT INTO otel.users (id, name) VALUES (1, 'opentelemetry')").Exec(); err != nil {
+		log.Fatal(err)
+	}
+	var name string
+	if err = session.Query("SELECT name FROM otel.users WHERE id = ?", 1).Consistency(gocql.Quorum).Scan(&name); err != nil {
+		log.Fatal(err)
+	}
+	batch := session.NewBatch(gocql.LoggedBatch)
+	batch.Query("INSERT INTO otel.users (id, name) VALUES (?, ?)", 2, "gocql")
+	batch.Query("UPDATE otel.users SET name = 'cassandra' WHERE id = 1")
+	if err = session.ExecuteBatch(batch); err != nil {
+		log.Fatal(err)
+	}
+	if err = session.Query("DROP KEYSPACE otel").Exec(); err != nil {
+		log.Fatal(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "CREATE", "cassandra", "", "CREATE KEYSPACE IF NOT EXISTS otel WITH replication = {?: ?, ?: ?}", "CREATE", "", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "CREATE", "cassandra", "", "CREATE TABLE IF NOT EXISTS otel.users (id int PRIMARY KEY, name text)", "CREATE", "", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "INSERT otel.users", "cassandra", "", "INSERT INTO otel.users (id, name) VALUES (?, ?)", "INSERT", "otel.users", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "SELECT otel.users", "cassandra", "", "SELECT name FROM otel.users WHERE id = ?", "SELECT", "otel.users", nil)
+		consistency := verifier.GetAttribute(stubs[3][0].Attributes, "cassandra.consistency.level").AsString()
+		verifier.Assert(consistency == "quorum", "Expect consistency level to be quorum, got %s", consistency)
+		verifier.VerifyDbAttributes(stubs[4][0], "BATCH", "cassandra", "", "INSERT INTO otel.users (id, name) VALUES (?, ?); UPDATE otel.users SET name = ? WHERE id = ?", "BATCH", "", nil)
+		batchSize := verifier.GetAttribute(stubs[4][0].Attributes, "db.operation.batch.size").AsInt64()
+		verifier.Assert(batchSize == 2, "Expect batch size to be 2, got %d", batchSize)
+		verifier.VerifyDbAttributes(stubs[5][0], "DROP", "cassandra", "", "DROP KEYSPACE otel", "DROP", "", nil)
+		for _, stub := range stubs {
+			consistency = verifier.GetAttribute(stub[0].Attributes, "cassandra.consistency.level").AsString()
+			verifier.Assert(consistency != "", "Expect consistency level to be set")
+			coordinator := verifier.GetAttribute(stub[0].Attributes, "cassandra.coordinator.id").AsString()
+			verifier.Assert(coordinator != "", "Expect coordinator id to be set")
+		}
+	}, 6)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/go-co-op/gocron"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	runs     sync.WaitGroup
+	slowOnce sync.Once
+)
+
+func report() {
+	runs.Done()
+}
+
+func failingJob() error {
+	defer runs.Done()
+	return errors.New("job failed")
+}
+
+func slowJob() {
+	time.Sleep(1500 * time.Millisecond)
+	slowOnce.Do(runs.Done)
+}
+
+func main() {
+	s := gocron.NewScheduler(time.UTC)
+	runs.Add(3)
+	if _, err := s.Every(1).Second().Tag("report").LimitRunsTo(1).Do(report); err != nil {
+		panic(err)
+	}
+	if _, err := s.Every(1).Second().LimitRunsTo(1).Do(failingJob); err != nil {
+		panic(err)
+	}
+	if _, err := s.Every(1).Second().SingletonMode().Do(slowJob); err != nil {
+		panic(err)
+	}
+	s.StartAsync()
+	runs.Wait()
+	s.Stop()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		jobs := map[string]tracetest.SpanStub{}
+		overlapped := false
+		for _, trace := range stubs {
+			for _, span := range trace {
+				if span.Parent.IsValid() {
+					continue
+				}
+				if _, ok := jobs[span.Name]; !ok {
+					jobs[span.Name] = span
+				}
+				for _, event := range span.Events {
+					if span.Name == "gocron main.slowJob" && event.Name == "gocron.run.overlapped" {
+						overlapped = true
+					}
+				}
+			}
+		}
+		ok, found := jobs["gocron main.report"]
+		verifier.Assert(found, "Expect a run of report")
+		verifier.Assert(verifier.GetAttribute(ok.Attributes, "gocron.job.name").AsString() == "main.report", "Expect gocron.job.name to be main.report")
+		tags := verifier.GetAttribute(ok.Attributes, "gocron.job.tags").AsStringSlice()
+		verifier.Assert(len(tags) == 1 && tags[0] == "report", "Expect gocron.job.tags to be [report], got %v", tags)
+		verifier.Assert(ok.Status.Code != codes.Error, "Expect the run of report to succeed")
+		failed, found := jobs["gocron main.failingJob"]
+		verifier.Assert(found, "Expect a run of failingJob")
+		verifier.Assert(failed.Status.Code == codes.Error, "Expect the run of failingJob to fail, got %v", failed.Status.Code)
+		verifier.Assert(overlapped, "Expect the overlapping run of slowJob to be recorded as an event")
+	}, 3)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const gocron_dependency_name = "github.com/go-co-op/gocron"
+const gocron_module_name = "gocron"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("gocron-test", gocron_module_name, "v1.37.0", "", "1.18", "", TestGocron),
+		NewMuzzleTestCase("gocron-muzzle-test", gocron_dependency_name, gocron_module_name, "v1.37.0", "", "1.18", "", []string{"go", "build", "test_gocron.go"}),
+		NewLatestDepthTestCase("gocron-latestdepth-test", gocron_dependency_name, gocron_module_name, "v1.37.0", "", "1.18", "", TestGocron),
+	)
+}
+
+func TestGocron(t *testing.T, env ...string) {
+	UseApp("gocron/v1.37.0")
+	RunGoBuild(t, "go", "build", "test_gocron.go")
+	RunApp(t, "test_gocron", env...)
+}
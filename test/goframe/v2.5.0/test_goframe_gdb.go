@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	_ "github.com/gogf/gf/contrib/drivers/sqlite/v2"
+	"github.com/gogf/gf/v2/database/gdb"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStubs, bool) {
+	for _, stub := range stubs {
+		if len(stub) > 0 && stub[0].Name == name {
+			return stub, true
+		}
+	}
+	return nil, false
+}
+
+func main() {
+	dir, err := os.MkdirTemp("", "goframe-gdb")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := gdb.New(gdb.ConfigNode{
+		Type: "sqlite",
+		Link: "sqlite::@file(" + filepath.Join(dir, "test.db") + ")",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	if _, err = db.Exec(ctx, "CREATE TABLE user (id INTEGER PRIMARY KEY, name TEXT)"); err != nil {
+		panic(err)
+	}
+	if _, err = db.Model("user").Ctx(ctx).Data(map[string]interface{}{"id": 1, "name": "goframe"}).Insert(); err != nil {
+		panic(err)
+	}
+	if _, err = db.Model("user").Ctx(ctx).Where("id", 1).One(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for _, name := range []string{"CREATE", "INSERT", "SELECT"} {
+			stub, ok := findSpan(stubs, name)
+			verifier.Assert(ok, "Expect span %s", name)
+			verifier.VerifyDbAttributes(stub[0], name, "sqlite", "", "", name, "", nil)
+			verifier.Assert(len(stub) > 1, "Expect child spans of %s", name)
+		}
+	}, 3)
+}
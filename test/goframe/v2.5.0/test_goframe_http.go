@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	s := g.Server()
+	s.BindHandler("/user/{id}", func(r *ghttp.Request) {
+		r.Response.Write("user " + r.Get("id").String())
+	})
+	s.SetAddr("127.0.0.1:8080")
+	go s.Run()
+	time.Sleep(5 * time.Second)
+	content := g.Client().SetAgent("goframe-client").GetContent(context.Background(), "http://127.0.0.1:8080/user/1")
+	verifier.Assert(content == "user 1", "Expect response user 1, got %s", content)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 2, "Expect only the spans of net/http, got %d spans", len(stubs[0]))
+		verifier.VerifyHttpClientAttributes(stubs[0][0], "GET", "GET", "http://127.0.0.1:8080/user/1", "http", "1.1", "tcp", "ipv4", "", "127.0.0.1:8080", 200, 0, 8080)
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "/user/{id}", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "goframe-client", "http", "/user/1", "", "/user/{id}", 200)
+	}, 1)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const goframe_dependency_name = "github.com/gogf/gf/v2"
+const goframe_module_name = "goframe"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("goframe-http-test", goframe_module_name, "v2.5.0", "", "1.18", "", TestGoframeHttp),
+		NewGeneralTestCase("goframe-gdb-test", goframe_module_name, "v2.5.0", "", "1.18", "", TestGoframeGdb),
+		NewMuzzleTestCase("goframe-muzzle-test", goframe_dependency_name, goframe_module_name, "v2.5.0", "", "1.18", "", []string{"go", "build", "test_goframe_http.go"}),
+		NewLatestDepthTestCase("goframe-latestdepth-test", goframe_dependency_name, goframe_module_name, "v2.5.0", "", "1.18", "", TestGoframeHttp),
+	)
+}
+
+func TestGoframeHttp(t *testing.T, env ...string) {
+	UseApp("goframe/v2.5.0")
+	RunGoBuild(t, "go", "build", "test_goframe_http.go")
+	RunApp(t, "test_goframe_http", env...)
+}
+
+func TestGoframeGdb(t *testing.T, env ...string) {
+	UseApp("goframe/v2.5.0")
+	RunGoBuild(t, "go", "build", "test_goframe_gdb.go")
+	RunApp(t, "test_goframe_gdb", env...)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/go-kit/kit/endpoint"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type uppercaseRequest struct {
+	S string
+}
+
+func makeUppercaseEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(uppercaseRequest)
+		if req.S == "" {
+			return nil, errors.New("empty string")
+		}
+		return strings.ToUpper(req.S), nil
+	}
+}
+
+func decodeUppercaseRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return uppercaseRequest{S: r.URL.Query().Get("s")}, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	_, err := w.Write([]byte(response.(string)))
+	return err
+}
+
+func main() {
+	http.Handle("/uppercase", httptransport.NewServer(
+		makeUppercaseEndpoint(),
+		decodeUppercaseRequest,
+		encodeResponse,
+	))
+	go http.ListenAndServe("127.0.0.1:8080", nil)
+	time.Sleep(3 * time.Second)
+
+	resp, err := http.Get("http://127.0.0.1:8080/uppercase?s=kit")
+	if err != nil {
+		panic(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	verifier.Assert(string(body) == "KIT", "Expect response KIT, got %s", string(body))
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 3, "Expect 3 spans, got %d spans", len(stubs[0]))
+		endpointSpan := stubs[0][2]
+		verifier.Assert(endpointSpan.SpanKind == trace.SpanKindInternal, "Expect an internal span, got %s", endpointSpan.SpanKind)
+		verifier.Assert(endpointSpan.Name == "main.makeUppercaseEndpoint", "Expect the span to be named by the endpoint, got %s", endpointSpan.Name)
+		verifier.Assert(endpointSpan.Parent.SpanID() == stubs[0][1].SpanContext.SpanID(), "Expect the endpoint span to be a child of the server span")
+		transport := verifier.GetAttribute(endpointSpan.Attributes, "gokit.transport").AsString()
+		verifier.Assert(transport == "http", "Expect gokit.transport to be http, got %s", transport)
+	}, 1)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const gokit_dependency_name = "github.com/go-kit/kit"
+const gokit_module_name = "gokit"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("gokit-http-test", gokit_module_name, "v0.10.0", "", "1.18", "", TestGokitHttp),
+		NewMuzzleTestCase("gokit-muzzle-test", gokit_dependency_name, gokit_module_name, "v0.10.0", "", "1.18", "", []string{"go", "build", "test_gokit_http.go"}),
+		NewLatestDepthTestCase("gokit-latestdepth-test", gokit_dependency_name, gokit_module_name, "v0.10.0", "", "1.18", "", TestGokitHttp),
+	)
+}
+
+func TestGokitHttp(t *testing.T, env ...string) {
+	UseApp("gokit/v0.10.0")
+	RunGoBuild(t, "go", "build", "test_gokit_http.go")
+	RunApp(t, "test_gokit_http", env...)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/bradfitz/gomemcache/memcache"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	client := memcache.New("127.0.0.1:" + os.Getenv("MEMCACHED_PORT"))
+	if err := client.Set(&memcache.Item{Key: "foo", Value: []byte("bar")}); err != nil {
+		panic(err)
+	}
+	if _, err := client.Get("foo"); err != nil {
+		panic(err)
+	}
+	if _, err := client.Get("missing"); err != memcache.ErrCacheMiss {
+		panic(err)
+	}
+	if _, err := client.GetMulti([]string{"foo", "missing"}); err != nil {
+		panic(err)
+	}
+	if err := client.Delete("foo"); err != nil {
+		panic(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "set", "memcached", "127.0.0.1", "set", "set", "", nil)
+		keyCount := verifier.GetAttribute(stubs[0][0].Attributes, "memcached.key.count").AsInt64()
+		verifier.Assert(keyCount == 1, "Expect key count to be 1, got %d", keyCount)
+		verifier.VerifyDbAttributes(stubs[1][0], "get", "memcached", "127.0.0.1", "get", "get", "", nil)
+		hit := verifier.GetAttribute(stubs[1][0].Attributes, "memcached.hit").AsBool()
+		verifier.Assert(hit, "Expect the first get to hit")
+		verifier.VerifyDbAttributes(stubs[2][0], "get", "memcached", "127.0.0.1", "get", "get", "", nil)
+		hit = verifier.GetAttribute(stubs[2][0].Attributes, "memcached.hit").AsBool()
+		verifier.Assert(!hit, "Expect the second get to miss")
+		verifier.Assert(stubs[2][0].Status.Code != codes.Error, "Expect a cache miss not to be an error")
+		verifier.VerifyDbAttributes(stubs[3][0], "get_multi", "memcached", "127.0.0.1", "get_multi", "get_multi", "", nil)
+		hits := verifier.GetAttribute(stubs[3][0].Attributes, "memcached.hit.count").AsInt64()
+		misses := verifier.GetAttribute(stubs[3][0].Attributes, "memcached.miss.count").AsInt64()
+		verifier.Assert(hits == 1 && misses == 1, "Expect 1 hit and 1 miss, got %d and %d", hits, misses)
+		verifier.VerifyDbAttributes(stubs[4][0], "delete", "memcached", "127.0.0.1", "delete", "delete", "", nil)
+	}, 5)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/bradfitz/gomemcache/memcache"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	client := memcache.New("127.0.0.1:" + os.Getenv("MEMCACHED_PORT"))
+	if err := client.Set(&memcache.Item{Key: "foo", Value: []byte("bar")}); err != nil {
+		panic(err)
+	}
+	if _, err := client.GetMulti([]string{"foo", "missing"}); err != nil {
+		panic(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "set", "memcached", "127.0.0.1", "set foo", "set", "", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "get_multi", "memcached", "127.0.0.1", "get_multi foo missing", "get_multi", "", nil)
+	}, 2)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const gomemcache_module_name = "gomemcache"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("gomemcache-commands-test", gomemcache_module_name, "", "", "1.18", "", TestGomemcacheCommands),
+		NewGeneralTestCase("gomemcache-keys-test", gomemcache_module_name, "", "", "1.18", "", TestGomemcacheKeys),
+	)
+}
+
+func TestGomemcacheCommands(t *testing.T, env ...string) {
+	_, memcachedPort := initMemcachedContainer()
+	UseApp("gomemcache")
+	RunGoBuild(t, "go", "build", "test_gomemcache.go")
+	env = append(env, "MEMCACHED_PORT="+memcachedPort.Port())
+	RunApp(t, "test_gomemcache", env...)
+}
+
+func TestGomemcacheKeys(t *testing.T, env ...string) {
+	_, memcachedPort := initMemcachedContainer()
+	UseApp("gomemcache")
+	RunGoBuild(t, "go", "build", "test_gomemcache_keys.go")
+	env = append(env, "MEMCACHED_PORT="+memcachedPort.Port(), "OTEL_INSTRUMENTATION_GOMEMCACHE_KEYS_ENABLED=true")
+	RunApp(t, "test_gomemcache_keys", env...)
+}
+
+func initMemcachedContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "memcached:1.6",
+		ExposedPorts: []string{"11211/tcp"},
+		WaitingFor:   wait.ForListeningPort("11211/tcp"),
+	}
+	memcachedC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := memcachedC.MappedPort(context.Background(), "11211")
+	if err != nil {
+		panic(err)
+	}
+	return memcachedC, port
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const gorilla_websocket_dependency_name = "github.com/gorilla/websocket"
+const gorilla_websocket_module_name = "gorilla-websocket"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("gorilla-websocket-test", gorilla_websocket_module_name, "v1.4.0", "", "1.18", "", TestGorillaWebsocket),
+		NewMuzzleTestCase("gorilla-websocket-muzzle-test", gorilla_websocket_dependency_name, gorilla_websocket_module_name, "v1.4.0", "", "1.18", "", []string{"go", "build", "test_websocket.go"}),
+		NewLatestDepthTestCase("gorilla-websocket-latestdepth-test", gorilla_websocket_dependency_name, gorilla_websocket_module_name, "v1.4.0", "", "1.18", "", TestGorillaWebsocket),
+	)
+}
+
+func TestGorillaWebsocket(t *testing.T, env ...string) {
+	UseApp("gorilla-websocket/v1.4.0")
+	RunGoBuild(t, "go", "build", "test_websocket.go")
+	RunApp(t, "test_websocket", env...)
+}
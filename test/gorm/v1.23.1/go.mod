@@ -31,6 +31,6 @@ require (
 	github.com/jinzhu/now v1.1.5 // indirect
 	go.opentelemetry.io/otel v1.35.0 // indirect
 	go.opentelemetry.io/otel/metric v1.35.0 // indirect
-	go.opentelemetry.io/otel/trace v1.35.0 // indirect
+	go.opentelemetry.io/otel/trace v1.35.0
 	golang.org/x/sys v0.30.0 // indirect
 )
@@ -17,6 +17,7 @@ package main
 import (
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"log"
 	"os"
 
@@ -87,14 +88,26 @@ func main() {
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
 		verifier.VerifyDbAttributes(stubs[0][0], "SELECT dual", "mysql", "127.0.0.1", "SELECT VERSION()", "SELECT", "dual", nil)
 		verifier.VerifyDbAttributes(stubs[1][0], "ping", "mysql", "127.0.0.1", "ping", "ping", "", nil)
-		verifier.VerifyDbAttributes(stubs[2][0], "raw", "mysql", "127.0.0.1", "", "raw", "", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "raw", "mysql", "127.0.0.1", "CREATE TABLE IF NOT EXISTS users (id char(?), name VARCHAR(?), age INTEGER)", "raw", "", nil)
 		verifier.VerifyDbAttributes(stubs[3][0], "START", "mysql", "127.0.0.1", "START TRANSACTION", "START", "", nil)
-		verifier.VerifyDbAttributes(stubs[4][0], "create", "mysql", "127.0.0.1", "", "create", "", nil)
-		verifier.VerifyDbAttributes(stubs[5][0], "query", "mysql", "127.0.0.1", "", "query", "", nil)
-		verifier.VerifyDbAttributes(stubs[6][0], "row", "mysql", "127.0.0.1", "", "row", "", nil)
+		verifyTransaction(stubs[4][0], "commit")
+		verifier.VerifyDbAttributes(stubs[4][1], "create users", "mysql", "127.0.0.1", "INSERT INTO `users`", "create", "users", nil)
+		verifier.VerifyDbAttributes(stubs[5][0], "query users", "mysql", "127.0.0.1", "SELECT * FROM `users`", "query", "users", nil)
+		verifier.VerifyDbAttributes(stubs[6][0], "row users", "mysql", "127.0.0.1", "FROM `users` WHERE name = ?", "row", "users", nil)
 		verifier.VerifyDbAttributes(stubs[7][0], "START", "mysql", "127.0.0.1", "START TRANSACTION", "START", "", nil)
-		verifier.VerifyDbAttributes(stubs[8][0], "update", "mysql", "127.0.0.1", "", "update", "", nil)
+		verifyTransaction(stubs[8][0], "commit")
+		verifier.VerifyDbAttributes(stubs[8][1], "update users", "mysql", "127.0.0.1", "UPDATE `users` SET `name`=?", "update", "users", nil)
 		verifier.VerifyDbAttributes(stubs[9][0], "START", "mysql", "127.0.0.1", "START TRANSACTION", "START", "", nil)
-		verifier.VerifyDbAttributes(stubs[10][0], "delete", "mysql", "127.0.0.1", "", "delete", "", nil)
+		verifyTransaction(stubs[10][0], "commit")
+		verifier.VerifyDbAttributes(stubs[10][1], "delete users", "mysql", "127.0.0.1", "DELETE FROM `users`", "delete", "users", nil)
 	}, 1)
 }
+
+// Statements executed in the default transaction of gorm are the children of
+// the transaction span.
+func verifyTransaction(span tracetest.SpanStub, outcome string) {
+	verifier.Assert(span.Name == "transaction", "Expect span name transaction, got %s", span.Name)
+	verifier.Assert(span.SpanKind == trace.SpanKindInternal, "Expect to be internal span, got %d", span.SpanKind)
+	actualOutcome := verifier.GetAttribute(span.Attributes, "db.transaction.outcome").AsString()
+	verifier.Assert(actualOutcome == outcome, "Expect transaction outcome %s, got %s", outcome, actualOutcome)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type DeepPet struct {
+	ID     uint
+	Name   string
+	UserID uint
+}
+
+type DeepUser struct {
+	ID   uint
+	Name string
+	Age  uint8
+	Pets []DeepPet `gorm:"foreignKey:UserID"`
+}
+
+func main() {
+	db, err := gorm.Open(mysql.Open("test:test@tcp(127.0.0.1:"+os.Getenv("MYSQL_PORT")+")/test"), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("open db error: %v \n", err)
+	}
+	if err := db.Exec(`CREATE TABLE IF NOT EXISTS deep_users (id INTEGER AUTO_INCREMENT PRIMARY KEY, name VARCHAR(255), age INTEGER)`).Error; err != nil {
+		log.Fatalf("%v", err)
+	}
+	if err := db.Exec(`CREATE TABLE IF NOT EXISTS deep_pets (id INTEGER AUTO_INCREMENT PRIMARY KEY, name VARCHAR(255), user_id INTEGER)`).Error; err != nil {
+		log.Fatalf("%v", err)
+	}
+	if err := db.Create(&DeepUser{Name: "deep", Age: 18, Pets: []DeepPet{{Name: "cat"}}}).Error; err != nil {
+		log.Fatalf("%v", err)
+	}
+	var users []DeepUser
+	if err := db.Preload("Pets").Where("age > ?", 10).Find(&users).Error; err != nil {
+		log.Fatalf("%v", err)
+	}
+	verifier.Assert(len(users) == 1 && len(users[0].Pets) == 1, "Expect 1 user with 1 pet, got %v", users)
+	_ = db.Transaction(func(tx *gorm.DB) error {
+		tx.Model(&DeepUser{}).Where("name = ?", "deep").Update("age", 20)
+		return errors.New("rollback")
+	})
+	db.Exec(`UPDATE deep_users SET age = 30 WHERE name = 'deep'`)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		// create, then the pets after the user, in the default transaction
+		stub := findTrace(stubs, "create deep_users")
+		verifyTransaction(stub[0], "commit")
+		verifier.VerifyDbAttributes(stub[1], "create deep_users", "mysql", "127.0.0.1", "INSERT INTO `deep_users`", "create", "deep_users", nil)
+		verifyRowsAffected(stub[1], 1)
+		createPets := findSpan(stub, "create deep_pets")
+		verifier.Assert(createPets.Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect pets to be created in the transaction")
+
+		// query, then the preloads within
+		stub = findTrace(stubs, "query deep_users")
+		verifier.VerifyDbAttributes(stub[0], "query deep_users", "mysql", "127.0.0.1", "SELECT * FROM `deep_users` WHERE age > ?", "query", "deep_users", nil)
+		verifyRowsAffected(stub[0], 1)
+		preload := findSpan(stub, "preload deep_users")
+		verifier.Assert(preload.Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect preload to be the child of the query")
+		preloads := verifier.GetAttribute(preload.Attributes, "gorm.preloads").AsStringSlice()
+		verifier.Assert(len(preloads) == 1 && preloads[0] == "Pets", "Expect preloads [Pets], got %v", preloads)
+		queryPets := findSpan(stub, "query deep_pets")
+		verifier.Assert(queryPets.Parent.SpanID() == preload.SpanContext.SpanID(), "Expect pets to be queried in the preload")
+
+		// update in a transaction rolled back
+		stub = findTrace(stubs, "update deep_users")
+		verifyTransaction(stub[0], "rollback")
+		verifier.Assert(stub[0].Status.Code != codes.Error, "Expect rollback not to be an error")
+		verifier.VerifyDbAttributes(stub[1], "update deep_users", "mysql", "127.0.0.1", "UPDATE `deep_users` SET `age`=? WHERE name = ?", "update", "deep_users", nil)
+
+		// literals of raw statements are sanitized
+		last := stubs[len(stubs)-1]
+		verifier.VerifyDbAttributes(last[0], "raw", "mysql", "127.0.0.1", "UPDATE deep_users SET age = ? WHERE name = ?", "raw", "", nil)
+	}, 1)
+}
+
+func findTrace(stubs []tracetest.SpanStubs, name string) tracetest.SpanStubs {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return stub
+			}
+		}
+	}
+	log.Fatalf("no trace of span %s", name)
+	return nil
+}
+
+func findSpan(stub tracetest.SpanStubs, name string) tracetest.SpanStub {
+	for _, span := range stub {
+		if span.Name == name {
+			return span
+		}
+	}
+	log.Fatalf("no span %s", name)
+	return tracetest.SpanStub{}
+}
+
+func verifyTransaction(span tracetest.SpanStub, outcome string) {
+	verifier.Assert(span.Name == "transaction", "Expect span name transaction, got %s", span.Name)
+	actualOutcome := verifier.GetAttribute(span.Attributes, "db.transaction.outcome").AsString()
+	verifier.Assert(actualOutcome == outcome, "Expect transaction outcome %s, got %s", outcome, actualOutcome)
+}
+
+func verifyRowsAffected(span tracetest.SpanStub, rows int64) {
+	actualRows := verifier.GetAttribute(span.Attributes, "db.rows_affected").AsInt64()
+	verifier.Assert(actualRows == rows, "Expect %d rows affected, got %d", rows, actualRows)
+}
@@ -24,7 +24,8 @@ const gorm_module_name = "gorm"
 func init() {
 	TestCases = append(TestCases, NewGeneralTestCase("gorm_crud_test", gorm_module_name, "v1.23.0", "v1.24.6", "1.18", "", TestGormCrud1231),
 		NewLatestDepthTestCase("gorm_latestdepth_test", gorm_dependency_name, gorm_module_name, "v1.23.0", "v1.24.6", "1.18", "", TestGormCrud1231),
-		NewGeneralTestCase("gorm_crud_test", gorm_module_name, "v1.22.0", "v1.23.0", "1.18", "", TestGormCrud1220))
+		NewGeneralTestCase("gorm_crud_test", gorm_module_name, "v1.22.0", "v1.23.0", "1.18", "", TestGormCrud1220),
+		NewGeneralTestCase("gorm_deep_test", gorm_module_name, "v1.23.0", "v1.24.6", "1.18", "", TestGormDeep1231))
 }
 
 func TestGormCrud1231(t *testing.T, env ...string) {
@@ -42,3 +43,11 @@ func TestGormCrud1220(t *testing.T, env ...string) {
 	env = append(env, "MYSQL_PORT="+mysqlPort.Port())
 	RunApp(t, "test_gorm_crud", env...)
 }
+
+func TestGormDeep1231(t *testing.T, env ...string) {
+	_, mysqlPort := init8xMySqlContainer()
+	UseApp("gorm/v1.23.1")
+	RunGoBuild(t, "go", "build", "test_gorm_deep.go")
+	env = append(env, "MYSQL_PORT="+mysqlPort.Port())
+	RunApp(t, "test_gorm_deep", env...)
+}
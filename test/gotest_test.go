@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package test
+
+import "testing"
+
+const GoTestAppName = "gotest"
+
+func TestRunGoTest(t *testing.T) {
+	UseApp(GoTestAppName)
+
+	// Rules of the test scope instrument the tests themselves, while rules of
+	// the default scope never touch the test files
+	RunSet(t, UseTestRules("test_scope.json"))
+	RunGoBuild(t, "go", "test", "-v")
+	stdout, stderr := readStdoutLog(t), readStderrLog(t)
+	ExpectContains(t, stdout, "--- PASS: TestAdd")
+	ExpectContains(t, stdout+stderr, "SCOPED")
+	ExpectContains(t, stdout+stderr, "SOURCE")
+	ExpectNotContains(t, stdout+stderr, "UNSCOPED")
+}
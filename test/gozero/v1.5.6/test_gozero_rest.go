@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	// the config is loaded so that the defaults, which enable the tracing
+	// middleware, are applied
+	var c rest.RestConf
+	if err := conf.LoadFromYamlBytes([]byte("Name: gozero-test\nHost: 127.0.0.1\nPort: 8888\nLog:\n  Mode: console\n"), &c); err != nil {
+		panic(err)
+	}
+	server := rest.MustNewServer(c)
+	server.AddRoute(rest.Route{
+		Method: http.MethodGet,
+		Path:   "/user/:id",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("user " + pathvar.Vars(r)["id"]))
+		},
+	})
+	go server.Start()
+	time.Sleep(3 * time.Second)
+
+	resp, err := http.Get("http://127.0.0.1:8888/user/1")
+	if err != nil {
+		panic(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	verifier.Assert(string(body) == "user 1", "Expect response user 1, got %s", string(body))
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.Assert(len(stubs[0]) == 2, "Expect only the spans of net/http, got %d spans", len(stubs[0]))
+		serverSpan := stubs[0][1]
+		verifier.Assert(serverSpan.SpanKind == trace.SpanKindServer, "Expect a server span, got %s", serverSpan.SpanKind)
+		verifier.Assert(serverSpan.Name == "/user/:id", "Expect the span to be named by the route, got %s", serverSpan.Name)
+	}, 1)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const gozero_dependency_name = "github.com/zeromicro/go-zero"
+const gozero_module_name = "gozero"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("gozero-rest-test", gozero_module_name, "v1.5.6", "", "1.19", "", TestGoZeroRest),
+		NewMuzzleTestCase("gozero-muzzle-test", gozero_dependency_name, gozero_module_name, "v1.5.6", "", "1.19", "", []string{"go", "build", "test_gozero_rest.go"}),
+		NewLatestDepthTestCase("gozero-latestdepth-test", gozero_dependency_name, gozero_module_name, "v1.5.6", "", "1.19", "", TestGoZeroRest),
+	)
+}
+
+func TestGoZeroRest(t *testing.T, env ...string) {
+	UseApp("gozero/v1.5.6")
+	RunGoBuild(t, "go", "build", "test_gozero_rest.go")
+	RunApp(t, "test_gozero_rest", env...)
+}
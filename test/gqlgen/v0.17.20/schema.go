@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const schemaSource = `
+type Query {
+	hello(name: String!): String!
+	version: String!
+	fail: String
+}
+`
+
+// executableSchema stands for the code generated by gqlgen, it resolves the
+// root fields of queries through the resolver middleware like the generated
+// code does. Complexity is never called as no complexity limit is set.
+type executableSchema struct {
+	graphql.ExecutableSchema
+	schema *ast.Schema
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	return e.schema
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	done := false
+	return func(ctx context.Context) *graphql.Response {
+		if done {
+			return nil
+		}
+		done = true
+		data := map[string]interface{}{}
+		for _, field := range graphql.CollectFields(rc, rc.Operation.SelectionSet, []string{"Query"}) {
+			fc := &graphql.FieldContext{
+				Object: "Query",
+				Field:  field,
+				Args:   field.ArgumentMap(rc.Variables),
+				// Fields backed by struct members have no resolver
+				IsMethod:   field.Name != "version",
+				IsResolver: field.Name != "version",
+			}
+			fieldCtx := graphql.WithFieldContext(ctx, fc)
+			res, err := rc.ResolverMiddleware(fieldCtx, func(ctx context.Context) (interface{}, error) {
+				return resolve(ctx, fc)
+			})
+			if err != nil {
+				graphql.AddError(fieldCtx, err)
+			}
+			data[field.Alias] = res
+		}
+		b, err := json.Marshal(data)
+		if err != nil {
+			panic(err)
+		}
+		return &graphql.Response{Data: b}
+	}
+}
+
+func resolve(ctx context.Context, fc *graphql.FieldContext) (interface{}, error) {
+	switch fc.Field.Name {
+	case "hello":
+		return "hello " + fc.Args["name"].(string), nil
+	case "version":
+		return "v1", nil
+	default:
+		return nil, errors.New("resolver failed")
+	}
+}
+
+func setupServer() {
+	srv := handler.New(&executableSchema{
+		schema: gqlparser.MustLoadSchema(&ast.Source{Input: schemaSource}),
+	})
+	srv.AddTransport(transport.POST{})
+	go func() {
+		if err := http.ListenAndServe("127.0.0.1:8080", srv); err != nil {
+			panic(err)
+		}
+	}()
+	time.Sleep(2 * time.Second)
+}
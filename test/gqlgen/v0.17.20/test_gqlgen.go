@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func query(q string) string {
+	body, err := json.Marshal(map[string]string{"query": q})
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.Post("http://127.0.0.1:8080/query", "application/json", bytes.NewReader(body))
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func main() {
+	setupServer()
+	resp := query(`query Hello { hello(name: "otel") version }`)
+	verifier.Assert(resp == `{"data":{"hello":"hello otel","version":"v1"}}`, "Unexpected response %s", resp)
+	query(`query Fail { fail }`)
+	resolverSpans := os.Getenv("OTEL_INSTRUMENTATION_GQLGEN_RESOLVER_SPANS_ENABLED") != "false"
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		expected := 3
+		if resolverSpans {
+			expected = 4
+		}
+		for _, stub := range stubs {
+			verifier.Assert(len(stub) == expected, "Expect %d spans, got %d", expected, len(stub))
+		}
+		verifyOperation(stubs[0][2], "query Hello", "Hello", false)
+		verifyOperation(stubs[1][2], "query Fail", "Fail", true)
+		verifier.Assert(stubs[0][2].Parent.SpanID() == stubs[0][1].SpanContext.SpanID(), "Expect the operation span to be the child of the http server span")
+		if resolverSpans {
+			verifyResolver(stubs[0][3], "Query.hello", "hello", false)
+			verifyResolver(stubs[1][3], "Query.fail", "fail", true)
+			verifier.Assert(stubs[0][3].Parent.SpanID() == stubs[0][2].SpanContext.SpanID(), "Expect the resolver span to be the child of the operation span")
+		}
+	}, 2)
+}
+
+func verifyOperation(span tracetest.SpanStub, name, operationName string, failed bool) {
+	verifier.Assert(span.Name == name, "Expect span name %s, got %s", name, span.Name)
+	verifier.Assert(verifier.GetAttribute(span.Attributes, "graphql.operation.name").AsString() == operationName, "Expect graphql.operation.name %s", operationName)
+	verifier.Assert(verifier.GetAttribute(span.Attributes, "graphql.operation.type").AsString() == "query", "Expect graphql.operation.type query")
+	verifier.Assert((span.Status.Code == codes.Error) == failed, "Unexpected status %v of span %s", span.Status, name)
+}
+
+func verifyResolver(span tracetest.SpanStub, name, field string, failed bool) {
+	verifier.Assert(span.Name == name, "Expect span name %s, got %s", name, span.Name)
+	verifier.Assert(verifier.GetAttribute(span.Attributes, "graphql.field.name").AsString() == field, "Expect graphql.field.name %s", field)
+	verifier.Assert(verifier.GetAttribute(span.Attributes, "graphql.field.path").AsString() == field, "Expect graphql.field.path %s", field)
+	verifier.Assert(verifier.GetAttribute(span.Attributes, "graphql.field.object").AsString() == "Query", "Expect graphql.field.object Query")
+	verifier.Assert((span.Status.Code == codes.Error) == failed, "Unexpected status %v of span %s", span.Status, name)
+}
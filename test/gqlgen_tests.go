@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const gqlgen_dependency_name = "github.com/99designs/gqlgen"
+const gqlgen_module_name = "gqlgen"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("gqlgen-test", gqlgen_module_name, "v0.17.20", "", "1.18", "", TestGqlgen),
+		NewGeneralTestCase("gqlgen-no-resolver-spans-test", gqlgen_module_name, "v0.17.20", "", "1.18", "", TestGqlgenNoResolverSpans),
+		NewMuzzleTestCase("gqlgen-muzzle-test", gqlgen_dependency_name, gqlgen_module_name, "v0.17.20", "", "1.18", "", []string{"go", "build", "test_gqlgen.go", "schema.go"}),
+		NewLatestDepthTestCase("gqlgen-latestdepth-test", gqlgen_dependency_name, gqlgen_module_name, "v0.17.20", "", "1.18", "", TestGqlgen),
+	)
+}
+
+func TestGqlgen(t *testing.T, env ...string) {
+	UseApp("gqlgen/v0.17.20")
+	RunGoBuild(t, "go", "build", "test_gqlgen.go", "schema.go")
+	RunApp(t, "test_gqlgen", env...)
+}
+
+func TestGqlgenNoResolverSpans(t *testing.T, env ...string) {
+	UseApp("gqlgen/v0.17.20")
+	RunGoBuild(t, "go", "build", "test_gqlgen.go", "schema.go")
+	env = append(env, "OTEL_INSTRUMENTATION_GQLGEN_RESOLVER_SPANS_ENABLED=false")
+	RunApp(t, "test_gqlgen", env...)
+}
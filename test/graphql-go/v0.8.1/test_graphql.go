@@ -0,0 +1,120 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var schema graphql.Schema
+
+func setupServer() {
+	var err error
+	schema, err = graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"hello": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "world", nil
+					},
+				},
+				"fail": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return nil, errors.New("resolver failed")
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		panic(err)
+	}
+	http.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query         string `json:"query"`
+			OperationName string `json:"operationName"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: body.Query,
+			OperationName: body.OperationName,
+			Context:       r.Context(),
+		})
+		_ = json.NewEncoder(w).Encode(result)
+	})
+	go http.ListenAndServe("127.0.0.1:8080", nil)
+	time.Sleep(3 * time.Second)
+}
+
+func query(q, operationName string) {
+	body, err := json.Marshal(map[string]string{"query": q, "operationName": operationName})
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.Post("http://127.0.0.1:8080/graphql", "application/json", bytes.NewReader(body))
+	if err != nil {
+		panic(err)
+	}
+	resp.Body.Close()
+}
+
+func main() {
+	setupServer()
+	query(`query Hello { hello } query Fail { fail }`, "Hello")
+	query(`query Hello { hello } query Fail { fail }`, "Fail")
+	query(`{ missing }`, "")
+	// Documents parsed by the application are executed directly
+	document, err := parser.Parse(parser.ParseParams{Source: `{ hello }`})
+	if err != nil {
+		panic(err)
+	}
+	graphql.Execute(graphql.ExecuteParams{Schema: schema, AST: document, Context: context.Background()})
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for i := 0; i < 3; i++ {
+			verifier.Assert(len(stubs[i]) == 3, "Expect 3 spans, got %d", len(stubs[i]))
+			verifier.Assert(stubs[i][2].Parent.SpanID() == stubs[i][1].SpanContext.SpanID(), "Expect the operation span to be the child of the http server span")
+		}
+		verifyOperation(stubs[0][2], "query Hello", "Hello", "query", false)
+		verifyOperation(stubs[1][2], "query Fail", "Fail", "query", true)
+		verifyOperation(stubs[2][2], "GraphQL Operation", "", "", true)
+		verifier.Assert(len(stubs[3]) == 1, "Expect 1 span, got %d", len(stubs[3]))
+		verifyOperation(stubs[3][0], "query", "", "query", false)
+	}, 4)
+}
+
+func verifyOperation(span tracetest.SpanStub, name, operationName, operationType string, failed bool) {
+	verifier.Assert(span.Name == name, "Expect span name %s, got %s", name, span.Name)
+	verifier.Assert(verifier.GetAttribute(span.Attributes, "graphql.operation.name").AsString() == operationName, "Expect graphql.operation.name %s", operationName)
+	verifier.Assert(verifier.GetAttribute(span.Attributes, "graphql.operation.type").AsString() == operationType, "Expect graphql.operation.type %s", operationType)
+	verifier.Assert((span.Status.Code == codes.Error) == failed, "Unexpected status %v of span %s", span.Status, name)
+}
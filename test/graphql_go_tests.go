@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const graphql_go_dependency_name = "github.com/graphql-go/graphql"
+const graphql_go_module_name = "graphql-go"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("graphql-go-test", graphql_go_module_name, "v0.8.1", "", "1.18", "", TestGraphqlGo),
+		NewMuzzleTestCase("graphql-go-muzzle-test", graphql_go_dependency_name, graphql_go_module_name, "v0.8.1", "", "1.18", "", []string{"go", "build", "test_graphql.go"}),
+		NewLatestDepthTestCase("graphql-go-latestdepth-test", graphql_go_dependency_name, graphql_go_module_name, "v0.8.1", "", "1.18", "", TestGraphqlGo),
+	)
+}
+
+func TestGraphqlGo(t *testing.T, env ...string) {
+	UseApp("graphql-go/v0.8.1")
+	RunGoBuild(t, "go", "build", "test_graphql.go")
+	RunApp(t, "test_graphql", env...)
+}
@@ -30,8 +30,27 @@ func main() {
 	sendStreamReq(context.Background())
 	// verify trace
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
-		// filter out client
-		verifier.Assert(len(stubs[0]) == 1, "Except client grpc system to be 1, got %d", len(stubs))
-		verifier.Assert(stubs[0][0].SpanKind == trace.SpanKindServer, "Except client grpc system to be server, got %v", stubs[0][0].SpanKind)
+		verifier.Assert(len(stubs[0]) == 2, "Except a client span and a server span for the stream, got %d", len(stubs[0]))
+		var clientSpan, serverSpan tracetest.SpanStub
+		for _, span := range stubs[0] {
+			switch span.SpanKind {
+			case trace.SpanKindClient:
+				clientSpan = span
+			case trace.SpanKindServer:
+				serverSpan = span
+			}
+		}
+		verifier.Assert(serverSpan.Parent.SpanID() == clientSpan.SpanContext.SpanID(), "Except the server span to be the child of the client span")
+		// the client sends the request and receives two responses
+		verifier.Assert(len(clientSpan.Events) == 3, "Except 3 message events of the client, got %d", len(clientSpan.Events))
+		verifier.Assert(len(serverSpan.Events) == 3, "Except 3 message events of the server, got %d", len(serverSpan.Events))
+		received := verifier.GetAttribute(clientSpan.Attributes, "rpc.grpc.messages.received").AsInt64()
+		verifier.Assert(received == 2, "Except 2 messages received by the client, got %d", received)
+		sent := verifier.GetAttribute(serverSpan.Attributes, "rpc.grpc.messages.sent").AsInt64()
+		verifier.Assert(sent == 2, "Except 2 messages sent by the server, got %d", sent)
+		serverStream := verifier.GetAttribute(serverSpan.Attributes, "rpc.grpc.server_stream").AsBool()
+		verifier.Assert(serverStream, "Except the rpc to be a server stream")
+		code := verifier.GetAttribute(clientSpan.Attributes, "rpc.grpc.status_code").AsInt64()
+		verifier.Assert(code == 0, "Except status code 0, got %d", code)
 	}, 1)
 }
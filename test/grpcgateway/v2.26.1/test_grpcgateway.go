@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	route     = "/v1/health/{service}"
+	rpcMethod = "/grpc.health.v1.Health/Check"
+)
+
+// The path pattern of "/v1/health/{service}" as compiled by protoc-gen-grpc-gateway
+var patternHealthCheck = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "health", "service"}, ""))
+
+func startGrpcServer() string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	s := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("greeter", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+	go s.Serve(lis)
+	return lis.Addr().String()
+}
+
+// registerHealthCheck registers the handler the way the generated
+// RegisterHealthHandlerClient does
+func registerHealthCheck(mux *runtime.ServeMux, client healthpb.HealthClient) {
+	mux.Handle(http.MethodGet, patternHealthCheck, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, rpcMethod, runtime.WithHTTPPathPattern(route))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		var md runtime.ServerMetadata
+		resp, err := client.Check(annotatedContext, &healthpb.HealthCheckRequest{Service: pathParams["service"]}, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		runtime.ForwardResponseMessage(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+}
+
+func main() {
+	conn, err := grpc.NewClient(startGrpcServer(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	mux := runtime.NewServeMux()
+	registerHealthCheck(mux, healthpb.NewHealthClient(conn))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go http.Serve(lis, mux)
+
+	for _, service := range []string{"greeter", "unknown"} {
+		resp, err := http.Get("http://" + lis.Addr().String() + "/v1/health/" + service)
+		if err != nil {
+			panic(err)
+		}
+		resp.Body.Close()
+		expected := http.StatusOK
+		if service == "unknown" {
+			expected = http.StatusNotFound
+		}
+		verifier.Assert(resp.StatusCode == expected, "Expect status %d for %s, got %d", expected, service, resp.StatusCode)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for _, stub := range stubs {
+			server := findSpan(stub, func(span tracetest.SpanStub) bool { return span.SpanKind == trace.SpanKindServer && span.Name == route })
+			verifier.Assert(server != nil, "Expect the server span to be named by the route %s", route)
+			httpRoute := verifier.GetAttribute(server.Attributes, "http.route").AsString()
+			verifier.Assert(httpRoute == route, "Expect http.route to be %s, got %s", route, httpRoute)
+
+			gateway := findSpan(stub, func(span tracetest.SpanStub) bool { return span.Name == "grpc-gateway "+rpcMethod })
+			verifier.Assert(gateway != nil, "Expect the gateway span of %s", rpcMethod)
+			verifier.Assert(gateway.Parent.SpanID() == server.SpanContext.SpanID(), "Expect the gateway span to be a child of the server span")
+			verifier.Assert(gateway.SpanKind == trace.SpanKindInternal, "Expect the gateway span to be internal, got %d", gateway.SpanKind)
+			rpcService := verifier.GetAttribute(gateway.Attributes, "rpc.service").AsString()
+			verifier.Assert(rpcService == "grpc.health.v1.Health", "Expect rpc.service to be grpc.health.v1.Health, got %s", rpcService)
+			gatewayRoute := verifier.GetAttribute(gateway.Attributes, "http.route").AsString()
+			verifier.Assert(gatewayRoute == route, "Expect http.route of the gateway span to be %s, got %s", route, gatewayRoute)
+
+			client := findSpan(stub, func(span tracetest.SpanStub) bool {
+				return span.SpanKind == trace.SpanKindClient && span.Parent.SpanID() == gateway.SpanContext.SpanID()
+			})
+			verifier.Assert(client != nil, "Expect the gRPC client span to be a child of the gateway span")
+
+			if verifier.GetAttribute(server.Attributes, "http.response.status_code").AsInt64() == http.StatusNotFound {
+				verifier.Assert(gateway.Status.Code == codes.Error, "Expect the gateway span of the unknown service to fail")
+			} else {
+				verifier.Assert(gateway.Status.Code != codes.Error, "Expect the gateway span of the greeter service to succeed")
+			}
+		}
+	}, 2)
+}
+
+func findSpan(stub tracetest.SpanStubs, match func(span tracetest.SpanStub) bool) *tracetest.SpanStub {
+	for i := range stub {
+		if match(stub[i]) {
+			return &stub[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const grpcgateway_dependency_name = "github.com/grpc-ecosystem/grpc-gateway/v2"
+const grpcgateway_module_name = "grpcgateway"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("grpcgateway-2.26.1-test", grpcgateway_module_name, "v2.15.0", "v2.29.0", "1.22", "", TestGrpcGateway),
+		NewMuzzleTestCase("grpcgateway-muzzle-test", grpcgateway_dependency_name, grpcgateway_module_name, "v2.15.0", "v2.29.0", "1.22", "", []string{"go", "build", "test_grpcgateway.go"}),
+		NewLatestDepthTestCase("grpcgateway-latestdepth-test", grpcgateway_dependency_name, grpcgateway_module_name, "v2.15.0", "v2.29.0", "1.22", "", TestGrpcGateway),
+	)
+}
+
+func TestGrpcGateway(t *testing.T, env ...string) {
+	UseApp("grpcgateway/v2.26.1")
+	RunGoBuild(t, "go", "build", "test_grpcgateway.go")
+	RunApp(t, "test_grpcgateway", env...)
+}
@@ -15,11 +15,21 @@
 package test
 
 import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
 )
 
 const HelloworldAppName = "helloworld"
@@ -58,6 +68,46 @@ func TestRunHelloworld(t *testing.T) {
 	}
 }
 
+func TestRunHelloworldVersionRange(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// golang.org/x/time is pinned to v0.11.0, the most specific version of
+	// rule "rate.every" should be chosen
+	RunSet(t, UseTestRules("test_version.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "VERSION-SPECIFIC")
+	ExpectContains(t, stderr, "VERSION-WILDCARD")
+	ExpectNotContains(t, stderr, "VERSION-GENERAL")
+	ExpectNotContains(t, stderr, "VERSION-MISMATCH")
+	ExpectDebugLogContains(t, "Resolve rule rate.every with version v0.11.0")
+}
+
+func TestRunHelloworldConflict(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Duplicated rules are merged, distinct hooks are all applied
+	RunSet(t, UseTestRules("test_conflict.json"), "-conflict=merge")
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "CONFLICT-HIGH")
+	if cnt := strings.Count(stderr, "CONFLICT-LOW"); cnt != 1 {
+		t.Fatalf("expecting CONFLICT-LOW once, but got %d", cnt)
+	}
+	ExpectDebugLogContains(t, "Merge duplicated rule")
+
+	// The rule with the highest priority wins
+	RunSet(t, "-conflict=first-wins")
+	RunGoBuild(t, "go", "build")
+	_, stderr = RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "CONFLICT-HIGH")
+	ExpectNotContains(t, stderr, "CONFLICT-LOW")
+
+	// Duplicated rules fail the build by default
+	RunSet(t, "-conflict=error")
+	RunGoBuildFallible(t, "go", "build")
+}
+
 func runModVendor(t *testing.T) {
 	_ = os.RemoveAll("vendor")
 	cmd := exec.Command("go", "mod", "tidy")
@@ -100,3 +150,428 @@ func TestBuildHelloworldWithVendor4(t *testing.T) {
 	ExpectDebugLogNotContains(t, "run go mod vendor")
 	ExpectDebugLogNotContains(t, "Bad match")
 }
+
+func TestBuildHelloworldPruneUnusedRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+	RunGoBuild(t, "go", "build")
+	// grpc is only introduced by the otlp exporter, not by the project itself
+	ExpectDebugLogContains(t, "Prune rule bundle google.golang.org/grpc")
+	ExpectDebugLogNotContains(t, "Prune rule bundle net/http")
+}
+
+func TestRunHelloworldInvalidRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Invalid rule files are rejected by otel set with the exact position
+	RunSetFallible(t, UseTestRules("invalid_rule.json"))
+	ExpectStdoutContains(t, "invalid_rule.json:5:5: unknown field \"OnEnterr\"")
+
+	// Nonexistent hook functions are reported once the rules are matched
+	RunSet(t, UseTestRules("invalid_hook.json"))
+	RunGoBuildFallible(t, "go", "build")
+	ExpectStderrContains(t,
+		"invalid_hook.json:2:3: hook function OnEnterNoSuchHook not found")
+}
+
+func TestRunHelloworldGlobRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	RunSet(t, UseTestRules("test_glob.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "GLOB")
+	ExpectNotContains(t, stderr, "BADGLOB")
+}
+
+func TestRunHelloworldGoVersionRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Rules that do not support the toolchain are skipped rather than
+	// breaking the compilation
+	RunSet(t, UseTestRules("test_goversion.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "MODERNGO")
+	ExpectDebugLogContains(t, "requires go version >= 999.0")
+	ExpectDebugLogContains(t, "requires go version <= go1.0")
+	report := filepath.Join(util.TempBuildDir, util.PPreprocess,
+		resource.SkippedRulesJsonFile)
+	ExpectContains(t, readLog(t, report), "notYetInStdlib")
+}
+
+func TestRunHelloworldModuleConditions(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Rules apply only if other modules are, or are not, used by the project
+	RunSet(t, UseTestRules("test_modules.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "WITH_TIME")
+	ExpectContains(t, stderr, "WITHOUT_LOGRUS")
+	ExpectNotContains(t, stderr, "WITH_OLD_TIME")
+	ExpectNotContains(t, stderr, "WITHOUT_TIME")
+	ExpectDebugLogContains(t, "module golang.org/x/time is in the build")
+	report := filepath.Join(util.TempBuildDir, util.PPreprocess,
+		resource.SkippedRulesJsonFile)
+	ExpectContains(t, readLog(t, report), "golang.org/x/time@[0.1.0,0.5.0) is not in the build")
+}
+
+func TestRunHelloworldManifest(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	RunSet(t, UseTestRules("signed_rule.json"))
+	RunGoBuild(t, "go", "build")
+	RunVerify(t, HelloworldAppName)
+	ExpectStdoutContains(t, "helloworld is instrumented by otel")
+	ExpectStdoutContains(t, "fmt.Printf")
+	RunVerify(t, "-json", HelloworldAppName)
+	ExpectStdoutContains(t, `"target": "fmt.Printf"`)
+}
+
+func TestRunHelloworldSimulate(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Rules are matched against go.mod without building, the outcome serves
+	// as the baseline of the coverage of instrumentation
+	RunSet(t, UseTestRules("test_modules.json"))
+	RunSimulate(t)
+	ExpectStdoutContains(t, "fmt.Printf")
+	ExpectStdoutContains(t, "module golang.org/x/time@[0.1.0,0.5.0) is not in the build")
+	RunSimulate(t, "-json")
+	baseline := filepath.Join(t.TempDir(), "baseline.json")
+	err := os.WriteFile(baseline, []byte(readStdoutLog(t)), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	RunSimulate(t, "-baseline="+baseline)
+
+	// Downgrading the module drops the rule that requires a newer one
+	content, err := os.ReadFile("go.mod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gomod := filepath.Join(t.TempDir(), "go.mod")
+	downgraded := strings.Replace(string(content),
+		"golang.org/x/time v0.11.0", "golang.org/x/time v0.4.0", 1)
+	err = os.WriteFile(gomod, []byte(downgraded), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	RunSimulateFallible(t, "-baseline="+baseline, gomod)
+	ExpectStderrContains(t, "rules are no longer applied")
+	ExpectStderrContains(t, "fmt.Printf: module golang.org/x/time@[0.5.0,) is not in the build")
+}
+
+func TestRunHelloworldTelemetry(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Only the rules applied to the project are documented by default
+	RunSet(t, "-rule=")
+	RunTelemetry(t)
+	ExpectStdoutContains(t, "# Telemetry")
+	ExpectStdoutContains(t, "## http.clientOnEnter")
+	ExpectStdoutContains(t, "`http.client.request.duration`")
+	ExpectStdoutContains(t, "Span kind: `client`")
+
+	// All available rules are documented regardless of the project
+	RunTelemetry(t, "-all", "-json")
+	ExpectStdoutContains(t, `"rule": "gotls.handshakeContextOnEnter"`)
+	ExpectStdoutContains(t, `"tls.protocol.version"`)
+}
+
+func TestRunHelloworldMirror(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Populate the mirror with the rule pack published by the origin
+	data := filepath.Join(filepath.Dir(pwd), "tool", "data")
+	origin := httptest.NewServer(http.FileServer(http.Dir(data)))
+	// Rule packs from the mirror are pinned as there are no trusted keys
+	content, err := os.ReadFile(filepath.Join(data, "test_fmt.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(content)
+	ref := origin.URL + "/test_fmt.json#sha256:" + hex.EncodeToString(sum[:])
+	RunSet(t, "-rule="+ref)
+	dir := t.TempDir()
+	RunMirror(t, dir)
+	ExpectStdoutContains(t, "Mirrored rule pack "+ref)
+	origin.Close()
+
+	// The rule pack is fetched from the mirror only once the origin is gone
+	mirror := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			if !ok || user != "otel" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			http.FileServer(http.Dir(dir)).ServeHTTP(w, r)
+		}))
+	defer mirror.Close()
+	host := strings.TrimPrefix(mirror.URL, "http://")
+	RunGoBuildWithEnv(t, []string{"OTELTOOL_MIRROR=http://otel:secret@" + host},
+		"go", "build")
+	ExpectDebugLogContains(t, "from mirror")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "Entering hook1")
+	RunSet(t, "-rule=")
+}
+
+func TestRunHelloworldSignedRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+	key := filepath.Join(filepath.Dir(pwd), "tool", "data", "signed_rule.pub")
+
+	// Unsigned rule files are rejected once trusted keys are configured
+	RunSetFallible(t, "-trustedkeys="+key, UseTestRules("test_fmt.json"))
+	ExpectStdoutContains(t, "test_fmt.json is not signed")
+
+	RunSet(t, "-trustedkeys="+key, UseTestRules("signed_rule.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "SIGNED")
+	ExpectDebugLogContains(t, "Verified signature of")
+	RunSet(t, "-trustedkeys=")
+}
+
+func TestRunHelloworldLocalRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Rules in the .otel/rules directory of the module are picked up without
+	// specifying them explicitly
+	dir := filepath.Join(pwd, HelloworldAppName, ".otel", "rules")
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(pwd), "tool",
+		"data", "test_local.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(dir)) })
+	err = os.WriteFile(filepath.Join(dir, "local.json"), content, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	RunSet(t, "-rule=")
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "LOCAL")
+	ExpectDebugLogContains(t, "Discovered rule file")
+}
+
+func TestRunHelloworldRequires(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// The hook imports a module that neither the project nor the hook module
+	// requires, it is added by the rule, while the project already requires
+	// a higher version of the other one
+	RunSet(t, UseTestRules("test_requires.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "REQUIRES")
+	ExpectDebugLogContains(t, "Require github.com/pkg/errors v0.9.1")
+	ExpectDebugLogContains(t, "Keep golang.org/x/time v0.11.0 required by go.mod")
+}
+
+func TestRunHelloworldDeprecatedRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Deprecated rules are still applied, but the user is told how to migrate
+	RunSet(t, UseTestRules("test_deprecated.json"))
+	RunGoBuild(t, "go", "build")
+	ExpectStderrContains(t, "rule rate.everyOnEnter for golang.org/x/time/rate"+
+		" v0.11.0 is deprecated: rate limiter instrumentation is superseded,"+
+		" upgrade to v0.12.0 or switch to rule rate.limiterOnEnter,"+
+		" it will be removed in otel v0.10.0")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "DEPRECATED")
+	report := filepath.Join(util.TempBuildDir, util.PPreprocess,
+		resource.DeprecatedRulesJsonFile)
+	ExpectContains(t, readLog(t, report), `"ReplacedBy": "rate.limiterOnEnter"`)
+}
+
+func TestRunHelloworldCompositeRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Members share the version range of the composite rule unless they
+	// override it, and their import paths are relative to the composite one
+	RunSet(t, UseTestRules("test_composite.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "COMPOSITE")
+	ExpectNotContains(t, stderr, "OVERRIDDEN")
+}
+
+func TestRunHelloworldExtendRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// The derived rule replaces its base, while the one pinned to another
+	// version range leaves it in place for other versions
+	RunSet(t, UseTestRules("test_extends.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "EXTENDED")
+	ExpectNotContains(t, stderr, "BASE")
+	ExpectNotContains(t, stderr, "PINNED")
+}
+
+func TestRunHelloworldSuppressRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Suppression takes precedence over the rules regardless of their order,
+	// either for the whole package tree or for single functions
+	RunSet(t, UseTestRules("test_suppress.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "RATE_KEPT")
+	ExpectNotContains(t, stderr, "RATE_SUPPRESSED")
+	// Printf is suppressed while Fprintf called by it is not
+	if strings.Count(stderr, "FMT_PRINT") != 1 {
+		t.Fatalf("expect FMT_PRINT once, got %s", stderr)
+	}
+	report := readLog(t, filepath.Join(util.TempBuildDir, util.PPreprocess,
+		resource.SkippedRulesJsonFile))
+	ExpectContains(t, report, "rate.every")
+	ExpectDebugLogContains(t, "for Printf by")
+}
+
+func TestRunHelloworldOverrideRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+	fork := filepath.Join(filepath.Dir(pwd), "pkg", "rules", "test", "fmt1fork")
+
+	// The hook code of the rule is replaced by the local fork, while other
+	// rules are kept as they are
+	RunSet(t, UseTestRules("test_override.json"), "-overriderules=fmt1.printf="+fork)
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "Entering forked hook1....")
+	ExpectContains(t, stderr, "Exiting forked hook1....")
+	ExpectNotContains(t, stderr, "Entering hook1....")
+	ExpectContains(t, stderr, "KEPT")
+
+	RunSet(t, "-overriderules=nonexist="+fork)
+	RunGoBuildFallible(t, "go", "build")
+	ExpectStderrContains(t, "no rule named nonexist to override")
+	RunSet(t, "-overriderules=")
+}
+
+// publishPkg publishes a version of the pkg module holding the given hook
+// packages to a file GOPROXY, and returns the GOPROXY
+func publishPkg(t *testing.T, version string, hooks map[string]string) string {
+	const pkgModule = "github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg"
+	proxy := t.TempDir()
+	dir := filepath.Join(proxy, pkgModule, "@v")
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gomod := "module " + pkgModule + "\n\ngo 1.23.0\n"
+	files := map[string]string{
+		"list":            version + "\n",
+		version + ".info": `{"Version":"` + version + `"}`,
+		version + ".mod":  gomod,
+	}
+	for name, content := range files {
+		err = os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	prefix := pkgModule + "@" + version + "/"
+	entries := map[string][]byte{"go.mod": []byte(gomod)}
+	for target, src := range hooks {
+		goFiles, err := filepath.Glob(filepath.Join(src, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range goFiles {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			entries[target+"/"+filepath.Base(file)] = content
+		}
+	}
+	for name, content := range entries {
+		w, err := zw.Create(prefix + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.Write(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = zw.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(dir, version+".zip"), buf.Bytes(), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "file://" + filepath.ToSlash(proxy)
+}
+
+func TestRunHelloworldPinRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// The hook code of the rule is taken from the pinned version of the pkg
+	// module, while other rules are kept as they are
+	fork := filepath.Join(filepath.Dir(pwd), "pkg", "rules", "test", "fmt1fork")
+	proxy := publishPkg(t, "v0.0.1", map[string]string{"rules/test/fmt1": fork})
+	env := []string{"GOPROXY=" + proxy, "GONOSUMDB=github.com/alibaba"}
+	RunSet(t, UseTestRules("test_override.json"), "-pinrules=fmt1.printf=v0.0.1")
+	RunGoBuildWithEnv(t, env, "go", "build")
+	ExpectDebugLogContains(t, "Pin hook code of rule fmt1.printf to v0.0.1")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "Entering forked hook1....")
+	ExpectNotContains(t, stderr, "Entering hook1....")
+	ExpectContains(t, stderr, "KEPT")
+
+	// Versions that are not published can not be pinned
+	RunSet(t, "-pinrules=fmt1.printf=v0.0.2")
+	RunGoBuildFallible(t, "go", "build")
+	ExpectStderrContains(t, "pkg@v0.0.2")
+	RunSet(t, "-pinrules=nonexist=v0.0.1")
+	RunGoBuildFallible(t, "go", "build")
+	ExpectStderrContains(t, "no rule named nonexist to pin")
+	RunSet(t, "-pinrules=")
+}
+
+func TestRunHelloworldRawInjection(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Snippets are spliced before the matched statement and before every
+	// return, math is imported by another name along with the existing fmt
+	RunSet(t, UseTestRules("test_rawinject.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "INJECT_BEFORE 2")
+	ExpectContains(t, stderr, "INJECT_RETURN")
+
+	// Packages that are not dependencies of the target can not be imported
+	RunSet(t, UseTestRules("test_rawinject_dep.json"))
+	RunGoBuildFallible(t, "go", "build")
+	ExpectDebugLogContains(t, "package strconv imported by rule")
+}
+
+func TestRunHelloworldInitRules(t *testing.T) {
+	UseApp(HelloworldAppName)
+
+	// Init functions are generated into distinct files even if the rules ask
+	// for the same file name, and rules whose build constraints are not
+	// satisfied are skipped
+	RunSet(t, UseTestRules("test_init.json"))
+	RunGoBuild(t, "go", "build")
+	_, stderr := RunApp(t, HelloworldAppName)
+	ExpectContains(t, stderr, "INIT_A 1")
+	ExpectContains(t, stderr, "INIT_B")
+	ExpectNotContains(t, stderr, "INIT_SKIPPED")
+	ExpectDebugLogContains(t, "otel_init_register_1.go")
+	ExpectDebugLogContains(t, "build constraint windows && !cgo is not satisfied")
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/client"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupWithNestedCall() {
+	h := server.Default()
+	h.GET("/ping", func(ctx context.Context, c *app.RequestContext) {
+		c.JSON(consts.StatusOK, utils.H{"message": "pong"})
+	})
+	h.GET("/proxy", func(ctx context.Context, c *app.RequestContext) {
+		cli, err := client.NewClient()
+		if err != nil {
+			panic(err)
+		}
+		status, body, err := cli.Get(ctx, nil, "http://127.0.0.1:8888/ping")
+		if err != nil {
+			panic(err)
+		}
+		c.Data(status, consts.MIMEApplicationJSONUTF8, body)
+	})
+	h.Spin()
+}
+
+func main() {
+	go setupWithNestedCall()
+	time.Sleep(5 * time.Second)
+	c, err := client.NewClient()
+	if err != nil {
+		panic(err)
+	}
+	_, _, err = c.Get(context.Background(), nil, "http://127.0.0.1:8888/proxy")
+	if err != nil {
+		panic(err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpClientAttributes(stubs[0][0], "GET", "GET", "http://127.0.0.1:8888/proxy", "http", "", "tcp", "ipv4", "", "127.0.0.1:8888", 200, 0, 8888)
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "GET /proxy", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8888", "Host", "http", "/proxy", "", "/proxy", 200)
+		verifier.VerifyHttpClientAttributes(stubs[0][2], "GET", "GET", "http://127.0.0.1:8888/ping", "http", "", "tcp", "ipv4", "", "127.0.0.1:8888", 200, 0, 8888)
+		verifier.VerifyHttpServerAttributes(stubs[0][3], "GET /ping", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8888", "Host", "http", "/ping", "", "/ping", 200)
+		for i := 1; i < 4; i++ {
+			verifier.Assert(stubs[0][i].Parent.SpanID() == stubs[0][i-1].SpanContext.SpanID(), "Expect span %d to be the child of span %d", i, i-1)
+		}
+	}, 1)
+}
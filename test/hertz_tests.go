@@ -24,6 +24,7 @@ func init() {
 		NewGeneralTestCase("hertz-090-basic-test-with-hook", hertz_module_name, "v0.9.0", "", "1.18", "1.22", TestBasicHertzWithHook),
 		NewGeneralTestCase("hertz-090-basic-test-with-exception", hertz_module_name, "v0.9.0", "", "1.18", "1.22", TestBasicHertzWithException),
 		NewGeneralTestCase("hertz-090-basic-test-with-regex", hertz_module_name, "v0.9.0", "", "1.18", "1.22", TestBasicHertzWithRegex),
+		NewGeneralTestCase("hertz-090-nested-test", hertz_module_name, "v0.9.0", "", "1.18", "1.22", TestNestedHertz),
 		NewLatestDepthTestCase("hertz-090-basic-test-latestdepth", hertz_dependency_name, hertz_module_name, "v0.9.0", "", "1.18", "", TestBasicHertz),
 		NewMuzzleTestCase("hertz-090-basic-muzzle", hertz_dependency_name, hertz_module_name, "v0.9.0", "v0.9.1", "1.18", "1.22.9", []string{"go", "build", "test_hertz_basic.go", "basic_func.go"}),
 		NewMuzzleTestCase("hertz-090-basic-muzzle-high", hertz_dependency_name, hertz_module_name, "v0.9.1", "", "1.18", "", []string{"go", "build", "test_hertz_basic.go", "basic_func.go"}))
@@ -52,3 +53,9 @@ func TestBasicHertzWithRegex(t *testing.T, env ...string) {
 	RunGoBuild(t, "go", "build", "test_hertz_with_regex.go", "basic_func.go")
 	RunApp(t, "test_hertz_with_regex", env...)
 }
+
+func TestNestedHertz(t *testing.T, env ...string) {
+	UseApp("hertz/v0.9.0")
+	RunGoBuild(t, "go", "build", "test_hertz_nested.go")
+	RunApp(t, "test_hertz_nested", env...)
+}
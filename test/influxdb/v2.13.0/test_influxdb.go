@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const queryResponse = `#datatype,string,long,dateTime:RFC3339,double,string,string
+#group,false,false,false,false,true,true
+#default,_result,,,,,
+,result,table,_time,_value,_field,_measurement
+,,0,2024-01-01T00:00:00Z,0.5,usage,cpu
+`
+
+// fakeInfluxDB accepts every write and answers every query with one record
+func fakeInfluxDB() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		switch r.URL.Path {
+		case "/api/v2/write":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/query":
+			w.Header().Set("Content-Type", "text/csv")
+			io.WriteString(w, queryResponse)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func main() {
+	server := fakeInfluxDB()
+	defer server.Close()
+	client := influxdb2.NewClient(server.URL, "token")
+	ctx := context.Background()
+
+	blocking := client.WriteAPIBlocking("my-org", "metrics")
+	err := blocking.WritePoint(ctx,
+		influxdb2.NewPoint("cpu", map[string]string{"host": "a"}, map[string]interface{}{"usage": 0.5}, time.Now()),
+		influxdb2.NewPoint("cpu", map[string]string{"host": "b"}, map[string]interface{}{"usage": 0.7}, time.Now()))
+	if err != nil {
+		panic(err)
+	}
+
+	async := client.WriteAPI("my-org", "metrics")
+	for _, host := range []string{"a", "b", "c"} {
+		async.WriteRecord("mem,host=" + host + " used=42i")
+	}
+	async.Flush()
+
+	result, err := client.QueryAPI("my-org").Query(ctx, `from(bucket: "metrics") |> range(start: -1h)`)
+	if err != nil {
+		panic(err)
+	}
+	records := 0
+	for result.Next() {
+		records++
+	}
+	verifier.Assert(result.Err() == nil && records == 1, "Expect one record to be queried, got %d and %v", records, result.Err())
+	client.Close()
+
+	addr := strings.TrimPrefix(server.URL, "http://")
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for i, points := range []int64{2, 3} {
+			write := stubs[i][0]
+			verifier.VerifyDbAttributes(write, "write metrics", "influxdb", addr, "", "write", "metrics", nil)
+			batch := verifier.GetAttribute(write.Attributes, "db.operation.batch.size").AsInt64()
+			verifier.Assert(batch == points, "Expect %d points to be written, got %d", points, batch)
+			org := verifier.GetAttribute(write.Attributes, "influxdb.org").AsString()
+			verifier.Assert(org == "my-org", "Expect influxdb.org to be my-org, got %s", org)
+		}
+		query := stubs[2][0]
+		verifier.VerifyDbAttributes(query, "query metrics", "influxdb", addr, `from(bucket: "metrics")`, "query", "metrics", nil)
+		bucket := verifier.GetAttribute(query.Attributes, "influxdb.bucket").AsString()
+		verifier.Assert(bucket == "metrics", "Expect influxdb.bucket to be metrics, got %s", bucket)
+	}, 3)
+}
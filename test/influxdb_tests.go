@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const influxdb_dependency_name = "github.com/influxdata/influxdb-client-go/v2"
+const influxdb_module_name = "influxdb"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("influxdb-test", influxdb_module_name, "v2.13.0", "v2.14.0", "1.18", "", TestInfluxdb),
+		NewMuzzleTestCase("influxdb-muzzle-test", influxdb_dependency_name, influxdb_module_name, "v2.13.0", "v2.14.0", "1.18", "", []string{"go", "build", "test_influxdb.go"}),
+		NewLatestDepthTestCase("influxdb-latestdepth-test", influxdb_dependency_name, influxdb_module_name, "v2.13.0", "v2.14.0", "1.18", "", TestInfluxdb),
+	)
+}
+
+func TestInfluxdb(t *testing.T, env ...string) {
+	UseApp("influxdb/v2.13.0")
+	RunGoBuild(t, "go", "build", "test_influxdb.go")
+	RunApp(t, "test_influxdb", env...)
+}
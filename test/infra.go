@@ -97,6 +97,56 @@ func RunVersion(t *testing.T) {
 	}
 }
 
+func RunVerify(t *testing.T, args ...string) {
+	util.Assert(pwd != "", "pwd is empty")
+	path := filepath.Join(filepath.Dir(pwd), getExecName())
+	cmd := runCmd(append([]string{path, "verify"}, args...))
+	err := cmd.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func RunSimulate(t *testing.T, args ...string) {
+	util.Assert(pwd != "", "pwd is empty")
+	path := filepath.Join(filepath.Dir(pwd), getExecName())
+	cmd := runCmd(append([]string{path, "simulate"}, args...))
+	err := cmd.Run()
+	if err != nil {
+		t.Fatal(err, readStdoutLog(t))
+	}
+}
+
+func RunSimulateFallible(t *testing.T, args ...string) {
+	util.Assert(pwd != "", "pwd is empty")
+	path := filepath.Join(filepath.Dir(pwd), getExecName())
+	cmd := runCmd(append([]string{path, "simulate"}, args...))
+	err := cmd.Run()
+	if err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
+func RunMirror(t *testing.T, args ...string) {
+	util.Assert(pwd != "", "pwd is empty")
+	path := filepath.Join(filepath.Dir(pwd), getExecName())
+	cmd := runCmd(append([]string{path, "mirror"}, args...))
+	err := cmd.Run()
+	if err != nil {
+		t.Fatal(err, readStderrLog(t))
+	}
+}
+
+func RunTelemetry(t *testing.T, args ...string) {
+	util.Assert(pwd != "", "pwd is empty")
+	path := filepath.Join(filepath.Dir(pwd), getExecName())
+	cmd := runCmd(append([]string{path, "telemetry"}, args...))
+	err := cmd.Run()
+	if err != nil {
+		t.Fatal(err, readStderrLog(t))
+	}
+}
+
 func RunSet(t *testing.T, args ...string) {
 	util.Assert(pwd != "", "pwd is empty")
 	path := filepath.Join(filepath.Dir(pwd), getExecName())
@@ -107,6 +157,16 @@ func RunSet(t *testing.T, args ...string) {
 	}
 }
 
+func RunSetFallible(t *testing.T, args ...string) {
+	util.Assert(pwd != "", "pwd is empty")
+	path := filepath.Join(filepath.Dir(pwd), getExecName())
+	cmd := runCmd(append([]string{path, "set"}, args...))
+	err := cmd.Run()
+	if err == nil {
+		t.Fatal("expected failure")
+	}
+}
+
 func RunGoBuild(t *testing.T, args ...string) {
 	util.Assert(pwd != "", "pwd is empty")
 	path := filepath.Join(filepath.Dir(pwd), getExecName())
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	iris "github.com/kataras/iris/v12"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func get(req *http.Request) string {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return string(body)
+}
+
+func setupRoute() {
+	app := iris.New()
+	app.Get("/user/{id:uint64}", func(ctx iris.Context) {
+		ctx.WriteString("user " + ctx.Params().Get("id"))
+	})
+	app.Get("/proxy/{id:uint64}", func(ctx iris.Context) {
+		req, err := http.NewRequestWithContext(ctx.Request().Context(), "GET", "http://127.0.0.1:8080/user/"+ctx.Params().Get("id"), nil)
+		if err != nil {
+			panic(err)
+		}
+		ctx.WriteString(get(req))
+	})
+	app.Run(iris.Addr(":8080"))
+}
+
+func main() {
+	go setupRoute()
+	time.Sleep(3 * time.Second)
+	req, err := http.NewRequest("GET", "http://127.0.0.1:8080/proxy/1", nil)
+	if err != nil {
+		panic(err)
+	}
+	body := get(req)
+	verifier.Assert(body == "user 1", "Expect response user 1, got %s", body)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpClientAttributes(stubs[0][0], "GET", "GET", "http://127.0.0.1:8080/proxy/1", "http", "1.1", "tcp", "ipv4", "", "127.0.0.1:8080", 200, 0, 8080)
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "/proxy/{id:uint64}", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/proxy/1", "", "/proxy/{id:uint64}", 200)
+		verifier.VerifyHttpClientAttributes(stubs[0][2], "GET", "GET", "http://127.0.0.1:8080/user/1", "http", "1.1", "tcp", "ipv4", "", "127.0.0.1:8080", 200, 0, 8080)
+		verifier.VerifyHttpServerAttributes(stubs[0][3], "/user/{id:uint64}", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/user/1", "", "/user/{id:uint64}", 200)
+		for i := 1; i < 4; i++ {
+			verifier.Assert(stubs[0][i].Parent.SpanID() == stubs[0][i-1].SpanContext.SpanID(), "Expect span %d to be the child of span %d", i, i-1)
+		}
+	}, 1)
+}
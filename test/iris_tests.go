@@ -19,6 +19,7 @@ import "testing"
 func init() {
 	TestCases = append(TestCases,
 		NewGeneralTestCase("iris-test", "iris", "", "", "1.21", "", TestIris),
+		NewGeneralTestCase("iris-route-test", "iris", "", "", "1.21", "", TestIrisRoute),
 	)
 }
 
@@ -27,3 +28,9 @@ func TestIris(t *testing.T, env ...string) {
 	RunGoBuild(t, "go", "build", "test_iris.go")
 	RunApp(t, "test_iris", env...)
 }
+
+func TestIrisRoute(t *testing.T, env ...string) {
+	UseApp("iris")
+	RunGoBuild(t, "go", "build", "test_iris_route.go")
+	RunApp(t, "test_iris_route", env...)
+}
@@ -20,6 +20,7 @@ import (
 	"kratos/v2.5.2/pkg/service"
 
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
@@ -28,6 +29,7 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService) *http.Server
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -20,6 +20,7 @@ import (
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	pb "kratos/v2.5.2/pkg/api/helloworld/v1"
@@ -37,6 +38,7 @@ func main() {
 		grpc.WithEndpoint("localhost:9000"),
 		grpc.WithMiddleware(
 			recovery.Recovery(),
+			tracing.Client(),
 		),
 	)
 	if err != nil {
@@ -51,29 +53,44 @@ func main() {
 	fmt.Printf("[grpc] SayHello %+v\n", reply)
 
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
-		protocolType := verifier.GetAttribute(stubs[0][2].Attributes, "kratos.protocol.type").AsString()
+		clientSpan := findSpan(stubs, "kratos.grpc.client")
+		operation := verifier.GetAttribute(clientSpan.Attributes, "kratos.operation").AsString()
+		if operation != "/helloworld.v1.Greeter/SayHello" {
+			panic("operation should be /helloworld.v1.Greeter/SayHello, actually got " + operation)
+		}
+		serverSpan := findSpan(stubs, "kratos.grpc.opentelemetry-kratos-server")
+		protocolType := verifier.GetAttribute(serverSpan.Attributes, "kratos.protocol.type").AsString()
 		if protocolType != "grpc" {
 			panic("protocol type should be grpc, actually got " + protocolType)
 		}
-		serviceName := verifier.GetAttribute(stubs[0][2].Attributes, "kratos.service.name").AsString()
+		serviceName := verifier.GetAttribute(serverSpan.Attributes, "kratos.service.name").AsString()
 		if serviceName != "opentelemetry-kratos-server" {
 			panic("service name should be opentelemetry-kratos-server, actually got " + serviceName)
 		}
-		serviceId := verifier.GetAttribute(stubs[0][2].Attributes, "kratos.service.id").AsString()
+		serviceId := verifier.GetAttribute(serverSpan.Attributes, "kratos.service.id").AsString()
 		if serviceId != "opentelemetry-id" {
 			panic("service id should be opentelemetry-id, actually got " + serviceId)
 		}
-		serviceVersion := verifier.GetAttribute(stubs[0][2].Attributes, "kratos.service.version").AsString()
+		serviceVersion := verifier.GetAttribute(serverSpan.Attributes, "kratos.service.version").AsString()
 		if serviceVersion != "v1" {
 			panic("service version should be v1, actually got " + serviceVersion)
 		}
-		serviceMetaAgent := verifier.GetAttribute(stubs[0][2].Attributes, "kratos.service.meta.agent").AsString()
+		serviceMetaAgent := verifier.GetAttribute(serverSpan.Attributes, "kratos.service.meta.agent").AsString()
 		if serviceMetaAgent != "opentelemetry-go" {
 			panic("service meta agent should be opentelemetry-go, actually got " + serviceMetaAgent)
 		}
-		serviceEndpoint := verifier.GetAttribute(stubs[0][2].Attributes, "kratos.service.endpoint").AsStringSlice()
+		serviceEndpoint := verifier.GetAttribute(serverSpan.Attributes, "kratos.service.endpoint").AsStringSlice()
 		if !strings.Contains(serviceEndpoint[0], ":9000") || !strings.Contains(serviceEndpoint[1], ":8000") {
 			panic("service endpoint should be grpc://30.221.144.142:9000 http://30.221.144.142:8000, actually got " + fmt.Sprintf("%v", serviceEndpoint))
 		}
 	}, 1)
 }
+
+func findSpan(stubs []tracetest.SpanStubs, name string) tracetest.SpanStub {
+	for _, span := range stubs[0] {
+		if span.Name == name {
+			return span
+		}
+	}
+	panic("can not find span " + name)
+}
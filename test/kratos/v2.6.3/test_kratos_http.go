@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"net/http"
 	"strings"
 	"time"
@@ -41,6 +42,15 @@ func main() {
 			}
 			println()
 		}
+		// the tracing middleware of kratos should not start another server span
+		if len(stubs[0]) != 3 {
+			panic(fmt.Sprintf("expect 3 spans, actually got %d", len(stubs[0])))
+		}
+		for _, span := range stubs[0] {
+			if span.SpanKind == trace.SpanKindServer && span.Name != "/helloworld/{name}" {
+				panic("server span should be named by the route, actually got " + span.Name)
+			}
+		}
 		protocolType := verifier.GetAttribute(stubs[0][2].Attributes, "kratos.protocol.type").AsString()
 		if protocolType != "http" {
 			panic("protocol type should be http, actually got " + protocolType)
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2"
+	redisbackend "github.com/RichardKnop/machinery/v2/backends/redis"
+	redisbroker "github.com/RichardKnop/machinery/v2/brokers/redis"
+	"github.com/RichardKnop/machinery/v2/config"
+	eagerlock "github.com/RichardKnop/machinery/v2/locks/eager"
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const queueName = "machinery_tasks"
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	addr := []string{"localhost:" + os.Getenv("REDIS_PORT")}
+	cnf := &config.Config{DefaultQueue: queueName, ResultsExpireIn: 3600, Redis: &config.RedisConfig{}}
+	server := machinery.NewServer(cnf, redisbroker.NewGR(cnf, addr, 0), redisbackend.NewGR(cnf, addr, 0), eagerlock.New())
+
+	processed := make(chan trace.SpanContext, 1)
+	err := server.RegisterTask("add", func(ctx context.Context, a, b int64) (int64, error) {
+		processed <- trace.SpanFromContext(ctx).SpanContext()
+		return a + b, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	worker := server.NewWorker("otel-worker", 1)
+	errCh := make(chan error, 1)
+	worker.LaunchAsync(errCh)
+
+	signature := &tasks.Signature{
+		Name: "add",
+		Args: []tasks.Arg{{Type: "int64", Value: 1}, {Type: "int64", Value: 2}},
+	}
+	result, err := server.SendTaskWithContext(context.Background(), signature)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = result.Get(10 * time.Millisecond); err != nil {
+		panic(err)
+	}
+	taskSpan := <-processed
+	worker.Quit()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		publish, ok := findSpan(stubs, queueName+" publish")
+		verifier.Assert(ok, "Expect a span sending the task")
+		verifier.VerifyMQPublishAttributes(publish, "", "", "", "publish", queueName, "machinery")
+		messageID := verifier.GetAttribute(publish.Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == signature.UUID, "Expect messaging.message.id to be %s, got %s", signature.UUID, messageID)
+		name := verifier.GetAttribute(publish.Attributes, "messaging.machinery.task.name").AsString()
+		verifier.Assert(name == "add", "Expect messaging.machinery.task.name to be add, got %s", name)
+
+		process, ok := findSpan(stubs, queueName+" process")
+		verifier.Assert(ok, "Expect a span processing the task")
+		verifier.VerifyMQConsumeAttributes(process, "", "", "", "process", queueName, "machinery")
+		verifier.Assert(process.Parent.SpanID() == publish.SpanContext.SpanID(), "Expect the process span to be a child of the span sending the task")
+		verifier.Assert(taskSpan.SpanID() == process.SpanContext.SpanID(), "Expect the task function to run in the process span")
+	}, 1)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const machinery_dependency_name = "github.com/RichardKnop/machinery"
+const machinery_v2_dependency_name = "github.com/RichardKnop/machinery/v2"
+const machinery_module_name = "machinery"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("machinery-1.10.0-test", machinery_module_name, "v1.10.0", "v1.10.8", "1.18", "", TestMachineryV1),
+		NewGeneralTestCase("machinery-2.0.11-test", machinery_module_name, "v2.0.11", "v2.0.16", "1.18", "", TestMachineryV2),
+		NewMuzzleTestCase("machinery-muzzle-test", machinery_dependency_name, machinery_module_name, "v1.10.0", "v1.10.8", "1.18", "", []string{"go", "build", "test_machinery.go"}),
+		NewMuzzleTestCase("machinery-v2-muzzle-test", machinery_v2_dependency_name, machinery_module_name, "v2.0.11", "v2.0.16", "1.18", "", []string{"go", "build", "test_machinery.go"}),
+		NewLatestDepthTestCase("machinery-v2-latest-depth-test", machinery_v2_dependency_name, machinery_module_name, "v2.0.11", "v2.0.16", "1.18", "", TestMachineryV2),
+	)
+}
+
+func TestMachineryV1(t *testing.T, env ...string) {
+	_, redisPort := initRedisContainer()
+	UseApp("machinery/v1.10.0")
+	RunGoBuild(t, "go", "build", "test_machinery.go")
+	env = append(env, "REDIS_PORT="+redisPort.Port())
+	RunApp(t, "test_machinery", env...)
+}
+
+func TestMachineryV2(t *testing.T, env ...string) {
+	_, redisPort := initRedisContainer()
+	UseApp("machinery/v2.0.11")
+	RunGoBuild(t, "go", "build", "test_machinery.go")
+	env = append(env, "REDIS_PORT="+redisPort.Port())
+	RunApp(t, "test_machinery", env...)
+}
@@ -62,12 +62,18 @@ func main() {
 
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
 		// TODO: add http server as root span
-		verifier.VerifyDbAttributes(stubs[0][0], "create", "mongodb", "127.0.0.1", "create", "create", "", nil)
-		verifier.VerifyDbAttributes(stubs[1][0], "insert", "mongodb", "127.0.0.1", "insert", "insert", "", nil)
-		verifier.VerifyDbAttributes(stubs[2][0], "find", "mongodb", "127.0.0.1", "find", "find", "", nil)
-		verifier.VerifyDbAttributes(stubs[3][0], "find", "mongodb", "127.0.0.1", "find", "find", "", nil)
-		verifier.VerifyDbAttributes(stubs[4][0], "update", "mongodb", "127.0.0.1", "update", "update", "", nil)
-		verifier.VerifyDbAttributes(stubs[5][0], "delete", "mongodb", "127.0.0.1", "delete", "delete", "", nil)
+		verifier.VerifyDbAttributes(stubs[0][0], "create users", "mongodb", "127.0.0.1", "create", "create", "users", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "insert users", "mongodb", "127.0.0.1", "insert", "insert", "users", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "find users", "mongodb", "127.0.0.1", "find", "find", "users", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "find users", "mongodb", "127.0.0.1", "find", "find", "users", nil)
+		verifier.VerifyDbAttributes(stubs[4][0], "update users", "mongodb", "127.0.0.1", "update", "update", "users", nil)
+		verifier.VerifyDbAttributes(stubs[5][0], "delete users", "mongodb", "127.0.0.1", "delete", "delete", "users", nil)
+		for _, stub := range stubs {
+			namespace := verifier.GetAttribute(stub[0].Attributes, "db.namespace").AsString()
+			verifier.Assert(namespace == db, "Expect db namespace to be %s, got %s", db, namespace)
+			summary := verifier.GetAttribute(stub[0].Attributes, "db.query.summary").AsString()
+			verifier.Assert(summary == stub[0].Name, "Expect db query summary to be %s, got %s", stub[0].Name, summary)
+		}
 	}, 6)
 }
 
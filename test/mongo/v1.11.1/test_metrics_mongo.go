@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -44,7 +46,17 @@ func main() {
 		log.Printf("failed to create collection: %v", err)
 	}
 	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
-		// TODO : verifier
+		"db.client.connection.wait_time": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No db.client.connection.wait_time metrics received!")
+			}
+			point := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+			if point.DataPoints[0].Count <= 0 {
+				panic("db.client.connection.wait_time metrics count is not positive, actually " + strconv.Itoa(int(point.DataPoints[0].Count)))
+			}
+			poolName, _ := point.DataPoints[0].Attributes.Value("db.client.connection.pool.name")
+			verifier.Assert(strings.HasPrefix(poolName.AsString(), "127.0.0.1:"), "Expect pool name to be the server address, got %s", poolName.AsString())
+		},
 	})
 }
 
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupVars() {
+	r := mux.NewRouter()
+	r.HandleFunc("/{name}/countries/{country}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(mux.Vars(r)["country"]))
+	})
+	http.ListenAndServe(":8080", r)
+}
+
+// Run with OTEL_INSTRUMENTATION_MUX_CAPTURE_VARS=country
+func main() {
+	go setupVars()
+	time.Sleep(5 * time.Second)
+	resp, err := http.Get("http://127.0.0.1:8080/1/countries/2")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	fmt.Println(string(body))
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyHttpServerAttributes(stubs[0][1], "/{name}/countries/{country}", "GET", "http", "tcp", "ipv4", "", "127.0.0.1:8080", "Go-http-client/1.1", "http", "/1/countries/2", "", "/{name}/countries/{country}", 200)
+		country := verifier.GetAttribute(stubs[0][1].Attributes, "http.route.param.country").AsString()
+		verifier.Assert(country == "2", "Except route param country to be 2, got %s", country)
+		name := verifier.GetAttribute(stubs[0][1].Attributes, "http.route.param.name").AsString()
+		verifier.Assert(name == "", "Except route param name not captured, got %s", name)
+	}, 1)
+}
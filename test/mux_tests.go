@@ -25,6 +25,8 @@ func init() {
 		NewGeneralTestCase("mux-middleware-test", mux_module_name, "v1.3.0", "", "1.18", "", TestMuxMiddleware),
 		NewGeneralTestCase("mux-pattern-test", mux_module_name, "v1.3.0", "", "1.18", "", TestMuxPattern),
 		NewGeneralTestCase("mux-prefix-test", mux_module_name, "v1.7.4", "", "1.18", "", TestMuxPrefix),
+		NewGeneralTestCase("mux-vars-test", mux_module_name, "v1.3.0", "v1.7.3", "1.18", "", TestMuxVars),
+		NewGeneralTestCase("mux-vars-174-test", mux_module_name, "v1.7.4", "", "1.18", "", TestMuxVars174),
 		NewMuzzleTestCase("mux-muzzle-test", mux_dependency_name, mux_module_name, "v1.3.0", "v1.6.2", "1.18", "", []string{"go", "build", "test_mux_basic.go"}),
 		NewMuzzleTestCase("mux-muzzle-test", mux_dependency_name, mux_module_name, "v1.7.4", "", "1.18", "", []string{"go", "build", "test_mux_middleware.go"}),
 		NewLatestDepthTestCase("mux-latestdepth-test", mux_dependency_name, mux_module_name, "v1.3.0", "", "1.18", "", TestBasicMux),
@@ -53,3 +55,17 @@ func TestMuxPrefix(t *testing.T, env ...string) {
 	RunGoBuild(t, "go", "build", "test_mux_prefix.go")
 	RunApp(t, "test_mux_prefix", env...)
 }
+
+func TestMuxVars(t *testing.T, env ...string) {
+	UseApp("mux/v1.3.0")
+	RunGoBuild(t, "go", "build", "test_mux_vars.go")
+	env = append(env, "OTEL_INSTRUMENTATION_MUX_CAPTURE_VARS=country")
+	RunApp(t, "test_mux_vars", env...)
+}
+
+func TestMuxVars174(t *testing.T, env ...string) {
+	UseApp("mux/v1.7.4")
+	RunGoBuild(t, "go", "build", "test_mux_vars.go")
+	env = append(env, "OTEL_INSTRUMENTATION_MUX_CAPTURE_VARS=country")
+	RunApp(t, "test_mux_vars", env...)
+}
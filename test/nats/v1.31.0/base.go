@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	subjectName = "nats-subject"
+	streamName  = "NATS_STREAM"
+)
+
+// getNatsURL returns the url of the nats server from environment or default
+func getNatsURL() string {
+	if port := os.Getenv("NATS_PORT"); port != "" {
+		return "nats://127.0.0.1:" + port
+	}
+	return nats.DefaultURL
+}
+
+func connect() *nats.Conn {
+	nc, err := nats.Connect(getNatsURL())
+	if err != nil {
+		panic(err)
+	}
+	return nc
+}
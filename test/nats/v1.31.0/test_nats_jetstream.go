@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	nc := connect()
+	defer nc.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		panic(err)
+	}
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:     streamName,
+		Subjects: []string{subjectName},
+	})
+	if err != nil {
+		panic(err)
+	}
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:   "nats-consumer",
+		AckPolicy: jetstream.AckExplicitPolicy,
+	})
+	if err != nil {
+		panic(err)
+	}
+	ack, err := js.Publish(ctx, subjectName, []byte("hello jetstream"))
+	if err != nil {
+		panic(err)
+	}
+
+	received := make(chan jetstream.Msg, 1)
+	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+		if err := msg.Ack(); err != nil {
+			panic(err)
+		}
+		received <- msg
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer consumeCtx.Stop()
+	msg := <-received
+	verifier.Assert(msg.Headers().Get("traceparent") != "", "Expect the trace context to be stored with the message")
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", subjectName, "nats")
+		messageId := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageId == strconv.FormatUint(ack.Sequence, 10), "Expect messaging.message.id to be the stream sequence %d, got %s", ack.Sequence, messageId)
+		verifier.VerifyMQConsumeAttributes(stubs[0][1], "", "", "", "process", subjectName, "nats")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Expect the consumer span to be a child of the producer span")
+	}, 1)
+}
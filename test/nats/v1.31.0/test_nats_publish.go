@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	nc := connect()
+	defer nc.Close()
+
+	received := make(chan *nats.Msg, 1)
+	if _, err := nc.QueueSubscribe(subjectName, "nats-queue", func(msg *nats.Msg) {
+		received <- msg
+	}); err != nil {
+		panic(err)
+	}
+	if err := nc.Publish(subjectName, []byte("hello nats")); err != nil {
+		panic(err)
+	}
+
+	// a synchronous subscription receives the message as well
+	sub, err := nc.SubscribeSync(subjectName)
+	if err != nil {
+		panic(err)
+	}
+	msg := nats.NewMsg(subjectName)
+	msg.Header.Set("custom", "value")
+	msg.Data = []byte("hello again")
+	if err = nc.PublishMsg(msg); err != nil {
+		panic(err)
+	}
+	polled, err := sub.NextMsg(5 * time.Second)
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(polled.Header.Get("custom") == "value", "Expect the headers of the application to be kept")
+	verifier.Assert(polled.Header.Get("traceparent") != "", "Expect the trace context to be injected into the message headers")
+
+	first := <-received
+	verifier.Assert(first.Header.Get("traceparent") != "", "Expect the trace context to be injected into the message headers")
+	<-received
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", subjectName, "nats")
+		verifier.VerifyMQConsumeAttributes(stubs[0][1], "", "", "", "process", subjectName, "nats")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Expect the consumer span to be a child of the producer span")
+		queue := verifier.GetAttribute(stubs[0][1].Attributes, "messaging.consumer.group.name").AsString()
+		verifier.Assert(queue == "nats-queue", "Expect messaging.consumer.group.name to be nats-queue, got %s", queue)
+
+		verifier.VerifyMQPublishAttributes(stubs[1][0], "", "", "", "publish", subjectName, "nats")
+		var receive, process bool
+		for _, span := range stubs[1][1:] {
+			verifier.Assert(span.Parent.SpanID() == stubs[1][0].SpanContext.SpanID(), "Expect the consumer span to be a child of the producer span")
+			switch span.Name {
+			case subjectName + " receive":
+				verifier.VerifyMQConsumeAttributes(span, "", "", "", "receive", subjectName, "nats")
+				receive = true
+			case subjectName + " process":
+				process = true
+			}
+		}
+		verifier.Assert(receive && process, "Expect the message to be received and processed, got %d spans", len(stubs[1]))
+	}, 2)
+}
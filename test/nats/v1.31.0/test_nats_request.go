@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	nc := connect()
+	defer nc.Close()
+
+	if _, err := nc.Subscribe(subjectName, func(msg *nats.Msg) {
+		if err := msg.Respond([]byte("pong")); err != nil {
+			panic(err)
+		}
+	}); err != nil {
+		panic(err)
+	}
+	reply, err := nc.Request(subjectName, []byte("ping"), 5*time.Second)
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(string(reply.Data) == "pong", "Expect the reply to be pong, got %s", string(reply.Data))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err = nc.RequestWithContext(ctx, subjectName, []byte("ping")); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for _, stub := range stubs {
+			verifier.Assert(len(stub) == 3, "Expect the request, the handling and the reply to be traced, got %d spans", len(stub))
+			client, server, reply := stub[0], stub[1], stub[2]
+			verifier.Assert(client.Name == subjectName+" request", "Expect the client span to be named %s request, got %s", subjectName, client.Name)
+			verifier.Assert(client.SpanKind == trace.SpanKindClient, "Expect to be client span, got %d", client.SpanKind)
+			system := verifier.GetAttribute(client.Attributes, "rpc.system").AsString()
+			verifier.Assert(system == "nats", "Expect rpc.system to be nats, got %s", system)
+			service := verifier.GetAttribute(client.Attributes, "rpc.service").AsString()
+			verifier.Assert(service == subjectName, "Expect rpc.service to be %s, got %s", subjectName, service)
+			verifier.Assert(server.SpanKind == trace.SpanKindServer, "Expect to be server span, got %d", server.SpanKind)
+			verifier.Assert(server.Parent.SpanID() == client.SpanContext.SpanID(), "Expect the server span to be a child of the client span")
+			verifier.VerifyMQPublishAttributes(reply, "", "", "", "publish", "(temporary)", "nats")
+			verifier.Assert(reply.Parent.SpanID() == server.SpanContext.SpanID(), "Expect the reply to be published within the server span")
+		}
+	}, 2)
+}
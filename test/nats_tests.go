@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const nats_dependency_name = "github.com/nats-io/nats.go"
+const nats_module_name = "nats"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("nats-1.31.0-publish-test", nats_module_name, "v1.31.0", "v1.54.0", "1.18", "", TestNatsPublish),
+		NewGeneralTestCase("nats-1.31.0-request-test", nats_module_name, "v1.31.0", "v1.54.0", "1.18", "", TestNatsRequest),
+		NewGeneralTestCase("nats-1.31.0-jetstream-test", nats_module_name, "v1.31.0", "v1.54.0", "1.18", "", TestNatsJetStream),
+		NewMuzzleTestCase("nats-muzzle-test", nats_dependency_name, nats_module_name, "v1.31.0", "v1.54.0", "1.18", "", []string{"go", "build", "test_nats_publish.go", "base.go"}),
+		NewLatestDepthTestCase("nats-latest-depth-test", nats_dependency_name, nats_module_name, "v1.31.0", "v1.54.0", "1.18", "", TestNatsRequest),
+	)
+}
+
+func TestNatsPublish(t *testing.T, env ...string) {
+	_, natsPort := initNatsContainer()
+	UseApp("nats/v1.31.0")
+	RunGoBuild(t, "go", "build", "test_nats_publish.go", "base.go")
+	env = append(env, "NATS_PORT="+natsPort.Port())
+	RunApp(t, "test_nats_publish", env...)
+}
+
+func TestNatsRequest(t *testing.T, env ...string) {
+	_, natsPort := initNatsContainer()
+	UseApp("nats/v1.31.0")
+	RunGoBuild(t, "go", "build", "test_nats_request.go", "base.go")
+	env = append(env, "NATS_PORT="+natsPort.Port())
+	RunApp(t, "test_nats_request", env...)
+}
+
+func TestNatsJetStream(t *testing.T, env ...string) {
+	_, natsPort := initNatsContainer()
+	UseApp("nats/v1.31.0")
+	RunGoBuild(t, "go", "build", "test_nats_jetstream.go", "base.go")
+	env = append(env, "NATS_PORT="+natsPort.Port())
+	RunApp(t, "test_nats_jetstream", env...)
+}
+
+func initNatsContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "nats:2.10",
+		Cmd:          []string{"-js"},
+		ExposedPorts: []string{"4222/tcp"},
+		WaitingFor:   wait.ForListeningPort("4222/tcp"),
+	}
+	natsC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := natsC.MappedPort(context.Background(), "4222")
+	if err != nil {
+		panic(err)
+	}
+	return natsC, port
+}
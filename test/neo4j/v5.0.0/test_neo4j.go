@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	ctx := context.Background()
+	driver, err := neo4j.NewDriverWithContext("bolt://127.0.0.1:"+os.Getenv("NEO4J_PORT"), neo4j.NoAuth())
+	if err != nil {
+		panic(err)
+	}
+	defer driver.Close(ctx)
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{DatabaseName: "neo4j"})
+	defer session.Close(ctx)
+	result, err := session.Run(ctx, "CREATE (p:Person {name: 'alice', age: 42})", nil)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = result.Consume(ctx); err != nil {
+		panic(err)
+	}
+
+	age, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, "MATCH (p:Person) WHERE p.name = $name RETURN p.age", map[string]any{"name": "alice"})
+		if err != nil {
+			return nil, err
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return record.Values[0], nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(age == int64(42), "Expect the age of alice to be 42, got %v", age)
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "CREATE Person", "neo4j", "127.0.0.1", "CREATE (p:Person {name: ?, age: ?})", "CREATE", "Person", nil)
+		statement := verifier.GetAttribute(stubs[0][0].Attributes, "db.query.text").AsString()
+		verifier.Assert(!strings.Contains(statement, "alice"), "Expect the statement to be sanitized, got %s", statement)
+		namespace := verifier.GetAttribute(stubs[0][0].Attributes, "db.namespace").AsString()
+		verifier.Assert(namespace == "neo4j", "Expect db.namespace to be neo4j, got %s", namespace)
+		routing := verifier.GetAttribute(stubs[0][0].Attributes, "db.neo4j.routing").AsString()
+		verifier.Assert(routing == "write", "Expect db.neo4j.routing of the session to be write, got %s", routing)
+
+		verifier.VerifyDbAttributes(stubs[1][0], "ExecuteRead", "neo4j", "127.0.0.1", "", "ExecuteRead", "", nil)
+		verifier.VerifyDbAttributes(stubs[1][1], "MATCH Person", "neo4j", "127.0.0.1", "MATCH (p:Person) WHERE p.name = $name RETURN p.age", "MATCH", "Person", nil)
+		verifier.Assert(stubs[1][1].Parent.SpanID() == stubs[1][0].SpanContext.SpanID(), "Expect the statement to be a child of the execution")
+		routing = verifier.GetAttribute(stubs[1][1].Attributes, "db.neo4j.routing").AsString()
+		verifier.Assert(routing == "read", "Expect db.neo4j.routing of the execution to be read, got %s", routing)
+	}, 2)
+}
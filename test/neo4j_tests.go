@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const neo4j_dependency_name = "github.com/neo4j/neo4j-go-driver/v5"
+const neo4j_module_name = "neo4j"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("neo4j-test", neo4j_module_name, "v5.0.0", "v5.28.1", "1.18", "", TestNeo4j),
+		NewMuzzleTestCase("neo4j-muzzle-test", neo4j_dependency_name, neo4j_module_name, "v5.0.0", "v5.28.1", "1.18", "", []string{"go", "build", "test_neo4j.go"}),
+		NewLatestDepthTestCase("neo4j-latestdepth-test", neo4j_dependency_name, neo4j_module_name, "v5.0.0", "v5.28.1", "1.18", "", TestNeo4j),
+	)
+}
+
+func TestNeo4j(t *testing.T, env ...string) {
+	_, neo4jPort := initNeo4jContainer()
+	UseApp("neo4j/v5.0.0")
+	RunGoBuild(t, "go", "build", "test_neo4j.go")
+	env = append(env, "NEO4J_PORT="+neo4jPort.Port())
+	RunApp(t, "test_neo4j", env...)
+}
+
+func initNeo4jContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "neo4j:5",
+		ExposedPorts: []string{"7687/tcp"},
+		Env: map[string]string{
+			"NEO4J_AUTH": "none",
+		},
+		WaitingFor: wait.ForLog("Started."),
+	}
+	neo4jC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := neo4jC.MappedPort(context.Background(), "7687")
+	if err != nil {
+		panic(err)
+	}
+	return neo4jC, port
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/opensearch-project/opensearch-go/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	client *opensearch.Client
+	url    = "http://127.0.0.1:" + os.Getenv("OTEL_OS_PORT")
+)
+
+func main() {
+	var err error
+	client, err = opensearch.NewClient(opensearch.Config{
+		Addresses: []string{url},
+	})
+	if err != nil {
+		panic(err)
+	}
+	// creating an index
+	_, err = client.Indices.Create("my_index")
+	if err != nil {
+		log.Printf("failed to create index %v\n", err)
+	}
+	// indexing documents
+	document := struct {
+		Name string `json:"name"`
+	}{
+		"opensearch-go",
+	}
+	data, _ := json.Marshal(document)
+	_, err = client.Index("my_index", bytes.NewReader(data))
+	if err != nil {
+		log.Printf("failed to index document %v\n", err)
+	}
+	// getting documents
+	_, err = client.Get("my_index", "id")
+	if err != nil {
+		log.Printf("failed to get documents %v\n", err)
+	}
+	// searching documents
+	query := `{ "query": { "match_all": {} } }`
+	_, err = client.Search(
+		client.Search.WithIndex("my_index"),
+		client.Search.WithBody(strings.NewReader(query)),
+	)
+	if err != nil {
+		log.Printf("failed to search documents %v\n", err)
+	}
+	// deleting documents
+	_, err = client.Delete("my_index", "id")
+	if err != nil {
+		log.Printf("failed to delete document %v\n", err)
+	}
+	// deleting an index
+	_, err = client.Indices.Delete([]string{"my_index"})
+	if err != nil {
+		log.Printf("failed to delete index %v\n", err)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "put my_index", "opensearch", "127.0.0.1", "/my_index", "put", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "_doc my_index", "opensearch", "127.0.0.1", "/my_index/_doc", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "_doc my_index", "opensearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "_search my_index", "opensearch", "127.0.0.1", "/my_index/_search", "_search", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[4][0], "_doc my_index", "opensearch", "127.0.0.1", "/my_index/_doc/id", "_doc", "my_index", nil)
+		verifier.VerifyDbAttributes(stubs[5][0], "delete my_index", "opensearch", "127.0.0.1", "/my_index", "delete", "my_index", nil)
+		method := verifier.GetAttribute(stubs[3][0].Attributes, "http.request.method").AsString()
+		verifier.Assert(method == "POST", "Expect http.request.method to be POST, got %s", method)
+		statusCode := verifier.GetAttribute(stubs[3][0].Attributes, "db.response.status_code").AsString()
+		verifier.Assert(statusCode == "200", "Expect db.response.status_code to be 200, got %s", statusCode)
+		took := verifier.GetAttribute(stubs[3][0].Attributes, "opensearch.took")
+		verifier.Assert(took.Type() == attribute.INT64 && took.AsInt64() >= 0, "Expect opensearch.took to be recorded, got %v", took)
+	}, 1)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const opensearch_dependency_name = "github.com/opensearch-project/opensearch-go/v2"
+const opensearch_module_name = "opensearch"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("opensearch-crud-test", opensearch_module_name, "v2.0.0", "v2.3.0", "1.18", "", TestOpenSearchCrud),
+		NewLatestDepthTestCase("opensearch-crud-latestdepth-test", opensearch_dependency_name, opensearch_module_name, "v2.0.0", "v2.3.0", "1.18", "", TestOpenSearchCrud),
+		NewMuzzleTestCase("opensearch-muzzle", opensearch_dependency_name, opensearch_module_name, "v2.0.0", "v2.3.0", "1.18", "", []string{"go", "build", "test_os_crud.go"}),
+	)
+}
+
+func TestOpenSearchCrud(t *testing.T, env ...string) {
+	_, osPort := initOpenSearchContainer()
+	UseApp("opensearch/v2.0.0")
+	RunGoBuild(t, "go", "build", "test_os_crud.go")
+	env = append(env, "OTEL_OS_PORT="+osPort.Port())
+	RunApp(t, "test_os_crud", env...)
+}
+
+func initOpenSearchContainer() (testcontainers.Container, nat.Port) {
+	containerReqeust := testcontainers.ContainerRequest{
+		Image:        "opensearchproject/opensearch:2.11.1",
+		ExposedPorts: []string{"9200/tcp"},
+		Env: map[string]string{
+			"discovery.type":          "single-node",
+			"OPENSEARCH_JAVA_OPTS":    "-Xms512m -Xmx512m",
+			"DISABLE_SECURITY_PLUGIN": "true",
+		},
+		WaitingFor: wait.ForListeningPort("9200/tcp")}
+	opensearchC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{ContainerRequest: containerReqeust, Started: true})
+	if err != nil {
+		panic(err)
+	}
+	port, err := opensearchC.MappedPort(context.Background(), "9200")
+	if err != nil {
+		panic(err)
+	}
+	return opensearchC, port
+}
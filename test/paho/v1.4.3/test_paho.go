@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const (
+	topicFilter = "sensors/+/temperature"
+	topicName   = "sensors/kitchen/temperature"
+)
+
+func connect(clientID string) mqtt.Client {
+	opts := mqtt.NewClientOptions().
+		AddBroker("tcp://127.0.0.1:" + os.Getenv("MQTT_PORT")).
+		SetClientID(clientID)
+	client := mqtt.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		panic(token.Error())
+	}
+	return client
+}
+
+func main() {
+	received := make(chan mqtt.Message, 1)
+	subscriber := connect("otel-subscriber")
+	defer subscriber.Disconnect(100)
+	token := subscriber.Subscribe(topicFilter, 1, func(client mqtt.Client, msg mqtt.Message) {
+		received <- msg
+	})
+	if token.Wait() && token.Error() != nil {
+		panic(token.Error())
+	}
+
+	publisher := connect("otel-publisher")
+	defer publisher.Disconnect(100)
+	token = publisher.Publish(topicName, 1, false, "21.5")
+	if token.Wait() && token.Error() != nil {
+		panic(token.Error())
+	}
+
+	select {
+	case msg := <-received:
+		verifier.Assert(string(msg.Payload()) == "21.5", "Expect the payload to be kept, got %s", msg.Payload())
+	case <-time.After(10 * time.Second):
+		panic("message was not delivered")
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var publish, process bool
+		for _, trace := range stubs {
+			for _, span := range trace {
+				switch span.Name {
+				case topicName + " publish":
+					verifier.VerifyMQPublishAttributes(span, "", "", "", "publish", topicName, "mqtt")
+					qos := verifier.GetAttribute(span.Attributes, "messaging.mqtt.qos").AsInt64()
+					verifier.Assert(qos == 1, "Expect messaging.mqtt.qos to be 1, got %d", qos)
+					clientID := verifier.GetAttribute(span.Attributes, "messaging.client.id").AsString()
+					verifier.Assert(clientID == "otel-publisher", "Expect messaging.client.id to be otel-publisher, got %s", clientID)
+					size := verifier.GetAttribute(span.Attributes, "messaging.message.body.size").AsInt64()
+					verifier.Assert(size == 4, "Expect messaging.message.body.size to be 4, got %d", size)
+					publish = true
+				case topicName + " process":
+					verifier.VerifyMQConsumeAttributes(span, "", "", "", "process", topicName, "mqtt")
+					qos := verifier.GetAttribute(span.Attributes, "messaging.mqtt.qos").AsInt64()
+					verifier.Assert(qos == 1, "Expect messaging.mqtt.qos to be 1, got %d", qos)
+					clientID := verifier.GetAttribute(span.Attributes, "messaging.client.id").AsString()
+					verifier.Assert(clientID == "otel-subscriber", "Expect messaging.client.id to be otel-subscriber, got %s", clientID)
+					process = true
+				}
+			}
+		}
+		verifier.Assert(publish && process, "Expect the message to be published and processed, got %d traces", len(stubs))
+	}, 2)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const paho_dependency_name = "github.com/eclipse/paho.mqtt.golang"
+const paho_module_name = "paho"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("paho-1.4.3-test", paho_module_name, "v1.4.0", "v1.5.1", "1.18", "", TestPaho),
+		NewMuzzleTestCase("paho-muzzle-test", paho_dependency_name, paho_module_name, "v1.4.0", "v1.5.1", "1.18", "", []string{"go", "build", "test_paho.go"}),
+		NewLatestDepthTestCase("paho-latestdepth-test", paho_dependency_name, paho_module_name, "v1.4.0", "v1.5.1", "1.18", "", TestPaho),
+	)
+}
+
+func TestPaho(t *testing.T, env ...string) {
+	_, mqttPort := initMosquittoContainer()
+	UseApp("paho/v1.4.3")
+	RunGoBuild(t, "go", "build", "test_paho.go")
+	env = append(env, "MQTT_PORT="+mqttPort.Port())
+	RunApp(t, "test_paho", env...)
+}
+
+func initMosquittoContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "eclipse-mosquitto:2",
+		Cmd:          []string{"mosquitto", "-c", "/mosquitto-no-auth.conf"},
+		ExposedPorts: []string{"1883/tcp"},
+		WaitingFor:   wait.ForListeningPort("1883/tcp"),
+	}
+	mosquittoC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := mosquittoC.MappedPort(context.Background(), "1883")
+	if err != nil {
+		panic(err)
+	}
+	return mosquittoC, port
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+const (
+	topicName        = "persistent://public/default/pulsar-topic"
+	subscriptionName = "pulsar-subscription"
+)
+
+// getPulsarURL returns the Pulsar service url from environment or default
+func getPulsarURL() string {
+	if port := os.Getenv("PULSAR_PORT"); port != "" {
+		return "pulsar://127.0.0.1:" + port
+	}
+	return "pulsar://127.0.0.1:6650"
+}
+
+func newClient() pulsar.Client {
+	client, err := pulsar.NewClient(pulsar.ClientOptions{URL: getPulsarURL()})
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func formatMessageID(id pulsar.MessageID) string {
+	return fmt.Sprintf("%d:%d:%d:%d", id.LedgerID(), id.EntryID(), id.PartitionIdx(), id.BatchIdx())
+}
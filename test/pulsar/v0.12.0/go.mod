@@ -0,0 +1,13 @@
+module pulsar
+
+go 1.23.0
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation => ../../../
+
+replace github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier => ../../../test/verifier
+
+require (
+	github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier v0.0.0-00010101000000-000000000000
+	github.com/apache/pulsar-client-go v0.12.0
+	go.opentelemetry.io/otel/sdk v1.35.0
+)
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	client := newClient()
+	defer client.Close()
+	consumer, err := client.Subscribe(pulsar.ConsumerOptions{
+		Topic:            topicName,
+		SubscriptionName: subscriptionName,
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer consumer.Close()
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{Topic: topicName})
+	if err != nil {
+		panic(err)
+	}
+	defer producer.Close()
+
+	ctx := context.Background()
+	if _, err = producer.Send(ctx, &pulsar.ProducerMessage{Payload: []byte("hello pulsar")}); err != nil {
+		panic(err)
+	}
+	msg, err := consumer.Receive(ctx)
+	if err != nil {
+		panic(err)
+	}
+	consumer.Ack(msg)
+	verifier.Assert(msg.Properties()["traceparent"] != "", "Expect the trace context to be carried by the message properties")
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "pulsar")
+		verifier.VerifyMQConsumeAttributes(stubs[0][1], "", "", "", "receive", topicName, "pulsar")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Expect the consumer span to be a child of the producer span")
+		subscription := verifier.GetAttribute(stubs[0][1].Attributes, "messaging.destination.subscription.name").AsString()
+		verifier.Assert(subscription == subscriptionName, "Expect messaging.destination.subscription.name to be %s, got %s", subscriptionName, subscription)
+		messageID := verifier.GetAttribute(stubs[0][1].Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == formatMessageID(msg.ID()), "Expect messaging.message.id to be %s, got %s", formatMessageID(msg.ID()), messageID)
+	}, 1)
+}
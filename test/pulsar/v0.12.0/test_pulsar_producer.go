@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	client := newClient()
+	defer client.Close()
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{Topic: topicName})
+	if err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+
+	// send a message synchronously
+	msg := &pulsar.ProducerMessage{Payload: []byte("hello sync")}
+	id, err := producer.Send(ctx, msg)
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(msg.Properties["traceparent"] != "", "Expect the trace context to be injected into the message properties")
+
+	// send a message asynchronously, the properties of the application are kept
+	done := make(chan pulsar.MessageID, 1)
+	asyncMsg := &pulsar.ProducerMessage{Payload: []byte("hello async"), Properties: map[string]string{"custom": "value"}}
+	producer.SendAsync(ctx, asyncMsg, func(id pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+		if err != nil {
+			panic(err)
+		}
+		done <- id
+	})
+	asyncID := <-done
+	verifier.Assert(asyncMsg.Properties["custom"] == "value", "Expect the properties of the application to be kept")
+	verifier.Assert(asyncMsg.Properties["traceparent"] != "", "Expect the trace context to be injected into the message properties")
+	producer.Close()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "pulsar")
+		messageID := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == formatMessageID(id), "Expect messaging.message.id to be %s, got %s", formatMessageID(id), messageID)
+		verifier.VerifyMQPublishAttributes(stubs[1][0], "", "", "", "publish", topicName, "pulsar")
+		asyncMessageID := verifier.GetAttribute(stubs[1][0].Attributes, "messaging.message.id").AsString()
+		verifier.Assert(asyncMessageID == formatMessageID(asyncID), "Expect messaging.message.id to be %s, got %s", formatMessageID(asyncID), asyncMessageID)
+	}, 2)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const pulsar_dependency_name = "github.com/apache/pulsar-client-go"
+const pulsar_module_name = "pulsar"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("pulsar-0.12.0-producer-test", pulsar_module_name, "v0.12.0", "v0.16.0", "1.18", "", TestPulsarProducer),
+		NewGeneralTestCase("pulsar-0.12.0-consumer-test", pulsar_module_name, "v0.12.0", "v0.16.0", "1.18", "", TestPulsarConsumer),
+		NewMuzzleTestCase("pulsar-muzzle-test", pulsar_dependency_name, pulsar_module_name, "v0.12.0", "v0.16.0", "1.18", "", []string{"go", "build", "test_pulsar_producer.go", "base.go"}),
+		NewLatestDepthTestCase("pulsar-latest-depth-test", pulsar_dependency_name, pulsar_module_name, "v0.12.0", "v0.16.0", "1.18", "", TestPulsarConsumer),
+	)
+}
+
+func TestPulsarProducer(t *testing.T, env ...string) {
+	_, pulsarPort := initPulsarContainer()
+	UseApp("pulsar/v0.12.0")
+	RunGoBuild(t, "go", "build", "test_pulsar_producer.go", "base.go")
+	env = append(env, "PULSAR_PORT="+pulsarPort.Port())
+	RunApp(t, "test_pulsar_producer", env...)
+}
+
+func TestPulsarConsumer(t *testing.T, env ...string) {
+	_, pulsarPort := initPulsarContainer()
+	UseApp("pulsar/v0.12.0")
+	RunGoBuild(t, "go", "build", "test_pulsar_consumer.go", "base.go")
+	env = append(env, "PULSAR_PORT="+pulsarPort.Port())
+	RunApp(t, "test_pulsar_consumer", env...)
+}
+
+func initPulsarContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "apachepulsar/pulsar:3.0.7",
+		Cmd:          []string{"bin/pulsar", "standalone"},
+		ExposedPorts: []string{"6650/tcp"},
+		WaitingFor:   wait.ForLog("messaging service is ready").WithStartupTimeout(2 * time.Minute),
+	}
+	pulsarC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := pulsarC.MappedPort(context.Background(), "6650")
+	if err != nil {
+		panic(err)
+	}
+	return pulsarC, port
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/gomodule/redigo/redis"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	pool := &redis.Pool{
+		MaxIdle: 1,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", "localhost:"+os.Getenv("REDIS_PORT"))
+		},
+	}
+	defer pool.Close()
+	ctx := context.Background()
+	c, err := pool.GetContext(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if _, err = redis.DoContext(c, ctx, "SET", "foo", "bar"); err != nil {
+		panic(err)
+	}
+	c.Close()
+	c = pool.Get()
+	if _, err = c.Do("GET", "foo"); err != nil {
+		panic(err)
+	}
+	c.Close()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "SET", "redis", "localhost", "SET foo bar", "SET", "", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "GET", "redis", "localhost", "GET foo", "GET", "", nil)
+	}, 2)
+	verifier.WaitAndAssertMetrics(map[string]func(metricdata.ResourceMetrics){
+		"db.client.connection.wait_time": func(mrs metricdata.ResourceMetrics) {
+			if len(mrs.ScopeMetrics) <= 0 {
+				panic("No db.client.connection.wait_time metrics received!")
+			}
+			point := mrs.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+			if point.DataPoints[0].Count != 2 {
+				panic("db.client.connection.wait_time metrics count is not 2, actually " + strconv.Itoa(int(point.DataPoints[0].Count)))
+			}
+			poolName, _ := point.DataPoints[0].Attributes.Value("db.client.connection.pool.name")
+			verifier.Assert(strings.HasPrefix(poolName.AsString(), "localhost:"), "Expect pool name to be the server address, got %s", poolName.AsString())
+		},
+	})
+}
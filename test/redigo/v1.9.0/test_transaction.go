@@ -22,6 +22,9 @@ func main() {
 	fmt.Println(r) // prints [1, 1]
 
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
-		verifier.VerifyDbAttributes(stubs[0][0], "EXEC", "redis", "localhost", "EXEC", "EXEC", "", nil)
-	}, 1)
+		verifier.VerifyDbAttributes(stubs[0][0], "MULTI", "redis", "localhost", "MULTI", "MULTI", "", nil)
+		verifier.VerifyDbAttributes(stubs[1][0], "INCR", "redis", "localhost", "INCR foo", "INCR", "", nil)
+		verifier.VerifyDbAttributes(stubs[2][0], "INCR", "redis", "localhost", "INCR bar", "INCR", "", nil)
+		verifier.VerifyDbAttributes(stubs[3][0], "EXEC", "redis", "localhost", "EXEC", "EXEC", "", nil)
+	}, 4)
 }
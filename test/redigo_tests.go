@@ -31,6 +31,7 @@ func init() {
 		NewGeneralTestCase("redigo-1.9.0-do-commands-test", redigo_module_name, "v1.9.0", "", "1.18", "", TestRedigoDoCommands),
 		NewGeneralTestCase("redigo-1.9.0-unsupported-commands-test", redigo_module_name, "v1.9.0", "", "1.18", "", TestRedigoUnsupportedCommands),
 		NewGeneralTestCase("redigo-1.9.0-transaction-test", redigo_module_name, "v1.9.0", "", "1.18", "", TestRedigoTransactions),
+		NewGeneralTestCase("redigo-1.9.0-pool-test", redigo_module_name, "v1.9.0", "", "1.18", "", TestRedigoPool),
 		NewMuzzleTestCase("redigo-muzzle-test", redigo_dependency_name, redigo_module_name, "v1.9.0", "", "1.18", "", []string{"go", "build", "test_do_commands.go"}),
 		NewLatestDepthTestCase("redigo-latest-depth-test", redigo_dependency_name, redigo_module_name, "v1.9.0", "", "1.18", "", TestRedigoDoCommands),
 	)
@@ -68,6 +69,14 @@ func TestRedigoTransactions(t *testing.T, env ...string) {
 	RunApp(t, "test_transaction", env...)
 }
 
+func TestRedigoPool(t *testing.T, env ...string) {
+	_, redisPort := initRedigoContainer()
+	UseApp("redigo/v1.9.0")
+	RunGoBuild(t, "go", "build", "test_pool.go")
+	env = append(env, "REDIS_PORT="+redisPort.Port())
+	RunApp(t, "test_pool", env...)
+}
+
 func initRedigoContainer() (testcontainers.Container, nat.Port) {
 	req := testcontainers.ContainerRequest{
 		Image:        "redis:latest",
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	ctx := context.Background()
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:" + os.Getenv("REDIS_PORT"),
+	})
+	pubsub := rdb.Subscribe(ctx, "news")
+	defer pubsub.Close()
+	// wait for the subscription to be confirmed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		panic(err)
+	}
+	if err := rdb.Publish(ctx, "news", "hello").Err(); err != nil {
+		panic(err)
+	}
+	msg, err := pubsub.ReceiveMessage(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if msg.Payload != "hello" {
+		panic("unexpected payload " + msg.Payload)
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		producer := stubs[0][0]
+		verifier.Assert(producer.Name == "publish news", "Expect producer span to be publish news, got %s", producer.Name)
+		verifier.Assert(producer.SpanKind == trace.SpanKindProducer, "Expect publish span to be a producer, got %v", producer.SpanKind)
+		consumer := stubs[1][0]
+		verifier.Assert(consumer.Name == "receive news", "Expect consumer span to be receive news, got %s", consumer.Name)
+		verifier.Assert(consumer.SpanKind == trace.SpanKindConsumer, "Expect receive span to be a consumer, got %v", consumer.SpanKind)
+		destination := verifier.GetAttribute(consumer.Attributes, "messaging.destination.name").AsString()
+		verifier.Assert(destination == "news", "Expect destination to be news, got %s", destination)
+		address := verifier.GetAttribute(consumer.Attributes, "server.address").AsString()
+		verifier.Assert(address == "localhost:"+os.Getenv("REDIS_PORT"), "Expect server address to be set, got %s", address)
+		verifier.Assert(len(consumer.Links) == 1, "Expect consumer span to be linked, got %d links", len(consumer.Links))
+		verifier.Assert(consumer.Links[0].SpanContext.SpanID() == producer.SpanContext.SpanID(), "Expect consumer span to be linked to the producer span")
+	}, 2)
+}
@@ -45,5 +45,13 @@ func main() {
 	fmt.Println(incr.Val())
 	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
 		verifier.VerifyDbAttributes(stubs[0][0], "pipeline", "redis", "localhost", "pipeline incr/expire/", "pipeline", "", nil)
+		batchSize := verifier.GetAttribute(stubs[0][0].Attributes, "db.operation.batch.size").AsInt64()
+		verifier.Assert(batchSize == 2, "Expect batch size to be 2, got %d", batchSize)
+		events := stubs[0][0].Events
+		verifier.Assert(len(events) == 2, "Expect 2 command events, got %d", len(events))
+		first := verifier.GetAttribute(events[0].Attributes, "db.operation.name").AsString()
+		verifier.Assert(first == "incr", "Expect the first command to be incr, got %s", first)
+		second := verifier.GetAttribute(events[1].Attributes, "db.query.text").AsString()
+		verifier.Assert(second == "expire pipeline_counter 3600", "Expect the second command to be expire, got %s", second)
 	}, 1)
 }
@@ -34,6 +34,7 @@ func init() {
 		NewGeneralTestCase("redis-9.0.5-ring-test", redis_module_name, "v9.0.5", "v9.5.1", "1.18", "", TestRedisRing),
 		NewGeneralTestCase("redis-9.0.5-transactions-test", redis_module_name, "v9.0.5", "v9.5.1", "1.18", "", TestRedisTransactions),
 		NewGeneralTestCase("redis-9.0.5-universal-test", redis_module_name, "v9.0.5", "v9.5.1", "1.18", "", TestRedisUniversal),
+		NewGeneralTestCase("redis-9.0.5-pubsub-test", redis_module_name, "v9.0.5", "v9.5.1", "1.18", "", TestRedisPubSub),
 		NewGeneralTestCase("redis-8.11.0-executing-commands-test", redis_module_name, "v8.11.0", "v8.11.5", "1.18", "", TestV8ExecutingCommands),
 		NewGeneralTestCase("redis-8.11.0-executing-unsupported-commands-test", redis_module_name, "v8.11.0", "v8.11.5", "1.18", "", TestV8ExecutingUnsupporetedCommands),
 		NewGeneralTestCase("redis-8.11.0-redis-conn-test", redis_module_name, "v8.11.0", "v8.11.5", "1.18", "", TestV8RedisConn),
@@ -93,6 +94,14 @@ func TestRedisUniversal(t *testing.T, env ...string) {
 	RunApp(t, "test_universal_client", env...)
 }
 
+func TestRedisPubSub(t *testing.T, env ...string) {
+	_, redisPort := initRedisContainer()
+	UseApp("redis/v9.0.5")
+	RunGoBuild(t, "go", "build", "test_redis_pubsub.go")
+	env = append(env, "REDIS_PORT="+redisPort.Port())
+	RunApp(t, "test_redis_pubsub", env...)
+}
+
 func TestV8ExecutingCommands(t *testing.T, env ...string) {
 	_, redisPort := initRedisContainer()
 	UseApp("redis/v8.11.0")
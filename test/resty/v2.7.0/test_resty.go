@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	var requests int32
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		// fail the first attempt to make resty retry
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		http.Redirect(w, r, "/new", http.StatusFound)
+	})
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("resty"))
+	})
+	go http.Serve(ln, mux)
+
+	client := resty.New().
+		SetRetryCount(2).
+		SetRetryWaitTime(10 * time.Millisecond).
+		AddRetryCondition(func(r *resty.Response, err error) bool {
+			return r.StatusCode() == http.StatusServiceUnavailable
+		})
+	resp, err := client.R().EnableTrace().Get("http://" + ln.Addr().String() + "/old")
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(resp.String() == "resty", "Expect response resty, got %s", resp.String())
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var restySpan tracetest.SpanStub
+		for _, stub := range stubs[0] {
+			if stub.InstrumentationScope.Name == "pkg/rules/resty/resty_setup.go" {
+				restySpan = stub
+			}
+		}
+		verifier.Assert(restySpan.SpanKind == trace.SpanKindClient, "Expect a resty client span, got %v", restySpan.SpanKind)
+		verifier.Assert(restySpan.Name == "GET", "Expect the span to be named GET, got %s", restySpan.Name)
+		statusCode := verifier.GetAttribute(restySpan.Attributes, "http.response.status_code").AsInt64()
+		verifier.Assert(statusCode == http.StatusOK, "Expect http.response.status_code to be 200, got %d", statusCode)
+		resendCount := verifier.GetAttribute(restySpan.Attributes, "http.request.resend_count").AsInt64()
+		verifier.Assert(resendCount == 1, "Expect http.request.resend_count to be 1, got %d", resendCount)
+		totalTime := verifier.GetAttribute(restySpan.Attributes, "resty.trace.total_time").AsFloat64()
+		verifier.Assert(totalTime > 0, "Expect resty.trace.total_time to be recorded")
+		// the failed attempt, the redirected attempt and the redirect itself
+		var roundTrips int
+		for _, stub := range stubs[0] {
+			if stub.SpanKind == trace.SpanKindClient && stub.Parent.SpanID() == restySpan.SpanContext.SpanID() {
+				roundTrips++
+			}
+		}
+		verifier.Assert(roundTrips == 3, "Expect 3 http client spans under the resty span, got %d", roundTrips)
+	}, 1)
+}
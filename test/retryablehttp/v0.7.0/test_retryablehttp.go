@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	var requests int32
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go http.Serve(ln, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// fail the first two attempts to make the client retry
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("retryablehttp"))
+	}))
+
+	client := retryablehttp.NewClient()
+	client.RetryWaitMin = 10 * time.Millisecond
+	client.RetryWaitMax = 20 * time.Millisecond
+	client.Logger = nil
+	resp, err := client.Get("http://" + ln.Addr().String() + "/retry")
+	if err != nil {
+		panic(err)
+	}
+	resp.Body.Close()
+	verifier.Assert(resp.StatusCode == http.StatusOK, "Expect status 200, got %d", resp.StatusCode)
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var logical tracetest.SpanStub
+		attempts := map[int]tracetest.SpanStub{}
+		for _, stub := range stubs[0] {
+			if stub.Name == "retryablehttp GET" {
+				logical = stub
+			}
+			if attempt := verifier.GetAttribute(stub.Attributes, "retryablehttp.attempt").AsInt64(); attempt > 0 {
+				attempts[int(attempt)] = stub
+			}
+		}
+		verifier.Assert(logical.SpanKind == trace.SpanKindClient, "Expect a logical client span, got %v", logical.SpanKind)
+		resendCount := verifier.GetAttribute(logical.Attributes, "http.request.resend_count").AsInt64()
+		verifier.Assert(resendCount == 2, "Expect http.request.resend_count to be 2, got %d", resendCount)
+		verifier.Assert(len(attempts) == 3, "Expect 3 attempts, got %d", len(attempts))
+		for i := 1; i <= 3; i++ {
+			attempt := attempts[i]
+			verifier.Assert(attempt.Name == "retryablehttp attempt "+strconv.Itoa(i), "Unexpected attempt span %s", attempt.Name)
+			verifier.Assert(attempt.Parent.SpanID() == logical.SpanContext.SpanID(), "Expect attempt %d to be a child of the logical span", i)
+			if i > 1 {
+				backoff := verifier.GetAttribute(attempt.Attributes, "retryablehttp.backoff").AsFloat64()
+				verifier.Assert(backoff > 0, "Expect the backoff of attempt %d to be recorded", i)
+			}
+			var httpClient int
+			for _, stub := range stubs[0] {
+				if stub.SpanKind == trace.SpanKindClient && stub.Parent.SpanID() == attempt.SpanContext.SpanID() {
+					httpClient++
+				}
+			}
+			verifier.Assert(httpClient == 1, "Expect one http client span under attempt %d, got %d", i, httpClient)
+		}
+	}, 1)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const retryablehttp_dependency_name = "github.com/hashicorp/go-retryablehttp"
+const retryablehttp_module_name = "retryablehttp"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("retryablehttp-test", retryablehttp_module_name, "v0.7.0", "", "1.18", "", TestRetryableHttp),
+		NewMuzzleTestCase("retryablehttp-muzzle-test", retryablehttp_dependency_name, retryablehttp_module_name, "v0.7.0", "", "1.18", "", []string{"go", "build", "test_retryablehttp.go"}),
+		NewLatestDepthTestCase("retryablehttp-latestdepth-test", retryablehttp_dependency_name, retryablehttp_module_name, "v0.7.0", "", "1.18", "", TestRetryableHttp),
+	)
+}
+
+func TestRetryableHttp(t *testing.T, env ...string) {
+	UseApp("retryablehttp/v0.7.0")
+	RunGoBuild(t, "go", "build", "test_retryablehttp.go")
+	RunApp(t, "test_retryablehttp", env...)
+}
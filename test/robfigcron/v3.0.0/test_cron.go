@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sync"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var runs sync.WaitGroup
+
+func cleanup() {
+	defer runs.Done()
+}
+
+func failingJob() {
+	defer runs.Done()
+	panic("job failed")
+}
+
+func main() {
+	c := cron.New(cron.WithChain(cron.Recover(cron.DefaultLogger)))
+	runs.Add(2)
+	if _, err := c.AddFunc("@every 1s", cleanup); err != nil {
+		panic(err)
+	}
+	if _, err := c.AddFunc("@every 1s", failingJob); err != nil {
+		panic(err)
+	}
+	c.Start()
+	runs.Wait()
+	<-c.Stop().Done()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		jobs := map[string]tracetest.SpanStub{}
+		for _, trace := range stubs {
+			verifier.Assert(len(trace) == 1, "Expect one span per run, got %d", len(trace))
+			verifier.Assert(!trace[0].Parent.IsValid(), "Expect the run of %s to be a root span", trace[0].Name)
+			jobs[trace[0].Name] = trace[0]
+		}
+		ok := jobs["cron main.cleanup"]
+		verifier.Assert(verifier.GetAttribute(ok.Attributes, "cron.spec").AsString() == "@every 1s", "Expect cron.spec to be @every 1s")
+		verifier.Assert(verifier.GetAttribute(ok.Attributes, "cron.job.name").AsString() == "main.cleanup", "Expect cron.job.name to be main.cleanup")
+		verifier.Assert(ok.Status.Code != codes.Error, "Expect the run of cleanup to succeed")
+		failed := jobs["cron main.failingJob"]
+		verifier.Assert(failed.Status.Code == codes.Error, "Expect the run of failingJob to fail, got %v", failed.Status.Code)
+	}, 2)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const robfigcron_dependency_name = "github.com/robfig/cron/v3"
+const robfigcron_module_name = "robfigcron"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("robfigcron-test", robfigcron_module_name, "v3.0.0", "", "1.18", "", TestRobfigCron),
+		NewMuzzleTestCase("robfigcron-muzzle-test", robfigcron_dependency_name, robfigcron_module_name, "v3.0.0", "", "1.18", "", []string{"go", "build", "test_cron.go"}),
+		NewLatestDepthTestCase("robfigcron-latestdepth-test", robfigcron_dependency_name, robfigcron_module_name, "v3.0.0", "", "1.18", "", TestRobfigCron),
+	)
+}
+
+func TestRobfigCron(t *testing.T, env ...string) {
+	UseApp("robfigcron/v3.0.0")
+	RunGoBuild(t, "go", "build", "test_cron.go")
+	RunApp(t, "test_cron", env...)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/producer"
+)
+
+const (
+	topicName = "rocketmq-topic"
+	groupName = "rocketmq-group"
+)
+
+// getNameServer returns the RocketMQ name server address from environment or
+// default
+func getNameServer() string {
+	if addr := os.Getenv("ROCKETMQ_NAMESRV"); addr != "" {
+		return addr
+	}
+	return "127.0.0.1:9876"
+}
+
+func newProducer() rocketmq.Producer {
+	p, err := rocketmq.NewProducer(
+		producer.WithNameServer([]string{getNameServer()}),
+		producer.WithRetry(2),
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err = p.Start(); err != nil {
+		panic(err)
+	}
+	return p
+}
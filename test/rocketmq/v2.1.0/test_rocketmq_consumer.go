@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	c, err := rocketmq.NewPushConsumer(
+		consumer.WithNameServer([]string{getNameServer()}),
+		consumer.WithGroupName(groupName),
+		consumer.WithConsumeFromWhere(consumer.ConsumeFromFirstOffset),
+	)
+	if err != nil {
+		panic(err)
+	}
+	received := make(chan *primitive.MessageExt, 1)
+	var handlerSpan trace.SpanContext
+	err = c.Subscribe(topicName, consumer.MessageSelector{}, func(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		received <- msgs[0]
+		return consumer.ConsumeSuccess, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	if err = c.Start(); err != nil {
+		panic(err)
+	}
+
+	p := newProducer()
+	if _, err = p.SendSync(context.Background(), primitive.NewMessage(topicName, []byte("hello rocketmq"))); err != nil {
+		panic(err)
+	}
+	msg := <-received
+	verifier.Assert(msg.GetProperty("traceparent") != "", "Expect the trace context to be carried by the message properties")
+	_ = p.Shutdown()
+	_ = c.Shutdown()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "rocketmq")
+		verifier.VerifyMQConsumeAttributes(stubs[0][1], "", "", "", "process", topicName, "rocketmq")
+		verifier.Assert(stubs[0][1].Parent.SpanID() == stubs[0][0].SpanContext.SpanID(), "Expect the consumer span to be a child of the producer span")
+		verifier.Assert(handlerSpan.SpanID() == stubs[0][1].SpanContext.SpanID(), "Expect the consume function to be passed the consumer span")
+		group := verifier.GetAttribute(stubs[0][1].Attributes, "messaging.consumer.group.name").AsString()
+		verifier.Assert(group == groupName, "Expect messaging.consumer.group.name to be %s, got %s", groupName, group)
+		messageID := verifier.GetAttribute(stubs[0][1].Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == msg.MsgId, "Expect messaging.message.id to be %s, got %s", msg.MsgId, messageID)
+	}, 1)
+}
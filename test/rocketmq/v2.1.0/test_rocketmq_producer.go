@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	p := newProducer()
+	ctx := context.Background()
+
+	// send a message synchronously
+	msg := primitive.NewMessage(topicName, []byte("hello sync"))
+	msg.WithTag("sync-tag")
+	msg.WithKeys([]string{"sync-key"})
+	result, err := p.SendSync(ctx, msg)
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(msg.GetProperty("traceparent") != "", "Expect the trace context to be injected into the message properties")
+
+	// send a message asynchronously
+	done := make(chan *primitive.SendResult, 1)
+	asyncMsg := primitive.NewMessage(topicName, []byte("hello async"))
+	err = p.SendAsync(ctx, func(ctx context.Context, result *primitive.SendResult, err error) {
+		if err != nil {
+			panic(err)
+		}
+		done <- result
+	}, asyncMsg)
+	if err != nil {
+		panic(err)
+	}
+	asyncResult := <-done
+	verifier.Assert(asyncMsg.GetProperty("traceparent") != "", "Expect the trace context to be injected into the message properties")
+	if err = p.Shutdown(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "rocketmq")
+		messageID := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.message.id").AsString()
+		verifier.Assert(messageID == result.MsgID, "Expect messaging.message.id to be %s, got %s", result.MsgID, messageID)
+		tag := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.rocketmq.message.tag").AsString()
+		verifier.Assert(tag == "sync-tag", "Expect messaging.rocketmq.message.tag to be sync-tag, got %s", tag)
+		keys := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.rocketmq.message.keys").AsStringSlice()
+		verifier.Assert(len(keys) == 1 && keys[0] == "sync-key", "Expect messaging.rocketmq.message.keys to be [sync-key], got %v", keys)
+		verifier.VerifyMQPublishAttributes(stubs[1][0], "", "", "", "publish", topicName, "rocketmq")
+		asyncMessageID := verifier.GetAttribute(stubs[1][0].Attributes, "messaging.message.id").AsString()
+		verifier.Assert(asyncMessageID == asyncResult.MsgID, "Expect messaging.message.id to be %s, got %s", asyncResult.MsgID, asyncMessageID)
+	}, 2)
+}
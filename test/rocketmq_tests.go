@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const rocketmq_dependency_name = "github.com/apache/rocketmq-client-go/v2"
+const rocketmq_module_name = "rocketmq"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("rocketmq-2.1.0-producer-test", rocketmq_module_name, "v2.1.0", "v2.1.2", "1.18", "", TestRocketMQProducer),
+		NewGeneralTestCase("rocketmq-2.1.0-consumer-test", rocketmq_module_name, "v2.1.0", "v2.1.2", "1.18", "", TestRocketMQConsumer),
+		NewMuzzleTestCase("rocketmq-muzzle-test", rocketmq_dependency_name, rocketmq_module_name, "v2.1.0", "v2.1.2", "1.18", "", []string{"go", "build", "test_rocketmq_producer.go", "base.go"}),
+		NewLatestDepthTestCase("rocketmq-latest-depth-test", rocketmq_dependency_name, rocketmq_module_name, "v2.1.0", "v2.1.2", "1.18", "", TestRocketMQConsumer),
+	)
+}
+
+func TestRocketMQProducer(t *testing.T, env ...string) {
+	initRocketMQContainer()
+	UseApp("rocketmq/v2.1.0")
+	RunGoBuild(t, "go", "build", "test_rocketmq_producer.go", "base.go")
+	env = append(env, "ROCKETMQ_NAMESRV=127.0.0.1:9876")
+	RunApp(t, "test_rocketmq_producer", env...)
+}
+
+func TestRocketMQConsumer(t *testing.T, env ...string) {
+	initRocketMQContainer()
+	UseApp("rocketmq/v2.1.0")
+	RunGoBuild(t, "go", "build", "test_rocketmq_consumer.go", "base.go")
+	env = append(env, "ROCKETMQ_NAMESRV=127.0.0.1:9876")
+	RunApp(t, "test_rocketmq_consumer", env...)
+}
+
+// initRocketMQContainer runs the name server and the broker in a container,
+// the broker registers its address to the name server and clients connect to
+// that address, so the ports are bound to the same ports of the host
+func initRocketMQContainer() testcontainers.Container {
+	req := testcontainers.ContainerRequest{
+		Image: "apache/rocketmq:4.9.7",
+		Cmd: []string{"sh", "-c", "echo 'brokerIP1=127.0.0.1' > /tmp/broker.conf && " +
+			"echo 'autoCreateTopicEnable=true' >> /tmp/broker.conf && " +
+			"(./mqnamesrv &) && ./mqbroker -n 127.0.0.1:9876 -c /tmp/broker.conf"},
+		ExposedPorts: []string{"9876/tcp", "10909/tcp", "10911/tcp"},
+		HostConfigModifier: func(hc *container.HostConfig) {
+			hc.PortBindings = nat.PortMap{}
+			for _, port := range []string{"9876", "10909", "10911"} {
+				hc.PortBindings[nat.Port(port+"/tcp")] = []nat.PortBinding{{
+					HostIP:   "0.0.0.0",
+					HostPort: port,
+				}}
+			}
+		},
+		WaitingFor: wait.ForLog("boot success").WithStartupTimeout(2 * time.Minute),
+	}
+	rocketmqC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rocketmqC
+}
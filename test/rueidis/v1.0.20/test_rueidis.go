@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/redis/rueidis"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	ctx := context.Background()
+	client, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress: []string{"localhost:" + os.Getenv("REDIS_PORT")},
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	if err = client.Do(ctx, client.B().Set().Key("rueidis_key").Value("value").Build()).Error(); err != nil {
+		panic(err)
+	}
+	client.DoMulti(ctx,
+		client.B().Incr().Key("rueidis_counter").Build(),
+		client.B().Mget().Key("rueidis_key", "rueidis_counter").Build())
+	// the second read is served by the client side cache
+	for i := 0; i < 2; i++ {
+		if err = client.DoCache(ctx, client.B().Get().Key("rueidis_key").Cache(), 60e9).Error(); err != nil {
+			panic(err)
+		}
+	}
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyDbAttributes(stubs[0][0], "set", "redis", "localhost", "SET rueidis_key value", "set", "", nil)
+		keyCount := verifier.GetAttribute(stubs[0][0].Attributes, "rueidis.key.count").AsInt64()
+		verifier.Assert(keyCount == 1, "Expect key count to be 1, got %d", keyCount)
+		verifier.VerifyDbAttributes(stubs[1][0], "pipeline", "redis", "localhost", "incr/mget", "pipeline", "", nil)
+		batchSize := verifier.GetAttribute(stubs[1][0].Attributes, "db.operation.batch.size").AsInt64()
+		verifier.Assert(batchSize == 2, "Expect batch size to be 2, got %d", batchSize)
+		keyCount = verifier.GetAttribute(stubs[1][0].Attributes, "rueidis.key.count").AsInt64()
+		verifier.Assert(keyCount == 3, "Expect key count to be 3, got %d", keyCount)
+		verifier.VerifyDbAttributes(stubs[2][0], "get", "redis", "localhost", "GET rueidis_key", "get", "", nil)
+		hit := verifier.GetAttribute(stubs[2][0].Attributes, "rueidis.cache.hit").AsBool()
+		verifier.Assert(!hit, "Expect the first read to miss the cache")
+		hit = verifier.GetAttribute(stubs[3][0].Attributes, "rueidis.cache.hit").AsBool()
+		verifier.Assert(hit, "Expect the second read to hit the cache")
+	}, 4)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const rueidis_dependency_name = "github.com/redis/rueidis"
+const rueidis_module_name = "rueidis"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("rueidis-1.0.20-commands-test", rueidis_module_name, "v1.0.20", "v1.0.78", "1.20", "", TestRueidisCommands),
+		NewMuzzleTestCase("rueidis-muzzle-test", rueidis_dependency_name, rueidis_module_name, "v1.0.20", "v1.0.78", "1.20", "", []string{"go", "build", "test_rueidis.go"}),
+		NewLatestDepthTestCase("rueidis-latest-depth-test", rueidis_dependency_name, rueidis_module_name, "v1.0.20", "v1.0.78", "1.20", "", TestRueidisCommands),
+	)
+}
+
+func TestRueidisCommands(t *testing.T, env ...string) {
+	_, redisPort := initRedisContainer()
+	UseApp("rueidis/v1.0.20")
+	RunGoBuild(t, "go", "build", "test_rueidis.go")
+	env = append(env, "REDIS_PORT="+redisPort.Port())
+	RunApp(t, "test_rueidis", env...)
+}
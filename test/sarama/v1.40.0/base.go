@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/IBM/sarama"
+)
+
+const (
+	topicName = "sarama-topic"
+	groupName = "sarama-group"
+)
+
+// getKafkaAddress returns Kafka broker address from environment or default
+func getKafkaAddress() string {
+	if addr := os.Getenv("KAFKA_ADDR"); addr != "" {
+		return addr
+	}
+	return "127.0.0.1:9092"
+}
+
+// newConfig creates a config supporting record headers, which carry the trace context
+func newConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_0_0_0
+	config.Producer.Return.Successes = true
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	return config
+}
+
+func getHeader(headers []sarama.RecordHeader, key string) string {
+	for _, header := range headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
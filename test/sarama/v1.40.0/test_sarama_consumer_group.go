@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type handler struct {
+	cancel context.CancelFunc
+}
+
+func (h *handler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *handler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *handler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		// the span of the message is the parent of the spans of the handler
+		_, span := otel.Tracer("handler").Start(context.Background(), "handle")
+		span.End()
+		session.MarkMessage(msg, "")
+		h.cancel()
+		break
+	}
+	return nil
+}
+
+func main() {
+	config := newConfig()
+
+	producer, err := sarama.NewSyncProducer([]string{getKafkaAddress()}, config)
+	if err != nil {
+		panic(err)
+	}
+	if _, _, err = producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topicName,
+		Value: sarama.StringEncoder("hello consumer"),
+	}); err != nil {
+		panic(err)
+	}
+	if err = producer.Close(); err != nil {
+		panic(err)
+	}
+
+	group, err := sarama.NewConsumerGroup([]string{getKafkaAddress()}, groupName, config)
+	if err != nil {
+		panic(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if err = group.Consume(ctx, []string{topicName}, &handler{cancel: cancel}); err != nil {
+		panic(err)
+	}
+	if err = group.Close(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "kafka")
+		consumerSpan := stubs[1][0]
+		verifier.VerifyMQConsumeAttributes(consumerSpan, "", "", "", "process", topicName, "kafka")
+		group := verifier.GetAttribute(consumerSpan.Attributes, "messaging.consumer.group.name").AsString()
+		verifier.Assert(group == groupName, "Expect messaging.consumer.group.name to be %s, got %s", groupName, group)
+		verifier.Assert(len(consumerSpan.Links) == 1, "Expect the consumer span to have 1 link, got %d", len(consumerSpan.Links))
+		linked := consumerSpan.Links[0].SpanContext.SpanID()
+		verifier.Assert(linked == stubs[0][0].SpanContext.SpanID(), "Expect the consumer span to link to the producer span %s, got %s", stubs[0][0].SpanContext.SpanID(), linked)
+		verifier.Assert(len(stubs[1]) == 2, "Expect the handler to start a span within the consumer span, got %d spans", len(stubs[1]))
+		handle := stubs[1][1]
+		verifier.Assert(handle.Parent.SpanID() == consumerSpan.SpanContext.SpanID(), "Expect the span of the handler to be the child of the consumer span")
+		verifier.Assert(!consumerSpan.EndTime.Before(handle.EndTime), "Expect the consumer span to end once the handler is done with the message")
+	}, 2)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	config := newConfig()
+
+	// send a message synchronously
+	producer, err := sarama.NewSyncProducer([]string{getKafkaAddress()}, config)
+	if err != nil {
+		panic(err)
+	}
+	msg := &sarama.ProducerMessage{
+		Topic: topicName,
+		Key:   sarama.StringEncoder("sync-key"),
+		Value: sarama.StringEncoder("hello sync"),
+	}
+	partition, offset, err := producer.SendMessage(msg)
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(getHeader(msg.Headers, "traceparent") != "", "Expect the trace context to be injected into the message headers")
+
+	// send a batch of messages within the span of the caller
+	_, batch := otel.Tracer("producer").Start(context.Background(), "batch")
+	if err = producer.SendMessages([]*sarama.ProducerMessage{
+		{Topic: topicName, Value: sarama.StringEncoder("hello batch 1")},
+		{Topic: topicName, Value: sarama.StringEncoder("hello batch 2")},
+	}); err != nil {
+		panic(err)
+	}
+	batch.End()
+	if err = producer.Close(); err != nil {
+		panic(err)
+	}
+
+	// send a message asynchronously
+	asyncProducer, err := sarama.NewAsyncProducer([]string{getKafkaAddress()}, config)
+	if err != nil {
+		panic(err)
+	}
+	_, async := otel.Tracer("producer").Start(context.Background(), "async")
+	asyncProducer.Input() <- &sarama.ProducerMessage{
+		Topic: topicName,
+		Value: sarama.StringEncoder("hello async"),
+	}
+	select {
+	case asyncMsg := <-asyncProducer.Successes():
+		verifier.Assert(getHeader(asyncMsg.Headers, "traceparent") != "", "Expect the trace context to be injected into the message headers")
+	case producerErr := <-asyncProducer.Errors():
+		panic(producerErr)
+	}
+	async.End()
+	if err = asyncProducer.Close(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "kafka")
+		key := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.kafka.message.key").AsString()
+		verifier.Assert(key == "sync-key", "Expect messaging.kafka.message.key to be sync-key, got %s", key)
+		partitionId := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.destination.partition.id").AsString()
+		verifier.Assert(partitionId == strconv.Itoa(int(partition)), "Expect messaging.destination.partition.id to be %d, got %s", partition, partitionId)
+		actualOffset := verifier.GetAttribute(stubs[0][0].Attributes, "messaging.kafka.offset").AsInt64()
+		verifier.Assert(actualOffset == offset, "Expect messaging.kafka.offset to be %d, got %d", offset, actualOffset)
+		// the messages of a batch are siblings within the span of the caller
+		verifier.Assert(len(stubs[1]) == 3, "Expect the batch to have 2 messages, got %d spans", len(stubs[1])-1)
+		for _, span := range stubs[1][1:] {
+			verifier.VerifyMQPublishAttributes(span, "", "", "", "publish", topicName, "kafka")
+			verifier.Assert(span.Parent.SpanID() == stubs[1][0].SpanContext.SpanID(), "Expect the message to be the child of the caller of SendMessages")
+		}
+		// the message sent asynchronously is the child of the caller of Input()
+		verifier.Assert(len(stubs[2]) == 2, "Expect the async message to be in the trace of its caller, got %d spans", len(stubs[2]))
+		verifier.VerifyMQPublishAttributes(stubs[2][1], "", "", "", "publish", topicName, "kafka")
+		verifier.Assert(stubs[2][1].Parent.SpanID() == stubs[2][0].SpanContext.SpanID(), "Expect the async message to be the child of the caller of Input()")
+	}, 3)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+)
+
+const sarama_dependency_name = "github.com/IBM/sarama"
+const sarama_module_name = "sarama"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("sarama-1.40.0-producer-test", sarama_module_name, "v1.40.0", "v1.61.0", "1.18", "", TestSaramaProducer),
+		NewGeneralTestCase("sarama-1.40.0-consumer-group-test", sarama_module_name, "v1.40.0", "v1.61.0", "1.18", "", TestSaramaConsumerGroup),
+		NewMuzzleTestCase("sarama-muzzle-test", sarama_dependency_name, sarama_module_name, "v1.40.0", "v1.61.0", "1.18", "", []string{"go", "build", "test_sarama_producer.go", "base.go"}),
+		NewLatestDepthTestCase("sarama-latest-depth-test", sarama_dependency_name, sarama_module_name, "v1.40.0", "v1.61.0", "1.18", "", TestSaramaConsumerGroup),
+	)
+}
+
+func TestSaramaProducer(t *testing.T, env ...string) {
+	containers := initKafkaContainer(t)
+	defer containers.CleanupContainers(context.Background())
+	UseApp("sarama/v1.40.0")
+	RunGoBuild(t, "go", "build", "test_sarama_producer.go", "base.go")
+	env = append(env, "KAFKA_ADDR="+containers.KafkaAddress)
+	RunApp(t, "test_sarama_producer", env...)
+}
+
+func TestSaramaConsumerGroup(t *testing.T, env ...string) {
+	containers := initKafkaContainer(t)
+	defer containers.CleanupContainers(context.Background())
+	UseApp("sarama/v1.40.0")
+	RunGoBuild(t, "go", "build", "test_sarama_consumer_group.go", "base.go")
+	env = append(env, "KAFKA_ADDR="+containers.KafkaAddress)
+	RunApp(t, "test_sarama_consumer_group", env...)
+}
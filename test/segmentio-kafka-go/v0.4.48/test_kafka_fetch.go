@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	ctx := context.Background()
+
+	producer := initProducer()
+	defer producer.Close()
+
+	consumer := initConsumer()
+	defer consumer.Close()
+
+	if err := producer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte("key1"),
+		Value: []byte("hello world1"),
+	}, kafka.Message{
+		Key:   []byte("key2"),
+		Value: []byte("hello world2"),
+	}); err != nil {
+		panic(err)
+	}
+
+	// Fetch messages and commit them explicitly
+	first, err := consumer.FetchMessage(ctx)
+	if err != nil {
+		panic(err)
+	}
+	second, err := consumer.FetchMessage(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if err = consumer.CommitMessages(ctx, first, second); err != nil {
+		panic(err)
+	}
+
+	// Forward the first message, its headers still carry the context of the first batch
+	if err = producer.WriteMessages(ctx, kafka.Message{
+		Key:     first.Key,
+		Value:   first.Value,
+		Headers: first.Headers,
+	}); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		verifier.VerifyMQPublishAttributes(stubs[0][0], "", "", "", "publish", topicName, "kafka")
+		for i, key := range []string{"key1", "key2"} {
+			span := stubs[0][i+1]
+			verifier.VerifyMQConsumeAttributes(span, "", "", "", "process", topicName, "kafka")
+			actualKey := verifier.GetAttribute(span.Attributes, "messaging.kafka.message.key").AsString()
+			verifier.Assert(actualKey == key, "Expect messaging.kafka.message.key to be %s, got %s", key, actualKey)
+			group := verifier.GetAttribute(span.Attributes, "messaging.consumer.group.name").AsString()
+			verifier.Assert(group == groupName, "Expect messaging.consumer.group.name to be %s, got %s", groupName, group)
+			partition := verifier.GetAttribute(span.Attributes, "messaging.destination.partition.id").AsString()
+			verifier.Assert(partition == "0", "Expect messaging.destination.partition.id to be 0, got %s", partition)
+			lag := verifier.GetAttribute(span.Attributes, "messaging.kafka.consumer.lag").AsInt64()
+			verifier.Assert(lag >= 0, "Expect messaging.kafka.consumer.lag to be non negative, got %d", lag)
+		}
+		forward := stubs[1][0]
+		verifier.VerifyMQPublishAttributes(forward, "", "", "", "publish", topicName, "kafka")
+		verifier.Assert(len(forward.Links) == 1, "Expect the forwarding span to have 1 link, got %d", len(forward.Links))
+		linked := forward.Links[0].SpanContext.SpanID()
+		verifier.Assert(linked == stubs[0][0].SpanContext.SpanID(), "Expect the forwarding span to link to %s, got %s", stubs[0][0].SpanContext.SpanID(), linked)
+	}, 2)
+}
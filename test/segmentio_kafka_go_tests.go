@@ -33,6 +33,7 @@ const kafkaModuleName = "segmentio-kafka-go"
 func init() {
 	TestCases = append(TestCases,
 		NewGeneralTestCase("segmentio-kafka-go-basic-test", kafkaModuleName, "0.4.0", "", "1.18.0", "", TestBasicKafka),
+		NewGeneralTestCase("segmentio-kafka-go-fetch-test", kafkaModuleName, "0.4.0", "", "1.18.0", "", TestFetchKafka),
 	)
 }
 
@@ -45,6 +46,15 @@ func TestBasicKafka(t *testing.T, env ...string) {
 	RunApp(t, "test_kafka_basic", env...)
 }
 
+func TestFetchKafka(t *testing.T, env ...string) {
+	containers := initKafkaContainer(t)
+	defer containers.CleanupContainers(context.Background())
+	UseApp("segmentio-kafka-go/v0.4.48")
+	RunGoBuild(t, "go", "build", "test_kafka_fetch.go", "base.go")
+	env = append(env, "KAFKA_ADDR="+containers.KafkaAddress)
+	RunApp(t, "test_kafka_fetch", env...)
+}
+
 // KafkaContainers encapsulates Kafka and Zookeeper containers for unified management
 type KafkaContainers struct {
 	ZookeeperContainer testcontainers.Container
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	instance "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/api/iterator"
+)
+
+const (
+	projectID  = "otel-project"
+	instanceID = "otel-instance"
+	databaseID = "otel-database"
+)
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func setupDatabase(ctx context.Context) string {
+	instanceAdmin, err := instance.NewInstanceAdminClient(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer instanceAdmin.Close()
+	createInstance, err := instanceAdmin.CreateInstance(ctx, &instancepb.CreateInstanceRequest{
+		Parent:     "projects/" + projectID,
+		InstanceId: instanceID,
+		Instance: &instancepb.Instance{
+			Config:      fmt.Sprintf("projects/%s/instanceConfigs/emulator-config", projectID),
+			DisplayName: instanceID,
+			NodeCount:   1,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = createInstance.Wait(ctx); err != nil {
+		panic(err)
+	}
+
+	databaseAdmin, err := database.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer databaseAdmin.Close()
+	createDatabase, err := databaseAdmin.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
+		Parent:          fmt.Sprintf("projects/%s/instances/%s", projectID, instanceID),
+		CreateStatement: "CREATE DATABASE `" + databaseID + "`",
+		ExtraStatements: []string{"CREATE TABLE users (id INT64 NOT NULL, name STRING(64)) PRIMARY KEY (id)"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = createDatabase.Wait(ctx); err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("projects/%s/instances/%s/databases/%s", projectID, instanceID, databaseID)
+}
+
+func main() {
+	ctx := context.Background()
+	client, err := spanner.NewClient(ctx, setupDatabase(ctx))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+
+	_, err = client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		_, err := tx.Update(ctx, spanner.Statement{
+			SQL:    "INSERT INTO users (id, name) VALUES (@id, @name)",
+			Params: map[string]interface{}{"id": 1, "name": "otel"},
+		})
+		return err
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	iter := client.Single().Query(ctx, spanner.Statement{SQL: "SELECT name FROM users"})
+	for {
+		_, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+	iter.Stop()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		transaction, ok := findSpan(stubs, "ReadWriteTransaction")
+		verifier.Assert(ok, "Expect a span of the read write transaction")
+		system := verifier.GetAttribute(transaction.Attributes, "db.system.name").AsString()
+		verifier.Assert(system == "gcp.spanner", "Expect db.system.name to be gcp.spanner, got %s", system)
+
+		insert, ok := findSpan(stubs, "INSERT")
+		verifier.Assert(ok, "Expect a span of the insert statement")
+		verifier.Assert(insert.Parent.SpanID() == transaction.SpanContext.SpanID(), "Expect the insert to be run in the transaction")
+		statement := verifier.GetAttribute(insert.Attributes, "db.query.text").AsString()
+		verifier.Assert(statement == "INSERT INTO users (id, name) VALUES (@id, @name)", "Expect the statement to be recorded, got %s", statement)
+
+		_, ok = findSpan(stubs, "SELECT")
+		verifier.Assert(ok, "Expect a span of the query")
+		_, ok = findSpan(stubs, "TakeSession")
+		verifier.Assert(ok, "Expect a span taking a session from the pool")
+	}, 2)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const spanner_dependency_name = "cloud.google.com/go/spanner"
+const spanner_module_name = "spanner"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("spanner-1.50.0-test", spanner_module_name, "v1.50.0", "", "1.19", "", TestSpanner),
+		NewMuzzleTestCase("spanner-muzzle-test", spanner_dependency_name, spanner_module_name, "v1.50.0", "", "1.19", "", []string{"go", "build", "test_spanner.go"}),
+		NewLatestDepthTestCase("spanner-latest-depth-test", spanner_dependency_name, spanner_module_name, "v1.50.0", "", "1.19", "", TestSpanner),
+	)
+}
+
+func TestSpanner(t *testing.T, env ...string) {
+	_, emulatorPort := initSpannerEmulatorContainer()
+	UseApp("spanner/v1.50.0")
+	RunGoBuild(t, "go", "build", "test_spanner.go")
+	env = append(env, "SPANNER_EMULATOR_HOST=localhost:"+emulatorPort.Port())
+	RunApp(t, "test_spanner", env...)
+}
+
+func initSpannerEmulatorContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "gcr.io/cloud-spanner-emulator/emulator:latest",
+		ExposedPorts: []string{"9010/tcp"},
+		WaitingFor:   wait.ForLog("gRPC server listening"),
+	}
+	emulatorC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := emulatorC.MappedPort(context.Background(), "9010")
+	if err != nil {
+		panic(err)
+	}
+	return emulatorC, port
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	_ "github.com/glebarez/go-sqlite"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const sqlxScopeName = "pkg/rules/sqlx/setup.go"
+
+type user struct {
+	Id   int    `db:"id"`
+	Name string `db:"name"`
+}
+
+func sqlxTraces(stubs []tracetest.SpanStubs) []tracetest.SpanStubs {
+	traces := make([]tracetest.SpanStubs, 0)
+	for _, stub := range stubs {
+		if len(stub) > 0 && stub[0].InstrumentationScope.Name == sqlxScopeName {
+			traces = append(traces, stub)
+		}
+	}
+	return traces
+}
+
+func verifySqlxTrace(stub tracetest.SpanStubs, operation, statement string, argsCount int64) {
+	verifier.VerifyDbAttributes(stub[0], operation, "sqlite", "", statement, operation, "", nil)
+	actualCount := verifier.GetAttribute(stub[0].Attributes, "sqlx.args_count").AsInt64()
+	verifier.Assert(actualCount == argsCount, "Expect args count to be %d, got %d", argsCount, actualCount)
+	// sqlx helpers call each other, only the outermost call is traced
+	verifier.Assert(len(stub) == 2, "Expect a span of database/sql under sqlx, got %d spans", len(stub))
+	verifier.Assert(stub[1].InstrumentationScope.Name != sqlxScopeName, "Expect no nested sqlx span")
+	verifier.Assert(stub[1].Parent.SpanID() == stub[0].SpanContext.SpanID(), "Expect database/sql span to be the child of sqlx span")
+}
+
+func main() {
+	dir, err := os.MkdirTemp("", "sqlx")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := sqlx.Open("sqlite", filepath.Join(dir, "test.db"))
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	if _, err = db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT)"); err != nil {
+		panic(err)
+	}
+	if _, err = db.NamedExec("INSERT INTO users (id, name) VALUES (:id, :name)", &user{Id: 1, Name: "sqlx"}); err != nil {
+		panic(err)
+	}
+	var u user
+	if err = db.Get(&u, "SELECT id, name FROM users WHERE id = ?", 1); err != nil {
+		panic(err)
+	}
+	var users []user
+	if err = db.SelectContext(ctx, &users, "SELECT id, name FROM users WHERE id > ? AND name = ?", 0, "sqlx"); err != nil {
+		panic(err)
+	}
+	rows, err := db.NamedQuery("SELECT id, name FROM users WHERE name = :name", map[string]interface{}{"name": "sqlx"})
+	if err != nil {
+		panic(err)
+	}
+	rows.Close()
+	tx := db.MustBegin()
+	rows, err = tx.Queryx("SELECT id, name FROM users")
+	if err != nil {
+		panic(err)
+	}
+	rows.Close()
+	if err = tx.Commit(); err != nil {
+		panic(err)
+	}
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		traces := sqlxTraces(stubs)
+		verifier.Assert(len(traces) == 5, "Expect 5 traces of sqlx, got %d", len(traces))
+		verifySqlxTrace(traces[0], "INSERT", "INSERT INTO users (id, name) VALUES (?, ?)", 2)
+		verifySqlxTrace(traces[1], "SELECT", "SELECT id, name FROM users WHERE id = ?", 1)
+		verifySqlxTrace(traces[2], "SELECT", "SELECT id, name FROM users WHERE id > ? AND name = ?", 2)
+		verifySqlxTrace(traces[3], "SELECT", "SELECT id, name FROM users WHERE name = ?", 1)
+		verifySqlxTrace(traces[4], "SELECT", "SELECT id, name FROM users", 0)
+	}, 8)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const sqlx_dependency_name = "github.com/jmoiron/sqlx"
+const sqlx_module_name = "sqlx"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("sqlx-test", sqlx_module_name, "v1.3.0", "", "1.18", "", TestSqlx),
+		NewMuzzleTestCase("sqlx-muzzle-test", sqlx_dependency_name, sqlx_module_name, "v1.3.0", "", "1.18", "", []string{"go", "build", "test_sqlx.go"}),
+		NewLatestDepthTestCase("sqlx-latestdepth-test", sqlx_dependency_name, sqlx_module_name, "v1.3.0", "", "1.18", "", TestSqlx),
+	)
+}
+
+func TestSqlx(t *testing.T, env ...string) {
+	UseApp("sqlx/v1.3.1")
+	RunGoBuild(t, "go", "build", "test_sqlx.go")
+	RunApp(t, "test_sqlx", env...)
+}
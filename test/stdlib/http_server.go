@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type payload struct {
+	Key string `json:"key"`
+}
+
+// serveAndRequest runs the handler within a traced http request, so that the
+// standard library operations performed by the handler have a parent span
+func serveAndRequest(handler http.HandlerFunc) {
+	port, err := verifier.GetFreePort()
+	if err != nil {
+		panic(err)
+	}
+	http.HandleFunc("/stdlib", handler)
+	go func() {
+		if err := http.ListenAndServe(":"+strconv.Itoa(port), nil); err != nil {
+			panic(err)
+		}
+	}()
+	time.Sleep(1 * time.Second)
+	resp, err := http.Get("http://127.0.0.1:" + strconv.Itoa(port) + "/stdlib")
+	if err != nil {
+		panic(err)
+	}
+	resp.Body.Close()
+}
+
+func findSpan(stubs tracetest.SpanStubs, name string) tracetest.SpanStub {
+	for _, stub := range stubs {
+		if stub.Name == name {
+			return stub
+		}
+	}
+	verifier.Assert(false, "Expect to have span %s, but not found", name)
+	return tracetest.SpanStub{}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "child" {
+		fmt.Print(os.Getenv("TRACEPARENT"))
+		os.Exit(3)
+	}
+	var traceparent string
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		out, err := exec.Command(os.Args[0], "child").Output()
+		var exitErr *exec.ExitError
+		verifier.Assert(errors.As(err, &exitErr), "Expect the child to exit with an error, got %v", err)
+		traceparent = string(out)
+		w.Write([]byte("exec"))
+	})
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		span := findSpan(stubs[0], "exec "+filepath.Base(os.Args[0]))
+		verifier.Assert(span.Status.Code.String() == "Error", "Expect the span to fail")
+		verifier.Assert(verifier.GetAttribute(span.Attributes, "process.exit.code").AsInt64() == 3, "Expect process.exit.code to be 3")
+		verifier.Assert(verifier.GetAttribute(span.Attributes, "process.pid").AsInt64() > 0, "Expect process.pid to be set")
+		path := verifier.GetAttribute(span.Attributes, "process.executable.path").AsString()
+		verifier.Assert(filepath.Base(path) == filepath.Base(os.Args[0]), "Expect process.executable.path to be %s, got %s", os.Args[0], path)
+		// arguments are only recorded when asked to
+		args := verifier.GetAttribute(span.Attributes, "process.command_args").AsStringSlice()
+		if os.Getenv("OTEL_INSTRUMENTATION_EXEC_CAPTURE_ARGS") == "true" {
+			verifier.Assert(len(args) == 2 && args[1] == "child", "Expect process.command_args to end with child, got %v", args)
+		} else {
+			verifier.Assert(len(args) == 0, "Expect no process.command_args, got %v", args)
+		}
+		// the child process is started within the span
+		verifier.Assert(strings.Contains(traceparent, span.SpanContext.TraceID().String()+"-"+span.SpanContext.SpanID().String()),
+			"Expect TRACEPARENT of the child to refer to the span, got %s", traceparent)
+	}, 1)
+}
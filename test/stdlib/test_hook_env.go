@@ -0,0 +1,51 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		// Marshal hook is disabled by its own environment variable
+		data, err := json.Marshal(payload{Key: "value"})
+		if err != nil {
+			panic(err)
+		}
+		var p payload
+		if err = json.Unmarshal(data, &p); err != nil {
+			panic(err)
+		}
+		w.Write(data)
+	})
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var marshal, unmarshal int
+		for _, stub := range stubs[0] {
+			switch stub.Name {
+			case "json.marshal":
+				marshal++
+			case "json.unmarshal":
+				unmarshal++
+			}
+		}
+		verifier.Assert(marshal == 0, "Expect no json.marshal span, got %d", marshal)
+		verifier.Assert(unmarshal == 1, "Expect one json.unmarshal span, got %d", unmarshal)
+	}, 1)
+}
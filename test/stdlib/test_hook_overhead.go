@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type overheadStats struct {
+	Calls   int64 `json:"calls"`
+	Nanos   int64 `json:"nanos"`
+	Panics  int64 `json:"panics"`
+	Dropped int64 `json:"dropped"`
+}
+
+func fetchOverhead() map[string]overheadStats {
+	port := os.Getenv("OTEL_INSTRUMENTATION_HOOK_ADMIN_PORT")
+	resp, err := http.Get("http://127.0.0.1:" + port + "/overhead")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	verifier.Assert(resp.StatusCode == http.StatusOK, "Expect to fetch overhead, got %d", resp.StatusCode)
+	list := map[string]overheadStats{}
+	if err = json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		panic(err)
+	}
+	return list
+}
+
+func main() {
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		var data []byte
+		for i := 0; i < 3; i++ {
+			var err error
+			data, err = json.Marshal(payload{Key: "value"})
+			if err != nil {
+				panic(err)
+			}
+		}
+		w.Write(data)
+	})
+	list := fetchOverhead()
+	marshal := list["gojson.jsonMarshalOnEnter"]
+	verifier.Assert(marshal.Calls >= 3, "Expect at least 3 calls of json.Marshal hooks, got %d", marshal.Calls)
+	verifier.Assert(marshal.Nanos > 0, "Expect time spent in json.Marshal hooks, got %d", marshal.Nanos)
+	verifier.Assert(marshal.Panics == 0, "Expect no panicked json.Marshal hooks, got %d", marshal.Panics)
+	scope, ok := list["pkg/rules/gojson/setup.go"]
+	verifier.Assert(ok, "Expect the spans of gojson to be accounted")
+	verifier.Assert(scope.Dropped == 0, "Expect no dropped json.marshal spans, got %d", scope.Dropped)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		count := 0
+		for _, stub := range stubs[0] {
+			if stub.Name == "json.marshal" {
+				count++
+			}
+		}
+		verifier.Assert(count == 3, "Expect 3 json.marshal spans, got %d", count)
+	}, 1)
+}
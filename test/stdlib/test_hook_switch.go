@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func switchHook(name string, enabled string) {
+	port := os.Getenv("OTEL_INSTRUMENTATION_HOOK_ADMIN_PORT")
+	url := "http://127.0.0.1:" + port + "/hooks?name=" + name + "&enabled=" + enabled
+	resp, err := http.Post(url, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	resp.Body.Close()
+	verifier.Assert(resp.StatusCode == http.StatusOK, "Expect to switch hook %s, got %d", name, resp.StatusCode)
+}
+
+func main() {
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		// Marshal hook is disabled via environment variable at startup
+		data, err := json.Marshal(payload{Key: "value"})
+		if err != nil {
+			panic(err)
+		}
+		var p payload
+		if err = json.Unmarshal(data, &p); err != nil {
+			panic(err)
+		}
+		// Switch hooks at runtime via admin endpoint
+		switchHook("gojson.jsonMarshalOnEnter", "true")
+		switchHook("gojson.jsonUnmarshalOnEnter", "false")
+		if data, err = json.Marshal(payload{Key: "value"}); err != nil {
+			panic(err)
+		}
+		if err = json.Unmarshal(data, &p); err != nil {
+			panic(err)
+		}
+		w.Write(data)
+	})
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var marshal, unmarshal []tracetest.SpanStub
+		for _, stub := range stubs[0] {
+			switch stub.Name {
+			case "json.marshal":
+				marshal = append(marshal, stub)
+			case "json.unmarshal":
+				unmarshal = append(unmarshal, stub)
+			}
+		}
+		verifier.Assert(len(marshal) == 1, "Expect one json.marshal span, got %d", len(marshal))
+		verifier.Assert(len(unmarshal) == 1, "Expect one json.unmarshal span, got %d", len(unmarshal))
+		verifier.Assert(unmarshal[0].StartTime.Before(marshal[0].StartTime), "Expect json.unmarshal span to be recorded before switching hooks")
+	}, 1)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			panic(err)
+		}
+		defer ln.Close()
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			panic(err)
+		}
+		conn.Close()
+		if _, err = net.DefaultResolver.LookupIPAddr(r.Context(), "localhost"); err != nil {
+			panic(err)
+		}
+		w.Write([]byte("net"))
+	})
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		listen := findSpan(stubs[0], "net.listen")
+		verifier.Assert(listen.SpanKind == trace.SpanKindInternal, "Expect to be internal span, got %v", listen.SpanKind)
+		verifier.Assert(verifier.GetAttribute(listen.Attributes, "network.transport").AsString() == "tcp", "Expect network.transport to be tcp")
+		verifier.Assert(verifier.GetAttribute(listen.Attributes, "network.local.port").AsInt64() > 0, "Expect network.local.port to be resolved")
+		dial := findSpan(stubs[0], "net.dial")
+		verifier.Assert(dial.SpanKind == trace.SpanKindClient, "Expect to be client span, got %v", dial.SpanKind)
+		verifier.Assert(verifier.GetAttribute(dial.Attributes, "server.address").AsString() == "127.0.0.1", "Expect server.address to be 127.0.0.1")
+		verifier.Assert(verifier.GetAttribute(dial.Attributes, "network.peer.address").AsString() == "127.0.0.1", "Expect network.peer.address to be 127.0.0.1")
+		lookup := findSpan(stubs[0], "net.lookup")
+		verifier.Assert(lookup.SpanKind == trace.SpanKindClient, "Expect to be client span, got %v", lookup.SpanKind)
+		verifier.Assert(verifier.GetAttribute(lookup.Attributes, "dns.question.name").AsString() == "localhost", "Expect dns.question.name to be localhost")
+		verifier.Assert(len(verifier.GetAttribute(lookup.Attributes, "dns.answers").AsStringSlice()) > 0, "Expect localhost to be resolved")
+	}, 1)
+}
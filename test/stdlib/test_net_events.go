@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func main() {
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			panic(err)
+		}
+		defer ln.Close()
+		port := ln.Addr().(*net.TCPAddr).Port
+		conn, err := net.Dial("tcp", "localhost:"+strconv.Itoa(port))
+		if err != nil {
+			panic(err)
+		}
+		conn.Close()
+		w.Write([]byte("net"))
+	})
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var server tracetest.SpanStub
+		for _, stub := range stubs[0] {
+			verifier.Assert(stub.Name != "net.dial" && stub.Name != "net.lookup", "Expect no span of %s", stub.Name)
+			if stub.SpanKind == trace.SpanKindServer {
+				server = stub
+			}
+		}
+		events := map[string]bool{}
+		for _, event := range server.Events {
+			events[event.Name] = true
+			if event.Name == "net.lookup" {
+				verifier.Assert(verifier.GetAttribute(event.Attributes, "dns.question.name").AsString() == "localhost", "Expect dns.question.name to be localhost")
+			}
+			if event.Name == "net.dial" {
+				verifier.Assert(verifier.GetAttribute(event.Attributes, "net.operation.duration").AsFloat64() > 0, "Expect the duration of dial to be recorded")
+			}
+		}
+		verifier.Assert(events["net.lookup"] && events["net.dial"], "Expect events of lookup and dial, got %v", events)
+	}, 1)
+}
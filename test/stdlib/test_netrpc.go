@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/rpc"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Args struct {
+	A, B int
+}
+
+type Arith int
+
+func (t *Arith) Multiply(args *Args, reply *int) error {
+	*reply = args.A * args.B
+	return nil
+}
+
+func (t *Arith) Divide(args *Args, reply *int) error {
+	if args.B == 0 {
+		return errors.New("divide by zero")
+	}
+	*reply = args.A / args.B
+	return nil
+}
+
+func main() {
+	if err := rpc.Register(new(Arith)); err != nil {
+		panic(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go rpc.Accept(l)
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		client, err := rpc.Dial("tcp", l.Addr().String())
+		if err != nil {
+			panic(err)
+		}
+		defer client.Close()
+		var reply int
+		if err = client.Call("Arith.Multiply", &Args{A: 7, B: 8}, &reply); err != nil {
+			panic(err)
+		}
+		verifier.Assert(reply == 56, "Expect reply 56, got %d", reply)
+		call := client.Go("Arith.Divide", &Args{A: 1, B: 0}, &reply, nil)
+		<-call.Done
+		verifier.Assert(call.Error != nil, "Expect an error dividing by zero")
+		w.Write([]byte("netrpc"))
+	})
+	// net/rpc carries no metadata, the calls served are traced on their own
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		var all tracetest.SpanStubs
+		for _, t := range stubs {
+			all = append(all, t...)
+		}
+		multiply := findSpanOfKind(all, "Arith.Multiply", trace.SpanKindClient)
+		verifier.Assert(verifier.GetAttribute(multiply.Attributes, "rpc.system").AsString() == "net_rpc", "Expect rpc.system to be net_rpc")
+		verifier.Assert(verifier.GetAttribute(multiply.Attributes, "rpc.service").AsString() == "Arith", "Expect rpc.service to be Arith")
+		verifier.Assert(verifier.GetAttribute(multiply.Attributes, "rpc.method").AsString() == "Multiply", "Expect rpc.method to be Multiply")
+		divide := findSpanOfKind(all, "Arith.Divide", trace.SpanKindClient)
+		verifier.Assert(divide.Status.Code.String() == "Error", "Expect the client span of Divide to fail")
+		served := findSpanOfKind(all, "Arith.Divide", trace.SpanKindServer)
+		verifier.Assert(served.Status.Code.String() == "Error", "Expect the server span of Divide to fail")
+		findSpanOfKind(all, "Arith.Multiply", trace.SpanKindServer)
+	}, 3)
+}
+
+func findSpanOfKind(stubs tracetest.SpanStubs, name string, kind trace.SpanKind) tracetest.SpanStub {
+	for _, stub := range stubs {
+		if stub.Name == name && stub.SpanKind == kind {
+			return stub
+		}
+	}
+	verifier.Assert(false, "Expect to have %s span %s, but not found", kind, name)
+	return tracetest.SpanStub{}
+}
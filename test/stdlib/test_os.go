@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	path := filepath.Join(os.TempDir(), "otel-stdlib-os-test")
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			panic(err)
+		}
+		f.Close()
+		if err = exec.Command("go", "version").Run(); err != nil {
+			panic(err)
+		}
+		w.Write([]byte("os"))
+	})
+	defer os.Remove(path)
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		open := findSpan(stubs[0], "os.open")
+		verifier.Assert(verifier.GetAttribute(open.Attributes, "file.path").AsString() == path, "Expect file.path to be %s", path)
+		verifier.Assert(verifier.GetAttribute(open.Attributes, "os.file.flag").AsInt64() == int64(os.O_CREATE|os.O_WRONLY), "Expect os.file.flag to be set")
+		process := findSpan(stubs[0], "os.start_process")
+		verifier.Assert(verifier.GetAttribute(process.Attributes, "process.pid").AsInt64() > 0, "Expect process.pid to be set")
+		args := verifier.GetAttribute(process.Attributes, "process.command_args").AsStringSlice()
+		verifier.Assert(len(args) == 2 && args[1] == "version", "Expect process.command_args to be [go version], got %v", args)
+	}, 1)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func main() {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("tls"))
+	}))
+	defer tlsServer.Close()
+	serveAndRequest(func(w http.ResponseWriter, r *http.Request) {
+		resp, err := tlsServer.Client().Get(tlsServer.URL)
+		if err != nil {
+			panic(err)
+		}
+		resp.Body.Close()
+		w.Write([]byte("tls"))
+	})
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		handshake := findSpan(stubs[0], "tls.handshake")
+		verifier.Assert(verifier.GetAttribute(handshake.Attributes, "tls.established").AsBool(), "Expect tls.established to be true")
+		verifier.Assert(verifier.GetAttribute(handshake.Attributes, "tls.protocol.name").AsString() == "tls", "Expect tls.protocol.name to be tls")
+		verifier.Assert(verifier.GetAttribute(handshake.Attributes, "tls.protocol.version").AsString() != "", "Expect tls.protocol.version to be set")
+		verifier.Assert(verifier.GetAttribute(handshake.Attributes, "tls.cipher").AsString() != "", "Expect tls.cipher to be set")
+	}, 1)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("stdlib-net-test", "stdlib", "", "", "1.18", "", TestStdlibNet),
+		NewGeneralTestCase("stdlib-net-events-test", "stdlib", "", "", "1.18", "", TestStdlibNetEvents),
+		NewGeneralTestCase("stdlib-os-test", "stdlib", "", "", "1.18", "", TestStdlibOs),
+		NewGeneralTestCase("stdlib-exec-test", "stdlib", "", "", "1.18", "", TestStdlibExec),
+		NewGeneralTestCase("stdlib-tls-test", "stdlib", "", "", "1.18", "", TestStdlibTls),
+		NewGeneralTestCase("stdlib-json-test", "stdlib", "", "", "1.18", "", TestStdlibJson),
+		NewGeneralTestCase("stdlib-netrpc-test", "stdlib", "", "", "1.18", "", TestStdlibNetRpc),
+		NewGeneralTestCase("stdlib-hook-switch-test", "stdlib", "", "", "1.18", "", TestStdlibHookSwitch),
+		NewGeneralTestCase("stdlib-hook-env-test", "stdlib", "", "", "1.18", "", TestStdlibHookEnv),
+		NewGeneralTestCase("stdlib-hook-overhead-test", "stdlib", "", "", "1.18", "", TestStdlibHookOverhead),
+	)
+}
+
+func TestStdlibNet(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_net.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_NET_ENABLED=true")
+	RunApp(t, "test_net", env...)
+}
+
+func TestStdlibNetEvents(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_net_events.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_NET_ENABLED=true", "OTEL_INSTRUMENTATION_NET_EVENTS_ENABLED=true")
+	RunApp(t, "test_net_events", env...)
+}
+
+func TestStdlibOs(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_os.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_OS_ENABLED=true")
+	RunApp(t, "test_os", env...)
+}
+
+func TestStdlibExec(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_exec.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_EXEC_PROPAGATION_ENABLED=true")
+	RunApp(t, "test_exec", env...)
+	RunApp(t, "test_exec", append(env, "OTEL_INSTRUMENTATION_EXEC_CAPTURE_ARGS=true")...)
+}
+
+func TestStdlibTls(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_tls.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_TLS_ENABLED=true")
+	RunApp(t, "test_tls", env...)
+}
+
+func TestStdlibJson(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_json.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_JSON_ENABLED=true")
+	RunApp(t, "test_json", env...)
+}
+
+func TestStdlibNetRpc(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_netrpc.go", "http_server.go")
+	RunApp(t, "test_netrpc", env...)
+}
+
+func TestStdlibHookSwitch(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_hook_switch.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_JSON_ENABLED=true",
+		"OTEL_INSTRUMENTATION_DISABLED_HOOKS=gojson.jsonMarshalOnEnter",
+		"OTEL_INSTRUMENTATION_HOOK_ADMIN_PORT=9465")
+	RunApp(t, "test_hook_switch", env...)
+}
+
+func TestStdlibHookEnv(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_hook_env.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_JSON_ENABLED=true",
+		"OTEL_INSTRUMENTATION_GOJSON_JSONMARSHALONENTER_ENABLED=false")
+	RunApp(t, "test_hook_env", env...)
+}
+
+func TestStdlibHookOverhead(t *testing.T, env ...string) {
+	UseApp("stdlib")
+	RunGoBuild(t, "go", "build", "test_hook_overhead.go", "http_server.go")
+	env = append(env, "OTEL_INSTRUMENTATION_JSON_ENABLED=true",
+		"OTEL_INSTRUMENTATION_HOOK_ADMIN_PORT=9465",
+		"OTEL_INSTRUMENTATION_OVERHEAD_ENABLED=true")
+	RunApp(t, "test_hook_overhead", env...)
+}
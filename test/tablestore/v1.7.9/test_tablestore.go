@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tableName = "otel_table"
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	// the server stands in for TableStore and rejects every request, which
+	// is enough to trace a request without a TableStore instance
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	config := tablestore.NewDefaultTableStoreConfig()
+	config.RetryTimes = 0
+	client := tablestore.NewClientWithConfig(server.URL, "otel", "ak", "sk", "", config)
+	pk := new(tablestore.PrimaryKey)
+	pk.AddPrimaryKeyColumn("id", "1")
+	_, err := client.GetRow(&tablestore.GetRowRequest{
+		SingleRowQueryCriteria: &tablestore.SingleRowQueryCriteria{
+			TableName:  tableName,
+			PrimaryKey: pk,
+			MaxVersion: 1,
+		},
+	})
+	verifier.Assert(err != nil, "Expect the request to be rejected")
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		get, ok := findSpan(stubs, "TableStore/GetRow")
+		verifier.Assert(ok, "Expect a span getting the row")
+		verifier.Assert(get.SpanKind == trace.SpanKindClient, "Expect a client span, got %s", get.SpanKind)
+		verifier.Assert(get.Status.Code == codes.Error, "Expect an error status, got %s", get.Status.Code)
+		table := verifier.GetAttribute(get.Attributes, "aliyun.tablestore.table").AsString()
+		verifier.Assert(table == tableName, "Expect aliyun.tablestore.table to be %s, got %s", tableName, table)
+	}, 1)
+}
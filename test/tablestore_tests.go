@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+const tablestore_dependency_name = "github.com/aliyun/aliyun-tablestore-go-sdk"
+const tablestore_module_name = "tablestore"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("tablestore-1.7.9-test", tablestore_module_name, "v1.7.9", "", "1.18", "", TestTablestore),
+		NewMuzzleTestCase("tablestore-muzzle-test", tablestore_dependency_name, tablestore_module_name, "v1.7.9", "", "1.18", "", []string{"go", "build", "test_tablestore.go"}),
+		NewLatestDepthTestCase("tablestore-latest-depth-test", tablestore_dependency_name, tablestore_module_name, "v1.7.9", "", "1.18", "", TestTablestore),
+	)
+}
+
+func TestTablestore(t *testing.T, env ...string) {
+	UseApp("tablestore/v1.7.9")
+	RunGoBuild(t, "go", "build", "test_tablestore.go")
+	RunApp(t, "test_tablestore", env...)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+const taskQueue = "greeting"
+
+func SayHello(ctx context.Context, name string) (string, error) {
+	return "hello " + name, nil
+}
+
+func Greet(ctx workflow.Context, name string) (string, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: 10 * time.Second})
+	var greeting string
+	err := workflow.ExecuteActivity(ctx, SayHello, name).Get(ctx, &greeting)
+	return greeting, err
+}
+
+func findSpan(stubs []tracetest.SpanStubs, name string) (tracetest.SpanStub, bool) {
+	for _, stub := range stubs {
+		for _, span := range stub {
+			if span.Name == name {
+				return span, true
+			}
+		}
+	}
+	return tracetest.SpanStub{}, false
+}
+
+func main() {
+	c, err := client.Dial(client.Options{HostPort: "localhost:" + os.Getenv("TEMPORAL_PORT")})
+	if err != nil {
+		panic(err)
+	}
+	w := worker.New(c, taskQueue, worker.Options{})
+	w.RegisterWorkflow(Greet)
+	w.RegisterActivity(SayHello)
+	if err = w.Start(); err != nil {
+		panic(err)
+	}
+
+	run, err := c.ExecuteWorkflow(context.Background(), client.StartWorkflowOptions{ID: "greet-otel", TaskQueue: taskQueue}, Greet, "otel")
+	if err != nil {
+		panic(err)
+	}
+	var greeting string
+	if err = run.Get(context.Background(), &greeting); err != nil {
+		panic(err)
+	}
+	w.Stop()
+	c.Close()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		start, ok := findSpan(stubs, "StartWorkflow:Greet")
+		verifier.Assert(ok, "Expect a span starting the workflow")
+		workflowID := verifier.GetAttribute(start.Attributes, "temporalWorkflowID").AsString()
+		verifier.Assert(workflowID == "greet-otel", "Expect temporalWorkflowID to be greet-otel, got %s", workflowID)
+
+		runWorkflow, ok := findSpan(stubs, "RunWorkflow:Greet")
+		verifier.Assert(ok, "Expect a span running the workflow")
+		verifier.Assert(runWorkflow.Parent.SpanID() == start.SpanContext.SpanID(), "Expect the workflow to be run under the span starting it")
+		runID := verifier.GetAttribute(runWorkflow.Attributes, "temporalRunID").AsString()
+		verifier.Assert(runID == run.GetRunID(), "Expect temporalRunID to be %s, got %s", run.GetRunID(), runID)
+
+		startActivity, ok := findSpan(stubs, "StartActivity:SayHello")
+		verifier.Assert(ok, "Expect a span starting the activity")
+		verifier.Assert(startActivity.Parent.SpanID() == runWorkflow.SpanContext.SpanID(), "Expect the activity to be started by the workflow")
+
+		runActivity, ok := findSpan(stubs, "RunActivity:SayHello")
+		verifier.Assert(ok, "Expect a span running the activity")
+		verifier.Assert(runActivity.Parent.SpanID() == startActivity.SpanContext.SpanID(), "Expect the activity to be run under the span starting it")
+		verifier.Assert(runActivity.SpanContext.TraceID() == start.SpanContext.TraceID(), "Expect the activity to be in the trace of the workflow")
+	}, 1)
+}
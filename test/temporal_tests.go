@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const temporal_dependency_name = "go.temporal.io/sdk"
+const temporal_module_name = "temporal"
+
+func init() {
+	TestCases = append(TestCases, NewGeneralTestCase("temporal-1.26.0-test", temporal_module_name, "v1.26.0", "", "1.21", "", TestTemporal),
+		NewMuzzleTestCase("temporal-muzzle-test", temporal_dependency_name, temporal_module_name, "v1.26.0", "", "1.21", "", []string{"go", "build", "test_temporal.go"}),
+		NewLatestDepthTestCase("temporal-latest-depth-test", temporal_dependency_name, temporal_module_name, "v1.26.0", "", "1.21", "", TestTemporal),
+	)
+}
+
+func TestTemporal(t *testing.T, env ...string) {
+	_, temporalPort := initTemporalContainer()
+	UseApp("temporal/v1.26.0")
+	RunGoBuild(t, "go", "build", "test_temporal.go")
+	env = append(env, "TEMPORAL_PORT="+temporalPort.Port())
+	RunApp(t, "test_temporal", env...)
+}
+
+func initTemporalContainer() (testcontainers.Container, nat.Port) {
+	req := testcontainers.ContainerRequest{
+		Image:        "temporalio/temporal:latest",
+		Cmd:          []string{"server", "start-dev", "--ip", "0.0.0.0"},
+		ExposedPorts: []string{"7233/tcp"},
+		WaitingFor:   wait.ForListeningPort("7233/tcp"),
+	}
+	temporalC, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	port, err := temporalC.MappedPort(context.Background(), "7233")
+	if err != nil {
+		panic(err)
+	}
+	return temporalC, port
+}
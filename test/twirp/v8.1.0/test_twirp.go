@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type haberdasher struct{}
+
+func (h *haberdasher) MakeHat(ctx context.Context, size *Size) (*Hat, error) {
+	if size.Inches <= 0 {
+		return nil, twirp.InvalidArgumentError("Inches", "I can't make a hat that small!")
+	}
+	return &Hat{Size: size.Inches, Color: "blue", Name: "bowler"}, nil
+}
+
+func main() {
+	go http.ListenAndServe("127.0.0.1:8080", NewHaberdasherServer(&haberdasher{}))
+	time.Sleep(3 * time.Second)
+	client := NewHaberdasherProtobufClient("http://127.0.0.1:8080", http.DefaultClient)
+	hat, err := client.MakeHat(context.Background(), &Size{Inches: 12})
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(hat.Size == 12, "Expect hat size 12, got %d", hat.Size)
+	_, err = client.MakeHat(context.Background(), &Size{Inches: 0})
+	verifier.Assert(err != nil, "Expect an error for a hat that small")
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		for _, stub := range stubs {
+			verifier.Assert(len(stub) == 4, "Expect 4 spans, got %d", len(stub))
+			verifier.VerifyRpcClientAttributes(stub[0], "twitch.twirp.example.Haberdasher/MakeHat", "twirp", "twitch.twirp.example.Haberdasher", "MakeHat")
+			verifier.VerifyRpcServerAttributes(stub[3], "twitch.twirp.example.Haberdasher/MakeHat", "twirp", "twitch.twirp.example.Haberdasher", "MakeHat")
+			for i := 1; i < 4; i++ {
+				verifier.Assert(stub[i].Parent.SpanID() == stub[i-1].SpanContext.SpanID(), "Expect span %d to be the child of span %d", i, i-1)
+			}
+		}
+		code := verifier.GetAttribute(stubs[1][0].Attributes, "rpc.twirp.error_code").AsString()
+		verifier.Assert(code == "invalid_argument", "Expect error code invalid_argument on the client, got %s", code)
+		code = verifier.GetAttribute(stubs[1][3].Attributes, "rpc.twirp.error_code").AsString()
+		verifier.Assert(code == "invalid_argument", "Expect error code invalid_argument on the server, got %s", code)
+	}, 2)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/test/verifier"
+	vault "github.com/hashicorp/vault/api"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const password = "hunter2"
+
+// server serves a kv v2 secret at secret/app, the approle login and the
+// renewal of the token it issues.
+func server(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method + " " + r.URL.Path {
+	case "PUT /v1/auth/approle/login":
+		w.Write([]byte(`{"auth":{"client_token":"s.token","lease_duration":3600,"renewable":true}}`))
+	case "PUT /v1/auth/token/renew-self":
+		w.Write([]byte(`{"auth":{"client_token":"s.token","lease_duration":60,"renewable":true}}`))
+	case "GET /v1/secret/data/app":
+		w.Write([]byte(`{"data":{"data":{"password":"` + password + `"}}}`))
+	case "PUT /v1/secret/data/app":
+		w.Write([]byte(`{"data":{"version":2}}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[]}`))
+	}
+}
+
+type approle struct{}
+
+func (a approle) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	return client.Logical().WriteWithContext(ctx, "auth/approle/login", map[string]interface{}{
+		"role_id":   "app",
+		"secret_id": password,
+	})
+}
+
+func main() {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go http.Serve(ln, http.HandlerFunc(server))
+
+	config := vault.DefaultConfig()
+	config.Address = "http://" + ln.Addr().String()
+	client, err := vault.NewClient(config)
+	if err != nil {
+		panic(err)
+	}
+	login, err := client.Auth().Login(context.Background(), approle{})
+	if err != nil {
+		panic(err)
+	}
+	secret, err := client.Logical().Read("secret/data/app")
+	if err != nil {
+		panic(err)
+	}
+	verifier.Assert(secret.Data["data"].(map[string]interface{})["password"] == password, "Expect the password to be read")
+	if _, err := client.Logical().Write("secret/data/app", map[string]interface{}{
+		"data": map[string]interface{}{"password": password},
+	}); err != nil {
+		panic(err)
+	}
+	missing, err := client.Logical().Read("secret/data/missing")
+	verifier.Assert(missing == nil && err == nil, "Expect the missing secret not to be found, got %v", err)
+
+	watcher, err := client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{Secret: login})
+	if err != nil {
+		panic(err)
+	}
+	go watcher.Start()
+	<-watcher.RenewCh()
+	watcher.Stop()
+
+	verifier.WaitAndAssertTraces(func(stubs []tracetest.SpanStubs) {
+		spans := map[string]tracetest.SpanStub{}
+		for _, stub := range stubs {
+			for _, span := range stub {
+				for _, attr := range span.Attributes {
+					verifier.Assert(!strings.Contains(attr.Value.Emit(), password), "Expect no secret in %s of %s", attr.Key, span.Name)
+				}
+				if !strings.HasPrefix(span.Name, "vault ") {
+					continue
+				}
+				name := span.Name
+				if verifier.GetAttribute(span.Attributes, "http.response.status_code").AsInt64() == 404 {
+					name += " missing"
+				}
+				spans[name] = span
+			}
+		}
+		login := spans["vault login auth/approle"]
+		verifier.Assert(login.SpanKind == trace.SpanKindClient, "Expect the login to be a client span, got %v", login.SpanKind)
+		verifier.Assert(verifier.GetAttribute(login.Attributes, "vault.operation").AsString() == "login", "Expect vault.operation to be login")
+		verifier.Assert(verifier.GetAttribute(login.Attributes, "vault.mount").AsString() == "auth/approle", "Expect vault.mount to be auth/approle")
+		read := spans["vault read secret"]
+		verifier.Assert(verifier.GetAttribute(read.Attributes, "vault.mount").AsString() == "secret", "Expect vault.mount to be secret")
+		verifier.Assert(verifier.GetAttribute(read.Attributes, "http.response.status_code").AsInt64() == 200, "Expect status 200 for the read")
+		write := spans["vault write secret"]
+		verifier.Assert(verifier.GetAttribute(write.Attributes, "http.request.method").AsString() == "PUT", "Expect the write to be a PUT")
+		_, ok := spans["vault read secret missing"]
+		verifier.Assert(ok, "Expect a read of the missing secret")
+		renew := spans["vault renew token"]
+		verifier.Assert(renew.SpanKind == trace.SpanKindInternal, "Expect the renewal to be an internal span, got %v", renew.SpanKind)
+		verifier.Assert(verifier.GetAttribute(renew.Attributes, "vault.lease.duration").AsInt64() == 60, "Expect vault.lease.duration to be 60")
+		renewSelf := spans["vault renew auth/token"]
+		verifier.Assert(renewSelf.Parent.SpanID() == renew.SpanContext.SpanID(), "Expect the renewal request to be made by the lifetime watcher")
+	}, 5)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import "testing"
+
+const vault_dependency_name = "github.com/hashicorp/vault/api"
+const vault_module_name = "vault"
+
+func init() {
+	TestCases = append(TestCases,
+		NewGeneralTestCase("vault-test", vault_module_name, "v1.9.2", "v1.23.0", "1.19", "", TestVault),
+		NewMuzzleTestCase("vault-muzzle-test", vault_dependency_name, vault_module_name, "v1.9.2", "v1.23.0", "1.19", "", []string{"go", "build", "test_vault.go"}),
+		NewLatestDepthTestCase("vault-latestdepth-test", vault_dependency_name, vault_module_name, "v1.9.2", "v1.23.0", "1.19", "", TestVault),
+	)
+}
+
+func TestVault(t *testing.T, env ...string) {
+	UseApp("vault/v1.9.2")
+	RunGoBuild(t, "go", "build", "test_vault.go")
+	RunApp(t, "test_vault", env...)
+}
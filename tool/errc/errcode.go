@@ -44,6 +44,14 @@ const (
 	ErrGetExecutable
 	ErrInstrument
 	ErrPreprocess
+	ErrInvalidYAML
+	ErrFetchRule
+	ErrRuleConflict
+	ErrScaffold
+	ErrVerify
+	ErrSimulate
+	ErrMirror
+	ErrTelemetry
 )
 
 var errMessages = map[int]string{
@@ -70,6 +78,14 @@ var errMessages = map[int]string{
 	ErrNotModularized: "Not a modularized project",
 	ErrGetExecutable:  "Failed to get executable",
 	ErrInstrument:     "Failed to instrument",
+	ErrInvalidYAML:    "Invalid YAML",
+	ErrFetchRule:      "Failed to fetch rule",
+	ErrRuleConflict:   "Conflicting rules",
+	ErrScaffold:       "Failed to scaffold rule",
+	ErrVerify:         "Failed to verify binary",
+	ErrSimulate:       "Failed to simulate rules",
+	ErrMirror:         "Failed to mirror",
+	ErrTelemetry:      "Failed to document telemetry",
 }
 
 type PlentifulError struct {
@@ -0,0 +1,327 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrument
+
+import (
+	"fmt"
+	"go/token"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"github.com/dave/dst"
+	"github.com/dave/dst/dstutil"
+)
+
+// -----------------------------------------------------------------------------
+// Raw Code Injection
+//
+// Raw rules may splice code snippets into the target function at its start,
+// before every return, or before and after the statements whose source code
+// matches the pattern, for cases that hooks can not cover, e.g. instrumenting
+// a statement in the middle of the function. Packages used by the snippets are
+// imported into the target file by the names that the snippets refer to. Note
+// that the import config of the compilation is decided by go build, so these
+// packages must be dependencies of the target package.
+
+func cloneStmts(stmts []dst.Stmt) []dst.Stmt {
+	cloned := make([]dst.Stmt, 0, len(stmts))
+	for _, stmt := range stmts {
+		cloned = append(cloned, dst.Clone(stmt).(dst.Stmt))
+	}
+	return cloned
+}
+
+func insertBefore(c *dstutil.Cursor, snippet []dst.Stmt) {
+	for _, stmt := range cloneStmts(snippet) {
+		c.InsertBefore(stmt)
+	}
+}
+
+func insertAfter(c *dstutil.Cursor, snippet []dst.Stmt) {
+	cloned := cloneStmts(snippet)
+	for i := len(cloned) - 1; i >= 0; i-- {
+		c.InsertAfter(cloned[i])
+	}
+}
+
+// injectBeforeReturns splices the snippet before every return statement of the
+// function, including the implicit one at the end of the function body
+func injectBeforeReturns(decl *dst.FuncDecl, snippet []dst.Stmt) int {
+	count := 0
+	dstutil.Apply(decl.Body, func(c *dstutil.Cursor) bool {
+		switch node := c.Node().(type) {
+		case *dst.FuncLit:
+			// Returns of the closure are not returns of the function
+			return false
+		case *dst.ReturnStmt:
+			if c.Index() >= 0 {
+				insertBefore(c, snippet)
+			} else {
+				// Not in a statement list, e.g. the labeled return statement
+				list := append(cloneStmts(snippet), node)
+				c.Replace(&dst.BlockStmt{List: list})
+			}
+			count++
+			return false
+		}
+		return true
+	}, nil)
+	if decl.Type.Results == nil {
+		list := decl.Body.List
+		if len(list) == 0 {
+			decl.Body.List = cloneStmts(snippet)
+			count++
+		} else if _, ok := list[len(list)-1].(*dst.ReturnStmt); !ok {
+			decl.Body.List = append(list, cloneStmts(snippet)...)
+			count++
+		}
+	}
+	return count
+}
+
+// stmtSource returns the source code of the statement, or empty if the
+// statement is not parsed from the target file, e.g. generated by other rules
+func (rp *RuleProcessor) stmtSource(source string, stmt dst.Stmt) string {
+	start := rp.parser.FindPosition(stmt)
+	end := rp.parser.FindEndPosition(stmt)
+	if start.Line == -1 || end.Line == -1 || end.Offset > len(source) {
+		return ""
+	}
+	return source[start.Offset:end.Offset]
+}
+
+// injectAroundStmts splices the snippet before or after the statements whose
+// source code matches the pattern. A compound statement, e.g. if statement,
+// only matches if none of its nested statements matches, so that the snippet
+// is spliced around the innermost statement
+func (rp *RuleProcessor) injectAroundStmts(decl *dst.FuncDecl,
+	inject *resource.RawInjection, snippet []dst.Stmt) (int, error) {
+	pattern, err := regexp.Compile(inject.Pattern)
+	if err != nil {
+		return 0, errc.New(errc.ErrInvalidRule, err.Error())
+	}
+	source, err := util.ReadFile(rp.targetPath)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	// Whether any nested statement matches, one for each statement on the path
+	nested := make([]bool, 0)
+	pre := func(c *dstutil.Cursor) bool {
+		if _, ok := c.Node().(dst.Stmt); ok {
+			nested = append(nested, false)
+		}
+		return true
+	}
+	post := func(c *dstutil.Cursor) bool {
+		stmt, ok := c.Node().(dst.Stmt)
+		if !ok {
+			return true
+		}
+		top := len(nested) - 1
+		matched := nested[top]
+		nested = nested[:top]
+		if !matched && c.Index() >= 0 &&
+			pattern.MatchString(rp.stmtSource(source, stmt)) {
+			if inject.At == resource.InjectAtBefore {
+				insertBefore(c, snippet)
+			} else {
+				insertAfter(c, snippet)
+			}
+			count++
+			matched = true
+		}
+		if matched && top > 0 {
+			nested[top-1] = true
+		}
+		return true
+	}
+	dstutil.Apply(decl.Body, pre, post)
+	return count, nil
+}
+
+// injectRaw splices the code snippets of the rule into the function, it
+// returns the number of places where the snippets are spliced
+func (rp *RuleProcessor) injectRaw(r *resource.InstFuncRule,
+	decl *dst.FuncDecl) (int, error) {
+	total := 0
+	for _, inject := range r.Inject {
+		p := util.NewAstParser()
+		snippet, err := p.ParseSnippet(inject.Code)
+		if err != nil {
+			return 0, err
+		}
+		count := 0
+		switch inject.At {
+		case resource.InjectAtEnter:
+			decl.Body.List = append(snippet, decl.Body.List...)
+			count = 1
+		case resource.InjectAtReturn:
+			count = injectBeforeReturns(decl, snippet)
+		case resource.InjectAtBefore, resource.InjectAtAfter:
+			count, err = rp.injectAroundStmts(decl, inject, snippet)
+			if err != nil {
+				return 0, err
+			}
+		default:
+			return 0, errc.New(errc.ErrInvalidRule,
+				"bad injection point "+inject.At)
+		}
+		if count == 0 {
+			util.Log("No statement of %s matches %s of rule %s",
+				decl.Name.Name, inject.Pattern, r)
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// importConfig returns the packages that can be imported by the compilation,
+// i.e. those listed in the -importcfg file, or nil if it's unknown
+func (rp *RuleProcessor) importConfig() (map[string]bool, error) {
+	file := ""
+	for i, arg := range rp.compileArgs {
+		if arg == "-importcfg" && i+1 < len(rp.compileArgs) {
+			file = rp.compileArgs[i+1]
+			break
+		}
+	}
+	if file == "" {
+		return nil, nil
+	}
+	content, err := util.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pkgs := map[string]bool{}
+	for _, line := range strings.Split(content, "\n") {
+		verb, args, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found || (verb != "packagefile" && verb != "importmap") {
+			continue
+		}
+		pkg, _, _ := strings.Cut(args, "=")
+		pkgs[pkg] = true
+	}
+	return pkgs, nil
+}
+
+// checkImport checks that the package imported by the rule is available to
+// the compilation, all packages are considered available if it's unknown
+func (rp *RuleProcessor) checkImport(available map[string]bool,
+	importPath string, r resource.InstRule) error {
+	if available == nil || importPath == "unsafe" || available[importPath] {
+		return nil
+	}
+	msg := fmt.Sprintf("package %s imported by rule %s is not a "+
+		"dependency of %s", importPath, r, rp.importPath)
+	return errc.New(errc.ErrInstrument, msg)
+}
+
+// importName returns the name declared by the import spec, the name of the
+// implicitly named import is guessed by its last path element
+func importName(spec *dst.ImportSpec) (string, string) {
+	importPath, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		importPath = spec.Path.Value
+	}
+	if spec.Name != nil {
+		return spec.Name.Name, importPath
+	}
+	return path.Base(importPath), importPath
+}
+
+// addRawImports imports packages used by the raw code into the target file,
+// imports that already exist are reused, and the new ones are appended to the
+// first import declaration as a separate group
+func (rp *RuleProcessor) addRawImports(r *resource.InstFuncRule) error {
+	if len(r.Imports) == 0 {
+		return nil
+	}
+	available, err := rp.importConfig()
+	if err != nil {
+		return err
+	}
+	var importDecl *dst.GenDecl
+	existing := map[string]string{}
+	for _, decl := range rp.target.Decls {
+		genDecl, ok := decl.(*dst.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			name, importPath := importName(spec.(*dst.ImportSpec))
+			if name == util.IdentIgnore || name == "." {
+				continue
+			}
+			existing[name] = importPath
+			// Don't mix new imports with import "C" of cgo
+			if importPath != "C" && importDecl == nil {
+				importDecl = genDecl
+			}
+		}
+	}
+
+	names := make([]string, 0, len(r.Imports))
+	for name := range r.Imports {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return r.Imports[names[i]] < r.Imports[names[j]]
+	})
+	specs := make([]dst.Spec, 0)
+	for _, name := range names {
+		importPath := r.Imports[name]
+		if prev, exist := existing[name]; exist {
+			if prev != importPath {
+				msg := fmt.Sprintf("import %s %q of rule %s conflicts with %q",
+					name, importPath, r, prev)
+				return errc.New(errc.ErrInstrument, msg)
+			}
+			continue
+		}
+		if err = rp.checkImport(available, importPath, r); err != nil {
+			return err
+		}
+		spec := &dst.ImportSpec{
+			Path: &dst.BasicLit{
+				Kind:  token.STRING,
+				Value: strconv.Quote(importPath),
+			},
+		}
+		if name != path.Base(importPath) {
+			spec.Name = dst.NewIdent(name)
+		}
+		specs = append(specs, spec)
+		existing[name] = importPath
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+	if importDecl == nil {
+		importDecl = &dst.GenDecl{Tok: token.IMPORT}
+		rp.target.Decls = append([]dst.Decl{importDecl}, rp.target.Decls...)
+	} else {
+		specs[0].(*dst.ImportSpec).Decs.Before = dst.EmptyLine
+	}
+	importDecl.Specs = append(importDecl.Specs, specs...)
+	importDecl.Lparen = len(importDecl.Specs) > 1
+	return nil
+}
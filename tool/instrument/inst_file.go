@@ -16,8 +16,12 @@ package instrument
 
 import (
 	"fmt"
+	"go/format"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
@@ -26,6 +30,13 @@ import (
 
 func (rp *RuleProcessor) applyFileRules(bundle *resource.RuleBundle) (err error) {
 	for _, rule := range bundle.FileRules {
+		if rule.Init != "" {
+			err = rp.applyInitRule(rule, bundle.PackageName)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		if rule.FileName == "" {
 			return errc.New(errc.ErrInvalidRule, "no file name")
 		}
@@ -70,3 +81,86 @@ func (rp *RuleProcessor) applyFileRules(bundle *resource.RuleBundle) (err error)
 	}
 	return nil
 }
+
+// initFileName returns a name of the generated init file that collides with
+// neither the files of the package nor the files generated before
+func (rp *RuleProcessor) initFileName(rule *resource.InstFileRule) string {
+	base := "init"
+	if rule.FileName != "" {
+		base = strings.TrimSuffix(filepath.Base(rule.FileName), ".go")
+	} else if rule.Name != "" {
+		base = rule.Name
+	}
+	base = strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, base)
+	taken := func(name string) bool {
+		for _, arg := range rp.compileArgs {
+			if filepath.Base(arg) == name {
+				return true
+			}
+		}
+		return util.PathExists(filepath.Join(rp.workDir, name))
+	}
+	name := fmt.Sprintf("otel_init_%s.go", base)
+	for i := 1; taken(name); i++ {
+		name = fmt.Sprintf("otel_init_%s_%d.go", base, i)
+	}
+	return filepath.Join(rp.workDir, name)
+}
+
+// applyInitRule generates a new file of the package, which holds an init
+// function consisting of the init code of the rule
+func (rp *RuleProcessor) applyInitRule(rule *resource.InstFileRule,
+	pkgName string) error {
+	available, err := rp.importConfig()
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(rule.Imports))
+	for name, importPath := range rule.Imports {
+		err = rp.checkImport(available, importPath, rule)
+		if err != nil {
+			return err
+		}
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return rule.Imports[names[i]] < rule.Imports[names[j]]
+	})
+
+	var sb strings.Builder
+	if rule.BuildConstraint != "" {
+		sb.WriteString("//go:build " + rule.BuildConstraint + "\n\n")
+	}
+	sb.WriteString("package " + pkgName + "\n\n")
+	if len(names) > 0 {
+		sb.WriteString("import (\n")
+		for _, name := range names {
+			importPath := rule.Imports[name]
+			if name == path.Base(importPath) {
+				name = ""
+			}
+			sb.WriteString(fmt.Sprintf("%s %q\n", name, importPath))
+		}
+		sb.WriteString(")\n")
+	}
+	sb.WriteString("\nfunc init() {\n" + rule.Init + "\n}\n")
+	source, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return errc.New(errc.ErrParseCode, err.Error())
+	}
+
+	target := rp.initFileName(rule)
+	_, err = util.WriteFile(target, string(source))
+	if err != nil {
+		return err
+	}
+	rp.addCompileArg(target)
+	util.Log("Apply init rule %v (%v)", rule, rp.compileArgs)
+	rp.saveDebugFile(target)
+	return nil
+}
@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"go/parser"
 	"path/filepath"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -32,6 +31,7 @@ const (
 	TJumpLabel         = "/* TRAMPOLINE_JUMP_IF */"
 	OtelAPIFile        = "otel_api.go"
 	OtelTrampolineFile = "otel_trampoline.go"
+	OtelImporterFile   = "otel_importer.go"
 )
 
 // Any modification should be synced with pkg/api declaration
@@ -70,6 +70,7 @@ func (rp *RuleProcessor) copyOtelApi(pkgName string) error {
 
 func (rp *RuleProcessor) loadAst(filePath string) (*dst.File, error) {
 	file := rp.tryRelocated(filePath)
+	rp.targetPath = file
 	rp.parser = util.NewAstParser()
 	var err error
 	rp.target, err = rp.parser.ParseFile(file, parser.ParseComments)
@@ -79,6 +80,7 @@ func (rp *RuleProcessor) loadAst(filePath string) (*dst.File, error) {
 func (rp *RuleProcessor) restoreAst(filePath string, root *dst.File) (string, error) {
 	rp.parser = nil
 	rp.target = nil
+	rp.targetPath = ""
 	filePath = rp.tryRelocated(filePath)
 	name := filepath.Base(filePath)
 	newFile, err := util.WriteAstToFile(root, filepath.Join(rp.workDir, name))
@@ -127,7 +129,8 @@ func findJumpPoint(jumpIf *dst.IfStmt) *dst.BlockStmt {
 
 func (rp *RuleProcessor) insertTJump(t *resource.InstFuncRule,
 	funcDecl *dst.FuncDecl) error {
-	util.Assert(t.OnEnter != "" || t.OnExit != "", "sanity check")
+	util.Assert(t.OnEnter != "" || t.OnExit != "" || t.Metrics != nil,
+		"sanity check")
 
 	var retVals []dst.Expr // nil by default
 	if retList := funcDecl.Type.Results; retList != nil {
@@ -224,7 +227,7 @@ func (rp *RuleProcessor) insertTJump(t *resource.InstFuncRule,
 		// Tag the trampoline-jump-if with a special line directive so that
 		// debugger can show the correct line number
 		tjump.Decs.Before = dst.NewLine
-		tjump.Decs.Start.Append("//line <generated>:1")
+		tjump.Decs.Start.Append(util.LineDirective("<generated>:1"))
 		pos := rp.parser.FindPosition(funcDecl.Body)
 		if len(funcDecl.Body.List) > 0 {
 			// It does happens because we may insert raw code snippets at the
@@ -238,13 +241,13 @@ func (rp *RuleProcessor) insertTJump(t *resource.InstFuncRule,
 				if !pos.IsValid() {
 					continue
 				}
-				tag := fmt.Sprintf("//line %s", pos.String())
+				tag := util.LineDirective(pos.String())
 				stmt.Decorations().Before = dst.NewLine
 				stmt.Decorations().Start.Append(tag)
 			}
 		} else {
 			pos = rp.parser.FindPosition(funcDecl.Body)
-			tag := fmt.Sprintf("//line %s", pos.String())
+			tag := util.LineDirective(pos.String())
 			empty := util.EmptyStmt()
 			empty.Decs.Before = dst.NewLine
 			empty.Decs.Start.Append(tag)
@@ -262,7 +265,8 @@ func (rp *RuleProcessor) insertTJump(t *resource.InstFuncRule,
 }
 
 func (rp *RuleProcessor) insertRaw(r *resource.InstFuncRule, decl *dst.FuncDecl) error {
-	util.Assert(r.OnEnter != "" || r.OnExit != "", "sanity check")
+	util.Assert(r.OnEnter != "" || r.OnExit != "" || len(r.Inject) > 0,
+		"sanity check")
 	if r.OnEnter != "" {
 		// Prepend raw code snippet to function body for onEnter
 		p := util.NewAstParser()
@@ -283,6 +287,44 @@ func (rp *RuleProcessor) insertRaw(r *resource.InstFuncRule, decl *dst.FuncDecl)
 		}
 		decl.Body.List = append(onExitSnippet, decl.Body.List...)
 	}
+	count, err := rp.injectRaw(r, decl)
+	if err != nil {
+		return err
+	}
+	// Imports would be unused if nothing is spliced
+	if r.OnEnter == "" && r.OnExit == "" && count == 0 {
+		return nil
+	}
+	return rp.addRawImports(r)
+}
+
+// insertMetrics records metrics of the function matched by metrics-only rule
+// without trampolines, the call context is not needed as there is no hook, and
+// the deferred closure is open-coded by the compiler, so that the call of the
+// function allocates nothing
+func (rp *RuleProcessor) insertMetrics(r *resource.InstFuncRule, decl *dst.FuncDecl) error {
+	util.Assert(r.IsMetricsOnly(), "sanity check")
+	retErr := rp.returnedError()
+	if retErr == "" {
+		retErr = "nil"
+	}
+	// if record, now := OtelRecordMetricsImpl, OtelNanotimeImpl; record != nil && now != nil {
+	//     start := now()
+	//     defer func() { record("rule", "pkg", "Func", start, err) }()
+	// }
+	snippet := fmt.Sprintf("if otelRecordMetrics, otelNow := %s, %s; "+
+		"otelRecordMetrics != nil && otelNow != nil {\n"+
+		"otelMetricsStart := otelNow()\n"+
+		"defer func() { otelRecordMetrics(%q, %q, %q, otelMetricsStart, %s) }()\n"+
+		"}",
+		TrampolineRecordMetricsName, TrampolineNanotimeName,
+		r.GetName(), r.GetImportPath(), rp.qualifiedFuncName(), retErr)
+	p := util.NewAstParser()
+	record, err := p.ParseSnippet(snippet)
+	if err != nil {
+		return err
+	}
+	decl.Body.List = append(record, decl.Body.List...)
 	return nil
 }
 
@@ -306,15 +348,60 @@ func sortFuncRules(fnRules []*resource.InstFuncRule) []*resource.InstFuncRule {
 	return fnRules
 }
 
-func (rp *RuleProcessor) writeTrampoline(pkgName string) error {
+// withImporter checks if the package is compiled along with otel_importer.go
+func (rp *RuleProcessor) withImporter() bool {
+	for _, arg := range rp.compileArgs {
+		if filepath.Base(arg) == OtelImporterFile {
+			return true
+		}
+	}
+	return false
+}
+
+// declareHookSwitches declares the runtime switches and overhead counters of
+// hooks used by the trampolines. They are set by the otel_importer.go file
+// during initialization and are only loaded on every invocation, the hook is
+// enabled and its overhead is not accounted until then. The interface types
+// are used as the package may not import sync/atomic or the overhead package
+func (rp *RuleProcessor) declareHookSwitches(trampoline *dst.File) error {
+	names := make([]string, 0, len(rp.hookSwitches))
+	for name := range rp.hookSwitches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	p := util.NewAstParser()
+	for _, name := range names {
+		// var OtelHookSwitchxxxxxxxx interface{ Load() bool }
+		// var OtelHookCounterxxxxxxxx interface{ Start() int64; Record(int64, bool) }
+		decl, err := p.ParseSource(fmt.Sprintf(
+			"package %s\nvar %s interface{ Load() bool }\nvar %s %s",
+			trampoline.Name.Name, name,
+			rp.hookSwitches[name].GetCounterName(), resource.HookCounterType))
+		if err != nil {
+			return err
+		}
+		trampoline.Decls = append(trampoline.Decls, decl.Decls...)
+	}
+	return nil
+}
+
+func (rp *RuleProcessor) writeTrampoline(bundle *resource.RuleBundle) error {
 	// Prepare trampoline code header
 	p := util.NewAstParser()
-	trampoline, err := p.ParseSource("package " + pkgName)
+	trampoline, err := p.ParseSource("package " + bundle.PackageName)
 	if err != nil {
 		return err
 	}
-	// One trampoline file shares common variable declarations
-	trampoline.Decls = append(trampoline.Decls, rp.varDecls...)
+	// One trampoline file shares common variable declarations, except for the
+	// package compiled along with the otel_importer.go file, i.e. the main
+	// package or the tested package of "go test", where they are defined by it
+	if !rp.withImporter() {
+		trampoline.Decls = append(trampoline.Decls, rp.varDecls...)
+		err = rp.declareHookSwitches(trampoline)
+		if err != nil {
+			return err
+		}
+	}
 	// Write trampoline code to file
 	path := filepath.Join(rp.workDir, OtelTrampolineFile)
 	trampolineFile, err := util.WriteAstToFile(trampoline, path)
@@ -326,18 +413,6 @@ func (rp *RuleProcessor) writeTrampoline(pkgName string) error {
 	return nil
 }
 
-func (rp *RuleProcessor) enableLineDirective(filePath string) error {
-	text, err := util.ReadFile(filePath)
-	if err != nil {
-		return err
-	}
-	re := regexp.MustCompile(".*//line ")
-	text = re.ReplaceAllString(text, "//line ")
-	// All done, persist to file
-	_, err = util.WriteFile(filePath, text)
-	return err
-}
-
 func (rp *RuleProcessor) applyFuncRules(bundle *resource.RuleBundle) (err error) {
 	// Nothing to do if no func rules
 	if len(bundle.File2FuncRules) == 0 {
@@ -369,7 +444,20 @@ func (rp *RuleProcessor) applyFuncRules(bundle *resource.RuleBundle) (err error)
 				recvType := nameAndRecvType[1]
 				if util.MatchFuncDecl(decl, name, recvType) {
 					fnDecl := decl.(*dst.FuncDecl)
-					util.Assert(fnDecl.Body != nil, "target func boby is empty")
+					// Rules using regexp may match functions that are not
+					// hookable, leave them as they are
+					reason := util.WhyNotHookable(rp.tryRelocated(file), fnDecl)
+					if reason != "" {
+						util.Log("Skip func rule %v for %s: %s",
+							rules, fnDecl.Name.Name, reason)
+						continue
+					}
+					// Suppression rules carve single functions out of rules
+					// using regexp
+					unsuppressed := bundle.Unsuppressed(rules, fnDecl)
+					if len(unsuppressed) == 0 {
+						continue
+					}
 					fnName := fnDecl.Name.Name
 					// Save raw function declaration
 					rp.rawFunc = fnDecl
@@ -385,10 +473,12 @@ func (rp *RuleProcessor) applyFuncRules(bundle *resource.RuleBundle) (err error)
 					nameReturnValues(fnDecl)
 
 					// Apply all matched rules for this function
-					fnRules := sortFuncRules(rules)
+					fnRules := sortFuncRules(unsuppressed)
 					for _, rule := range fnRules {
 						if rule.UseRaw {
 							err = rp.insertRaw(rule, fnDecl)
+						} else if rule.IsMetricsOnly() {
+							err = rp.insertMetrics(rule, fnDecl)
 						} else {
 							err = rp.insertTJump(rule, fnDecl)
 						}
@@ -411,16 +501,10 @@ func (rp *RuleProcessor) applyFuncRules(bundle *resource.RuleBundle) (err error)
 		if err != nil {
 			return err
 		}
-		// Line directive must be placed at the beginning of the line, otherwise
-		// it will be ignored by the compiler
-		err = rp.enableLineDirective(newFile)
-		if err != nil {
-			return err
-		}
 		rp.saveDebugFile(newFile)
 	}
 
-	err = rp.writeTrampoline(bundle.PackageName)
+	err = rp.writeTrampoline(bundle)
 	if err != nil {
 		return err
 	}
@@ -52,12 +52,6 @@ func (rp *RuleProcessor) applyStructRules(bundle *resource.RuleBundle) error {
 		if err != nil {
 			return err
 		}
-		// Line directive must be placed at the beginning of the line, otherwise
-		// it will be ignored by the compiler
-		err = rp.enableLineDirective(newFile)
-		if err != nil {
-			return err
-		}
 		rp.saveDebugFile(newFile)
 	}
 	return nil
@@ -35,12 +35,16 @@ import (
 // applies the rules to the dependencies one by one.
 
 type RuleProcessor struct {
+	// The import path of the target package
+	importPath string
 	// The package name of the target file
 	packageName string
 	// The working directory during compilation
 	workDir string
 	// The target file to be instrumented
 	target *dst.File
+	// The path of the target file, it may be relocated by previous rules
+	targetPath string
 	// The parser for the target file
 	parser *util.AstParser
 	// The compiling arguments for the target file
@@ -57,6 +61,9 @@ type RuleProcessor struct {
 	onExitHookFunc *dst.FuncDecl
 	// Variable declarations waiting to be inserted into target source file
 	varDecls []dst.Decl
+	// Runtime switches and overhead counters of the hooks used by trampolines,
+	// keyed by the name of the switch variable, see InstFuncRule.GetSwitchName
+	hookSwitches map[string]*resource.InstFuncRule
 	// Relocated files
 	relocated map[string]string
 	// Optimization candidates for the trampoline function
@@ -67,7 +74,7 @@ type RuleProcessor struct {
 	callCtxMethods []*dst.FuncDecl
 }
 
-func newRuleProcessor(args []string, pkgName string) *RuleProcessor {
+func newRuleProcessor(args []string, importPath, pkgName string) *RuleProcessor {
 	// Read compilation output directory
 	var outputDir string
 	for i, v := range args {
@@ -79,12 +86,14 @@ func newRuleProcessor(args []string, pkgName string) *RuleProcessor {
 	util.Assert(outputDir != "", "sanity check")
 	// Create a new rule processor
 	rp := &RuleProcessor{
-		packageName: pkgName,
-		workDir:     outputDir,
-		target:      nil,
-		compileArgs: args,
-		rule2Suffix: make(map[*resource.InstFuncRule]string),
-		relocated:   make(map[string]string),
+		importPath:   importPath,
+		packageName:  pkgName,
+		workDir:      outputDir,
+		target:       nil,
+		compileArgs:  args,
+		rule2Suffix:  make(map[*resource.InstFuncRule]string),
+		relocated:    make(map[string]string),
+		hookSwitches: make(map[string]*resource.InstFuncRule),
 	}
 	return rp
 }
@@ -158,6 +167,31 @@ func (rp *RuleProcessor) replaceCompileArg(newArg string, pred func(string) bool
 	return errc.New(errc.ErrInstrument, msg)
 }
 
+// relocateCgoFiles relocates the source files of a package using cgo to the
+// files generated by cgo, i.e. foo.go to $WORK/b001/foo.cgo1.go, as the latter
+// are the ones being compiled
+func (rp *RuleProcessor) relocateCgoFiles(bundle *resource.RuleBundle) {
+	files := make([]string, 0, len(bundle.File2FuncRules))
+	for file := range bundle.File2FuncRules {
+		files = append(files, file)
+	}
+	for file := range bundle.File2StructRules {
+		files = append(files, file)
+	}
+	for _, file := range files {
+		generated := strings.TrimSuffix(filepath.Base(file), ".go") + ".cgo1.go"
+		for _, arg := range rp.compileArgs {
+			if arg == file {
+				break
+			}
+			if filepath.Base(arg) == generated {
+				rp.setRelocated(file, arg)
+				break
+			}
+		}
+	}
+}
+
 func (rp *RuleProcessor) saveDebugFile(path string) {
 	escape := func(s string) string {
 		dirName := strings.ReplaceAll(s, "/", "_")
@@ -212,7 +246,8 @@ func matchImportPath(importPath string, args []string) bool {
 }
 
 func compileRemix(bundle *resource.RuleBundle, args []string) error {
-	rp := newRuleProcessor(args, bundle.PackageName)
+	rp := newRuleProcessor(args, bundle.ImportPath, bundle.PackageName)
+	rp.relocateCgoFiles(bundle)
 	err := rp.applyRules(bundle)
 	if err != nil {
 		return err
@@ -129,8 +129,14 @@ func (rp *RuleProcessor) removeOnExitTrampolineCall(tjump *TJump) error {
 
 func replenishCallContextLiteral(tjump *TJump, expr dst.Expr) {
 	rawFunc := tjump.target
-	// Replenish call context literal with addresses of all arguments
+	// Replenish call context literal with addresses of all arguments, along
+	// with the receiver if any, as the onEnter trampoline does, otherwise the
+	// indices of CallContext.GetParam are off by one
 	names := make([]dst.Expr, 0)
+	if util.HasReceiver(rawFunc) {
+		receiver := rawFunc.Recv.List[0].Names[0].Name
+		names = append(names, util.AddressOf(util.Ident(receiver)))
+	}
 	for _, name := range getNames(rawFunc.Type.Params) {
 		names = append(names, util.AddressOf(util.Ident(name)))
 	}
@@ -156,8 +162,13 @@ func (rp *RuleProcessor) newCallContextImpl(tjump *TJump) (dst.Expr, error) {
 	// TODO: This generated structure construction can also be marked via line
 	// directive
 	// One line please, otherwise debugging line number will be a nightmare
-	tmpl := fmt.Sprintf("&CallContextImpl%s{Params:[]interface{}{},ReturnVals:[]interface{}{}}",
-		rp.rule2Suffix[tjump.rule])
+	// Since there is no onEnter trampoline, whether the hook is disabled at
+	// runtime is decided right here
+	tmpl := fmt.Sprintf("&CallContextImpl%s{Params:[]interface{}{},ReturnVals:[]interface{}{},%s:%s!=nil&&%s.Load()}",
+		rp.rule2Suffix[tjump.rule],
+		TrampolineHookDisabledIdentifier,
+		tjump.rule.GetSwitchName(),
+		tjump.rule.GetSwitchName())
 	p := util.NewAstParser()
 	astRoot, err := p.ParseSnippet(tmpl)
 	if err != nil {
@@ -238,6 +249,36 @@ func stripTJumpLabel(tjump *TJump) {
 	ifStmt.Decs.If = ifStmt.Decs.If[1:]
 }
 
+// usesSkipCall reports whether the onEnter hook of the rule may skip the call
+// of the original function
+func usesSkipCall(rule *resource.InstFuncRule) (bool, error) {
+	if rule.OnEnter == "" {
+		return false, nil
+	}
+	onEnterHook, err := getHookFunc(rule, true)
+	if err != nil {
+		return false, err
+	}
+	foundPoison := false
+	const poison = "SkipCall"
+	// FIXME: We should traverse the call graph to find all possible
+	// usage of SkipCall, but for now, we just check the onEnter hook
+	// function body.
+	dst.Inspect(onEnterHook, func(node dst.Node) bool {
+		if ident, ok := node.(*dst.Ident); ok {
+			if strings.Contains(ident.Name, poison) {
+				foundPoison = true
+				return false
+			}
+		}
+		if foundPoison {
+			return false
+		}
+		return true
+	})
+	return foundPoison, nil
+}
+
 func (rp *RuleProcessor) optimizeTJumps() (err error) {
 	for _, tjump := range rp.trampolineJumps {
 		mustTJump(tjump.ifStmt)
@@ -252,7 +293,7 @@ func (rp *RuleProcessor) optimizeTJumps() (err error) {
 		// TODO: Remove corresponding CallContextImpl methods
 		rule := tjump.rule
 		removedOnExit := false
-		if rule.OnExit == "" {
+		if rule.OnExit == "" && rule.Metrics == nil {
 			err = rp.removeOnExitTrampolineCall(tjump)
 			if err != nil {
 				return err
@@ -262,8 +303,9 @@ func (rp *RuleProcessor) optimizeTJumps() (err error) {
 
 		// No onEnter hook present? Construct CallContext on the fly and pass it
 		// to onExit trampoline defer call and rewrite the whole condition to
-		// always false, then null out its initialization statement.
-		if rule.OnEnter == "" {
+		// always false, then null out its initialization statement. Note that
+		// metrics are started in onEnter trampoline, so it should be kept.
+		if rule.OnEnter == "" && rule.Metrics == nil {
 			err = rp.removeOnEnterTrampolineCall(tjump)
 			if err != nil {
 				return err
@@ -275,28 +317,11 @@ func (rp *RuleProcessor) optimizeTJumps() (err error) {
 		// memory aware and may generate memory SSA values during compilation.
 		// This further simplifies the trampoline-jump-if and gives more chances
 		// for optimization passes to kick in.
-		if rule.OnEnter != "" {
-			onEnterHook, err := getHookFunc(rule, true)
+		if rule.OnEnter != "" || rule.Metrics != nil {
+			foundPoison, err := usesSkipCall(rule)
 			if err != nil {
 				return err
 			}
-			foundPoison := false
-			const poison = "SkipCall"
-			// FIXME: We should traverse the call graph to find all possible
-			// usage of SkipCall, but for now, we just check the onEnter hook
-			// function body.
-			dst.Inspect(onEnterHook, func(node dst.Node) bool {
-				if ident, ok := node.(*dst.Ident); ok {
-					if strings.Contains(ident.Name, poison) {
-						foundPoison = true
-						return false
-					}
-				}
-				if foundPoison {
-					return false
-				}
-				return true
-			})
 			if !foundPoison {
 				flattenTJump(tjump, removedOnExit)
 			}
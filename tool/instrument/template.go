@@ -21,12 +21,14 @@ package instrument
 
 // Struct Template
 type CallContextImpl struct {
-	Params      []interface{}
-	ReturnVals  []interface{}
-	SkipCall    bool
-	Data        interface{}
-	FuncName    string
-	PackageName string
+	Params       []interface{}
+	ReturnVals   []interface{}
+	SkipCall     bool
+	Data         interface{}
+	FuncName     string
+	PackageName  string
+	HookDisabled bool
+	MetricsDone  func(error)
 }
 
 func (c *CallContextImpl) SetSkipCall(skip bool)    { c.SkipCall = skip }
@@ -87,12 +89,19 @@ func (c *CallContextImpl) GetPackageName() string { return c.PackageName }
 // Variable Template
 var OtelGetStackImpl func() []byte = nil
 var OtelPrintStackImpl func([]byte) = nil
+var OtelStartMetricsImpl func(string, string, string) func(error) = nil
+var OtelRecordMetricsImpl func(string, string, string, int64, error) = nil
+var OtelNanotimeImpl func() int64 = nil
+var OtelPanicHookImpl func(string) = nil
 
 // Trampoline Template
 func OtelOnEnterTrampoline() (CallContext, bool) {
 	defer func() {
 		if err := recover(); err != nil {
 			println("failed to exec onEnter hook", "OtelOnEnterNamePlaceholder")
+			if panicked := OtelPanicHookImpl; panicked != nil {
+				panicked("OtelHookNamePlaceholder")
+			}
 			if e, ok := err.(error); ok {
 				println(e.Error())
 			}
@@ -106,6 +115,10 @@ func OtelOnEnterTrampoline() (CallContext, bool) {
 	callContext.Params = []interface{}{}
 	callContext.FuncName = ""
 	callContext.PackageName = ""
+	if disabled := OtelHookSwitchPlaceholder; disabled != nil && disabled.Load() {
+		callContext.HookDisabled = true
+		return callContext, false
+	}
 	return callContext, callContext.SkipCall
 }
 
@@ -113,6 +126,9 @@ func OtelOnExitTrampoline(callContext CallContext) {
 	defer func() {
 		if err := recover(); err != nil {
 			println("failed to exec onExit hook", "OtelOnExitNamePlaceholder")
+			if panicked := OtelPanicHookImpl; panicked != nil {
+				panicked("OtelHookNamePlaceholder")
+			}
 			if e, ok := err.(error); ok {
 				println(e.Error())
 			}
@@ -122,5 +138,8 @@ func OtelOnExitTrampoline(callContext CallContext) {
 			}
 		}
 	}()
+	if callContext.(*CallContextImpl).HookDisabled {
+		return
+	}
 	callContext.(*CallContextImpl).ReturnVals = []interface{}{}
 }
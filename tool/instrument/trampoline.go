@@ -56,6 +56,14 @@ const (
 	TrampolineOnExitName             = "OtelOnExitTrampoline"
 	TrampolineOnEnterNamePlaceholder = "\"OtelOnEnterNamePlaceholder\""
 	TrampolineOnExitNamePlaceholder  = "\"OtelOnExitNamePlaceholder\""
+	TrampolineHookNamePlaceholder    = "\"OtelHookNamePlaceholder\""
+	TrampolineHookSwitchPlaceholder  = "OtelHookSwitchPlaceholder"
+	TrampolineHookDisabledIdentifier = "HookDisabled"
+	TrampolineStartMetricsName       = "OtelStartMetricsImpl"
+	TrampolineMetricsDoneIdentifier  = "MetricsDone"
+	TrampolineRecordMetricsName      = "OtelRecordMetricsImpl"
+	TrampolineNanotimeName           = "OtelNanotimeImpl"
+	TrampolineHookStartIdentifier    = "otelHookStart"
 )
 
 // @@ Modification on this trampoline template should be cautious, as it imposes
@@ -268,7 +276,13 @@ func (rp *RuleProcessor) callOnEnterHook(t *resource.InstFuncRule, traits []Para
 		util.Block(call),
 		nil,
 	)
-	insertAt(rp.onEnterHookFunc, iff, len(rp.onEnterHookFunc.Body.List)-1)
+	stmts, err := accountHook(t, iff, true)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		insertAt(rp.onEnterHookFunc, stmt, len(rp.onEnterHookFunc.Body.List)-1)
+	}
 	return nil
 }
 
@@ -309,10 +323,49 @@ func (rp *RuleProcessor) callOnExitHook(t *resource.InstFuncRule, traits []Param
 		util.Block(call),
 		nil,
 	)
-	insertAtEnd(rp.onExitHookFunc, iff)
+	// The invocation is counted by onEnter hook if there is one
+	stmts, err := accountHook(t, iff, t.OnEnter == "")
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		insertAtEnd(rp.onExitHookFunc, stmt)
+	}
 	return nil
 }
 
+// accountHook wraps the call to hook function with the overhead accounting of
+// the rule, i.e. the time spent in the hook is recorded, and the invocation is
+// counted as well if count is true. The counters of the rule are resolved once
+// during initialization, no clock is read unless the overhead is accounted
+func accountHook(t *resource.InstFuncRule, call dst.Stmt, count bool) ([]dst.Stmt, error) {
+	p := util.NewAstParser()
+	// otelHookStart := int64(0)
+	// if counter := OtelHookCounterxxxxxxxx; counter != nil {
+	//     otelHookStart = counter.Start()
+	// }
+	snippet := fmt.Sprintf("%s := int64(0)\n"+
+		"if counter := %s; counter != nil { %s = counter.Start() }",
+		TrampolineHookStartIdentifier,
+		t.GetCounterName(), TrampolineHookStartIdentifier)
+	enter, err := p.ParseSnippet(snippet)
+	if err != nil {
+		return nil, err
+	}
+	// if counter := OtelHookCounterxxxxxxxx; counter != nil && otelHookStart != 0 {
+	//     counter.Record(otelHookStart, true)
+	// }
+	snippet = fmt.Sprintf("if counter := %s; counter != nil && %s != 0 { counter.Record(%s, %v) }",
+		t.GetCounterName(), TrampolineHookStartIdentifier,
+		TrampolineHookStartIdentifier, count)
+	exit, err := p.ParseSnippet(snippet)
+	if err != nil {
+		return nil, err
+	}
+	stmts := append(enter, call)
+	return append(stmts, exit...), nil
+}
+
 func rectifyAnyType(paramList *dst.FieldList, traits []ParamTrait) error {
 	if len(paramList.List) != len(traits) {
 		return errc.New(errc.ErrInternal, "mismatched param traits")
@@ -392,6 +445,17 @@ func (rp *RuleProcessor) renameFunc(t *resource.InstFuncRule) {
 			if basicLit.Value == TrampolineOnEnterNamePlaceholder {
 				basicLit.Value = strconv.Quote(t.OnEnter)
 			}
+			// Replace OtelHookNamePlaceholder to rule name, it's used to check
+			// whether the hook is enabled at runtime and to account overhead
+			if basicLit.Value == TrampolineHookNamePlaceholder {
+				basicLit.Value = strconv.Quote(t.GetName())
+			}
+		}
+		// Replace OtelHookSwitchPlaceholder to the switch of the rule
+		if ident, ok := node.(*dst.Ident); ok {
+			if ident.Name == TrampolineHookSwitchPlaceholder {
+				ident.Name = t.GetSwitchName()
+			}
 		}
 		return true
 	})
@@ -401,6 +465,9 @@ func (rp *RuleProcessor) renameFunc(t *resource.InstFuncRule) {
 			if basicLit.Value == TrampolineOnExitNamePlaceholder {
 				basicLit.Value = strconv.Quote(t.OnExit)
 			}
+			if basicLit.Value == TrampolineHookNamePlaceholder {
+				basicLit.Value = strconv.Quote(t.GetName())
+			}
 		}
 		return true
 	})
@@ -704,6 +771,84 @@ func (rp *RuleProcessor) rewriteCallContextImpl() {
 	}
 }
 
+// qualifiedFuncName returns the name of raw function, which is prefixed with
+// its receiver type if any, e.g. "Engine.ServeHTTP"
+func (rp *RuleProcessor) qualifiedFuncName() string {
+	name := rp.rawFunc.Name.Name
+	if !util.HasReceiver(rp.rawFunc) {
+		return name
+	}
+	recvType := rp.rawFunc.Recv.List[0].Type
+	if star, ok := recvType.(*dst.StarExpr); ok {
+		recvType = star.X
+	}
+	switch t := recvType.(type) {
+	case *dst.IndexExpr:
+		recvType = t.X
+	case *dst.IndexListExpr:
+		recvType = t.X
+	}
+	if ident, ok := recvType.(*dst.Ident); ok {
+		return ident.Name + "." + name
+	}
+	return name
+}
+
+// returnedError returns the name of the last return value of raw function if
+// it's an error, otherwise empty string
+func (rp *RuleProcessor) returnedError() string {
+	results := rp.rawFunc.Type.Results
+	if results == nil {
+		return ""
+	}
+	last := results.List[len(results.List)-1]
+	name := last.Names[len(last.Names)-1].Name
+	if ident, ok := last.Type.(*dst.Ident); ok && ident.Name == "error" &&
+		name != "_" {
+		return name
+	}
+	return ""
+}
+
+// callMetrics starts recording metrics in onEnter trampoline and stops it in
+// onExit trampoline. If the last return value of raw function is an error, it
+// is recorded as well.
+func (rp *RuleProcessor) callMetrics(t *resource.InstFuncRule) error {
+	p := util.NewAstParser()
+	// if start := OtelStartMetricsImpl; start != nil {
+	//     callContext.MetricsDone = start("rule", "pkg", "Func")
+	// }
+	snippet := fmt.Sprintf("if start := %s; start != nil { %s.%s = start(%q, %q, %q) }",
+		TrampolineStartMetricsName,
+		TrampolineCallContextName,
+		TrampolineMetricsDoneIdentifier,
+		t.GetName(), rp.importPath, rp.qualifiedFuncName())
+	enter, err := p.ParseSnippet(snippet)
+	if err != nil {
+		return err
+	}
+	insertAt(rp.onEnterHookFunc, enter[0], len(rp.onEnterHookFunc.Body.List)-1)
+
+	retErr := "nil"
+	if name := rp.returnedError(); name != "" {
+		retErr = "*" + name
+	}
+	// if done := callContext.(*CallContextImpl).MetricsDone; done != nil {
+	//     done(*err)
+	// }
+	snippet = fmt.Sprintf("if done := %s.(*%s%s).%s; done != nil { done(%s) }",
+		TrampolineCallContextName,
+		TrampolineCallContextImplType, rp.rule2Suffix[t],
+		TrampolineMetricsDoneIdentifier,
+		retErr)
+	exit, err := p.ParseSnippet(snippet)
+	if err != nil {
+		return err
+	}
+	insertAtEnd(rp.onExitHookFunc, exit[0])
+	return nil
+}
+
 func (rp *RuleProcessor) callHookFunc(t *resource.InstFuncRule,
 	onEnter bool) error {
 	traits, err := getHookParamTraits(t, onEnter)
@@ -741,6 +886,7 @@ func (rp *RuleProcessor) generateTrampoline(t *resource.InstFuncRule) error {
 	rp.rewriteCallContextImpl()
 	// Rename trampoline functions
 	rp.renameFunc(t)
+	rp.hookSwitches[t.GetSwitchName()] = t
 	// Rectify types of trampoline functions
 	rp.rectifyTypes()
 	// Generate calls to hook functions
@@ -750,6 +896,14 @@ func (rp *RuleProcessor) generateTrampoline(t *resource.InstFuncRule) error {
 			return err
 		}
 	}
+	// Generate calls to record metrics, it's placed between hook calls so
+	// that the overhead of hooks is not counted
+	if t.Metrics != nil {
+		err = rp.callMetrics(t)
+		if err != nil {
+			return err
+		}
+	}
 	if t.OnExit != "" {
 		err = rp.callHookFunc(t, false)
 		if err != nil {
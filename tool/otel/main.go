@@ -25,14 +25,21 @@ import (
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/instrument"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/preprocess"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/scaffold"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/verify"
 )
 
 const (
-	SubcommandSet     = "set"
-	SubcommandGo      = "go"
-	SubcommandVersion = "version"
-	SubcommandRemix   = "remix"
+	SubcommandSet       = "set"
+	SubcommandGo        = "go"
+	SubcommandVersion   = "version"
+	SubcommandRemix     = "remix"
+	SubcommandRule      = "rule"
+	SubcommandVerify    = "verify"
+	SubcommandSimulate  = "simulate"
+	SubcommandMirror    = "mirror"
+	SubcommandTelemetry = "telemetry"
 )
 
 var usage = `Usage: {} <command> [args]
@@ -40,13 +47,24 @@ Example:
 	{} go build
 	{} go install
 	{} go build main.go
+	{} go test -v ./pkg
 	{} version
 	{} set -verbose -rule=custom.json
+	{} rule new net/http "(*Client).Do"
+	{} verify ./app
+	{} simulate -baseline=otel-coverage.json
+	{} mirror ./mirror
+	{} telemetry > TELEMETRY.md
 
 Command:
 	version    print the version
 	set        set the configuration
 	go         build the Go application
+	rule       generate a new rule, see "{} rule new -help"
+	verify     print the instrumentation inside the binary
+	simulate   match the rules against go.mod without building
+	mirror     populate a mirror of rule packs and hook packages
+	telemetry  document the telemetry emitted by the rules
 `
 
 func printUsage() {
@@ -104,6 +122,15 @@ func initEnv() error {
 	case strings.HasSuffix(os.Args[1], SubcommandGo):
 		// otel go build?
 		util.SetRunPhase(util.PPreprocess)
+	case os.Args[1] == SubcommandSimulate:
+		// otel simulate? It matches rules as the preprocess phase does
+		util.SetRunPhase(util.PPreprocess)
+	case os.Args[1] == SubcommandMirror:
+		// otel mirror? It fetches rules as the preprocess phase does
+		util.SetRunPhase(util.PPreprocess)
+	case os.Args[1] == SubcommandTelemetry:
+		// otel telemetry? It loads rules as the preprocess phase does
+		util.SetRunPhase(util.PPreprocess)
 	case os.Args[1] == SubcommandRemix:
 		// otel remix?
 		util.SetRunPhase(util.PInstrument)
@@ -169,6 +196,16 @@ func main() {
 		err = preprocess.Preprocess()
 	case SubcommandRemix:
 		err = instrument.Instrument()
+	case SubcommandRule:
+		err = scaffold.Rule()
+	case SubcommandVerify:
+		err = verify.Verify()
+	case SubcommandSimulate:
+		err = preprocess.Simulate()
+	case SubcommandMirror:
+		err = preprocess.Mirror()
+	case SubcommandTelemetry:
+		err = preprocess.DocumentTelemetry()
 	default:
 		printUsage()
 	}
@@ -0,0 +1,394 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// -----------------------------------------------------------------------------
+// Instrumentation Annotation
+//
+// Application authors can annotate their own functions with a magic comment to
+// trace them without writing rule JSON or hook packages, i.e.
+//
+//	//otel:instrument process-order team=payment
+//	func (s *Service) Process(ctx context.Context, id string) error
+//
+// The optional first argument is the span name, the rest are static attributes
+// of the span. For each annotation, we generate a pair of hook functions into
+// the otel_pkg/annotation package of the project and synthesize a func rule
+// pointing to them. The hook functions are backed by the annotation rule in pkg,
+// they start a span on entry and end it on exit, the span is propagated through
+// the context.Context parameter if any.
+
+const (
+	annotationDirective = "//otel:instrument"
+	annotationPkg       = "annotation"
+	annotationFile      = "otel_annotation.go"
+	annotationRulePath  = pkgPrefix + "/rules/annotation"
+)
+
+type annotation struct {
+	importPath string   // Import path of the package
+	pkgName    string   // Name of the package
+	function   string   // Name of the function
+	receiver   string   // Receiver type of the function, e.g. "*Service"
+	spanName   string   // Name of the span
+	attributes []string // Static attributes of the span as key-value pairs
+	ctxIndex   int      // Index of the context.Context parameter, or -1
+	errIndex   int      // Index of the error return value, or -1
+}
+
+// qualifiedName returns the function name prefixed with its receiver type, if
+// any, e.g. "Service.Process"
+func (a *annotation) qualifiedName() string {
+	if a.receiver == "" {
+		return a.function
+	}
+	return strings.TrimPrefix(a.receiver, "*") + "." + a.function
+}
+
+func (a *annotation) onEnter(idx int) string {
+	return fmt.Sprintf("otelAnnotationOnEnter%d", idx)
+}
+
+func (a *annotation) onExit(idx int) string {
+	return fmt.Sprintf("otelAnnotationOnExit%d", idx)
+}
+
+// parseAnnotation parses arguments of the directive, e.g. "name k1=v1 k2=v2"
+func parseAnnotation(a *annotation, args string) error {
+	for i, field := range strings.Fields(args) {
+		k, v, ok := strings.Cut(field, "=")
+		if !ok {
+			if i != 0 {
+				return errc.New(errc.ErrInvalidRule,
+					fmt.Sprintf("bad attribute %q of %s", field, a.qualifiedName()))
+			}
+			a.spanName = field
+			continue
+		}
+		if k == "" {
+			return errc.New(errc.ErrInvalidRule,
+				fmt.Sprintf("bad attribute %q of %s", field, a.qualifiedName()))
+		}
+		a.attributes = append(a.attributes, k, v)
+	}
+	if a.spanName == "" {
+		a.spanName = a.pkgName + "." + a.qualifiedName()
+	}
+	return nil
+}
+
+// findAnnotationArgs returns the arguments of the directive in the doc comment
+// of the function, ok is false if the function is not annotated
+func findAnnotationArgs(doc *ast.CommentGroup) (string, bool) {
+	if doc == nil {
+		return "", false
+	}
+	for _, c := range doc.List {
+		if c.Text == annotationDirective {
+			return "", true
+		}
+		if strings.HasPrefix(c.Text, annotationDirective+" ") ||
+			strings.HasPrefix(c.Text, annotationDirective+"\t") {
+			return strings.TrimPrefix(c.Text, annotationDirective), true
+		}
+	}
+	return "", false
+}
+
+func fieldCount(field *ast.Field) int {
+	if len(field.Names) == 0 {
+		return 1
+	}
+	return len(field.Names)
+}
+
+func isContextType(expr ast.Expr, contextName string) bool {
+	if sel, ok := expr.(*ast.SelectorExpr); ok {
+		if x, ok := sel.X.(*ast.Ident); ok {
+			return x.Name == contextName && sel.Sel.Name == "Context"
+		}
+	}
+	return false
+}
+
+// contextImportName returns the local name of the context package in the file
+func contextImportName(file *ast.File) string {
+	for _, spec := range file.Imports {
+		if strings.Trim(spec.Path.Value, "\"") == "context" {
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+			return "context"
+		}
+	}
+	return ""
+}
+
+// whyNotAnnotatable returns the reason why the annotated function can not be
+// instrumented, or empty string if it can
+func whyNotAnnotatable(decl *ast.FuncDecl) string {
+	if decl.Body == nil {
+		return "function has no body"
+	}
+	if decl.Type.TypeParams != nil {
+		return "generic function is not supported"
+	}
+	if decl.Recv != nil && len(decl.Recv.List) > 0 {
+		recv := decl.Recv.List[0].Type
+		if s, ok := recv.(*ast.StarExpr); ok {
+			recv = s.X
+		}
+		if _, ok := recv.(*ast.Ident); !ok {
+			return "generic receiver is not supported"
+		}
+	}
+	return ""
+}
+
+func newAnnotation(importPath string, file *ast.File, decl *ast.FuncDecl,
+	args string) (*annotation, error) {
+	a := &annotation{
+		importPath: importPath,
+		pkgName:    file.Name.Name,
+		function:   decl.Name.Name,
+		ctxIndex:   -1,
+		errIndex:   -1,
+	}
+	idx := 0
+	if decl.Recv != nil && len(decl.Recv.List) > 0 {
+		recv := decl.Recv.List[0].Type
+		star := ""
+		if s, ok := recv.(*ast.StarExpr); ok {
+			recv, star = s.X, "*"
+		}
+		a.receiver = star + recv.(*ast.Ident).Name
+		idx++
+	}
+	if contextName := contextImportName(file); contextName != "" {
+		for _, field := range decl.Type.Params.List {
+			if a.ctxIndex == -1 && isContextType(field.Type, contextName) {
+				a.ctxIndex = idx
+			}
+			idx += fieldCount(field)
+		}
+	}
+	if results := decl.Type.Results; results != nil {
+		cnt := 0
+		for _, field := range results.List {
+			cnt += fieldCount(field)
+		}
+		last := results.List[len(results.List)-1]
+		if ident, ok := last.Type.(*ast.Ident); ok && ident.Name == "error" {
+			a.errIndex = cnt - 1
+		}
+	}
+	err := parseAnnotation(a, args)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// findAnnotations finds all annotated functions of the project, nested modules,
+// vendor directory and test files are not taken into account
+func (dp *DepProcessor) findAnnotations() ([]*annotation, error) {
+	root := dp.getGoModDir()
+	annotations := make([]*annotation, 0)
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path == root {
+				return nil
+			}
+			name := d.Name()
+			if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") ||
+				name == VendorDir || name == OtelPkgDir || name == "testdata" ||
+				util.PathExists(filepath.Join(path, util.GoModFile)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !util.IsGoFile(path) || strings.HasSuffix(path, "_test.go") ||
+			filepath.Base(path) == OtelImporter {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return errc.New(errc.ErrReadDir, err.Error())
+		}
+		// Fast path, most of files are not annotated
+		if !bytes.Contains(content, []byte(annotationDirective)) {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, content, parser.ParseComments)
+		if err != nil {
+			return errc.New(errc.ErrParseCode, err.Error())
+		}
+		// The main package is always compiled as "main"
+		importPath := "main"
+		if file.Name.Name != "main" {
+			rel, err := filepath.Rel(root, filepath.Dir(path))
+			if err != nil {
+				return errc.New(errc.ErrPreprocess, err.Error())
+			}
+			importPath = dp.moduleName
+			if rel != "." {
+				importPath += "/" + filepath.ToSlash(rel)
+			}
+		}
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			args, ok := findAnnotationArgs(funcDecl.Doc)
+			if !ok {
+				continue
+			}
+			if reason := whyNotAnnotatable(funcDecl); reason != "" {
+				util.Log("Skip annotation of %s.%s: %s",
+					importPath, funcDecl.Name.Name, reason)
+				continue
+			}
+			a, err := newAnnotation(importPath, file, funcDecl, args)
+			if err != nil {
+				return err
+			}
+			annotations = append(annotations, a)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Keep the generated code stable
+	sort.SliceStable(annotations, func(i, j int) bool {
+		ai, aj := annotations[i], annotations[j]
+		if ai.importPath != aj.importPath {
+			return ai.importPath < aj.importPath
+		}
+		return ai.qualifiedName() < aj.qualifiedName()
+	})
+	return annotations, nil
+}
+
+func (dp *DepProcessor) annotationPkgPath() string {
+	return dp.moduleName + "/" + OtelPkgDir + "/" + annotationPkg
+}
+
+// newAnnotationHooks generates hook functions for all annotations, the hooks
+// are pushed to the target packages by go:linkname
+func newAnnotationHooks(annotations []*annotation) string {
+	content := "// This file is generated by alibaba-otel tool, DO NOT EDIT MANUALLY\n"
+	content += "package " + annotationPkg + "\n\n"
+	content += "import (\n"
+	content += "\t_ \"unsafe\"\n\n"
+	content += fmt.Sprintf("\t%q\n", pkgPrefix+"/api")
+	content += fmt.Sprintf("\tannotation %q\n", annotationRulePath)
+	content += ")\n"
+	for i, a := range annotations {
+		attrs := make([]string, 0, len(a.attributes))
+		for _, attr := range a.attributes {
+			attrs = append(attrs, strconv.Quote(attr))
+		}
+		content += fmt.Sprintf("\nvar annotation%d = annotation.New(annotation.Config{\n", i)
+		content += fmt.Sprintf("\tSpanName:     %q,\n", a.spanName)
+		content += fmt.Sprintf("\tNamespace:    %q,\n", a.importPath)
+		content += fmt.Sprintf("\tFunction:     %q,\n", a.qualifiedName())
+		content += fmt.Sprintf("\tContextIndex: %d,\n", a.ctxIndex)
+		content += fmt.Sprintf("\tErrorIndex:   %d,\n", a.errIndex)
+		content += fmt.Sprintf("\tAttributes:   []string{%s},\n", strings.Join(attrs, ", "))
+		content += "})\n\n"
+		content += fmt.Sprintf("//go:linkname %s %s.%s\n", a.onEnter(i), a.importPath, a.onEnter(i))
+		content += fmt.Sprintf("func %s(call api.CallContext) { annotation%d.OnEnter(call) }\n\n", a.onEnter(i), i)
+		content += fmt.Sprintf("//go:linkname %s %s.%s\n", a.onExit(i), a.importPath, a.onExit(i))
+		content += fmt.Sprintf("func %s(call api.CallContext) { annotation%d.OnExit(call) }\n", a.onExit(i), i)
+	}
+	return content
+}
+
+// newAnnotationRules generates the hook package for all annotated functions of
+// the project and synthesizes func rules for them
+func (dp *DepProcessor) newAnnotationRules() ([]resource.InstRule, error) {
+	annotations, err := dp.findAnnotations()
+	if err != nil {
+		return nil, err
+	}
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+	dir := filepath.Join(dp.generatedOf(OtelPkgDir), annotationPkg)
+	err = os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return nil, errc.New(errc.ErrMkdirAll, err.Error())
+	}
+	_, err = util.WriteFile(filepath.Join(dir, annotationFile),
+		newAnnotationHooks(annotations))
+	if err != nil {
+		return nil, err
+	}
+	// The generated hooks depend on the annotation rule, which should be
+	// resolved from the local module cache as other rules
+	t := strings.TrimPrefix(annotationRulePath, pkgPrefix)
+	replaceMap := map[string][2]string{
+		annotationRulePath: {filepath.Join(dp.pkgLocalCache, t), ""},
+	}
+	err = addModReplace(dp.getGoModPath(), replaceMap)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]resource.InstRule, 0, len(annotations))
+	for i, a := range annotations {
+		rule := &resource.InstFuncRule{
+			InstBaseRule: resource.InstBaseRule{
+				Name:       a.importPath + "." + a.qualifiedName(),
+				Path:       dp.annotationPkgPath(),
+				ImportPath: a.importPath,
+			},
+			// Anchor the function name so that the rule is not an exact match,
+			// which allows the hooks to take only the call context
+			Function:     "^" + regexp.QuoteMeta(a.function) + "$",
+			ReceiverType: regexp.QuoteMeta(a.receiver),
+			OnEnter:      a.onEnter(i),
+			OnExit:       a.onExit(i),
+		}
+		rules = append(rules, rule)
+		util.Log("Found annotation %s with span name %q and attributes %v",
+			rule.Name, a.spanName, a.attributes)
+	}
+	return rules, nil
+}
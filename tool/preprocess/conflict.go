@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/config"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// Rules conflict if they target the same function, i.e. the same Function and
+// ReceiverType, the same struct field, or the same file of one package. They
+// are resolved by the conflict policy:
+//
+//   - merge: duplicated rules are applied once, hooks of the function and init
+//     functions of the package are all applied, while struct fields or files
+//     that differ are reported as error
+//   - first-wins: only the rule with the highest priority is applied, rules
+//     loaded earlier win if priorities are equal
+//   - error: duplicated rules, struct fields and files are reported as error,
+//     while distinct hooks of the function and init functions of the package
+//     are all applied, which is the default
+//
+// Conflicts are resolved right after matching, so that they are reported at
+// preprocess time rather than manifesting as duplicated spans or compile
+// errors during instrumentation.
+
+// resolveConflict resolves one group of conflicting rules, loadOrder tells the
+// order in which rules are loaded, mergeable tells if distinct rules can be
+// applied together.
+func resolveConflict[T resource.InstRule](policy, target string, rules []T,
+	loadOrder map[resource.InstRule]int, mergeable bool) ([]T, error) {
+	if len(rules) <= 1 {
+		return rules, nil
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].GetPriority() != rules[j].GetPriority() {
+			return rules[i].GetPriority() > rules[j].GetPriority()
+		}
+		return loadOrder[rules[i]] < loadOrder[rules[j]]
+	})
+	describe := func(rules []T) string {
+		texts := make([]string, len(rules))
+		for i, rule := range rules {
+			texts[i] = rule.String()
+		}
+		return strings.Join(texts, ", ")
+	}
+
+	switch policy {
+	case config.ConflictError:
+		if mergeable {
+			seen := map[string]bool{}
+			for _, rule := range rules {
+				if seen[rule.String()] {
+					err := errc.New(errc.ErrRuleConflict,
+						fmt.Sprintf("duplicated rule on %s", target))
+					return nil, errc.Adhere(err, "rules", describe(rules))
+				}
+				seen[rule.String()] = true
+			}
+			return rules, nil
+		}
+		err := errc.New(errc.ErrRuleConflict, target)
+		return nil, errc.Adhere(err, "rules", describe(rules))
+	case config.ConflictFirstWins:
+		util.Log("Resolve conflict on %s, %s wins over %s",
+			target, rules[0], describe(rules[1:]))
+		return rules[:1], nil
+	case config.ConflictMerge:
+		merged := make([]T, 0, len(rules))
+		seen := map[string]bool{}
+		for _, rule := range rules {
+			if seen[rule.String()] {
+				util.Log("Merge duplicated rule %s on %s", rule, target)
+				continue
+			}
+			seen[rule.String()] = true
+			merged = append(merged, rule)
+		}
+		if len(merged) > 1 && !mergeable {
+			err := errc.New(errc.ErrRuleConflict,
+				fmt.Sprintf("can not merge rules on %s", target))
+			return nil, errc.Adhere(err, "rules", describe(merged))
+		}
+		return merged, nil
+	default:
+		return nil, errc.New(errc.ErrRuleConflict,
+			fmt.Sprintf("unknown conflict policy %q", policy))
+	}
+}
+
+// resolveConflicts resolves conflicting rules of all bundles in place.
+func (rm *ruleMatcher) resolveConflicts(bundles []*resource.RuleBundle) error {
+	policy := config.GetConf().GetConflictPolicy()
+	for _, bundle := range bundles {
+		loadOrder := map[resource.InstRule]int{}
+		for i, rule := range rm.rulesOf(bundle.ImportPath) {
+			loadOrder[rule] = i
+		}
+
+		for file, fn2rules := range bundle.File2FuncRules {
+			for fn, rules := range fn2rules {
+				function, receiver, _ := strings.Cut(fn, ",")
+				target := bundle.ImportPath + "." + function
+				if receiver != "" {
+					target = fmt.Sprintf("%s.(%s).%s",
+						bundle.ImportPath, receiver, function)
+				}
+				resolved, err := resolveConflict(policy, target, rules,
+					loadOrder, true)
+				if err != nil {
+					return err
+				}
+				bundle.File2FuncRules[file][fn] = resolved
+			}
+		}
+
+		for file, st2rules := range bundle.File2StructRules {
+			for st, rules := range st2rules {
+				fields := map[string][]*resource.InstStructRule{}
+				names := make([]string, 0)
+				for _, rule := range rules {
+					if _, exist := fields[rule.FieldName]; !exist {
+						names = append(names, rule.FieldName)
+					}
+					fields[rule.FieldName] = append(fields[rule.FieldName], rule)
+				}
+				resolved := make([]*resource.InstStructRule, 0, len(rules))
+				for _, name := range names {
+					target := fmt.Sprintf("%s.%s.%s", bundle.ImportPath, st, name)
+					rs, err := resolveConflict(policy, target, fields[name],
+						loadOrder, false)
+					if err != nil {
+						return err
+					}
+					resolved = append(resolved, rs...)
+				}
+				bundle.File2StructRules[file][st] = resolved
+			}
+		}
+
+		files := map[string][]*resource.InstFileRule{}
+		names := make([]string, 0)
+		inits := make([]*resource.InstFileRule, 0)
+		for _, rule := range bundle.FileRules {
+			// Init functions are generated into distinct files, they never
+			// conflict with other files
+			if rule.Init != "" {
+				inits = append(inits, rule)
+				continue
+			}
+			name := filepath.Base(rule.FileName)
+			if _, exist := files[name]; !exist {
+				names = append(names, name)
+			}
+			files[name] = append(files[name], rule)
+		}
+		resolved := make([]*resource.InstFileRule, 0, len(bundle.FileRules))
+		for _, name := range names {
+			target := bundle.ImportPath + "/" + name
+			rs, err := resolveConflict(policy, target, files[name],
+				loadOrder, false)
+			if err != nil {
+				return err
+			}
+			resolved = append(resolved, rs...)
+		}
+		// Package may have several init functions, only the duplicated
+		// ones are merged
+		rs, err := resolveConflict(policy, bundle.ImportPath+".init", inits,
+			loadOrder, true)
+		if err != nil {
+			return err
+		}
+		bundle.FileRules = append(resolved, rs...)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"go/build/constraint"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"golang.org/x/mod/semver"
+)
+
+// -----------------------------------------------------------------------------
+// Build Constraints
+//
+// File rules may carry build constraints, e.g. "linux && !cgo", so that files
+// relying on platform specific APIs are only added to the package if the build
+// satisfies them, just as go build does for files of the package. Constraints
+// are evaluated against the target platform, the build tags specified by the
+// build command, and the release tags of the toolchain.
+
+type buildContext struct {
+	goos   string
+	goarch string
+	cgo    bool
+	tags   map[string]bool
+}
+
+var unixOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true,
+	"freebsd": true, "hurd": true, "illumos": true, "ios": true, "linux": true,
+	"netbsd": true, "openbsd": true, "solaris": true,
+}
+
+func (dp *DepProcessor) newBuildContext() (*buildContext, error) {
+	out, err := runCmdCombinedOutput(dp.getGoModDir(), nil,
+		"go", "env", "GOOS", "GOARCH", "CGO_ENABLED")
+	if err != nil {
+		return nil, err
+	}
+	env := strings.Fields(out)
+	if len(env) != 3 {
+		return nil, errc.New(errc.ErrPreprocess, "unexpected go env "+out)
+	}
+	ctx := &buildContext{
+		goos:   env[0],
+		goarch: env[1],
+		cgo:    env[2] == "1",
+		tags:   map[string]bool{},
+	}
+	// Build tags are separated by commas, or spaces in the legacy form
+	tags := strings.FieldsFunc(findBuildTags(dp.goBuildCmd), func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	for _, tag := range tags {
+		ctx.tags[tag] = true
+	}
+	return ctx, nil
+}
+
+// matchTag reports whether the tag is satisfied, goVersion is the version of
+// the toolchain, e.g. "v1.22.1"
+func (ctx *buildContext) matchTag(tag, goVersion string) bool {
+	switch {
+	case tag == ctx.goos, tag == ctx.goarch, tag == "gc":
+		return true
+	case tag == "unix":
+		return unixOS[ctx.goos]
+	case tag == "cgo":
+		return ctx.cgo
+	case tag == "linux":
+		return ctx.goos == "android"
+	case tag == "solaris":
+		return ctx.goos == "illumos"
+	case tag == "darwin":
+		return ctx.goos == "ios"
+	case strings.HasPrefix(tag, "go1."):
+		version := "v" + strings.TrimPrefix(tag, "go")
+		return semver.IsValid(version) && semver.Compare(version, goVersion) <= 0
+	}
+	return ctx.tags[tag]
+}
+
+// satisfies reports whether the build satisfies the constraint expression
+func (ctx *buildContext) satisfies(expr, goVersion string) bool {
+	// Constraints are validated when the rule is loaded
+	x, err := constraint.Parse("//go:build " + expr)
+	if err != nil {
+		return false
+	}
+	return x.Eval(func(tag string) bool {
+		return ctx.matchTag(tag, goVersion)
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// -----------------------------------------------------------------------------
+// Rule Deprecation
+//
+// Rules do not silently disappear between tool releases, they are deprecated
+// first. Whenever a deprecated rule is applied to the build, we warn the user
+// how to migrate away from it, e.g. upgrade the instrumented module or switch
+// to another rule, and report it in deprecated_rules.json for automation.
+
+// deprecationMessage tells what is deprecated and how to migrate, e.g. "rule
+// redigo.onBeforeDialContext for github.com/gomodule/redigo v1.8.0 is
+// deprecated: no longer maintained, upgrade to v1.9.0 or switch to rule
+// redigo.onDial, it will be removed in otel v0.10.0"
+func deprecationMessage(d *resource.DeprecatedRule) string {
+	msg := fmt.Sprintf("rule %s for %s", d.Rule, d.ImportPath)
+	if d.Version != "" {
+		msg += " " + d.Version
+	}
+	msg += " is deprecated"
+	if d.Reason != "" {
+		msg += ": " + d.Reason
+	}
+	advices := make([]string, 0, 2)
+	if d.UpgradeTo != "" {
+		advices = append(advices, "upgrade to "+d.UpgradeTo)
+	}
+	if d.ReplacedBy != "" {
+		advices = append(advices, "switch to rule "+d.ReplacedBy)
+	}
+	if len(advices) > 0 {
+		msg += ", " + strings.Join(advices, " or ")
+	}
+	if d.RemovedIn != "" {
+		msg += ", it will be removed in otel " + d.RemovedIn
+	}
+	return msg
+}
+
+// reportDeprecatedRules warns about deprecated rules that are applied to the
+// build and reports them, each rule is reported once per package
+func reportDeprecatedRules(bundles []*resource.RuleBundle) error {
+	deprecated := make([]*resource.DeprecatedRule, 0)
+	seen := map[string]bool{}
+	add := func(bundle *resource.RuleBundle, rule resource.InstRule) {
+		if rule.GetDeprecation() == nil {
+			return
+		}
+		name := ruleDisplayName(rule)
+		key := name + "@" + bundle.ImportPath
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		deprecated = append(deprecated, &resource.DeprecatedRule{
+			Rule:            name,
+			ImportPath:      bundle.ImportPath,
+			Version:         bundle.ModuleVersion,
+			RuleDeprecation: rule.GetDeprecation(),
+		})
+	}
+	for _, bundle := range bundles {
+		for _, funcRules := range bundle.File2FuncRules {
+			for _, rules := range funcRules {
+				for _, rule := range rules {
+					add(bundle, rule)
+				}
+			}
+		}
+		for _, structRules := range bundle.File2StructRules {
+			for _, rules := range structRules {
+				for _, rule := range rules {
+					add(bundle, rule)
+				}
+			}
+		}
+		for _, rule := range bundle.FileRules {
+			add(bundle, rule)
+		}
+	}
+	sort.Slice(deprecated, func(i, j int) bool {
+		if deprecated[i].ImportPath != deprecated[j].ImportPath {
+			return deprecated[i].ImportPath < deprecated[j].ImportPath
+		}
+		return deprecated[i].Rule < deprecated[j].Rule
+	})
+	for _, d := range deprecated {
+		util.LogWarning("%s", deprecationMessage(d))
+	}
+	return resource.StoreDeprecatedRules(deprecated)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// Default rules can be disabled individually by selectors, so that users can
+// turn off the default instrumentation that conflicts with their own manual
+// instrumentation. A selector designates rules by one of
+//
+//   - rule file name, e.g. "gorm" for all rules in gorm.json
+//   - rule directory, e.g. "kratos" or "kratos/grpc"
+//   - target import path, e.g. "net/http"
+//   - rule name, e.g. "http.clientOnEnter"
+//
+// optionally followed by "." and a hook name prefix, e.g. "net/http.client"
+// disables the client side hooks of net/http while keeping the server side.
+// Rules targeting the runtime package and the otel API and SDK are never
+// disabled, as the tool itself relies on them.
+
+// disabledBy returns true if the rule loaded from ruleFile is designated by
+// the selector.
+func disabledBy(selector, ruleFile string, rule resource.InstRule) bool {
+	if selector == rule.GetName() {
+		return true
+	}
+	dir := strings.TrimPrefix(rule.GetPath(), pkgPrefix+"/rules/")
+	targets := []string{
+		strings.TrimSuffix(ruleFile, filepath.Ext(ruleFile)),
+		dir,
+		rule.GetImportPath(),
+	}
+	for _, target := range targets {
+		if selector == target {
+			return true
+		}
+		hook, found := strings.CutPrefix(selector, target+".")
+		if !found || hook == "" {
+			continue
+		}
+		if r, ok := rule.(*resource.InstFuncRule); ok {
+			if (r.OnEnter != "" && strings.HasPrefix(r.OnEnter, hook)) ||
+				(r.OnExit != "" && strings.HasPrefix(r.OnExit, hook)) {
+				return true
+			}
+		}
+	}
+	// Parent directory disables all rules in its subdirectories
+	return strings.HasPrefix(dir, selector+"/")
+}
+
+func ruleDisplayName(rule resource.InstRule) string {
+	if rule.GetName() != "" {
+		return rule.GetName()
+	}
+	return rule.String()
+}
+
+// disableRules drops the rules loaded from ruleFile that are designated by any
+// of the selectors, selectors that designate any rule are recorded in used.
+func disableRules(selectors []string, ruleFile string,
+	rules []resource.InstRule, used map[string]bool) []resource.InstRule {
+	if len(selectors) == 0 {
+		return rules
+	}
+	enabled := make([]resource.InstRule, 0, len(rules))
+	for _, rule := range rules {
+		disabled := false
+		for _, selector := range selectors {
+			if disabledBy(selector, ruleFile, rule) {
+				used[selector] = true
+				if isFundamental(rule) {
+					util.Log("Rule %s is fundamental, can not be disabled by %s",
+						ruleDisplayName(rule), selector)
+					continue
+				}
+				util.Log("Disable rule %s by %s", ruleDisplayName(rule), selector)
+				disabled = true
+				break
+			}
+		}
+		if !disabled {
+			enabled = append(enabled, rule)
+		}
+	}
+	return enabled
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/config"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// -----------------------------------------------------------------------------
+// Rule Discovery
+//
+// Projects can version their custom rules alongside the code by placing rule
+// files in the .otel/rules directory of the module, or of the workspace if the
+// module is part of a go.work. These rules are picked up automatically without
+// specifying them by "otel set -rule". They are loaded after the default rules
+// and before the rules specified explicitly, rules of the workspace first, the
+// load order matters when resolving versioned and conflicting rules.
+
+// localRuleDir is the conventional directory of rule files within the module
+// or the workspace root
+const localRuleDir = ".otel/rules"
+
+// findGoWorkDir returns the directory of go.work that the module belongs to,
+// or empty if the module is not built in workspace mode
+func findGoWorkDir(moduleDir string) string {
+	out, err := runCmdCombinedOutput(moduleDir, nil, "go", "env", "GOWORK")
+	if err != nil {
+		util.Log("Failed to find go.work: %v", err)
+		return ""
+	}
+	gowork := strings.TrimSpace(out)
+	if gowork == "" || gowork == "off" {
+		return ""
+	}
+	return filepath.Dir(gowork)
+}
+
+// listLocalRuleFiles lists JSON and YAML rule files in the .otel/rules
+// directory of dir in lexical order, signature files are read along with
+// their rule files
+func listLocalRuleFiles(dir string) ([]string, error) {
+	ruleDir := filepath.Join(dir, filepath.FromSlash(localRuleDir))
+	if util.PathNotExists(ruleDir) {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(ruleDir)
+	if err != nil {
+		return nil, errc.New(errc.ErrReadDir, err.Error())
+	}
+	files := make([]string, 0)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		if filepath.Ext(name) == ".json" || resource.IsYAMLRuleFile(name) {
+			files = append(files, filepath.Join(ruleDir, name))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// discoverLocalRules loads rules from the .otel/rules directory of the
+// workspace root and the module, in that order
+func (dp *DepProcessor) discoverLocalRules() ([]resource.InstRule, error) {
+	moduleDir := dp.getGoModDir()
+	dirs := make([]string, 0, 2)
+	if workDir := findGoWorkDir(moduleDir); workDir != "" &&
+		workDir != moduleDir {
+		dirs = append(dirs, workDir)
+	}
+	dirs = append(dirs, moduleDir)
+
+	trusted, err := config.GetConf().GetTrustedKeys()
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]resource.InstRule, 0)
+	for _, dir := range dirs {
+		files, err := listLocalRuleFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			util.Log("Discovered rule file %s", file)
+			rs, err := loadRuleFile(file, trusted)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rs...)
+		}
+	}
+	return rules, nil
+}
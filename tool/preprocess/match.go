@@ -16,12 +16,12 @@ package preprocess
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/config"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/data"
@@ -36,70 +36,144 @@ import (
 
 type ruleMatcher struct {
 	availableRules map[string][]resource.InstRule
+	// Rules whose import path is a glob pattern, e.g. github.com/mycorp/*/api,
+	// they are matched against every package being compiled
+	globRules []resource.InstRule
+	// Rules that suppress others for specific packages or functions, they take
+	// precedence over all other rules, see suppress.go
+	suppressions   []*resource.InstSuppressRule
 	moduleVersions []*vendorModule // vendor used only
+	// Target platform and build tags, used by rules with build constraints
+	buildContext *buildContext
+	// Versions of all packages in the build keyed by their import paths, used
+	// by rules with module conditions, see matchModules
+	packages map[string]string
+	// Source directories of packages using cgo keyed by their import paths,
+	// see sourceFiles
+	cgoDirs map[string]string
+	// Results of module conditions, the value is the reason why the condition
+	// is not satisfied, or empty if it is
+	conditions sync.Map
+	// Rules that target the package but are not applied, guarded by skipLock
+	// as packages are matched concurrently
+	skipped  []*resource.SkippedRule
+	skipLock sync.Mutex
 }
 
-func newRuleMatcher() *ruleMatcher {
-	rules := make(map[string][]resource.InstRule)
-	for _, rule := range findAvailableRules() {
-		rules[rule.GetImportPath()] = append(rules[rule.GetImportPath()], rule)
+func newRuleMatcher(localRules, extraRules []resource.InstRule) (*ruleMatcher, error) {
+	available, err := findAvailableRules(localRules)
+	if err != nil {
+		return nil, err
+	}
+	rm := &ruleMatcher{
+		availableRules: make(map[string][]resource.InstRule),
+		skipped:        make([]*resource.SkippedRule, 0),
+	}
+	available, rm.suppressions = splitSuppressRules(append(available, extraRules...))
+	for _, rule := range available {
+		if resource.IsImportPathPattern(rule.GetImportPath()) {
+			rm.globRules = append(rm.globRules, rule)
+			continue
+		}
+		rm.availableRules[rule.GetImportPath()] =
+			append(rm.availableRules[rule.GetImportPath()], rule)
 	}
 	if config.GetConf().Verbose {
-		util.Log("Available rules: %v", rules)
+		util.Log("Available rules: %v, glob rules: %v, suppressions: %v",
+			rm.availableRules, rm.globRules, rm.suppressions)
 	}
-	return &ruleMatcher{availableRules: rules}
+	return rm, nil
 }
 
-type ruleHolder struct {
-	resource.InstBaseRule
-	resource.InstFileRule
-	resource.InstStructRule
-	resource.InstFuncRule
+// rulesOf returns all rules that target the package, i.e. rules of the exact
+// import path followed by glob rules whose pattern matches the import path
+func (rm *ruleMatcher) rulesOf(importPath string) []resource.InstRule {
+	rules := make([]resource.InstRule, len(rm.availableRules[importPath]))
+	copy(rules, rm.availableRules[importPath])
+	for _, rule := range rm.globRules {
+		if resource.MatchImportPath(rule.GetImportPath(), importPath) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
 }
 
-func loadRuleFile(path string) ([]resource.InstRule, error) {
-	content, err := util.ReadFile(path)
+func loadRuleFile(path string, trusted []*resource.PublicKey) ([]resource.InstRule, error) {
+	if resource.IsRemoteRule(path) {
+		return loadRulePack(path, trusted)
+	}
+	file, err := resource.ReadRuleFile(path)
 	if err != nil {
 		currentDir, _ := os.Getwd()
 		err = errc.Adhere(err, "pwd", currentDir)
 		return nil, err
 	}
-	return loadRuleRaw(content)
+	return loadRuleContent(file, trusted)
 }
 
-func loadRuleRaw(content string) ([]resource.InstRule, error) {
-	var h []*ruleHolder
-	err := json.Unmarshal([]byte(content), &h)
+// loadRulePack loads rules from the remote rule pack. YAML is a superset of
+// JSON, so rule files in either format are loaded as YAML.
+func loadRulePack(ref string, trusted []*resource.PublicKey) ([]resource.InstRule, error) {
+	files, err := resource.FetchRulePack(ref)
 	if err != nil {
-		return nil, errc.New(errc.ErrInvalidJSON, err.Error())
+		return nil, err
 	}
 	rules := make([]resource.InstRule, 0)
-	for _, rule := range h {
-		if rule.StructType != "" {
-			r := &rule.InstStructRule
-			r.InstBaseRule = rule.InstBaseRule
-			rules = append(rules, r)
-		} else if rule.Function != "" {
-			r := &rule.InstFuncRule
-			r.InstBaseRule = rule.InstBaseRule
-			rules = append(rules, r)
-		} else if rule.FileName != "" {
-			r := &rule.InstFileRule
-			r.InstBaseRule = rule.InstBaseRule
-			rules = append(rules, r)
-		} else {
-			util.ShouldNotReachHereT("invalid rule type")
+	for _, file := range files {
+		rs, err := loadRuleContent(file, trusted)
+		if err != nil {
+			return nil, errc.Adhere(err, "rulePack", ref)
+		}
+		rules = append(rules, rs...)
+	}
+	return rules, nil
+}
+
+// loadRuleContent parses and validates rules from the rule file, the rule file
+// must be signed by one of the trusted keys if any. Func rules are named after
+// their hooks if they are not named explicitly.
+func loadRuleContent(file *resource.RuleFile, trusted []*resource.PublicKey) ([]resource.InstRule, error) {
+	if len(trusted) > 0 {
+		err := resource.VerifySignature(trusted, file.Name, file.Content,
+			file.Signature)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rules, err := resource.ParseRules(file.Name, file.Content)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if r, ok := rule.(*resource.InstFuncRule); ok {
+			if r.Name == "" && !r.UseRaw {
+				r.Name = defaultRuleName(r)
+			}
 		}
 	}
 	return rules, nil
 }
 
-func loadDefaultRules() []resource.InstRule {
+// defaultRuleName names the func rule after its hook function, the hook package
+// is relative to the rule directory if possible, e.g. "redigo.onBeforeDialContext"
+// The rule without hooks is named after its target, e.g. "net/http.Get"
+func defaultRuleName(rule *resource.InstFuncRule) string {
+	hook := rule.OnEnter
+	if hook == "" {
+		hook = rule.OnExit
+	}
+	if hook == "" {
+		return rule.ImportPath + "." + rule.Function
+	}
+	path := strings.TrimPrefix(rule.Path, pkgPrefix+"/rules/")
+	return path + "." + hook
+}
+
+func loadDefaultRules() ([]resource.InstRule, error) {
 	// Read all default embedded rule files
 	files, err := data.ListRuleFiles()
 	if err != nil {
-		util.Log("Failed to list default rule json files: %v", err)
-		return nil
+		return nil, errc.New(errc.ErrReadDir, err.Error())
 	}
 
 	type chunk []resource.InstRule
@@ -114,15 +188,14 @@ func loadDefaultRules() []resource.InstRule {
 		group.Go(func() error {
 			raw, err := data.ReadRuleFile(name)
 			if err != nil {
-				util.Log("Failed to read rule file %s: %v", name, err)
-				return err
+				return errc.New(errc.ErrOpenFile, err.Error())
 			}
 
-			// Parse JSON content into InstRule slice
-			rule, err := loadRuleRaw(string(raw))
+			// Default rules are shipped with the tool, they are trusted
+			file := &resource.RuleFile{Name: name, Content: string(raw)}
+			rule, err := loadRuleContent(file, nil)
 			if err != nil {
-				util.Log("Failed to parse rule file %s: %v", name, err)
-				return nil
+				return err
 			}
 
 			ruleChunks[i] = rule
@@ -131,63 +204,92 @@ func loadDefaultRules() []resource.InstRule {
 	}
 
 	if err := group.Wait(); err != nil {
-		util.Log("One or more default rule files failed to load: %v", err)
-		return nil
+		return nil, err
 	}
 
-	// Merge all ruleChunks
+	// Merge all ruleChunks except the disabled rules
 	rules := make([]resource.InstRule, 0)
-	for _, c := range ruleChunks {
-		rules = append(rules, c...)
+	selectors := config.GetConf().GetDisabledRules()
+	used := map[string]bool{}
+	for i, c := range ruleChunks {
+		rules = append(rules, disableRules(selectors, files[i], c, used)...)
+	}
+	for _, selector := range selectors {
+		if !used[selector] {
+			util.Log("No default rule is disabled by %s", selector)
+		}
 	}
 
-	return rules
+	return rules, nil
 }
 
-func findAvailableRules() []resource.InstRule {
+func findAvailableRules(localRules []resource.InstRule) ([]resource.InstRule, error) {
 	util.GuaranteeInPreprocess()
 	// Disable all instrumentation rules and rebuild the whole project to restore
 	// all instrumentation actions, this also reverts the modification on Golang
 	// runtime package.
 	if config.GetConf().Restore {
-		return nil
+		return nil, nil
 	}
 
 	rules := make([]resource.InstRule, 0)
 
 	// Load default rules unless explicitly disabled
 	if !config.GetConf().IsDisableDefault() {
-		defaultRules := loadDefaultRules()
+		defaultRules, err := loadDefaultRules()
+		if err != nil {
+			return nil, err
+		}
 		rules = append(rules, defaultRules...)
 	}
 
-	// If rule files are provided, load them
+	// Rules discovered in the .otel/rules directory of the project are loaded
+	// before explicit rule files, so that the latter take precedence
+	rules = append(rules, localRules...)
+
+	// If rule files are provided, load them. Invalid rule files are reported
+	// rather than ignored, otherwise the instrumentation silently goes away
 	if config.GetConf().RuleJsonFiles != "" {
-		// Load multiple rule files
-		if strings.Contains(config.GetConf().RuleJsonFiles, ",") {
-			ruleFiles := strings.Split(config.GetConf().RuleJsonFiles, ",")
-			for _, ruleFile := range ruleFiles {
-				r, err := loadRuleFile(ruleFile)
-				if err != nil {
-					util.Log("Failed to load rules: %v", err)
-					continue
-				}
-				rules = append(rules, r...)
-			}
-			return rules
-		}
-		// Load the one rule file
-		rs, err := loadRuleFile(config.GetConf().RuleJsonFiles)
+		trusted, err := config.GetConf().GetTrustedKeys()
 		if err != nil {
-			util.Log("Failed to load rules: %v", err)
-			return nil
+			return nil, err
+		}
+		for _, ruleFile := range strings.Split(config.GetConf().RuleJsonFiles, ",") {
+			rs, err := loadRuleFile(ruleFile, trusted)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rs...)
 		}
-		rules = append(rules, rs...)
 	}
-	return rules
+	// Rules may extend the ones loaded before them, e.g. custom rules pin a
+	// patched hook package of a default rule
+	rules, err := resource.ResolveExtends(rules)
+	if err != nil {
+		return nil, err
+	}
+	// The hook code of single rules may be overridden by local forks
+	overrides, err := config.GetConf().GetOverriddenRules()
+	if err != nil {
+		return nil, err
+	}
+	err = overrideRules(rules, overrides)
+	if err != nil {
+		return nil, err
+	}
+	// Or pinned to the ones of former versions of the pkg module
+	pins, err := config.GetConf().GetPinnedRules()
+	if err != nil {
+		return nil, err
+	}
+	err = pinRules(rules, pins)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
 }
 
-var versionRegexp = regexp.MustCompile(`@v\d+\.\d+\.\d+(-.*?)?/`)
+var versionRegexp = regexp.MustCompile(`@v\d+\.\d+\.\d+([-+].*?)?/`)
 
 func extractVersion(path string) string {
 	// Unify the path to Unix style
@@ -200,68 +302,222 @@ func extractVersion(path string) string {
 	return version[1 : len(version)-1]
 }
 
-// splitVersionRange splits the version range into two parts, start and end.
-func splitVersionRange(vr string) (string, string) {
-	util.Assert(strings.Contains(vr, ","), "invalid version range format")
-	util.Assert(strings.Contains(vr, "["), "invalid version range format")
-	util.Assert(strings.Contains(vr, ")"), "invalid version range format")
+// findModuleVersion finds the version of the module that the file belongs to.
+// If it's a vendor build, we need to extract the version of the module from
+// vendor/modules.txt, otherwise we find the version from source file path
+func (rm *ruleMatcher) findModuleVersion(importPath, file string) string {
+	version := extractVersion(file)
+	if rm.moduleVersions != nil {
+		recorded := findVendorModuleVersion(rm.moduleVersions, importPath)
+		if recorded != "" {
+			version = recorded
+		}
+	}
+	return version
+}
+
+// moreSpecific checks if the version range [lo1, hi1) is more specific than
+// [lo2, hi2), i.e. it has a higher lower bound, or a lower upper bound if the
+// lower bounds are equal. Empty bound means the range is open-ended.
+func moreSpecific(lo1, hi1, lo2, hi2 string) bool {
+	if lo1 != lo2 {
+		return lo2 == "" || (lo1 != "" && semver.Compare(lo1, lo2) > 0)
+	}
+	if hi1 != hi2 {
+		return hi2 == "" || (hi1 != "" && semver.Compare(hi1, hi2) < 0)
+	}
+	return false
+}
+
+// resolveVersionedRules resolves rules of the same name that cover the version.
+// Rule sets usually ship several versions of one rule for different version
+// ranges of the module, and they may overlap, e.g. a general rule for ">=1.0.0"
+// and a dedicated one for ">=1.4.0 <2.0.0", only the most specific one, i.e.
+// the one with the narrowest range is kept. If there is a tie, the one loaded
+// later wins, so that custom rules take precedence over default rules.
+func resolveVersionedRules(rules []resource.InstRule, version string) []resource.InstRule {
+	if version == "" {
+		return rules
+	}
+	type candidate struct {
+		index  int
+		lo, hi string
+	}
+	best := map[string]candidate{}
+	versioned := map[string]bool{}
+	for i, rule := range rules {
+		if rule.GetName() == "" {
+			continue
+		}
+		lo, hi := "", ""
+		if rule.GetVersion() != "" {
+			vc, err := util.ParseVersionConstraint(rule.GetVersion())
+			if err != nil || !vc.Match(version) {
+				continue
+			}
+			lo, hi = vc.Bounds(version)
+			versioned[rule.GetName()] = true
+		}
+		c, exist := best[rule.GetName()]
+		if !exist || !moreSpecific(c.lo, c.hi, lo, hi) {
+			best[rule.GetName()] = candidate{i, lo, hi}
+		}
+	}
+	resolved := make([]resource.InstRule, 0, len(rules))
+	for i, rule := range rules {
+		c, exist := best[rule.GetName()]
+		// Unversioned duplicates are left as they are
+		if exist && versioned[rule.GetName()] && c.index != i {
+			if matched, _ := util.MatchVersion(version, rule.GetVersion()); matched {
+				util.Log("Resolve rule %s with version %s, prefer %s over %s",
+					rule.GetName(), version,
+					rules[c.index].GetVersion(), rule.GetVersion())
+				continue
+			}
+		}
+		resolved = append(resolved, rule)
+	}
+	return resolved
+}
+
+// mismatchGoVersion returns the reason why the rule does not support the go
+// version, or empty if it does
+func mismatchGoVersion(rule resource.InstRule, goVersion string) (string, error) {
+	if rule.GetGoVersion() != "" {
+		matched, err := util.MatchVersion(goVersion, rule.GetGoVersion())
+		if err != nil {
+			return "", err
+		}
+		if !matched {
+			return fmt.Sprintf("requires go version %s, got %s",
+				rule.GetGoVersion(), goVersion), nil
+		}
+	}
+	bounds := []struct{ op, version, reason string }{
+		{">=", rule.GetMinGoVersion(), "requires go version >= %s, got %s"},
+		{"<=", rule.GetMaxGoVersion(), "requires go version <= %s, got %s"},
+	}
+	for _, bound := range bounds {
+		if bound.version == "" {
+			continue
+		}
+		vc, err := util.ParseVersionBound(bound.op, bound.version)
+		if err != nil {
+			return "", err
+		}
+		if !semver.IsValid(goVersion) {
+			return "", errc.New(errc.ErrMatchRule,
+				fmt.Sprintf("invalid version %v", goVersion))
+		}
+		if !vc.Match(goVersion) {
+			return fmt.Sprintf(bound.reason, bound.version, goVersion), nil
+		}
+	}
+	return "", nil
+}
+
+// skip records the rule that is not applied to the package
+func (rm *ruleMatcher) skip(rule resource.InstRule, importPath, reason string) {
+	util.Log("Skip rule %s for %s, %s", rule, importPath, reason)
+	rm.skipLock.Lock()
+	defer rm.skipLock.Unlock()
+	rm.skipped = append(rm.skipped, &resource.SkippedRule{
+		Rule:       ruleDisplayName(rule),
+		ImportPath: importPath,
+		Reason:     reason,
+	})
+}
+
+// findPackages finds the versions of all packages in the build, the version is
+// empty if it's unknown, e.g. packages of the standard library. Packages that
+// are only used by the otel pipeline are left out if used packages are known.
+func (rm *ruleMatcher) findPackages(compileCmds []string, used map[string]bool) {
+	rm.packages = make(map[string]string, len(compileCmds))
+	for _, cmd := range compileCmds {
+		args := util.SplitCmds(cmd)
+		importPath := findFlagValue(args, util.BuildPattern)
+		if importPath == "" || (used != nil && !used[importPath]) {
+			continue
+		}
+		rm.packages[importPath] = ""
+		for _, file := range rm.sourceFiles(importPath, args) {
+			rm.packages[importPath] = rm.findModuleVersion(importPath, file)
+			break
+		}
+	}
+}
+
+// sourceFiles returns the Go files of the compile command. The files generated
+// by cgo are mapped back to their sources, i.e. $WORK/b001/foo.cgo1.go comes
+// from foo.go of the source directory, so that the version of the package can
+// be found and their functions can be matched, the files that are generated
+// from scratch, e.g. _cgo_gotypes.go, are left out
+func (rm *ruleMatcher) sourceFiles(importPath string, args []string) []string {
+	dir, cgo := rm.cgoDirs[importPath]
+	files := make([]string, 0, len(args))
+	for _, arg := range args {
+		if !util.IsGoFile(arg) {
+			continue
+		}
+		if cgo && filepath.Dir(arg) != filepath.Clean(dir) {
+			name := filepath.Base(arg)
+			if strings.HasPrefix(name, "_cgo_") {
+				continue
+			}
+			if strings.HasSuffix(name, ".cgo1.go") {
+				arg = filepath.Join(dir, strings.TrimSuffix(name, ".cgo1.go")+".go")
+			}
+		}
+		files = append(files, arg)
+	}
+	return files
+}
 
-	start := vr[1:strings.Index(vr, ",")]
-	end := vr[strings.Index(vr, ",")+1 : len(vr)-1]
-	return "v" + start, "v" + end
+// findModule reports whether any package of the module is in the build, along
+// with the version of the module
+func (rm *ruleMatcher) findModule(path string) (string, bool) {
+	for importPath, version := range rm.packages {
+		if importPath == path || strings.HasPrefix(importPath, path+"/") {
+			return version, true
+		}
+	}
+	return "", false
 }
 
-// matchVersion checks if the version string matches the version range in the
-// rule. The version range is in format [start, end), where start is inclusive
-// and end is exclusive. If the rule version string is empty, it always matches.
-func matchVersion(version string, ruleVersion string) (bool, error) {
-	// Fast path, always match if the rule version is not specified
-	if ruleVersion == "" {
-		return true, nil
-	}
-	// Check if both rule version and package version are in sane
-	if !strings.Contains(version, "v") {
-		return false, errc.New(errc.ErrMatchRule,
-			fmt.Sprintf("invalid version %v", version))
-	}
-	if !strings.Contains(ruleVersion, "[") ||
-		!strings.Contains(ruleVersion, ")") ||
-		!strings.Contains(ruleVersion, ",") ||
-		strings.Contains(ruleVersion, "v") {
-		return false, errc.New(errc.ErrMatchRule,
-			fmt.Sprintf("invalid rule version %v", ruleVersion))
-	}
-	// Remove extra whitespace from the rule version string
-	ruleVersion = strings.ReplaceAll(ruleVersion, " ", "")
-
-	// Compare the version with the rule version, the rule version is in the
-	// format [start, end), where start is inclusive and end is exclusive
-	// and start or end can be omitted, which means the range is open-ended.
-	ruleVersionStart, ruleVersionEnd := splitVersionRange(ruleVersion)
-	switch {
-	case ruleVersionStart != "v" && ruleVersionEnd != "v":
-		// Full version range
-		if semver.Compare(version, ruleVersionStart) >= 0 &&
-			semver.Compare(version, ruleVersionEnd) < 0 {
-			return true, nil
-		}
-	case ruleVersionStart == "v":
-		// Only end is specified
-		util.Assert(ruleVersionEnd != "v", "sanity check")
-		if semver.Compare(version, ruleVersionEnd) < 0 {
-			return true, nil
-		}
-	case ruleVersionEnd == "v":
-		// Only start is specified
-		util.Assert(ruleVersionStart != "v", "sanity check")
-		if semver.Compare(version, ruleVersionStart) >= 0 {
-			return true, nil
-		}
-	default:
-		return false, errc.New(errc.ErrMatchRule,
-			fmt.Sprintf("invalid rule version range %v", ruleVersion))
-	}
-	return false, nil
+// mismatchModules returns the reason why the modules in the build do not
+// satisfy the module conditions of the rule, or empty if they do
+func (rm *ruleMatcher) mismatchModules(rule resource.InstRule) string {
+	for _, cond := range rule.GetWhenModules() {
+		if reason, ok := rm.conditions.Load(cond); ok {
+			if reason != "" {
+				return reason.(string)
+			}
+			continue
+		}
+		reason := ""
+		path, versionRange, absent, err := resource.ParseModuleCondition(cond)
+		if err != nil {
+			reason = err.Error()
+		} else {
+			version, present := rm.findModule(path)
+			// Modules of unknown versions, e.g. replaced by local directories,
+			// never fall in the version range
+			if present && versionRange != "" {
+				matched, err := util.MatchVersion(version, versionRange)
+				present = err == nil && matched
+			}
+			if present && absent {
+				reason = fmt.Sprintf("module %s is in the build", cond[1:])
+			} else if !present && !absent {
+				reason = fmt.Sprintf("module %s is not in the build", cond)
+			}
+		}
+		rm.conditions.Store(cond, reason)
+		if reason != "" {
+			return reason
+		}
+	}
+	return ""
 }
 
 // match gives compilation arguments and finds out all interested rules
@@ -272,12 +528,10 @@ func (rm *ruleMatcher) match(cmdArgs []string) *resource.RuleBundle {
 	if config.GetConf().Verbose {
 		util.Log("RunMatch: %v (%v)", importPath, cmdArgs)
 	}
-	availables := make([]resource.InstRule, len(rm.availableRules[importPath]))
-
 	// Okay, we are interested in these candidates, let's read it and match with
 	// the instrumentation rule, but first we need to check if the package name
 	// are already registered, to avoid futile effort
-	copy(availables, rm.availableRules[importPath])
+	availables := rm.rulesOf(importPath)
 	if len(availables) == 0 {
 		return nil // fast fail
 	}
@@ -288,29 +542,57 @@ func (rm *ruleMatcher) match(cmdArgs []string) *resource.RuleBundle {
 	util.Assert(goVersion != "", "sanity check")
 	util.Assert(strings.HasPrefix(goVersion, "go"), "sanity check")
 	goVersion = strings.Replace(goVersion, "go", "v", 1)
-	for _, candidate := range cmdArgs {
-		// It's not a go file, ignore silently
-		if !util.IsGoFile(candidate) {
-			continue
+
+	// Several versions of one rule may cover the module version, resolve them
+	// in advance so that only the most specific one is applied
+	files := rm.sourceFiles(importPath, cmdArgs)
+	for _, candidate := range files {
+		version := rm.findModuleVersion(importPath, candidate)
+		availables = resolveVersionedRules(availables, version)
+		bundle.ModuleVersion = version
+		break
+	}
+
+	// Suppression rules take precedence over all others, rules suppressed for
+	// the whole package are dropped in advance, while those suppressed for
+	// some functions are checked once the functions are found
+	suppressions := suppressionsOf(rm.suppressions, importPath,
+		bundle.ModuleVersion)
+	for i := len(availables) - 1; i >= 0; i-- {
+		s := suppressedBy(suppressions, availables[i], nil)
+		if s != nil {
+			rm.skip(availables[i], importPath, "suppressed by "+s.GetSource())
+			availables = append(availables[:i], availables[i+1:]...)
 		}
-		file := candidate
+	}
+	if len(availables) == 0 {
+		return nil
+	}
+	for _, s := range suppressions {
+		if s.Function != "" {
+			bundle.Suppressions = append(bundle.Suppressions, s)
+		}
+	}
 
-		// If it's a vendor build, we need to extract the version of the module
-		// from vendor/modules.txt, otherwise we find the version from source
-		// code file path
-		version := extractVersion(file)
-		if rm.moduleVersions != nil {
-			recorded := findVendorModuleVersion(rm.moduleVersions, importPath)
-			if recorded != "" {
-				version = recorded
-			}
+	// The package is compiled along with its test files by "go test", only
+	// then rules of the test scope may apply
+	withTests := false
+	for _, candidate := range files {
+		if !resource.InScope(resource.ScopeSource, candidate) {
+			withTests = true
+			break
 		}
+	}
+
+	for _, file := range files {
+
+		version := rm.findModuleVersion(importPath, file)
 
 		for i := len(availables) - 1; i >= 0; i-- {
 			rule := availables[i]
 
 			// Check if the version is supported
-			matched, err := matchVersion(version, rule.GetVersion())
+			matched, err := util.MatchVersion(version, rule.GetVersion())
 			if err != nil {
 				util.Log("Bad match: file %s, rule %s, version %s",
 					file, rule, version)
@@ -319,22 +601,40 @@ func (rm *ruleMatcher) match(cmdArgs []string) *resource.RuleBundle {
 			if !matched {
 				continue
 			}
-			// Check if the rule requires a specific Go version(range)
-			if rule.GetGoVersion() != "" {
-				matched, err = matchVersion(goVersion, rule.GetGoVersion())
-				if err != nil {
-					util.Log("Bad match: file %s, rule %s, go version %s",
-						file, rule, goVersion)
-					continue
-				}
-				if !matched {
-					continue
-				}
+			// Check if the rule requires a specific Go version(range), rules
+			// that do not support the toolchain are skipped for the package
+			reason, err := mismatchGoVersion(rule, goVersion)
+			if err != nil {
+				util.Log("Bad match: file %s, rule %s, go version %s",
+					file, rule, goVersion)
+				continue
+			}
+			if reason != "" {
+				rm.skip(rule, importPath, reason)
+				availables = append(availables[:i], availables[i+1:]...)
+				continue
+			}
+			// Check if other modules of the build satisfy the rule, e.g. a
+			// rule that correlates logs of zap with spans of gin
+			if reason = rm.mismatchModules(rule); reason != "" {
+				rm.skip(rule, importPath, reason)
+				availables = append(availables[:i], availables[i+1:]...)
+				continue
 			}
 
 			// Check if it matches with file rule early as we try to avoid
 			// parsing the file content, which is time consuming
-			if _, ok := rule.(*resource.InstFileRule); ok {
+			if fileRule, ok := rule.(*resource.InstFileRule); ok {
+				if rule.GetScope() == resource.ScopeTest && !withTests {
+					continue
+				}
+				expr := fileRule.BuildConstraint
+				if expr != "" && !rm.buildContext.satisfies(expr, goVersion) {
+					rm.skip(rule, importPath,
+						"build constraint "+expr+" is not satisfied")
+					availables = append(availables[:i], availables[i+1:]...)
+					continue
+				}
 				ast, err := util.ParseAstFromFileOnlyPackage(file)
 				if ast == nil || err != nil {
 					util.Log("Failed to parse %s: %v", file, err)
@@ -347,6 +647,12 @@ func (rm *ruleMatcher) match(cmdArgs []string) *resource.RuleBundle {
 				continue
 			}
 
+			// The file is not targeted by the rule, e.g. a _test.go file for
+			// rules of the default scope
+			if !resource.InScope(rule.GetScope(), file) {
+				continue
+			}
+
 			// Fair enough, parse the file content
 			var tree *dst.File
 			if _, ok := parsedAst[file]; !ok {
@@ -390,6 +696,19 @@ func (rm *ruleMatcher) match(cmdArgs []string) *resource.RuleBundle {
 				} else if funcDecl, ok := decl.(*dst.FuncDecl); ok {
 					if rl, ok := rule.(*resource.InstFuncRule); ok {
 						if util.MatchFuncDecl(funcDecl, rl.Function, rl.ReceiverType) {
+							reason := util.WhyNotHookable(file, funcDecl)
+							if reason != "" {
+								// Keep looking for other hookable functions
+								util.Log("Skip func rule %s for %s.%s: %s",
+									rule, importPath, funcDecl.Name.Name, reason)
+								continue
+							}
+							s := suppressedBy(suppressions, rule, funcDecl)
+							if s != nil {
+								util.Log("Skip func rule %s for %s.%s: suppressed by %s",
+									rule, importPath, funcDecl.Name.Name, s.GetSource())
+								continue
+							}
 							util.Log("Match func rule %s with %v", rule, cmdArgs)
 							err = bundle.AddFile2FuncRule(file, rl)
 							if err != nil {
@@ -570,7 +889,17 @@ func (dp *DepProcessor) matchRules() ([]*resource.RuleBundle, error) {
 		return nil, err
 	}
 
-	matcher := newRuleMatcher()
+	// Rules discovered in the project and synthesized from //otel:instrument
+	// annotations are matched along with the available rules
+	matcher, err := newRuleMatcher(dp.localRules, dp.annotationRules)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher.buildContext, err = dp.newBuildContext()
+	if err != nil {
+		return nil, err
+	}
 
 	// If we are in vendor mode, we need to parse the vendor/modules.txt file
 	// to get the version of each module for future matching
@@ -585,6 +914,16 @@ func (dp *DepProcessor) matchRules() ([]*resource.RuleBundle, error) {
 		matcher.moduleVersions = modules
 	}
 
+	// Packages using cgo are compiled from the files generated by cgo, they
+	// are matched against their sources instead
+	matcher.cgoDirs, err = getCgoSourceDirs()
+	if err != nil {
+		return nil, err
+	}
+
+	// Rules may be conditional on other modules used by the project
+	matcher.findPackages(compileCmds, dp.getUsedPackages())
+
 	// Find used instrumentation rule according to compile commands
 	ch := make(chan *resource.RuleBundle)
 	for _, cmd := range compileCmds {
@@ -599,5 +938,19 @@ func (dp *DepProcessor) matchRules() ([]*resource.RuleBundle, error) {
 		}
 		cnt++
 	}
+	// Drop rules whose target packages are only used by the otel pipeline
+	bundles = dp.pruneRuleBundles(bundles)
+
+	// Resolve rules that target the same function, struct field or file
+	err = matcher.resolveConflicts(bundles)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report rules that are skipped rather than failing the compilation
+	err = resource.StoreSkippedRules(matcher.skipped)
+	if err != nil {
+		return nil, err
+	}
 	return bundles, nil
 }
@@ -0,0 +1,190 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/config"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// goProxy memorizes GOPROXY of the build that prefers the mirror
+var goProxy = ""
+
+// goProxyEnv returns the environment that makes the go command download the
+// hook packages and their dependencies from the mirror, if configured. Modules
+// that are not mirrored, e.g. dependencies of the project, are downloaded from
+// the proxies configured as usual.
+func goProxyEnv() []string {
+	if config.GetConf().Mirror == "" {
+		return nil
+	}
+	if goProxy == "" {
+		mirror := strings.TrimSuffix(config.GetConf().Mirror, "/")
+		goProxy = mirror + "/" + resource.MirrorGoDir
+		out, err := runCmdCombinedOutput("", nil, "go", "env", "GOPROXY")
+		if err == nil && strings.TrimSpace(out) != "" {
+			goProxy += "," + strings.TrimSpace(out)
+		}
+	}
+	return []string{"GOPROXY=" + goProxy}
+}
+
+func printMirrorUsage(fs *flag.FlagSet) {
+	name, _ := util.GetToolName()
+	fmt.Printf("Usage: %s mirror [-rule=<rule packs>] <dir>\n", name)
+	fs.PrintDefaults()
+}
+
+// Mirror populates the directory with everything the build fetches remotely,
+// i.e. the configured rule packs and the hook packages along with their
+// dependencies, so that the directory can be served as the mirror for builds
+// that cannot reach public endpoints, see resource/mirror.go.
+//
+//	otel mirror [-rule=<rule packs>] <dir>
+func Mirror() error {
+	fs := flag.NewFlagSet("mirror", flag.ContinueOnError)
+	rules := fs.String("rule", "",
+		"Remote rule packs to mirror besides the configured ones, separated by comma")
+	fs.Usage = func() { printMirrorUsage(fs) }
+	err := fs.Parse(os.Args[2:])
+	if err == flag.ErrHelp {
+		return nil
+	}
+	if err != nil {
+		return errc.New(errc.ErrMirror, err.Error())
+	}
+	if fs.NArg() != 1 {
+		printMirrorUsage(fs)
+		return errc.New(errc.ErrMirror, "expect the mirror directory")
+	}
+	dir, err := filepath.Abs(fs.Arg(0))
+	if err != nil {
+		return errc.New(errc.ErrAbsPath, err.Error())
+	}
+
+	// Everything is fetched from where it is published, even if the mirror
+	// is configured for builds
+	err = resource.SetRuleMirror("", false)
+	if err != nil {
+		return err
+	}
+	refs := make([]string, 0)
+	mirrored := map[string]bool{}
+	candidates := strings.Split(config.GetConf().RuleJsonFiles+","+*rules, ",")
+	for _, ref := range candidates {
+		ref = strings.TrimSpace(ref)
+		if resource.IsRemoteRule(ref) && !mirrored[ref] {
+			refs = append(refs, ref)
+			mirrored[ref] = true
+		}
+	}
+	for _, ref := range refs {
+		err = resource.MirrorRulePack(ref, dir)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Mirrored rule pack %s\n", ref)
+	}
+	err = mirrorGoModules(filepath.Join(dir, resource.MirrorGoDir))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Mirrored hook packages to %s\n", dir)
+	return nil
+}
+
+// fileProxy returns the GOPROXY entry that serves modules from the directory
+func fileProxy(dir string) string {
+	dir = filepath.ToSlash(dir)
+	if !strings.HasPrefix(dir, "/") {
+		// Windows paths are like file:///C:/path
+		dir = "/" + dir
+	}
+	return "file://" + dir
+}
+
+// mirrorGoModules downloads the hook packages and all their dependencies into
+// a fresh module cache, whose download directory is a valid GOPROXY. Modules
+// in the local module cache are reused rather than downloaded again, their
+// checksums are verified against the checksum database as usual.
+func mirrorGoModules(dir string) error {
+	work, err := os.MkdirTemp("", "otel-mirror")
+	if err != nil {
+		return errc.New(errc.ErrMkdirAll, err.Error())
+	}
+	defer func() { _ = os.RemoveAll(work) }()
+	modCache, err := runCmdCombinedOutput("", nil, "go", "env", "GOMODCACHE")
+	if err != nil {
+		return err
+	}
+	proxy, err := runCmdCombinedOutput("", nil, "go", "env", "GOPROXY")
+	if err != nil {
+		return err
+	}
+	localProxy := filepath.Join(strings.TrimSpace(modCache), "cache", "download")
+	env := []string{
+		"GOMODCACHE=" + filepath.Join(work, "modcache"),
+		"GOPROXY=" + fileProxy(localProxy) + "," + strings.TrimSpace(proxy),
+		"GOFLAGS=-modcacherw",
+	}
+	_, err = runCmdCombinedOutput(work, env, "go", "mod", "init", "otel-mirror")
+	if err != nil {
+		return err
+	}
+
+	// Resolve the hook packages as the build does, see findModCacheDir and
+	// rectifyMod
+	pkgDir := config.BuildPath
+	if pkgDir == "" || util.PathNotExists(pkgDir) {
+		out, err := runCmdCombinedOutput(work, env, "go", "mod", "download",
+			"-json", pkgPrefix+"@"+config.UsedPkg)
+		if err != nil {
+			return err
+		}
+		var info moduleInfo
+		if err = json.Unmarshal([]byte(out), &info); err != nil {
+			return errc.New(errc.ErrInvalidJSON, err.Error())
+		}
+		if info.Error != "" {
+			return errc.New(errc.ErrMirror, info.Error)
+		}
+		pkgDir = info.Dir
+	}
+	args := []string{"go", "mod", "edit",
+		"-require=" + pkgPrefix + "@v0.0.0-00010101000000-000000000000",
+		"-replace=" + pkgPrefix + "=" + pkgDir,
+	}
+	for path, version := range otelDeps {
+		args = append(args, "-replace="+path+"="+path+"@"+version)
+	}
+	_, err = runCmdCombinedOutput(work, env, args...)
+	if err != nil {
+		return err
+	}
+	_, err = runCmdCombinedOutput(work, env, "go", "mod", "download", "all")
+	if err != nil {
+		return err
+	}
+	return util.CopyDir(filepath.Join(work, "modcache", "cache", "download"), dir)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// -----------------------------------------------------------------------------
+// Local hook packages
+//
+// The hook code of a rule is usually provided by the pkg module, it can also be
+// a local directory, e.g. a fork of the hook code of a default rule that is
+// overridden by -overriderules. Local hook packages are copied into the
+// project, so that they can be imported like the generated annotation hooks.
+
+const localHookDir = "local"
+
+// overrideRules replaces the hook code of the rules of given names with local
+// forks, while all other fields of them are kept
+func overrideRules(rules []resource.InstRule, overrides map[string]string) error {
+	for name, dir := range overrides {
+		found := false
+		for _, rule := range rules {
+			if rule.GetName() != name {
+				continue
+			}
+			if fr, ok := rule.(*resource.InstFuncRule); ok && fr.UseRaw {
+				return errc.New(errc.ErrInvalidRule,
+					fmt.Sprintf("rule %s has no hook code to override", name))
+			}
+			util.Log("Override hook code of rule %s with %s", name, dir)
+			rule.SetPath(dir)
+			found = true
+		}
+		if !found {
+			return errc.New(errc.ErrInvalidRule,
+				fmt.Sprintf("no rule named %s to override", name))
+		}
+	}
+	return nil
+}
+
+// isLocalHookPath checks if the hook code of the rule is a local directory
+// rather than a package of the pkg module
+func isLocalHookPath(path string) bool {
+	return filepath.IsAbs(path)
+}
+
+// localHookName names the copy of the local hook package after its directory,
+// e.g. "redigo_1a2b3c4d", so that forks of the same name do not collide
+func localHookName(dir string) string {
+	sum := sha256.Sum256([]byte(dir))
+	return filepath.Base(dir) + "_" + hex.EncodeToString(sum[:4])
+}
+
+// localHookDirOf returns where the local hook package is copied to
+func (dp *DepProcessor) localHookDirOf(dir string) string {
+	return filepath.Join(dp.generatedOf(OtelPkgDir), localHookDir,
+		localHookName(dir))
+}
+
+// localHookPkgPath returns the import path of the copied local hook package
+func (dp *DepProcessor) localHookPkgPath(dir string) string {
+	return dp.moduleName + "/" + OtelPkgDir + "/" + localHookDir + "/" +
+		localHookName(dir)
+}
+
+// copyLocalHooks copies the go files of the local hook package into the
+// project, other files of the fork, e.g. go.mod and test files, are left
+func (dp *DepProcessor) copyLocalHooks(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errc.New(errc.ErrReadDir, err.Error())
+	}
+	target := dp.localHookDirOf(dir)
+	err = os.MkdirAll(target, 0777)
+	if err != nil {
+		return errc.New(errc.ErrMkdirAll, err.Error())
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !util.IsGoFile(name) ||
+			strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		err = util.CopyFile(filepath.Join(dir, name), filepath.Join(target, name))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/config"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"golang.org/x/mod/semver"
+)
+
+// -----------------------------------------------------------------------------
+// Pinned rules
+//
+// The hook code of a rule comes from the pkg module that is bound to the tool,
+// so upgrading the tool changes the behavior of all hooks at once. Sensitive
+// services pin the hook code of single rules to the version of the pkg module
+// they have verified by -pinrules. The hook package of the pinned version is
+// used as a local hook package, see override.go, while the packages shared by
+// all hooks, e.g. inst-api, still come from the pkg module of the tool, which
+// is why the pinned version is checked to be compatible with the tool.
+
+// pinnedPkgs caches the downloaded pkg modules keyed by their versions
+var pinnedPkgs = map[string]*moduleInfo{}
+
+// pinRules replaces the hook code of the rules of given names with the hook
+// code of given versions of the pkg module
+func pinRules(rules []resource.InstRule, pins map[string]string) error {
+	if len(pins) == 0 {
+		return nil
+	}
+	current := config.BuildPath
+	if current == "" || util.PathNotExists(current) {
+		info, err := downloadPkgModule("", config.UsedPkg)
+		if err != nil {
+			return err
+		}
+		current = info.Dir
+	}
+	for name, version := range pins {
+		found := false
+		for _, rule := range rules {
+			if rule.GetName() != name {
+				continue
+			}
+			if fr, ok := rule.(*resource.InstFuncRule); ok && fr.UseRaw {
+				return errc.New(errc.ErrInvalidRule,
+					fmt.Sprintf("rule %s has no hook code to pin", name))
+			}
+			path := rule.GetPath()
+			if !strings.HasPrefix(path, pkgPrefix+"/") {
+				return errc.New(errc.ErrInvalidRule,
+					fmt.Sprintf("hook code of rule %s is not in the pkg module", name))
+			}
+			dir, err := pinnedHookDir(path, version, current)
+			if err != nil {
+				return errc.Adhere(err, "rule", name)
+			}
+			util.Log("Pin hook code of rule %s to %s", name, version)
+			rule.SetPath(dir)
+			found = true
+		}
+		if !found {
+			return errc.New(errc.ErrInvalidRule,
+				fmt.Sprintf("no rule named %s to pin", name))
+		}
+	}
+	return nil
+}
+
+// pinnedHookDir returns the directory of the hook package in the pinned
+// version of the pkg module, once the version is compatible with the tool
+func pinnedHookDir(path, version, current string) (string, error) {
+	err := checkPinnedVersion(version)
+	if err != nil {
+		return "", err
+	}
+	info, exist := pinnedPkgs[version]
+	if !exist {
+		info, err = downloadPkgModule("", version)
+		if err != nil {
+			return "", err
+		}
+		err = checkPinnedDeps(info, version)
+		if err != nil {
+			return "", err
+		}
+		pinnedPkgs[version] = info
+	}
+	dir := filepath.Join(info.Dir, strings.TrimPrefix(path, pkgPrefix))
+	if util.PathNotExists(dir) {
+		return "", errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("hook package %s is not found in %s", path, version))
+	}
+	err = checkPinnedImports(dir, version, current)
+	if err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// checkPinnedVersion checks the pinned version against the version of the pkg
+// module bound to the tool, the tool does not know about newer hook code, nor
+// is it compatible with another major version
+func checkPinnedVersion(version string) error {
+	if !semver.IsValid(config.UsedPkg) {
+		return nil
+	}
+	if semver.Major(version) != semver.Major(config.UsedPkg) {
+		return errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("pinned version %s is incompatible with %s of the tool",
+				version, config.UsedPkg))
+	}
+	if semver.Compare(version, config.UsedPkg) > 0 {
+		return errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("pinned version %s is newer than %s of the tool",
+				version, config.UsedPkg))
+	}
+	return nil
+}
+
+// checkPinnedDeps checks that the pinned version of the pkg module requires no
+// newer OTel dependencies than the tool, as they are replaced by the versions
+// of the tool, see rectifyMod
+func checkPinnedDeps(info *moduleInfo, version string) error {
+	if info.GoMod == "" {
+		return nil
+	}
+	mod, err := parseGoMod(info.GoMod)
+	if err != nil {
+		return err
+	}
+	for _, req := range mod.Require {
+		v, exist := otelDeps[req.Mod.Path]
+		if exist && semver.Compare(req.Mod.Version, v) > 0 {
+			return errc.New(errc.ErrInvalidRule,
+				fmt.Sprintf("pinned version %s requires %s, while the tool uses %s",
+					version, req.Mod, v))
+		}
+	}
+	return nil
+}
+
+// checkPinnedImports checks that the packages of the pkg module imported by the
+// pinned hook package are still there in the pkg module of the tool
+func checkPinnedImports(dir, version, current string) error {
+	files, err := util.ListFilesFlat(dir)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if !util.IsGoFile(file) || util.IsGoTestFile(file) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ImportsOnly)
+		if err != nil {
+			return errc.New(errc.ErrParseCode, err.Error())
+		}
+		for _, spec := range f.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || !strings.HasPrefix(path, pkgPrefix+"/") {
+				continue
+			}
+			p := filepath.Join(current, strings.TrimPrefix(path, pkgPrefix))
+			if util.PathNotExists(p) {
+				return errc.New(errc.ErrInvalidRule,
+					fmt.Sprintf("pinned version %s imports %s, which is "+
+						"no longer provided by the tool", version, path))
+			}
+		}
+	}
+	return nil
+}
@@ -23,6 +23,8 @@ import (
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -62,8 +64,15 @@ type DepProcessor struct {
 	modulePath    string // Where go.mod is located
 	goBuildCmd    []string
 	vendorMode    bool
-	pkgLocalCache string // Local module cache path of alibaba-otel pkg module
-	otelImporter  string // Path to the otel_importer.go file
+	pkgLocalCache string          // Local module cache path of alibaba-otel pkg module
+	otelImporter  string          // Path to the otel_importer.go file
+	importerPkg   string          // Import path of the package of otel_importer.go
+	importerName  string          // Package name of otel_importer.go
+	usedPkgs      map[string]bool // Packages used by the project itself
+	// Rules discovered in the .otel/rules directory of the project
+	localRules []resource.InstRule
+	// Rules synthesized from //otel:instrument annotations of the project
+	annotationRules []resource.InstRule
 }
 
 func newDepProcessor() *DepProcessor {
@@ -72,6 +81,8 @@ func newDepProcessor() *DepProcessor {
 		vendorMode:    false,
 		pkgLocalCache: "",
 		otelImporter:  "",
+		importerPkg:   "main",
+		importerName:  "main",
 	}
 	return dp
 }
@@ -140,12 +151,20 @@ func parseGoMod(gomod string) (*modfile.File, error) {
 	return modFile, nil
 }
 
-func (dp *DepProcessor) initCmd() {
+func (dp *DepProcessor) initCmd() error {
 	// There is a tricky, all arguments after the otel tool itself are saved for
 	// later use, which means the subcommand "go build" itself are also included
 	dp.goBuildCmd = make([]string, len(os.Args)-1)
 	copy(dp.goBuildCmd, os.Args[1:])
 	util.AssertGoBuild(dp.goBuildCmd)
+	// Exporters that are not wanted are excluded by build tags, which apply
+	// to both the dry build and the final build
+	pruned, err := config.GetConf().GetPrunedExporters()
+	if err != nil {
+		return err
+	}
+	dp.goBuildCmd = withBuildTags(dp.goBuildCmd, exporterTags(pruned))
+	return nil
 }
 
 func findMainDir(pkgs []*packages.Package) (string, error) {
@@ -195,6 +214,20 @@ func (dp *DepProcessor) initMod() (err error) {
 			util.Assert(pkg.Module.GoMod != "", "pkg.Module.GoMod is empty")
 			dp.moduleName = pkg.Module.Path
 			dp.modulePath = pkg.Module.GoMod
+			if util.IsGoTestCmd(dp.goBuildCmd) {
+				// The tested package is compiled under its import path even
+				// if it's a main package, and there may be no main package
+				// at all, place the importer in the tested package instead
+				if dp.importerPkg != "main" && dp.importerPkg != pkg.PkgPath {
+					return errc.New(errc.ErrPreprocess,
+						"cannot test multiple packages at once")
+				}
+				dp.otelImporter = filepath.Join(filepath.Dir(pkg.GoFiles[0]),
+					OtelImporter)
+				dp.importerPkg = pkg.PkgPath
+				dp.importerName = pkg.Name
+				continue
+			}
 			dir, err := findMainDir(pkgs)
 			if err != nil {
 				return err
@@ -304,8 +337,11 @@ func (dp *DepProcessor) initSignalHandler() {
 }
 
 func (dp *DepProcessor) init() error {
-	dp.initCmd()
-	err := dp.initMod()
+	err := dp.initCmd()
+	if err != nil {
+		return err
+	}
+	err = dp.initMod()
 	if err != nil {
 		return err
 	}
@@ -399,6 +435,59 @@ func getCompileCommands() ([]string, error) {
 	return compileCmds, nil
 }
 
+// getCgoSourceDirs finds the source directories of packages using cgo, keyed
+// by their import paths. The compiler is given the files generated by cgo for
+// such packages, e.g. $WORK/b001/foo.cgo1.go, while cgo itself runs in the
+// source directory, i.e.
+//
+//	cd /path/to/pkg
+//	CGO_LDFLAGS='...' /path/to/cgo -objdir $WORK/b001/ -importpath pkg ...
+func getCgoSourceDirs() (map[string]string, error) {
+	dryRunLog, err := os.Open(util.GetLogPath(DryRunLog))
+	if err != nil {
+		return nil, errc.New(errc.ErrOpenFile, err.Error())
+	}
+	defer func(dryRunLog *os.File) {
+		err := dryRunLog.Close()
+		if err != nil {
+			util.Log("Failed to close dry run log file: %v", err)
+		}
+	}(dryRunLog)
+
+	cgoDirs := make(map[string]string)
+	scanner := bufio.NewScanner(dryRunLog)
+	// 10MB should be enough to accommodate most long line
+	buffer := make([]byte, 0, 10*1024*1024)
+	scanner.Buffer(buffer, cap(buffer))
+	dir := ""
+	for scanner.Scan() {
+		line := strings.Trim(scanner.Text(), " ")
+		if after, found := cutPrefix(line, "cd "); found {
+			dir = strings.Trim(after, " ")
+			continue
+		}
+		if dir == "" || !strings.Contains(line, "-objdir") {
+			continue
+		}
+		args := util.SplitCmds(line)
+		for _, arg := range args {
+			name := filepath.Base(arg)
+			if name == "cgo" || name == "cgo.exe" {
+				importPath := findFlagValue(args, "-importpath")
+				if importPath != "" {
+					cgoDirs[importPath] = dir
+				}
+				break
+			}
+		}
+	}
+	err = scanner.Err()
+	if err != nil {
+		return nil, errc.New(errc.ErrParseCode, "cannot parse dry run log")
+	}
+	return cgoDirs, nil
+}
+
 // $ go help packages
 // Many commands apply to a set of packages:
 //
@@ -505,7 +594,8 @@ func findModule(buildCmd []string) ([]*packages.Package, error) {
 		// Stop canary when we see a build flag or a "build" command
 		if strings.HasPrefix("-", buildArg) ||
 			buildArg == "build" ||
-			buildArg == "install" {
+			buildArg == "install" ||
+			buildArg == "test" {
 			break
 		}
 
@@ -573,9 +663,9 @@ func runDryBuild(goBuildCmd []string) ([]string, error) {
 	if err != nil {
 		return nil, errc.New(errc.ErrCreateFile, err.Error())
 	}
-	// The full build command is: "go build/install -a -x -n  {...}"
+	// The full build command is: "go build/install/test -a -x -n  {...}"
 	args := []string{}
-	args = append(args, goBuildCmd[:2]...)             // go build/install
+	args = append(args, goBuildCmd[:2]...)             // go build/install/test
 	args = append(args, []string{"-a", "-x", "-n"}...) // -a -x -n
 	args = append(args, goBuildCmd[2:]...)             // {...} remaining
 	util.AssertGoBuild(goBuildCmd)
@@ -584,6 +674,7 @@ func runDryBuild(goBuildCmd []string) ([]string, error) {
 	// Run the dry build
 	util.Log("Run dry build %v", args)
 	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), goProxyEnv()...)
 	// This is a little anti-intuitive as the error message is not printed to
 	// the stderr, instead it is printed to the stdout, only the build tool
 	// knows the reason why.
@@ -607,7 +698,7 @@ func runDryBuild(goBuildCmd []string) ([]string, error) {
 }
 
 func (dp *DepProcessor) runModTidy() error {
-	out, err := runCmdCombinedOutput(dp.getGoModDir(), nil,
+	out, err := runCmdCombinedOutput(dp.getGoModDir(), goProxyEnv(),
 		"go", "mod", "tidy")
 	util.Log("Run go mod tidy: %v", out)
 	return err
@@ -708,7 +799,22 @@ func runBuildWithToolexec(goBuildCmd []string) error {
 	// @@ Note that we should not set the working directory here, as the build
 	// with toolexec should be run in the same directory as the original build
 	// command
-	out, err := runCmdCombinedOutput("", buildGoCacheEnv(goCachePath), args...)
+	if util.IsGoTestCmd(args) {
+		// The output of tests is what the user is waiting for, stream it
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Env = append(os.Environ(), buildGoCacheEnv(goCachePath)...)
+		cmd.Env = append(cmd.Env, goProxyEnv()...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err = cmd.Run()
+		if err != nil {
+			return errc.New(errc.ErrRunCmd, err.Error()).
+				With("command", fmt.Sprintf("%v", args))
+		}
+		return nil
+	}
+	env := append(buildGoCacheEnv(goCachePath), goProxyEnv()...)
+	out, err := runCmdCombinedOutput("", env, args...)
 	util.Log("Output from toolexec build: %v", out)
 	return err
 }
@@ -729,7 +835,7 @@ func precheck() error {
 		config.PrintVersion()
 		os.Exit(0)
 	}
-	if os.Args[2] != "build" && os.Args[2] != "install" {
+	if os.Args[2] != "build" && os.Args[2] != "install" && os.Args[2] != "test" {
 		// exec original go command
 		err := util.RunCmd(os.Args[1:]...)
 		if err != nil {
@@ -801,14 +907,44 @@ func (dp *DepProcessor) saveDebugFiles() {
 //go:embed template.go
 var importerTemplate string
 
+// hookSwitchesOf returns the rules whose trampolines load the runtime switch
+// and the overhead counters of their hooks on every invocation, keyed by the
+// name of the switch variable in the instrumented package
+func hookSwitchesOf(bundle *resource.RuleBundle) map[string]*resource.InstFuncRule {
+	switches := map[string]*resource.InstFuncRule{}
+	for _, funcRules := range bundle.File2FuncRules {
+		for _, rules := range funcRules {
+			for _, rule := range rules {
+				if !rule.UseRaw && !rule.IsMetricsOnly() {
+					switches[rule.GetSwitchName()] = rule
+				}
+			}
+		}
+	}
+	return switches
+}
+
 func (dp *DepProcessor) newRuleImporterWith(bundles []*resource.RuleBundle) error {
 	importerTemplate = strings.ReplaceAll(importerTemplate,
 		util.GoBuildIgnoreComment, "")
+	if dp.importerName != "main" {
+		importerTemplate = strings.Replace(importerTemplate,
+			"package main", "package "+dp.importerName, 1)
+	}
+
+	// Embed the manifest of matched rules into the binary, so that it can be
+	// told what instrumentation exactly is inside the binary
+	manifest, err := resource.NewManifest(bundles, config.ToolVersion).Encode()
+	if err != nil {
+		return err
+	}
+	register := fmt.Sprintf("\tmanifest.Register(%s)\n", strconv.Quote(manifest))
 
 	// No rule bundles? We still need to generate the otel_importer.go file whose
 	// purpose is to import the fundamental dependencies
 	if len(bundles) == 0 {
-		_, err := util.WriteFile(dp.otelImporter, importerTemplate)
+		content := importerTemplate + "func init() {\n" + register + "}\n"
+		_, err = util.WriteFile(dp.otelImporter, content)
 		if err != nil {
 			return err
 		}
@@ -817,6 +953,8 @@ func (dp *DepProcessor) newRuleImporterWith(bundles []*resource.RuleBundle) erro
 
 	// Generate the otel_importer.go file with the rule bundles
 	paths := map[string]bool{}
+	hooks := map[string]bool{}
+	metrics := map[string]*resource.FuncMetrics{}
 	for _, bundle := range bundles {
 		for _, funcRules := range bundle.File2FuncRules {
 			for _, rules := range funcRules {
@@ -824,6 +962,12 @@ func (dp *DepProcessor) newRuleImporterWith(bundles []*resource.RuleBundle) erro
 					if rule.GetPath() != "" {
 						paths[rule.GetPath()] = true
 					}
+					if !rule.UseRaw {
+						hooks[rule.GetName()] = true
+					}
+					if rule.Metrics != nil {
+						metrics[rule.GetName()] = rule.Metrics
+					}
 				}
 			}
 		}
@@ -831,12 +975,81 @@ func (dp *DepProcessor) newRuleImporterWith(bundles []*resource.RuleBundle) erro
 	content := importerTemplate
 	replaceMap := map[string][2]string{}
 	for path := range paths {
+		// Local hook packages are copied into the project
+		if isLocalHookPath(path) {
+			err = dp.copyLocalHooks(path)
+			if err != nil {
+				return err
+			}
+			content += fmt.Sprintf("import _ %q\n", dp.localHookPkgPath(path))
+			continue
+		}
 		content += fmt.Sprintf("import _ %q\n", path)
+		// The generated annotation hooks are part of the project
+		if path == dp.annotationPkgPath() {
+			continue
+		}
 		t := strings.TrimPrefix(path, pkgPrefix)
 		replaceMap[path] = [2]string{filepath.Join(dp.pkgLocalCache, t), ""}
 	}
 	cnt := 0
+	local := map[string]bool{}
+	switchInit := ""
+	content += "type hookEnabled struct{}\n"
+	content += "func (hookEnabled) Load() bool { return false }\n"
+	content += "type hookUncounted struct{}\n"
+	content += "func (hookUncounted) Start() int64 { return 0 }\n"
+	content += "func (hookUncounted) Record(int64, bool) {}\n"
 	for _, bundle := range bundles {
+		// Switches and overhead counters of hooks are resolved once during
+		// initialization instead of being looked up by the rule name on every
+		// invocation. The importer defines the ones of its own package, as it
+		// can not link to them. They are resolved in init function, hooks of
+		// package initializers running before it see the statically
+		// initialized hookEnabled and hookUncounted, which keeps the linked
+		// variables definitions rather than references
+		switches := hookSwitchesOf(bundle)
+		names := make([]string, 0, len(switches))
+		for name := range switches {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for i, name := range names {
+			rule := switches[name]
+			if bundle.ImportPath == dp.importerPkg {
+				if local[name] {
+					continue
+				}
+				local[name] = true
+				content += fmt.Sprintf("var %s interface{ Load() bool }\n", name)
+				content += fmt.Sprintf("var %s %s\n", rule.GetCounterName(), resource.HookCounterType)
+				switchInit += fmt.Sprintf("\t%s = hook.Switch(%q)\n", name, rule.GetName())
+				switchInit += fmt.Sprintf("\t%s = overhead.Counter(%q)\n", rule.GetCounterName(), rule.GetName())
+				continue
+			}
+			lb := fmt.Sprintf("//go:linkname hookswitch%d_%d %s.%s\n", cnt, i, bundle.ImportPath, name)
+			content += lb
+			s := fmt.Sprintf("var hookswitch%d_%d interface{ Load() bool } = hookEnabled{}\n", cnt, i)
+			content += s
+			switchInit += fmt.Sprintf("\thookswitch%d_%d = hook.Switch(%q)\n", cnt, i, rule.GetName())
+			lb = fmt.Sprintf("//go:linkname hookcounter%d_%d %s.%s\n", cnt, i, bundle.ImportPath, rule.GetCounterName())
+			content += lb
+			s = fmt.Sprintf("var hookcounter%d_%d %s = hookUncounted{}\n", cnt, i, resource.HookCounterType)
+			content += s
+			switchInit += fmt.Sprintf("\thookcounter%d_%d = overhead.Counter(%q)\n", cnt, i, rule.GetName())
+		}
+		if bundle.ImportPath == dp.importerPkg {
+			// The importer itself is placed in the main package, or the tested
+			// package of "go test", it can not link to variables of its own
+			// package, define them instead
+			content += "var OtelGetStackImpl = debug.Stack\n"
+			content += "var OtelPrintStackImpl = func (bt []byte){ log.Printf(string(bt)) }\n"
+			content += "var OtelStartMetricsImpl = funcmetrics.Start\n"
+			content += "var OtelRecordMetricsImpl = funcmetrics.Record\n"
+			content += "var OtelNanotimeImpl = overhead.Now\n"
+			content += "var OtelPanicHookImpl = overhead.Panic\n"
+			continue
+		}
 		lb := fmt.Sprintf("//go:linkname getstatck%d %s.OtelGetStackImpl\n", cnt, bundle.ImportPath)
 		content += lb
 		s := fmt.Sprintf("var getstatck%d = debug.Stack\n", cnt)
@@ -845,11 +1058,69 @@ func (dp *DepProcessor) newRuleImporterWith(bundles []*resource.RuleBundle) erro
 		content += lb
 		s = fmt.Sprintf("var printstack%d = func (bt []byte){ log.Printf(string(bt)) }\n", cnt)
 		content += s
+		lb = fmt.Sprintf("//go:linkname startmetrics%d %s.OtelStartMetricsImpl\n", cnt, bundle.ImportPath)
+		content += lb
+		s = fmt.Sprintf("var startmetrics%d = funcmetrics.Start\n", cnt)
+		content += s
+		lb = fmt.Sprintf("//go:linkname recordmetrics%d %s.OtelRecordMetricsImpl\n", cnt, bundle.ImportPath)
+		content += lb
+		s = fmt.Sprintf("var recordmetrics%d = funcmetrics.Record\n", cnt)
+		content += s
+		lb = fmt.Sprintf("//go:linkname nanotime%d %s.OtelNanotimeImpl\n", cnt, bundle.ImportPath)
+		content += lb
+		s = fmt.Sprintf("var nanotime%d = overhead.Now\n", cnt)
+		content += s
+		lb = fmt.Sprintf("//go:linkname panichook%d %s.OtelPanicHookImpl\n", cnt, bundle.ImportPath)
+		content += lb
+		s = fmt.Sprintf("var panichook%d = overhead.Panic\n", cnt)
+		content += s
 		cnt++
 	}
+	// Register all matched hooks so that they can be listed and switched at
+	// runtime by their names
+	names := make([]string, 0, len(hooks))
+	for name := range hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	content += "func init() {\n"
+	content += register
+	content += switchInit
+	for _, name := range names {
+		content += fmt.Sprintf("\thook.Register(%q)\n", name)
+		// Static attributes of the metrics, if any
+		if m := metrics[name]; m != nil && len(m.Attributes) > 0 {
+			keys := make([]string, 0, len(m.Attributes))
+			for k := range m.Attributes {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			kv := make([]string, 0, 2*len(keys))
+			for _, k := range keys {
+				kv = append(kv, strconv.Quote(k), strconv.Quote(m.Attributes[k]))
+			}
+			content += fmt.Sprintf("\tfuncmetrics.Register(%q, %s)\n",
+				name, strings.Join(kv, ", "))
+		}
+		// So are the instruments chosen by the rule
+		if m := metrics[name]; m != nil && len(m.Instruments) > 0 {
+			kinds := make([]string, 0, len(m.Instruments))
+			for _, kind := range m.Instruments {
+				kinds = append(kinds, strconv.Quote(kind))
+			}
+			content += fmt.Sprintf("\tfuncmetrics.SetInstruments(%q, %s)\n",
+				name, strings.Join(kinds, ", "))
+		}
+	}
+	content += "}\n"
 	util.WriteFile(dp.otelImporter, content)
 	// Add replace directives for all matched rules
-	err := addModReplace(dp.getGoModPath(), replaceMap)
+	err = addModReplace(dp.getGoModPath(), replaceMap)
+	if err != nil {
+		return err
+	}
+	// Add modules required by hook code of the matched rules
+	err = addModRequire(dp.getGoModPath(), collectRequirements(bundles))
 	if err != nil {
 		return err
 	}
@@ -879,6 +1150,19 @@ func Preprocess() error {
 			return err
 		}
 
+		// Discover rules versioned alongside the project and synthesize rules
+		// for functions annotated with //otel:instrument
+		if !config.GetConf().Restore {
+			dp.localRules, err = dp.discoverLocalRules()
+			if err != nil {
+				return err
+			}
+			dp.annotationRules, err = dp.newAnnotationRules()
+			if err != nil {
+				return err
+			}
+		}
+
 		// Two round of rule matching
 		//    {prepare->refresh}
 		//        1st match
@@ -916,6 +1200,12 @@ func Preprocess() error {
 			}
 		}
 
+		// Warn about deprecated rules before they are removed for good
+		err = reportDeprecatedRules(bundles)
+		if err != nil {
+			return err
+		}
+
 		// Rectify file rules to make sure we can find them locally
 		err = dp.rectifyRule(bundles)
 		if err != nil {
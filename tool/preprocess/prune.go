@@ -0,0 +1,246 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"golang.org/x/tools/go/packages"
+)
+
+// -----------------------------------------------------------------------------
+// Dead Rule Elimination
+//
+// The otel_importer.go file imports the whole otel pipeline, i.e. the SDK and
+// all of its exporters, which in turn bring in packages such as grpc and
+// net/http. Rules targeting these packages are matched in the dry build even
+// if the project itself never uses them, the corresponding hook packages and
+// their dependencies are then linked into the final binary for nothing. To
+// avoid this, we compute the import graph of the project without the importer
+// and drop all rule bundles whose target packages are not part of it.
+//
+// Besides, exporters that are not wanted by -exporters are excluded from the
+// otel pipeline by build tags, so neither they nor their dependencies such as
+// grpc are linked into the final binary.
+
+const (
+	otelApiPrefix     = "go.opentelemetry.io/otel"
+	runtimePkg        = "runtime"
+	exporterTagPrefix = "otel_no_"
+)
+
+// findBuildTags finds the value of -tags flag from the build command, which
+// affects the import graph of the project.
+func findBuildTags(goBuildCmd []string) string {
+	for i, arg := range goBuildCmd {
+		if arg == "-tags" && i+1 < len(goBuildCmd) {
+			return goBuildCmd[i+1]
+		}
+		if strings.HasPrefix(arg, "-tags=") {
+			return strings.TrimPrefix(arg, "-tags=")
+		}
+	}
+	return ""
+}
+
+// withBuildTags adds the given build tags to the -tags flag of the build
+// command, the flag is inserted right after the subcommand if it's absent.
+func withBuildTags(goBuildCmd []string, tags []string) []string {
+	if len(tags) == 0 {
+		return goBuildCmd
+	}
+	join := func(value string) string {
+		// Both comma and space separated tags are accepted by go build
+		existing := strings.Fields(strings.ReplaceAll(value, ",", " "))
+		return strings.Join(append(existing, tags...), ",")
+	}
+	cmd := make([]string, 0, len(goBuildCmd)+1)
+	found := false
+	for i := 0; i < len(goBuildCmd); i++ {
+		arg := goBuildCmd[i]
+		if found {
+			cmd = append(cmd, arg)
+			continue
+		}
+		if arg == "-tags" && i+1 < len(goBuildCmd) {
+			cmd = append(cmd, arg, join(goBuildCmd[i+1]))
+			i++
+			found = true
+			continue
+		}
+		if strings.HasPrefix(arg, "-tags=") {
+			cmd = append(cmd, "-tags="+join(strings.TrimPrefix(arg, "-tags=")))
+			found = true
+			continue
+		}
+		cmd = append(cmd, arg)
+	}
+	if !found {
+		cmd = append(cmd[:2:2], "-tags="+strings.Join(tags, ","))
+		cmd = append(cmd, goBuildCmd[2:]...)
+	}
+	return cmd
+}
+
+// exporterTags returns the build tags that exclude the given exporters
+func exporterTags(exporters []string) []string {
+	tags := make([]string, 0, len(exporters))
+	for _, exporter := range exporters {
+		tags = append(tags, exporterTagPrefix+exporter)
+	}
+	return tags
+}
+
+func (dp *DepProcessor) loadProjectPackages() ([]*packages.Package, error) {
+	candidates, err := findModule(dp.goBuildCmd)
+	if err != nil {
+		return nil, err
+	}
+	// Files and packages can not be loaded together, load them separately
+	files := make([]string, 0)
+	pkgPaths := make([]string, 0)
+	for _, pkg := range candidates {
+		if pkg.PkgPath == "" || pkg.PkgPath == "command-line-arguments" {
+			for _, file := range pkg.GoFiles {
+				if filepath.Base(file) == OtelImporter {
+					continue
+				}
+				files = append(files, file)
+			}
+			continue
+		}
+		pkgPaths = append(pkgPaths, pkg.PkgPath)
+	}
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles |
+			packages.NeedImports | packages.NeedDeps,
+	}
+	if tags := findBuildTags(dp.goBuildCmd); tags != "" {
+		cfg.BuildFlags = []string{"-tags=" + tags}
+	}
+	// Imports of test files are also used by "go test"
+	cfg.Tests = util.IsGoTestCmd(dp.goBuildCmd)
+	pkgs := make([]*packages.Package, 0)
+	for _, patterns := range [][]string{files, pkgPaths} {
+		if len(patterns) == 0 {
+			continue
+		}
+		loaded, err := packages.Load(cfg, patterns...)
+		if err != nil {
+			return nil, errc.New(errc.ErrPreprocess, err.Error())
+		}
+		pkgs = append(pkgs, loaded...)
+	}
+	return pkgs, nil
+}
+
+// findUsedPackages returns all packages that are reachable from the project
+// without the otel_importer.go file.
+func (dp *DepProcessor) findUsedPackages() (map[string]bool, error) {
+	pkgs, err := dp.loadProjectPackages()
+	if err != nil {
+		return nil, err
+	}
+	return collectUsedPackages(pkgs)
+}
+
+// collectUsedPackages walks the import graph from the given project packages,
+// only imports of their original source files are taken into account.
+func collectUsedPackages(pkgs []*packages.Package) (map[string]bool, error) {
+	used := map[string]bool{}
+	var walk func(pkg *packages.Package)
+	walk = func(pkg *packages.Package) {
+		if used[pkg.PkgPath] {
+			return
+		}
+		used[pkg.PkgPath] = true
+		for _, dep := range pkg.Imports {
+			walk(dep)
+		}
+	}
+	fset := token.NewFileSet()
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, errc.New(errc.ErrPreprocess, pkg.Errors[0].Error())
+		}
+		used[pkg.PkgPath] = true
+		if pkg.Name == "main" {
+			// The main package is always compiled as "main"
+			used["main"] = true
+		}
+		// The importer is placed in the main package, only imports of the
+		// original source files are taken into account
+		for _, file := range pkg.GoFiles {
+			if filepath.Base(file) == OtelImporter {
+				continue
+			}
+			f, err := parser.ParseFile(fset, file, nil, parser.ImportsOnly)
+			if err != nil {
+				return nil, errc.New(errc.ErrParseCode, err.Error())
+			}
+			for _, spec := range f.Imports {
+				path := strings.Trim(spec.Path.Value, "\"")
+				if dep, ok := pkg.Imports[path]; ok {
+					walk(dep)
+				}
+			}
+		}
+	}
+	return used, nil
+}
+
+// getUsedPackages returns the packages used by the project, or nil if they can
+// not be found
+func (dp *DepProcessor) getUsedPackages() map[string]bool {
+	if dp.usedPkgs == nil {
+		used, err := dp.findUsedPackages()
+		if err != nil {
+			// Not a big deal, we just lose the chance to shrink the binary
+			util.Log("Failed to find used packages: %v", err)
+			return nil
+		}
+		dp.usedPkgs = used
+	}
+	return dp.usedPkgs
+}
+
+// pruneRuleBundles removes rule bundles whose target packages are only
+// introduced by the otel pipeline. Rules targeting the otel API and SDK are
+// always retained as they are fundamental to the instrumentation itself, so
+// are rules targeting the runtime package, which is linked into every binary
+// even if the project imports nothing.
+func (dp *DepProcessor) pruneRuleBundles(bundles []*resource.RuleBundle) []*resource.RuleBundle {
+	if dp.getUsedPackages() == nil {
+		return bundles
+	}
+	retained := make([]*resource.RuleBundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		if dp.usedPkgs[bundle.ImportPath] ||
+			bundle.ImportPath == runtimePkg ||
+			strings.HasPrefix(bundle.ImportPath, otelApiPrefix) {
+			retained = append(retained, bundle)
+			continue
+		}
+		util.Log("Prune rule bundle %s as it is not used by the project",
+			bundle.ImportPath)
+	}
+	return retained
+}
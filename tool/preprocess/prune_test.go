@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"golang.org/x/tools/go/packages"
+)
+
+// writeSource writes a source file importing the given packages and returns
+// its path.
+func writeSource(t *testing.T, name string, imports ...string) string {
+	var sb strings.Builder
+	sb.WriteString("package p\n")
+	for _, imp := range imports {
+		sb.WriteString("import _ \"" + imp + "\"\n")
+	}
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(sb.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestCollectUsedPackages(t *testing.T) {
+	runtime := &packages.Package{PkgPath: "runtime"}
+	fmt := &packages.Package{PkgPath: "fmt",
+		Imports: map[string]*packages.Package{"runtime": runtime}}
+	grpc := &packages.Package{PkgPath: "google.golang.org/grpc",
+		Imports: map[string]*packages.Package{"fmt": fmt}}
+	redis := &packages.Package{PkgPath: "github.com/redis/go-redis/v9",
+		Imports: map[string]*packages.Package{"fmt": fmt}}
+
+	cases := []struct {
+		name   string
+		pkgs   func(t *testing.T) []*packages.Package
+		expect []string
+	}{
+		{
+			name: "transitive imports",
+			pkgs: func(t *testing.T) []*packages.Package {
+				return []*packages.Package{{
+					Name: "lib", PkgPath: "example.com/lib",
+					GoFiles: []string{writeSource(t, "lib.go", redis.PkgPath)},
+					Imports: map[string]*packages.Package{redis.PkgPath: redis},
+				}}
+			},
+			expect: []string{"example.com/lib", "fmt",
+				"github.com/redis/go-redis/v9", "runtime"},
+		},
+		{
+			name: "main package",
+			pkgs: func(t *testing.T) []*packages.Package {
+				return []*packages.Package{{
+					Name: "main", PkgPath: "example.com/app",
+					GoFiles: []string{writeSource(t, "main.go")},
+				}}
+			},
+			expect: []string{"example.com/app", "main"},
+		},
+		{
+			name: "imports of otel importer",
+			pkgs: func(t *testing.T) []*packages.Package {
+				return []*packages.Package{{
+					Name: "main", PkgPath: "example.com/app",
+					GoFiles: []string{
+						writeSource(t, "main.go", redis.PkgPath),
+						writeSource(t, OtelImporter, grpc.PkgPath),
+					},
+					Imports: map[string]*packages.Package{
+						redis.PkgPath: redis,
+						grpc.PkgPath:  grpc,
+					},
+				}}
+			},
+			expect: []string{"example.com/app", "fmt",
+				"github.com/redis/go-redis/v9", "main", "runtime"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			used, err := collectUsedPackages(c.pkgs(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := make([]string, 0, len(used))
+			for pkg := range used {
+				actual = append(actual, pkg)
+			}
+			sort.Strings(actual)
+			if !reflect.DeepEqual(actual, c.expect) {
+				t.Fatalf("expect %v, got %v", c.expect, actual)
+			}
+		})
+	}
+
+	broken := []*packages.Package{{PkgPath: "example.com/broken",
+		Errors: []packages.Error{{Msg: "no Go files"}}}}
+	if _, err := collectUsedPackages(broken); err == nil {
+		t.Fatal("expect error for packages with errors")
+	}
+}
+
+func TestPruneRuleBundles(t *testing.T) {
+	cases := []struct {
+		name       string
+		importPath string
+		retained   bool
+	}{
+		{"used", "github.com/redis/go-redis/v9", true},
+		{"unused", "google.golang.org/grpc", false},
+		{"main", "main", true},
+		{"runtime", "runtime", true},
+		{"otel api", "go.opentelemetry.io/otel/trace", true},
+		{"otel sdk", "go.opentelemetry.io/otel/sdk/trace", true},
+	}
+	dp := &DepProcessor{usedPkgs: map[string]bool{
+		"main":                         true,
+		"github.com/redis/go-redis/v9": true,
+	}}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			bundle := resource.NewRuleBundle(c.importPath)
+			retained := dp.pruneRuleBundles([]*resource.RuleBundle{bundle})
+			if (len(retained) == 1) != c.retained {
+				t.Fatalf("expect retained %v, got %v", c.retained, retained)
+			}
+		})
+	}
+}
+
+func TestWithBuildTags(t *testing.T) {
+	tags := []string{"otel_no_zipkin", "otel_no_otlpgrpc"}
+	cases := []struct {
+		name   string
+		cmd    []string
+		expect []string
+	}{
+		{
+			name:   "no tags",
+			cmd:    []string{"go", "build", "-o", "app", "."},
+			expect: []string{"go", "build", "-tags=otel_no_zipkin,otel_no_otlpgrpc", "-o", "app", "."},
+		},
+		{
+			name:   "tags flag",
+			cmd:    []string{"go", "build", "-tags", "foo,bar", "."},
+			expect: []string{"go", "build", "-tags", "foo,bar,otel_no_zipkin,otel_no_otlpgrpc", "."},
+		},
+		{
+			name:   "tags flag with value",
+			cmd:    []string{"go", "test", "-tags=foo bar", "./..."},
+			expect: []string{"go", "test", "-tags=foo,bar,otel_no_zipkin,otel_no_otlpgrpc", "./..."},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := withBuildTags(c.cmd, tags)
+			if !reflect.DeepEqual(actual, c.expect) {
+				t.Fatalf("expect %v, got %v", c.expect, actual)
+			}
+		})
+	}
+	cmd := []string{"go", "build", "."}
+	if actual := withBuildTags(cmd, nil); !reflect.DeepEqual(actual, cmd) {
+		t.Fatalf("expect %v, got %v", cmd, actual)
+	}
+}
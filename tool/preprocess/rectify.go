@@ -24,6 +24,7 @@ import (
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"github.com/dave/dst"
 )
 
 const (
@@ -53,6 +54,7 @@ type moduleInfo struct {
 	Path  string `json:"Path"`
 	Error string `json:"Error"`
 	Dir   string `json:"Dir"`
+	GoMod string `json:"GoMod"`
 }
 
 func (dp *DepProcessor) findModCacheDir() (string, error) {
@@ -66,22 +68,31 @@ func (dp *DepProcessor) findModCacheDir() (string, error) {
 		// fall back to using the remote pkg module.
 		return config.BuildPath, nil
 	}
-	pkgVersion := config.UsedPkg
-	modulePath := pkgPrefix + "@" + pkgVersion
-	output, err := runCmdCombinedOutput(dp.getGoModDir(), nil,
-		"go", "mod", "download", "-json", modulePath)
+	info, err := downloadPkgModule(dp.getGoModDir(), config.UsedPkg)
 	if err != nil {
 		return "", err
 	}
+	return info.Dir, nil
+}
+
+// downloadPkgModule downloads the given version of the pkg module into the
+// module cache
+func downloadPkgModule(dir, version string) (*moduleInfo, error) {
+	modulePath := pkgPrefix + "@" + version
+	output, err := runCmdCombinedOutput(dir, goProxyEnv(),
+		"go", "mod", "download", "-json", modulePath)
+	if err != nil {
+		return nil, err
+	}
 	var moduleInfo moduleInfo
 	if err := json.Unmarshal([]byte(output), &moduleInfo); err != nil {
-		return "", errc.New(errc.ErrPreprocess, "failed to unmarshal module info")
+		return nil, errc.New(errc.ErrPreprocess, "failed to unmarshal module info")
 	}
 	if moduleInfo.Error != "" {
-		return "", errc.New(errc.ErrPreprocess,
+		return nil, errc.New(errc.ErrPreprocess,
 			fmt.Sprintf("error downloading module: %s", moduleInfo.Error))
 	}
-	return moduleInfo.Dir, nil
+	return &moduleInfo, nil
 }
 
 // rectifyRule rectifies the file rules path to the local module cache path.
@@ -93,7 +104,8 @@ func (dp *DepProcessor) rectifyRule(bundles []*resource.RuleBundle) error {
 		for _, funcRules := range bundle.File2FuncRules {
 			for _, rs := range funcRules {
 				for _, rule := range rs {
-					if rule.UseRaw {
+					// Raw code and metrics-only rules have no hook code
+					if rule.UseRaw || rule.GetPath() == "" {
 						continue
 					}
 					if rectified[rule.GetPath()] {
@@ -101,22 +113,95 @@ func (dp *DepProcessor) rectifyRule(bundles []*resource.RuleBundle) error {
 					}
 					p := strings.TrimPrefix(rule.Path, pkgPrefix)
 					p = filepath.Join(dp.pkgLocalCache, p)
+					if rule.Path == dp.annotationPkgPath() {
+						// Generated annotation hooks are placed in the project
+						p = filepath.Join(dp.generatedOf(OtelPkgDir), annotationPkg)
+					} else if isLocalHookPath(rule.Path) {
+						// So are local hook packages
+						p = dp.localHookDirOf(rule.Path)
+					}
 					rule.SetPath(p)
 					rectified[p] = true
 				}
 			}
 		}
 		for _, fileRule := range bundle.FileRules {
-			if rectified[fileRule.GetPath()] {
+			// Init code is generated rather than copied from the hook files
+			if fileRule.Init != "" || rectified[fileRule.GetPath()] {
 				continue
 			}
-			p := strings.TrimPrefix(fileRule.Path, pkgPrefix)
-			p = filepath.Join(dp.pkgLocalCache, p)
+			p := fileRule.Path
+			if !isLocalHookPath(p) {
+				p = strings.TrimPrefix(p, pkgPrefix)
+				p = filepath.Join(dp.pkgLocalCache, p)
+			}
 			fileRule.SetPath(p)
 			fileRule.FileName = filepath.Join(p, fileRule.FileName)
 			rectified[p] = true
 		}
 	}
+	return verifyRuleHooks(bundles)
+}
+
+// findHookFuncs returns the names of all top-level functions in the hook package
+func findHookFuncs(dir string) (map[string]bool, error) {
+	files, err := util.ListFilesFlat(dir)
+	if err != nil {
+		return nil, err
+	}
+	funcs := map[string]bool{}
+	for _, file := range files {
+		if !util.IsGoFile(file) || util.IsGoTestFile(file) {
+			continue
+		}
+		root, err := util.ParseAstFromFileFast(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range root.Decls {
+			if fn, ok := decl.(*dst.FuncDecl); ok && fn.Recv == nil {
+				funcs[fn.Name.Name] = true
+			}
+		}
+	}
+	return funcs, nil
+}
+
+// verifyRuleHooks verifies that hook functions of the rules loaded from rule
+// files exist, otherwise the rule would fail the compilation of the target
+// package with an obscure error.
+func verifyRuleHooks(bundles []*resource.RuleBundle) error {
+	hookFuncs := map[string]map[string]bool{}
+	for _, bundle := range bundles {
+		for _, funcRules := range bundle.File2FuncRules {
+			for _, rs := range funcRules {
+				for _, rule := range rs {
+					if rule.UseRaw || rule.GetPath() == "" ||
+						rule.GetSource() == "" {
+						continue
+					}
+					funcs, exist := hookFuncs[rule.GetPath()]
+					if !exist {
+						var err error
+						funcs, err = findHookFuncs(rule.GetPath())
+						if err != nil {
+							return errc.New(errc.ErrInvalidRule,
+								fmt.Sprintf("%s: hook package %s not found",
+									rule.GetSource(), rule.GetPath()))
+						}
+						hookFuncs[rule.GetPath()] = funcs
+					}
+					for _, hook := range []string{rule.OnEnter, rule.OnExit} {
+						if hook != "" && !funcs[hook] {
+							return errc.New(errc.ErrInvalidRule,
+								fmt.Sprintf("%s: hook function %s not found in %s",
+									rule.GetSource(), hook, rule.GetPath()))
+						}
+					}
+				}
+			}
+		}
+	}
 	return nil
 }
 
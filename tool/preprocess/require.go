@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"golang.org/x/mod/semver"
+)
+
+// -----------------------------------------------------------------------------
+// Rule Requirements
+//
+// Hook code may import modules that the project does not depend on, e.g. a
+// helper package, rules declare them by "Requires" and we add them to go.mod
+// of the project before refreshing the dependencies, go.sum is then updated by
+// go mod tidy. The requirements never downgrade the project, i.e. if go.mod
+// already requires a higher version, or replaces the module, it is kept as is.
+
+type requirement struct {
+	version string
+	rule    string // Rule that requires the version, for logging only
+}
+
+func addRequirement(requires map[string]*requirement, rule resource.InstRule) {
+	for _, req := range rule.GetRequires() {
+		// Requirements are validated when the rule is loaded
+		path, version, err := resource.ParseRequirement(req)
+		if err != nil {
+			continue
+		}
+		prev, exist := requires[path]
+		if exist && semver.Compare(prev.version, version) >= 0 {
+			continue
+		}
+		name := rule.GetName()
+		if name == "" {
+			name = rule.GetSource()
+		}
+		requires[path] = &requirement{version, name}
+	}
+}
+
+// collectRequirements collects modules required by the matched rules, the
+// highest version wins if several rules require the same module
+func collectRequirements(bundles []*resource.RuleBundle) map[string]*requirement {
+	requires := map[string]*requirement{}
+	for _, bundle := range bundles {
+		for _, funcRules := range bundle.File2FuncRules {
+			for _, rules := range funcRules {
+				for _, rule := range rules {
+					addRequirement(requires, rule)
+				}
+			}
+		}
+		for _, structRules := range bundle.File2StructRules {
+			for _, rules := range structRules {
+				for _, rule := range rules {
+					addRequirement(requires, rule)
+				}
+			}
+		}
+		for _, rule := range bundle.FileRules {
+			addRequirement(requires, rule)
+		}
+	}
+	return requires
+}
+
+// addModRequire adds the requirements to the go.mod file unless go.mod
+// replaces the module or requires a higher version of it
+func addModRequire(gomod string, requires map[string]*requirement) error {
+	modfile, err := parseGoMod(gomod)
+	if err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(requires))
+	for path := range requires {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	added := false
+	for _, path := range paths {
+		req := requires[path]
+		if modfile.Module != nil && modfile.Module.Mod.Path == path {
+			continue
+		}
+		replaced := false
+		for _, r := range modfile.Replace {
+			if r.Old.Path == path {
+				replaced = true
+				break
+			}
+		}
+		if replaced {
+			util.Log("Keep %s replaced by go.mod, rule %s requires %s",
+				path, req.rule, req.version)
+			continue
+		}
+		for _, e := range modfile.Exclude {
+			if e.Mod.Path == path && e.Mod.Version == req.version {
+				return errc.New(errc.ErrPreprocess,
+					fmt.Sprintf("%s@%s required by rule %s is excluded by go.mod",
+						path, req.version, req.rule))
+			}
+		}
+		current := ""
+		for _, r := range modfile.Require {
+			if r.Mod.Path == path {
+				current = r.Mod.Version
+				break
+			}
+		}
+		if current != "" && semver.Compare(current, req.version) >= 0 {
+			util.Log("Keep %s %s required by go.mod, rule %s requires %s",
+				path, current, req.rule, req.version)
+			continue
+		}
+		err = modfile.AddRequire(path, req.version)
+		if err != nil {
+			return errc.New(errc.ErrPreprocess, err.Error())
+		}
+		util.Log("Require %s %s by rule %s", path, req.version, req.rule)
+		added = true
+	}
+
+	if added {
+		bs, err := modfile.Format()
+		if err != nil {
+			return errc.New(errc.ErrPreprocess, err.Error())
+		}
+		_, err = util.WriteFile(gomod, string(bs))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,417 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"golang.org/x/mod/semver"
+)
+
+// -----------------------------------------------------------------------------
+// Simulate
+//
+// "otel simulate" matches the rules against the modules required by go.mod
+// and go.sum without building the project, it's fast enough to run as a
+// pre-commit hook, so that dependency upgrades that would drop the coverage
+// of instrumentation are caught at review time. Functions are not looked up in
+// the source code, a rule is considered applied as long as its module is
+// required with a satisfying version, and rules targeting the standard library
+// are considered applied as long as the go version is satisfied.
+
+var simulateUsage = `Usage: {} simulate [-json] [-baseline=<report>] [go.mod]
+Example:
+	{} simulate
+	{} simulate -json > otel-coverage.json
+	{} simulate -baseline=otel-coverage.json
+
+Flags:
+`
+
+// SimulatedRule is the match outcome of a rule, it's shaped like the entry of
+// the manifest, see resource.ManifestEntry
+type SimulatedRule struct {
+	Rule    string `json:"rule,omitempty"`
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Target  string `json:"target"`
+	// Module that provides the package and its version, the module is empty
+	// if it's not required, or "std" for the standard library
+	Module  string `json:"module,omitempty"`
+	Version string `json:"version,omitempty"`
+	Applied bool   `json:"applied"`
+	Reason  string `json:"reason,omitempty"`
+	// The rule itself, e.g. for documenting its telemetry
+	rule resource.InstRule
+}
+
+// Simulation is the match outcome of all rules against the go.mod
+type Simulation struct {
+	GoVersion string           `json:"go"`
+	Entries   []*SimulatedRule `json:"rules"`
+}
+
+// indexSimulation designates the outcome by the rule and its target. Unnamed
+// rules, e.g. raw rules, may share the target, they are told apart by their
+// order, which is stable as long as the rules are loaded in the same order.
+func indexSimulation(sim *Simulation) map[string]*SimulatedRule {
+	index := make(map[string]*SimulatedRule, len(sim.Entries))
+	seen := map[string]int{}
+	for _, sr := range sim.Entries {
+		key := sr.Rule + " " + sr.Target
+		index[fmt.Sprintf("%s#%d", key, seen[key])] = sr
+		seen[key]++
+	}
+	return index
+}
+
+func printSimulateUsage(fs *flag.FlagSet) {
+	name, _ := util.GetToolName()
+	fmt.Print(strings.ReplaceAll(simulateUsage, "{}", name))
+	fs.PrintDefaults()
+}
+
+// Simulate runs the "simulate" command
+func Simulate() error {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "Print the match outcome as JSON")
+	baseline := fs.String("baseline", "",
+		"Fail if rules applied in the given JSON outcome are no longer applied")
+	fs.Usage = func() { printSimulateUsage(fs) }
+	err := fs.Parse(os.Args[2:])
+	if err == flag.ErrHelp {
+		return nil
+	}
+	if err != nil {
+		return errc.New(errc.ErrSimulate, err.Error())
+	}
+	if fs.NArg() > 1 {
+		printSimulateUsage(fs)
+		return errc.New(errc.ErrSimulate, "expect at most one go.mod")
+	}
+	gomod := util.GoModFile
+	if fs.NArg() == 1 {
+		gomod = fs.Arg(0)
+	}
+	gomod, err = filepath.Abs(gomod)
+	if err != nil {
+		return errc.New(errc.ErrAbsPath, err.Error())
+	}
+
+	sim, err := simulate(gomod)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		bs, err := json.MarshalIndent(sim, "", "  ")
+		if err != nil {
+			return errc.New(errc.ErrInvalidJSON, err.Error())
+		}
+		fmt.Println(string(bs))
+	} else {
+		printSimulation(sim)
+	}
+	if *baseline != "" {
+		return checkBaseline(sim, *baseline)
+	}
+	return nil
+}
+
+// findModuleVersions finds the versions of modules required by go.mod, as well
+// as modules that are only listed in go.sum, e.g. dependencies of modules that
+// predate the module graph pruning, whose highest version is selected.
+// Modules replaced by local directories are of unknown versions.
+func findModuleVersions(gomod string) (string, map[string]string, error) {
+	modfile, err := parseGoMod(gomod)
+	if err != nil {
+		return "", nil, err
+	}
+	versions := map[string]string{}
+	gosum := filepath.Join(filepath.Dir(gomod), util.GoSumFile)
+	if util.PathExists(gosum) {
+		content, err := util.ReadFile(gosum)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, line := range strings.Split(content, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) != 3 {
+				continue
+			}
+			path := fields[0]
+			version := strings.TrimSuffix(fields[1], "/go.mod")
+			if semver.Compare(version, versions[path]) > 0 {
+				versions[path] = version
+			}
+		}
+	}
+	for _, r := range modfile.Require {
+		versions[r.Mod.Path] = r.Mod.Version
+	}
+	for _, r := range modfile.Replace {
+		if r.Old.Version != "" && versions[r.Old.Path] != r.Old.Version {
+			continue
+		}
+		versions[r.Old.Path] = r.New.Version
+	}
+	versions[modfile.Module.Mod.Path] = ""
+
+	// The toolchain is the one that builds the project if specified, otherwise
+	// the minimum go version is assumed
+	goVersion := ""
+	if modfile.Go != nil {
+		goVersion = "v" + modfile.Go.Version
+	}
+	if modfile.Toolchain != nil &&
+		strings.HasPrefix(modfile.Toolchain.Name, "go") {
+		goVersion = "v" + strings.TrimPrefix(modfile.Toolchain.Name, "go")
+	}
+	// Release candidates, e.g. go1.24rc1, are not semver
+	if i := strings.Index(goVersion, "rc"); i > 0 {
+		goVersion = goVersion[:i] + "-" + goVersion[i:]
+	}
+	return goVersion, versions, nil
+}
+
+// findModuleOf finds the module that provides the package, i.e. the one with
+// the longest matching path
+func findModuleOf(importPath string, versions map[string]string) (string, bool) {
+	module := ""
+	for path := range versions {
+		if (importPath == path || strings.HasPrefix(importPath, path+"/")) &&
+			len(path) > len(module) {
+			module = path
+		}
+	}
+	return module, module != ""
+}
+
+func simulate(gomod string) (*Simulation, error) {
+	goVersion, versions, err := findModuleVersions(gomod)
+	if err != nil {
+		return nil, err
+	}
+	dp := newDepProcessor()
+	dp.modulePath = gomod
+	localRules, err := dp.discoverLocalRules()
+	if err != nil {
+		return nil, err
+	}
+	rules, err := findAvailableRules(localRules)
+	if err != nil {
+		return nil, err
+	}
+	rules, suppressions := splitSuppressRules(rules)
+	rm := &ruleMatcher{packages: versions}
+
+	// Rules of one package share the module version, several versions of one
+	// rule may cover it, as a real build does, only the most specific one is
+	// applied
+	targets := map[string][]resource.InstRule{}
+	for _, rule := range rules {
+		targets[rule.GetImportPath()] = append(targets[rule.GetImportPath()], rule)
+	}
+	sim := &Simulation{GoVersion: goVersion, Entries: make([]*SimulatedRule, 0)}
+	for importPath, candidates := range targets {
+		module, version := "", ""
+		reason := ""
+		switch {
+		case resource.IsImportPathPattern(importPath):
+			reason = "import path pattern is only matched by a build"
+		case !strings.Contains(strings.Split(importPath, "/")[0], "."):
+			module = "std"
+		default:
+			var found bool
+			module, found = findModuleOf(importPath, versions)
+			if !found {
+				reason = "module is not required"
+			}
+			version = versions[module]
+		}
+		// Only suppressions of whole packages are known without the source
+		suppressed := suppressionsOf(suppressions, importPath, version)
+		resolved := map[resource.InstRule]bool{}
+		for _, rule := range resolveVersionedRules(candidates, version) {
+			resolved[rule] = true
+		}
+		for _, rule := range candidates {
+			kind, target := simulatedTarget(rule)
+			sr := &SimulatedRule{
+				Rule:    rule.GetName(),
+				Kind:    kind,
+				Package: importPath,
+				Target:  target,
+				Module:  module,
+				Version: version,
+				Reason:  reason,
+				rule:    rule,
+			}
+			if sr.Reason == "" {
+				sr.Reason = rm.mismatch(rule, version, goVersion)
+				if !resolved[rule] {
+					sr.Reason = "superseded by a more specific version range"
+				}
+				if s := suppressedBy(suppressed, rule, nil); s != nil {
+					sr.Reason = "suppressed by " + s.GetSource()
+				}
+			}
+			sr.Applied = sr.Reason == ""
+			sim.Entries = append(sim.Entries, sr)
+		}
+	}
+	sort.SliceStable(sim.Entries, func(i, j int) bool {
+		if sim.Entries[i].Target != sim.Entries[j].Target {
+			return sim.Entries[i].Target < sim.Entries[j].Target
+		}
+		return sim.Entries[i].Rule < sim.Entries[j].Rule
+	})
+	return sim, nil
+}
+
+// simulatedTarget tells the kind and the target of the rule as the manifest
+// does, the target may be a pattern since the source code is not looked up
+func simulatedTarget(rule resource.InstRule) (string, string) {
+	importPath := rule.GetImportPath()
+	switch rl := rule.(type) {
+	case *resource.InstFuncRule:
+		if rl.ReceiverType != "" {
+			return resource.ManifestKindFunc, fmt.Sprintf("%s.(%s).%s",
+				importPath, strings.ReplaceAll(rl.ReceiverType, `\*`, "*"),
+				rl.Function)
+		}
+		return resource.ManifestKindFunc, importPath + "." + rl.Function
+	case *resource.InstStructRule:
+		return resource.ManifestKindStruct, fmt.Sprintf("%s.%s.%s",
+			importPath, rl.StructType, rl.FieldName)
+	case *resource.InstFileRule:
+		if rl.Init != "" {
+			return resource.ManifestKindFile, importPath + ".init"
+		}
+		return resource.ManifestKindFile, importPath + "/" + filepath.Base(rl.FileName)
+	}
+	return "", importPath
+}
+
+// mismatch returns the reason why the rule does not apply to the module of
+// given version, or empty if it does
+func (rm *ruleMatcher) mismatch(rule resource.InstRule, version, goVersion string) string {
+	// Modules of unknown versions, e.g. replaced by local directories, never
+	// fall in the version range
+	if rule.GetVersion() != "" && version == "" {
+		return fmt.Sprintf("requires version %s, got unknown version",
+			rule.GetVersion())
+	}
+	matched, err := util.MatchVersion(version, rule.GetVersion())
+	if err != nil {
+		return err.Error()
+	}
+	if !matched {
+		return fmt.Sprintf("requires version %s, got %s",
+			rule.GetVersion(), version)
+	}
+	if goVersion != "" {
+		reason, err := mismatchGoVersion(rule, goVersion)
+		if err != nil {
+			return err.Error()
+		}
+		if reason != "" {
+			return reason
+		}
+	}
+	return rm.mismatchModules(rule)
+}
+
+func printSimulation(sim *Simulation) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "RULE\tKIND\tTARGET\tVERSION\tOUTCOME")
+	applied, absent := 0, 0
+	for _, sr := range sim.Entries {
+		if sr.Applied {
+			applied++
+		}
+		// Most rules target modules that are never required by the project,
+		// they are left out for brevity
+		if sr.Module == "" && !resource.IsImportPathPattern(sr.Package) {
+			absent++
+			continue
+		}
+		rule := sr.Rule
+		if rule == "" {
+			rule = "-"
+		}
+		version := sr.Version
+		if version == "" {
+			version = "-"
+			if sr.Module == "std" {
+				version = sim.GoVersion
+			}
+		}
+		outcome := "applied"
+		if !sr.Applied {
+			outcome = "skipped, " + sr.Reason
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", rule, sr.Kind, sr.Target,
+			version, outcome)
+	}
+	_ = w.Flush()
+	fmt.Printf("%d of %d rules applied, %d rules target modules that are not required\n",
+		applied, len(sim.Entries), absent)
+}
+
+// checkBaseline fails if any rule applied in the baseline is no longer applied
+func checkBaseline(sim *Simulation, baseline string) error {
+	content, err := util.ReadFile(baseline)
+	if err != nil {
+		return err
+	}
+	base := &Simulation{}
+	err = json.Unmarshal([]byte(content), base)
+	if err != nil {
+		return errc.New(errc.ErrInvalidJSON, err.Error()).
+			With("baseline", baseline)
+	}
+	current := indexSimulation(sim)
+	dropped := make([]string, 0)
+	for key, sr := range indexSimulation(base) {
+		if !sr.Applied {
+			continue
+		}
+		reason := "rule is gone"
+		if now := current[key]; now != nil {
+			if now.Applied {
+				continue
+			}
+			reason = now.Reason
+		}
+		dropped = append(dropped, fmt.Sprintf("%s: %s", sr.Target, reason))
+	}
+	sort.Strings(dropped)
+	if len(dropped) > 0 {
+		return errc.New(errc.ErrSimulate,
+			"rules are no longer applied:\n"+strings.Join(dropped, "\n")).
+			With("baseline", baseline)
+	}
+	return nil
+}
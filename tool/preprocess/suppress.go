@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"github.com/dave/dst"
+)
+
+// -----------------------------------------------------------------------------
+// Suppression rules
+//
+// Suppression rules carve sensitive or hot code out of the instrumentation
+// declaratively, e.g. never instrument anything under
+// example.com/app/internal/crypto/..., or never record metrics of the Get
+// method of a cache. They are evaluated with the following precedence
+//
+//   - suppression always wins over instrumentation, no matter the rules are
+//     default, local or custom ones, and no matter in which order they are
+//     loaded
+//   - rules targeting the runtime package and the otel API and SDK are never
+//     suppressed, as they are never disabled by -disablerules
+//   - a suppression rule applies only if the module version of the package
+//     falls in its Version range, if any
+//
+// Suppressed rules are reported as skipped along with the suppression rule.
+
+// splitSuppressRules separates the suppression rules from the others
+func splitSuppressRules(rules []resource.InstRule) ([]resource.InstRule,
+	[]*resource.InstSuppressRule) {
+	others := make([]resource.InstRule, 0, len(rules))
+	suppressions := make([]*resource.InstSuppressRule, 0)
+	for _, rule := range rules {
+		if s, ok := rule.(*resource.InstSuppressRule); ok {
+			suppressions = append(suppressions, s)
+			continue
+		}
+		others = append(others, rule)
+	}
+	return others, suppressions
+}
+
+// isFundamental checks if the rule is essential to all others, i.e. it targets
+// the runtime package or the otel API and SDK
+func isFundamental(rule resource.InstRule) bool {
+	return isFundamentalPkg(rule.GetImportPath())
+}
+
+func isFundamentalPkg(importPath string) bool {
+	return importPath == runtimePkg || strings.HasPrefix(importPath, otelApiPrefix)
+}
+
+// suppressionsOf returns the suppression rules that cover the package of the
+// given module version, fundamental packages are never covered
+func suppressionsOf(suppressions []*resource.InstSuppressRule,
+	importPath, version string) []*resource.InstSuppressRule {
+	covered := make([]*resource.InstSuppressRule, 0)
+	if isFundamentalPkg(importPath) {
+		return covered
+	}
+	for _, s := range suppressions {
+		if !s.MatchImportPath(importPath) {
+			continue
+		}
+		matched, err := util.MatchVersion(version, s.GetVersion())
+		if err != nil || !matched {
+			continue
+		}
+		covered = append(covered, s)
+	}
+	return covered
+}
+
+// suppressedBy returns the suppression rule that suppresses the rule for the
+// function, or for the whole package if the function is nil
+func suppressedBy(suppressions []*resource.InstSuppressRule,
+	rule resource.InstRule, funcDecl *dst.FuncDecl) *resource.InstSuppressRule {
+	if isFundamental(rule) {
+		return nil
+	}
+	for _, s := range suppressions {
+		if funcDecl == nil {
+			if s.Function == "" && s.Suppresses(rule.GetName()) {
+				return s
+			}
+			continue
+		}
+		if s.SuppressesFunc(rule.GetName(), funcDecl) {
+			return s
+		}
+	}
+	return nil
+}
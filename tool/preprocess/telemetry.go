@@ -0,0 +1,350 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// -----------------------------------------------------------------------------
+// Telemetry
+//
+// "otel telemetry" documents the span names, span kinds, attributes and
+// metrics emitted by every rule of the active ruleset, i.e. rules that apply
+// to the project as "otel simulate" tells, so that SREs can build dashboards
+// and alerts against a known telemetry contract. The telemetry is found by
+// reading the hook code, see telemetry_source.go.
+
+var telemetryUsage = `Usage: {} telemetry [-json] [-all] [go.mod]
+Example:
+	{} telemetry > TELEMETRY.md
+	{} telemetry -json
+	{} telemetry -all
+
+Flags:
+`
+
+// RuleTelemetry is the telemetry emitted by the hooks of the rule
+type RuleTelemetry struct {
+	Rule   string `json:"rule,omitempty"`
+	Kind   string `json:"kind"`
+	Target string `json:"target"`
+	Telemetry
+	// Why the telemetry is unknown, e.g. the hook code is not found
+	Note string `json:"note,omitempty"`
+}
+
+func printTelemetryUsage(fs *flag.FlagSet) {
+	name, _ := util.GetToolName()
+	fmt.Print(strings.ReplaceAll(telemetryUsage, "{}", name))
+	fs.PrintDefaults()
+}
+
+// DocumentTelemetry runs the "telemetry" command
+func DocumentTelemetry() error {
+	fs := flag.NewFlagSet("telemetry", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "Print the telemetry as JSON")
+	all := fs.Bool("all", false,
+		"Document all available rules rather than rules that apply to the project")
+	fs.Usage = func() { printTelemetryUsage(fs) }
+	err := fs.Parse(os.Args[2:])
+	if err == flag.ErrHelp {
+		return nil
+	}
+	if err != nil {
+		return errc.New(errc.ErrTelemetry, err.Error())
+	}
+	if fs.NArg() > 1 {
+		printTelemetryUsage(fs)
+		return errc.New(errc.ErrTelemetry, "expect at most one go.mod")
+	}
+	gomod := util.GoModFile
+	if fs.NArg() == 1 {
+		gomod = fs.Arg(0)
+	}
+	gomod, err = filepath.Abs(gomod)
+	if err != nil {
+		return errc.New(errc.ErrAbsPath, err.Error())
+	}
+
+	rules, err := activeRules(gomod, *all)
+	if err != nil {
+		return err
+	}
+	dp := newDepProcessor()
+	dp.modulePath = gomod
+	ta := newTelemetryAnalyzer(dp.locateSource)
+	entries := make([]*RuleTelemetry, 0, len(rules))
+	for _, rule := range rules {
+		rt, err := documentRule(ta, rule)
+		if err != nil {
+			return errc.Adhere(err, "rule", rule.String())
+		}
+		entries = append(entries, rt)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Target != entries[j].Target {
+			return entries[i].Target < entries[j].Target
+		}
+		return entries[i].Rule < entries[j].Rule
+	})
+	if *asJSON {
+		bs, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return errc.New(errc.ErrInvalidJSON, err.Error())
+		}
+		fmt.Println(string(bs))
+		return nil
+	}
+	printTelemetry(entries)
+	return nil
+}
+
+// activeRules returns rules that apply to the project, or all available rules
+func activeRules(gomod string, all bool) ([]resource.InstRule, error) {
+	if all {
+		dp := newDepProcessor()
+		dp.modulePath = gomod
+		localRules, err := dp.discoverLocalRules()
+		if err != nil {
+			return nil, err
+		}
+		rules, err := findAvailableRules(localRules)
+		if err != nil {
+			return nil, err
+		}
+		rules, _ = splitSuppressRules(rules)
+		return rules, nil
+	}
+	sim, err := simulate(gomod)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]resource.InstRule, 0)
+	for _, sr := range sim.Entries {
+		if sr.Applied {
+			rules = append(rules, sr.rule)
+		}
+	}
+	return rules, nil
+}
+
+// locateSource returns the directory of the package for reading its source,
+// i.e. packages of the pkg module, local hook packages and packages of the
+// OpenTelemetry modules the hooks depend on, or empty for others
+func (dp *DepProcessor) locateSource(importPath string) (string, error) {
+	switch {
+	case importPath == pkgPrefix || strings.HasPrefix(importPath, pkgPrefix+"/"):
+		if dp.pkgLocalCache == "" {
+			dir, err := dp.findModCacheDir()
+			if err != nil {
+				return "", err
+			}
+			dp.pkgLocalCache = dir
+		}
+		return filepath.Join(dp.pkgLocalCache,
+			strings.TrimPrefix(importPath, pkgPrefix)), nil
+	case isLocalHookPath(importPath):
+		return importPath, nil
+	}
+	// The OpenTelemetry module of the longest prefix, at the version the build
+	// would use
+	module := ""
+	for path := range otelDeps {
+		if (importPath == path || strings.HasPrefix(importPath, path+"/")) &&
+			len(path) > len(module) {
+			module = path
+		}
+	}
+	if module == "" {
+		return "", nil
+	}
+	output, err := runCmdCombinedOutput(dp.getGoModDir(), goProxyEnv(),
+		"go", "mod", "download", "-json", module+"@"+otelDeps[module])
+	if err != nil {
+		return "", err
+	}
+	var info moduleInfo
+	if err = json.Unmarshal([]byte(output), &info); err != nil {
+		return "", errc.New(errc.ErrInvalidJSON, err.Error())
+	}
+	if info.Error != "" {
+		return "", errc.New(errc.ErrTelemetry, info.Error)
+	}
+	return filepath.Join(info.Dir, strings.TrimPrefix(importPath, module)), nil
+}
+
+func documentRule(ta *telemetryAnalyzer, rule resource.InstRule) (*RuleTelemetry, error) {
+	kind, target := simulatedTarget(rule)
+	rt := &RuleTelemetry{Rule: rule.GetName(), Kind: kind, Target: target}
+	hookPath := rule.GetPath()
+	switch rl := rule.(type) {
+	case *resource.InstFuncRule:
+		if rl.UseRaw {
+			rt.Note = "raw code is not analyzed"
+			return rt, nil
+		}
+		if rl.Metrics != nil {
+			t, err := ta.AnalyzePackage(funcMetricsPkg)
+			if err != nil {
+				return nil, err
+			}
+			// Only the instruments chosen by the rule are recorded
+			if kinds := rl.Metrics.Instruments; len(kinds) > 0 {
+				chosen := make([]*MetricTelemetry, 0, len(t.Metrics))
+				for _, m := range t.Metrics {
+					for _, kind := range kinds {
+						if strings.HasSuffix(strings.ToLower(m.Instrument), kind) {
+							chosen = append(chosen, m)
+						}
+					}
+				}
+				t.Metrics = chosen
+			}
+			mergeTelemetry(&rt.Telemetry, t)
+			for key := range rl.Metrics.Attributes {
+				appendUnique(&rt.Attributes, key)
+			}
+		}
+		roots := make([]string, 0)
+		for _, hook := range []string{rl.OnEnter, rl.OnExit} {
+			if hook != "" {
+				roots = append(roots, hook)
+			}
+		}
+		if len(roots) == 0 {
+			break
+		}
+		sp, err := ta.loadPackage(hookPath)
+		if err != nil {
+			return nil, err
+		}
+		if sp == nil {
+			rt.Note = "hook code is not found"
+			break
+		}
+		t, err := ta.Analyze(hookPath, roots)
+		if err != nil {
+			return nil, err
+		}
+		mergeTelemetry(&rt.Telemetry, t)
+	case *resource.InstFileRule:
+		if rl.Init != "" {
+			break
+		}
+		sp, err := ta.loadPackage(hookPath)
+		if err != nil {
+			return nil, err
+		}
+		if sp == nil {
+			rt.Note = "hook code is not found"
+			break
+		}
+		t, err := ta.AnalyzeFile(hookPath, filepath.Base(rl.FileName))
+		if err != nil {
+			return nil, err
+		}
+		mergeTelemetry(&rt.Telemetry, t)
+	}
+	sort.Strings(rt.Attributes)
+	return rt, nil
+}
+
+func mergeTelemetry(dst, src *Telemetry) {
+	for _, v := range src.Scopes {
+		appendUnique(&dst.Scopes, v)
+	}
+	for _, v := range src.SpanNames {
+		appendUnique(&dst.SpanNames, v)
+	}
+	for _, v := range src.SpanKinds {
+		appendUnique(&dst.SpanKinds, v)
+	}
+	for _, v := range src.Attributes {
+		appendUnique(&dst.Attributes, v)
+	}
+	dst.Metrics = append(dst.Metrics, src.Metrics...)
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "`" + v + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// printTelemetry prints the telemetry as a markdown document
+func printTelemetry(entries []*RuleTelemetry) {
+	fmt.Println("# Telemetry")
+	fmt.Println()
+	fmt.Printf("Telemetry emitted by the hooks of %d rules, generated by "+
+		"`otel telemetry`. Attributes are the ones the hooks may record, some "+
+		"of them are only recorded when available or when experimental "+
+		"features are enabled.\n", len(entries))
+	for _, rt := range entries {
+		// Unnamed rules are titled by their targets
+		if rt.Rule != "" {
+			fmt.Printf("\n## %s\n\n", rt.Rule)
+			fmt.Printf("Target: `%s` (%s)\n\n", rt.Target, rt.Kind)
+		} else {
+			fmt.Printf("\n## %s\n\n", rt.Target)
+		}
+		if rt.empty() {
+			note := "No telemetry is emitted"
+			if rt.Note != "" {
+				note = "Unknown telemetry, " + rt.Note
+			}
+			fmt.Printf("%s.\n", note)
+			continue
+		}
+		if len(rt.Scopes) > 0 {
+			fmt.Printf("- Instrumentation scope: %s\n", quoteAll(rt.Scopes))
+		}
+		for _, name := range rt.SpanNames {
+			fmt.Printf("- Span name: %s\n", name)
+		}
+		if len(rt.SpanKinds) > 0 {
+			fmt.Printf("- Span kind: %s\n", quoteAll(rt.SpanKinds))
+		}
+		if len(rt.Attributes) > 0 {
+			fmt.Printf("- Attributes: %s\n", quoteAll(rt.Attributes))
+		}
+		if len(rt.Metrics) > 0 {
+			fmt.Println("- Metrics:")
+			for _, m := range rt.Metrics {
+				fmt.Printf("  - `%s` (%s", m.Name, m.Instrument)
+				if m.Unit != "" {
+					fmt.Printf(", `%s`", m.Unit)
+				}
+				fmt.Print(")")
+				if m.Description != "" {
+					fmt.Printf(": %s", m.Description)
+				}
+				fmt.Println()
+			}
+		}
+	}
+}
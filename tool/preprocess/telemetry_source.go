@@ -0,0 +1,668 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preprocess
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// The telemetry of hooks is found by reading their source code. Starting from
+// the hook functions, package-level declarations they refer to are followed,
+// including types along with their methods, into the semantic convention
+// helpers of the pkg module, and the following facts are collected on the way
+//
+//   - attribute keys, e.g. semconv.DBSystemNameKey or attribute.String("k", v)
+//   - span kinds, e.g. trace.SpanKindClient
+//   - span names, i.e. the doc comment of the span name extractor, or what
+//     its Extract method returns if not documented
+//   - metric instruments, e.g. meter.Float64Histogram("name", ...)
+//   - instrumentation scopes, e.g. instrumentation.Scope{Name: "name"}
+//
+// Identifiers are resolved by name rather than type checking, which is good
+// enough as hook code rarely shadows package-level identifiers, but it means
+// everything reachable is reported, e.g. attributes that are only recorded
+// when experimental features are enabled.
+const (
+	attributePkg       = "go.opentelemetry.io/otel/attribute"
+	tracePkg           = "go.opentelemetry.io/otel/trace"
+	instrumentationPkg = "go.opentelemetry.io/otel/sdk/instrumentation"
+	semconvPkgPrefix   = "go.opentelemetry.io/otel/semconv/"
+	instApiPkg         = pkgPrefix + "/inst-api/instrumenter"
+	instApiSemconvPkg  = pkgPrefix + "/inst-api-semconv"
+	funcMetricsPkg     = pkgPrefix + "/core/funcmetrics"
+)
+
+var metricInstrumentRegexp = regexp.MustCompile(
+	`^(Int64|Float64)(Observable)?(Counter|UpDownCounter|Histogram|Gauge)$`)
+
+var attributeCtors = map[string]bool{
+	"Key": true, "String": true, "Int": true, "Int64": true, "Float64": true,
+	"Bool": true, "StringSlice": true, "IntSlice": true, "Int64Slice": true,
+	"Float64Slice": true, "BoolSlice": true, "Stringer": true,
+}
+
+var spanKinds = map[string]string{
+	"SpanKindInternal": "internal",
+	"SpanKindServer":   "server",
+	"SpanKindClient":   "client",
+	"SpanKindProducer": "producer",
+	"SpanKindConsumer": "consumer",
+}
+
+// MetricTelemetry describes the metric instrument created by hooks
+type MetricTelemetry struct {
+	Name        string `json:"name"`
+	Instrument  string `json:"instrument"`
+	Unit        string `json:"unit,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Telemetry is what the hooks of one rule emit
+type Telemetry struct {
+	Scopes     []string           `json:"scopes,omitempty"`
+	SpanNames  []string           `json:"spanNames,omitempty"`
+	SpanKinds  []string           `json:"spanKinds,omitempty"`
+	Attributes []string           `json:"attributes,omitempty"`
+	Metrics    []*MetricTelemetry `json:"metrics,omitempty"`
+}
+
+func (t *Telemetry) empty() bool {
+	return len(t.Scopes) == 0 && len(t.SpanNames) == 0 &&
+		len(t.SpanKinds) == 0 && len(t.Attributes) == 0 && len(t.Metrics) == 0
+}
+
+// srcDecl is a package-level declaration, methods are kept with their types
+type srcDecl struct {
+	node    ast.Node
+	file    *ast.File
+	doc     *ast.CommentGroup
+	methods []*ast.FuncDecl
+}
+
+// srcPackage is the parsed source code of the package
+type srcPackage struct {
+	path  string
+	fset  *token.FileSet
+	files map[string]*ast.File
+	decls map[string]*srcDecl
+}
+
+func parseSrcPackage(path, dir string) (*srcPackage, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errc.New(errc.ErrReadDir, err.Error())
+	}
+	sp := &srcPackage{
+		path:  path,
+		fset:  token.NewFileSet(),
+		files: map[string]*ast.File{},
+		decls: map[string]*srcDecl{},
+	}
+	methods := map[string][]*ast.FuncDecl{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !util.IsGoFile(name) ||
+			strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(sp.fset, filepath.Join(dir, name), nil,
+			parser.ParseComments)
+		if err != nil {
+			return nil, errc.New(errc.ErrParseCode, err.Error())
+		}
+		sp.files[name] = file
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					sp.decls[decl.Name.Name] = &srcDecl{node: decl, file: file,
+						doc: decl.Doc}
+				} else if recv := receiverName(decl); recv != "" {
+					methods[recv] = append(methods[recv], decl)
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						doc := spec.Doc
+						if doc == nil {
+							doc = decl.Doc
+						}
+						sp.decls[spec.Name.Name] = &srcDecl{node: spec,
+							file: file, doc: doc}
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							sp.decls[name.Name] = &srcDecl{node: spec,
+								file: file, doc: spec.Doc}
+						}
+					}
+				}
+			}
+		}
+	}
+	for recv, fns := range methods {
+		if decl, exist := sp.decls[recv]; exist {
+			decl.methods = fns
+		}
+	}
+	return sp, nil
+}
+
+// receiverName returns the type name of the method receiver, e.g. "Foo" for
+// (f *Foo[T])
+func receiverName(fn *ast.FuncDecl) string {
+	typ := fn.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	switch t := typ.(type) {
+	case *ast.IndexExpr:
+		typ = t.X
+	case *ast.IndexListExpr:
+		typ = t.X
+	}
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// importsOf returns the import paths of the file keyed by the name they are
+// referred to
+func importsOf(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		} else if strings.HasPrefix(name, "v") && strings.Contains(path, "/") {
+			// Major version suffix, e.g. semconv/v1.30.0 is named semconv
+			if _, err := strconv.Atoi(strings.Split(name[1:], ".")[0]); err == nil {
+				name = filepath.Base(filepath.Dir(path))
+			}
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// telemetryAnalyzer collects the telemetry of hooks, packages are located by
+// the given function, which returns empty if the package is not analyzable
+type telemetryAnalyzer struct {
+	locate   func(importPath string) (string, error)
+	packages map[string]*srcPackage
+}
+
+func newTelemetryAnalyzer(locate func(string) (string, error)) *telemetryAnalyzer {
+	return &telemetryAnalyzer{locate: locate, packages: map[string]*srcPackage{}}
+}
+
+func (ta *telemetryAnalyzer) loadPackage(path string) (*srcPackage, error) {
+	if sp, exist := ta.packages[path]; exist {
+		return sp, nil
+	}
+	dir, err := ta.locate(path)
+	if err != nil {
+		return nil, err
+	}
+	var sp *srcPackage
+	if dir != "" && util.PathExists(dir) {
+		sp, err = parseSrcPackage(path, dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Packages that are not found are memorized as well
+	ta.packages[path] = sp
+	return sp, nil
+}
+
+// followed tells if declarations of the package are followed, i.e. the hook
+// package itself and the semantic convention helpers, span kind extractors
+// of the instrumenter are the only ones followed in its package as the rest
+// refers to everything
+func followed(path, hookPath, name string) bool {
+	switch {
+	case path == hookPath,
+		strings.HasPrefix(path, instApiSemconvPkg),
+		path == funcMetricsPkg:
+		return true
+	case path == instApiPkg:
+		return strings.HasPrefix(name, "Always") &&
+			strings.HasSuffix(name, "Extractor")
+	}
+	return false
+}
+
+type telemetryCollector struct {
+	ta        *telemetryAnalyzer
+	hookPath  string
+	visited   map[string]bool
+	telemetry *Telemetry
+}
+
+// Analyze collects the telemetry of given declarations of the hook package,
+// e.g. the hook functions
+func (ta *telemetryAnalyzer) Analyze(hookPath string, roots []string) (*Telemetry, error) {
+	tc := &telemetryCollector{
+		ta:        ta,
+		hookPath:  hookPath,
+		visited:   map[string]bool{},
+		telemetry: &Telemetry{},
+	}
+	for _, root := range roots {
+		err := tc.follow(hookPath, root)
+		if err != nil {
+			return nil, err
+		}
+	}
+	t := tc.telemetry
+	sort.Strings(t.Scopes)
+	sort.Strings(t.SpanKinds)
+	sort.Strings(t.Attributes)
+	sort.Slice(t.Metrics, func(i, j int) bool {
+		return t.Metrics[i].Name < t.Metrics[j].Name
+	})
+	return t, nil
+}
+
+// AnalyzePackage collects the telemetry of all declarations in the package
+func (ta *telemetryAnalyzer) AnalyzePackage(path string) (*Telemetry, error) {
+	sp, err := ta.loadPackage(path)
+	if err != nil || sp == nil {
+		return &Telemetry{}, err
+	}
+	roots := make([]string, 0, len(sp.decls))
+	for name := range sp.decls {
+		roots = append(roots, name)
+	}
+	sort.Strings(roots)
+	return ta.Analyze(path, roots)
+}
+
+// AnalyzeFile collects the telemetry of all declarations in the file of the
+// hook package
+func (ta *telemetryAnalyzer) AnalyzeFile(hookPath, fileName string) (*Telemetry, error) {
+	sp, err := ta.loadPackage(hookPath)
+	if err != nil || sp == nil {
+		return &Telemetry{}, err
+	}
+	roots := make([]string, 0)
+	for name, decl := range sp.decls {
+		if decl.file == sp.files[fileName] {
+			roots = append(roots, name)
+		}
+	}
+	sort.Strings(roots)
+	return ta.Analyze(hookPath, roots)
+}
+
+func appendUnique(list *[]string, value string) {
+	for _, v := range *list {
+		if v == value {
+			return
+		}
+	}
+	*list = append(*list, value)
+}
+
+func (tc *telemetryCollector) follow(path, name string) error {
+	key := path + "." + name
+	if tc.visited[key] || !followed(path, tc.hookPath, name) {
+		return nil
+	}
+	tc.visited[key] = true
+	sp, err := tc.ta.loadPackage(path)
+	if err != nil || sp == nil {
+		return err
+	}
+	decl, exist := sp.decls[name]
+	if !exist {
+		return nil
+	}
+	err = tc.walk(sp, decl.file, decl.node)
+	if err != nil {
+		return err
+	}
+	for _, method := range decl.methods {
+		err = tc.walk(sp, decl.file, method)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk collects the telemetry in the node and follows declarations it refers
+// to, identifiers are resolved against the package and imports of the file
+func (tc *telemetryCollector) walk(sp *srcPackage, file *ast.File, node ast.Node) error {
+	imports := importsOf(file)
+	var err error
+	ast.Inspect(node, func(n ast.Node) bool {
+		if err != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.Ident:
+			if _, exist := sp.decls[n.Name]; exist {
+				err = tc.follow(sp.path, n.Name)
+			}
+		case *ast.SelectorExpr:
+			x, ok := n.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			path, imported := imports[x.Name]
+			if !imported {
+				return true
+			}
+			err = tc.collectSelector(path, n.Sel.Name)
+			if err == nil {
+				err = tc.follow(path, n.Sel.Name)
+			}
+			return false
+		case *ast.CallExpr:
+			err = tc.collectCall(sp, imports, n)
+		case *ast.CompositeLit:
+			err = tc.collectScope(sp, imports, n)
+		}
+		return true
+	})
+	return err
+}
+
+func (tc *telemetryCollector) collectSelector(path, name string) error {
+	switch {
+	case path == tracePkg:
+		if kind, exist := spanKinds[name]; exist {
+			appendUnique(&tc.telemetry.SpanKinds, kind)
+		}
+	case strings.HasPrefix(path, semconvPkgPrefix):
+		key, err := tc.resolveSemconv(path, name)
+		if err != nil {
+			return err
+		}
+		if key != "" {
+			appendUnique(&tc.telemetry.Attributes, key)
+		}
+	}
+	return nil
+}
+
+// resolveSemconv resolves the semconv key or the function that creates the
+// attribute, e.g. DBSystemNameKey or ServerAddress, to the attribute key
+func (tc *telemetryCollector) resolveSemconv(path, name string) (string, error) {
+	sp, err := tc.ta.loadPackage(path)
+	if err != nil || sp == nil {
+		return "", err
+	}
+	decl, exist := sp.decls[name]
+	if !exist {
+		return "", nil
+	}
+	switch node := decl.node.(type) {
+	case *ast.ValueSpec:
+		for i, n := range node.Names {
+			if n.Name == name && i < len(node.Values) {
+				return attributeKeyOf(node.Values[i]), nil
+			}
+		}
+	case *ast.FuncDecl:
+		// e.g. func ServerAddress(val string) attribute.KeyValue {
+		//          return ServerAddressKey.String(val) }
+		key := ""
+		ast.Inspect(node.Body, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && key == "" &&
+				ident.Name != name && strings.HasSuffix(ident.Name, "Key") {
+				key, err = tc.resolveSemconv(path, ident.Name)
+			}
+			return key == ""
+		})
+		return key, err
+	}
+	return "", nil
+}
+
+// attributeKeyOf returns the key of attribute.Key("key")
+func attributeKeyOf(expr ast.Expr) string {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return ""
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Key" {
+		return ""
+	}
+	return stringLit(call.Args[0])
+}
+
+func stringLit(expr ast.Expr) string {
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		s, err := strconv.Unquote(lit.Value)
+		if err == nil {
+			return s
+		}
+	}
+	return ""
+}
+
+// resolveString resolves the string literal or the string constant, e.g.
+// utils.REDIGO_SCOPE_NAME
+func (tc *telemetryCollector) resolveString(sp *srcPackage,
+	imports map[string]string, expr ast.Expr) (string, error) {
+	if s := stringLit(expr); s != "" {
+		return s, nil
+	}
+	name := ""
+	switch e := expr.(type) {
+	case *ast.Ident:
+		name = e.Name
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		path, imported := imports[x.Name]
+		if !ok || !imported {
+			return "", nil
+		}
+		var err error
+		sp, err = tc.ta.loadPackage(path)
+		if err != nil || sp == nil {
+			return "", err
+		}
+		name = e.Sel.Name
+	}
+	if sp == nil {
+		return "", nil
+	}
+	if decl, exist := sp.decls[name]; exist {
+		if spec, ok := decl.node.(*ast.ValueSpec); ok {
+			for i, n := range spec.Names {
+				if n.Name == name && i < len(spec.Values) {
+					return tc.resolveString(sp, importsOf(decl.file), spec.Values[i])
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+func (tc *telemetryCollector) collectCall(sp *srcPackage, imports map[string]string,
+	call *ast.CallExpr) error {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) == 0 {
+		return nil
+	}
+	method := sel.Sel.Name
+	x, _ := sel.X.(*ast.Ident)
+	switch {
+	case x != nil && imports[x.Name] == attributePkg && attributeCtors[method]:
+		// e.g. attribute.String("key", value)
+		key, err := tc.resolveString(sp, imports, call.Args[0])
+		if err != nil {
+			return err
+		}
+		if key != "" {
+			appendUnique(&tc.telemetry.Attributes, key)
+		}
+	case metricInstrumentRegexp.MatchString(method):
+		// e.g. meter.Float64Histogram("name", metric.WithUnit("ms"))
+		name, err := tc.resolveString(sp, imports, call.Args[0])
+		if err != nil || name == "" {
+			return err
+		}
+		m := &MetricTelemetry{Name: name, Instrument: method}
+		for _, arg := range call.Args[1:] {
+			opt, ok := arg.(*ast.CallExpr)
+			if !ok || len(opt.Args) != 1 {
+				continue
+			}
+			optSel, ok := opt.Fun.(*ast.SelectorExpr)
+			if !ok {
+				continue
+			}
+			value, err := tc.resolveString(sp, imports, opt.Args[0])
+			if err != nil {
+				return err
+			}
+			switch optSel.Sel.Name {
+			case "WithUnit":
+				m.Unit = value
+			case "WithDescription":
+				m.Description = value
+			}
+		}
+		for _, existing := range tc.telemetry.Metrics {
+			if existing.Name == m.Name {
+				return nil
+			}
+		}
+		tc.telemetry.Metrics = append(tc.telemetry.Metrics, m)
+	case method == "SetSpanNameExtractor" && len(call.Args) == 1:
+		name, err := tc.spanNameOf(sp, imports, call.Args[0])
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			appendUnique(&tc.telemetry.SpanNames, name)
+		}
+	}
+	return nil
+}
+
+// collectScope collects the instrumentation scope, e.g.
+// instrumentation.Scope{Name: utils.REDIGO_SCOPE_NAME}
+func (tc *telemetryCollector) collectScope(sp *srcPackage, imports map[string]string,
+	lit *ast.CompositeLit) error {
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Scope" {
+		return nil
+	}
+	if x, ok := sel.X.(*ast.Ident); !ok || imports[x.Name] != instrumentationPkg {
+		return nil
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Name" {
+			name, err := tc.resolveString(sp, imports, kv.Value)
+			if err != nil {
+				return err
+			}
+			if name != "" {
+				appendUnique(&tc.telemetry.Scopes, name)
+			}
+		}
+	}
+	return nil
+}
+
+// spanNameOf describes the span name given by the span name extractor, which
+// is the doc comment of the extractor type, or what its Extract method returns
+// if it is not documented, e.g. "net." + request.operation
+func (tc *telemetryCollector) spanNameOf(sp *srcPackage, imports map[string]string,
+	expr ast.Expr) (string, error) {
+	if unary, ok := expr.(*ast.UnaryExpr); ok {
+		expr = unary.X
+	}
+	if lit, ok := expr.(*ast.CompositeLit); ok {
+		expr = lit.Type
+	}
+	switch e := expr.(type) {
+	case *ast.IndexExpr:
+		expr = e.X
+	case *ast.IndexListExpr:
+		expr = e.X
+	}
+	name := ""
+	switch e := expr.(type) {
+	case *ast.Ident:
+		name = e.Name
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			return "", nil
+		}
+		var err error
+		sp, err = tc.ta.loadPackage(imports[x.Name])
+		if err != nil || sp == nil {
+			return "", err
+		}
+		name = e.Sel.Name
+	default:
+		return "", nil
+	}
+	decl, exist := sp.decls[name]
+	if !exist {
+		return "", nil
+	}
+	if decl.doc != nil {
+		doc := strings.Join(strings.Fields(decl.doc.Text()), " ")
+		doc = strings.TrimPrefix(doc, name+" names the span ")
+		return strings.TrimSuffix(doc, "."), nil
+	}
+	returns := make([]string, 0)
+	for _, method := range decl.methods {
+		if method.Name.Name != "Extract" || method.Body == nil {
+			continue
+		}
+		ast.Inspect(method.Body, func(n ast.Node) bool {
+			if _, ok := n.(*ast.FuncLit); ok {
+				return false
+			}
+			if ret, ok := n.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+				var buf bytes.Buffer
+				_ = printer.Fprint(&buf, sp.fset, ret.Results[0])
+				returns = append(returns, buf.String())
+			}
+			return true
+		})
+	}
+	return strings.Join(returns, " or "), nil
+}
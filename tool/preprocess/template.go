@@ -6,6 +6,10 @@ import (
 	_ "unsafe" // for go:linkname when declaring printstack/getstack variable
 	"runtime/debug" // for debug.Stack
 	"log" // for log.Printf
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/hook" // for hook.Switch
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/funcmetrics" // for funcmetrics.Start
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/manifest" // for manifest.Register
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/core/overhead" // for overhead.Counter
 	_ "go.opentelemetry.io/otel"// depends on otel
 	_ "go.opentelemetry.io/otel/sdk/trace"// depends on otel
 	_ "go.opentelemetry.io/otel/baggage"// depends on otel
@@ -15,24 +15,53 @@
 package resource
 
 import (
+	"bytes"
 	"encoding/json"
 	"path/filepath"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"github.com/dave/dst"
 )
 
 const (
 	MatchedRulesJsonFile = "matched_rules.json"
+	SkippedRulesJsonFile = "skipped_rules.json"
+	// Deprecated rules applied to the build
+	DeprecatedRulesJsonFile = "deprecated_rules.json"
 )
 
+// SkippedRule tells why a rule that targets a package is not applied to it,
+// e.g. the rule requires a newer go version than the toolchain in use
+type SkippedRule struct {
+	Rule       string
+	ImportPath string
+	Reason     string
+}
+
+// DeprecatedRule tells that a deprecated rule is applied to the package and
+// how to migrate away from it
+type DeprecatedRule struct {
+	Rule       string
+	ImportPath string
+	Version    string `json:",omitempty"`
+	*RuleDeprecation
+}
+
 // RuleBundle is a collection of rules that matched with one compilation action
 type RuleBundle struct {
-	PackageName      string
-	ImportPath       string
+	PackageName string
+	ImportPath  string
+	// Version of the module that provides the package, empty if it's unknown,
+	// e.g. the package belongs to the standard library or the main module
+	ModuleVersion    string
 	FileRules        []*InstFileRule
 	File2FuncRules   map[string]map[string][]*InstFuncRule
 	File2StructRules map[string]map[string][]*InstStructRule
+	// Rules that suppress others for single functions of the package, func
+	// rules matching functions by patterns are checked against them once the
+	// functions are found, see Unsuppressed
+	Suppressions []*InstSuppressRule
 }
 
 func NewRuleBundle(importPath string) *RuleBundle {
@@ -74,6 +103,30 @@ func (rb *RuleBundle) AddFile2FuncRule(file string, rule *InstFuncRule) error {
 	return nil
 }
 
+// Unsuppressed returns the rules that are not suppressed for the function
+func (rb *RuleBundle) Unsuppressed(rules []*InstFuncRule,
+	decl *dst.FuncDecl) []*InstFuncRule {
+	if len(rb.Suppressions) == 0 {
+		return rules
+	}
+	unsuppressed := make([]*InstFuncRule, 0, len(rules))
+	for _, rule := range rules {
+		suppressed := false
+		for _, s := range rb.Suppressions {
+			if s.SuppressesFunc(rule.GetName(), decl) {
+				util.Log("Suppress func rule %s for %s by %s",
+					rule, decl.Name.Name, s)
+				suppressed = true
+				break
+			}
+		}
+		if !suppressed {
+			unsuppressed = append(unsuppressed, rule)
+		}
+	}
+	return unsuppressed
+}
+
 func (rb *RuleBundle) AddFile2StructRule(file string, rule *InstStructRule) error {
 	file, err := filepath.Abs(file)
 	if err != nil {
@@ -113,6 +166,37 @@ func StoreRuleBundles(bundles []*RuleBundle) error {
 	return nil
 }
 
+// StoreSkippedRules writes the skipped rules to the report file, which is
+// always written so that it reflects the latest build
+func StoreSkippedRules(skipped []*SkippedRule) error {
+	return storeReport(SkippedRulesJsonFile, skipped)
+}
+
+// StoreDeprecatedRules writes the applied deprecated rules to the report file,
+// which is always written so that it reflects the latest build
+func StoreDeprecatedRules(deprecated []*DeprecatedRule) error {
+	return storeReport(DeprecatedRulesJsonFile, deprecated)
+}
+
+func storeReport(name string, report any) error {
+	util.GuaranteeInPreprocess()
+	reportFile := util.GetPreprocessLogPath(name)
+	// Keep version operators such as >= readable in the report
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(report)
+	if err != nil {
+		return errc.New(errc.ErrInvalidJSON, err.Error())
+	}
+	_, err = util.WriteFile(reportFile, buf.String())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func LoadRuleBundles() ([]*RuleBundle, error) {
 	util.GuaranteeInInstrument()
 
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+)
+
+// The manifest is embedded into the binary between these markers, so that it
+// can be found in the binary without running it. They must be kept in sync
+// with pkg/core/manifest.
+const (
+	ManifestBegin = "\x00otel-manifest-begin:"
+	ManifestEnd   = ":otel-manifest-end\x00"
+)
+
+// Manifest tells which rules are compiled into the binary and at what versions
+// of the instrumented modules, it is embedded into the binary at build time
+type Manifest struct {
+	ToolVersion string           `json:"tool"`
+	Entries     []*ManifestEntry `json:"rules"`
+}
+
+// ManifestEntry is one rule applied to one target of one package
+type ManifestEntry struct {
+	Rule    string `json:"rule,omitempty"`
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Target  string `json:"target"`
+	Version string `json:"version,omitempty"`
+}
+
+const (
+	ManifestKindFunc   = "func"
+	ManifestKindStruct = "struct"
+	ManifestKindFile   = "file"
+)
+
+// NewManifest summarizes the matched rule bundles, entries are deduplicated and
+// sorted so that the manifest is stable across builds
+func NewManifest(bundles []*RuleBundle, toolVersion string) *Manifest {
+	m := &Manifest{ToolVersion: toolVersion, Entries: make([]*ManifestEntry, 0)}
+	seen := map[ManifestEntry]bool{}
+	add := func(rule InstRule, kind, pkg, target, version string) {
+		e := ManifestEntry{rule.GetName(), kind, pkg, target, version}
+		if !seen[e] {
+			seen[e] = true
+			m.Entries = append(m.Entries, &e)
+		}
+	}
+	for _, bundle := range bundles {
+		version := bundle.ModuleVersion
+		for _, fn2rules := range bundle.File2FuncRules {
+			for fn, rules := range fn2rules {
+				function, receiver, _ := strings.Cut(fn, ",")
+				target := bundle.ImportPath + "." + function
+				if receiver != "" {
+					target = fmt.Sprintf("%s.(%s).%s", bundle.ImportPath,
+						strings.ReplaceAll(receiver, `\*`, "*"), function)
+				}
+				for _, rule := range rules {
+					add(rule, ManifestKindFunc, bundle.ImportPath, target, version)
+				}
+			}
+		}
+		for _, st2rules := range bundle.File2StructRules {
+			for st, rules := range st2rules {
+				for _, rule := range rules {
+					target := fmt.Sprintf("%s.%s.%s", bundle.ImportPath, st,
+						rule.FieldName)
+					add(rule, ManifestKindStruct, bundle.ImportPath, target,
+						version)
+				}
+			}
+		}
+		for _, rule := range bundle.FileRules {
+			target := bundle.ImportPath + "/" + filepath.Base(rule.FileName)
+			if rule.Init != "" {
+				target = bundle.ImportPath + ".init"
+			}
+			add(rule, ManifestKindFile, bundle.ImportPath, target, version)
+		}
+	}
+	sort.Slice(m.Entries, func(i, j int) bool {
+		if m.Entries[i].Target != m.Entries[j].Target {
+			return m.Entries[i].Target < m.Entries[j].Target
+		}
+		return m.Entries[i].Rule < m.Entries[j].Rule
+	})
+	return m
+}
+
+// Encode returns the compact manifest wrapped by the markers
+func (m *Manifest) Encode() (string, error) {
+	bs, err := json.Marshal(m)
+	if err != nil {
+		return "", errc.New(errc.ErrInvalidJSON, err.Error())
+	}
+	return ManifestBegin + string(bs) + ManifestEnd, nil
+}
+
+// ExtractManifest finds the manifest embedded in the content of binary, note
+// that the markers may appear in the binary elsewhere, e.g. as the constants
+// of pkg/core/manifest, so the first well-formed one is taken
+func ExtractManifest(content []byte) (*Manifest, error) {
+	begin := []byte(ManifestBegin)
+	end := []byte(ManifestEnd)
+	for {
+		i := bytes.Index(content, begin)
+		if i < 0 {
+			return nil, errc.New(errc.ErrNotExist, "no manifest found")
+		}
+		content = content[i+len(begin):]
+		j := bytes.Index(content, end)
+		if j < 0 {
+			return nil, errc.New(errc.ErrNotExist, "no manifest found")
+		}
+		m := &Manifest{}
+		if json.Unmarshal(content[:j], m) == nil && m.Entries != nil {
+			return m, nil
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestManifest(t *testing.T) {
+	bundle := NewRuleBundle("github.com/gomodule/redigo/redis")
+	bundle.ModuleVersion = "v1.9.2"
+	rule := &InstFuncRule{
+		InstBaseRule: InstBaseRule{
+			Name:       "redigo.dial",
+			ImportPath: "github.com/gomodule/redigo/redis",
+		},
+		Function:     "Do",
+		ReceiverType: `\*conn`,
+		OnEnter:      "onEnterDo",
+	}
+	// The same rule matched in two files is listed once
+	for _, file := range []string{"a.go", "b.go"} {
+		if err := bundle.AddFile2FuncRule(file, rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bundle.AddFileRule(&InstFileRule{
+		InstBaseRule: InstBaseRule{ImportPath: "github.com/gomodule/redigo/redis"},
+		FileName:     "/rules/redigo/otel.go",
+	})
+	std := NewRuleBundle("net/http")
+	err := std.AddFile2StructRule("c.go", &InstStructRule{
+		InstBaseRule: InstBaseRule{ImportPath: "net/http"},
+		StructType:   "Request",
+		FieldName:    "otelCtx",
+		FieldType:    "interface{}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewManifest([]*RuleBundle{std, bundle}, "1.0.0")
+	expect := []*ManifestEntry{
+		{Rule: "redigo.dial", Kind: ManifestKindFunc,
+			Package: "github.com/gomodule/redigo/redis",
+			Target:  "github.com/gomodule/redigo/redis.(*conn).Do", Version: "v1.9.2"},
+		{Kind: ManifestKindFile, Package: "github.com/gomodule/redigo/redis",
+			Target: "github.com/gomodule/redigo/redis/otel.go", Version: "v1.9.2"},
+		{Kind: ManifestKindStruct, Package: "net/http",
+			Target: "net/http.Request.otelCtx"},
+	}
+	if !reflect.DeepEqual(m.Entries, expect) {
+		t.Fatalf("unexpected manifest %+v", m.Entries)
+	}
+
+	encoded, err := m.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Markers may appear in the binary elsewhere, e.g. as string constants
+	binary := []byte("\x7fELF" + ManifestBegin + "garbage" + ManifestEnd +
+		"..." + encoded + "...")
+	extracted, err := ExtractManifest(binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(extracted, m) {
+		t.Fatalf("expect %+v, got %+v", m, extracted)
+	}
+	if _, err = ExtractManifest([]byte("\x7fELF")); err == nil {
+		t.Fatal("expect error for binary without manifest")
+	}
+}
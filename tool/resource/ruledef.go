@@ -15,10 +15,20 @@ package resource
 
 import (
 	"encoding/json"
+	"fmt"
+	"go/build/constraint"
+	"go/token"
+	"hash/fnv"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"github.com/dave/dst"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
 )
 
 // -----------------------------------------------------------------------------
@@ -35,29 +45,94 @@ import (
 // - InstFuncRule: Instrumentation rule for a specific function call
 // - InstStructRule: Instrumentation rule for a specific struct type
 // - InstFileRule: Instrumentation rule for a specific file
+// - InstExtendRule: Rule that overrides some fields of other rules
+// - InstSuppressRule: Rule that suppresses other rules for specific code
 
 type InstRule interface {
-	GetVersion() string    // GetVersion returns the version of the rule
-	GetGoVersion() string  // GetGoVersion returns the go version of the rule
-	GetImportPath() string // GetImportPath returns import path of the rule
-	GetPath() string       // GetPath returns the local path of the rule
-	SetPath(path string)   // SetPath sets the local path of the rule
-	String() string        // String returns string representation of rule
-	Verify() error         // Verify checks the rule is valid
+	GetName() string          // GetName returns the name of the rule
+	GetVersion() string       // GetVersion returns the version of the rule
+	GetGoVersion() string     // GetGoVersion returns the go version of the rule
+	GetMinGoVersion() string  // GetMinGoVersion returns the minimum go version
+	GetMaxGoVersion() string  // GetMaxGoVersion returns the maximum go version
+	GetPriority() int         // GetPriority returns the priority of the rule
+	GetRequires() []string    // GetRequires returns modules required by the rule
+	GetWhenModules() []string // GetWhenModules returns module conditions of the rule
+	GetScope() string         // GetScope returns which files the rule targets
+	GetImportPath() string    // GetImportPath returns import path of the rule
+	GetPath() string          // GetPath returns the local path of the rule
+	SetPath(path string)      // SetPath sets the local path of the rule
+	GetSource() string        // GetSource returns where the rule is defined
+	SetSource(src string)     // SetSource sets where the rule is defined
+	String() string           // String returns string representation of rule
+	Verify() error            // Verify checks the rule is valid
+	// GetDeprecation returns the deprecation of the rule, nil if it's not
+	GetDeprecation() *RuleDeprecation
 }
 
 type InstBaseRule struct {
+	// Name of the rule, e.g. "redigo.onBeforeDialContext", it designates the
+	// rule at runtime, i.e. the hook can be disabled by its name
+	Name string `json:"Name,omitempty"`
 	// Local path of the rule, it desginates where we can found the hook code
 	Path string `json:"Path,omitempty"`
-	// Version of the rule, e.g. "[1.9.1,1.9.2)" or "", it desginates the
-	// version range of rule, all other version will not be instrumented
+	// Version of the rule, e.g. "[1.9.1,1.9.2)", ">=1.9.1 <2.0.0" or "", it
+	// desginates the version range of rule, all other version will not be
+	// instrumented
 	Version string `json:"Version,omitempty"`
 	// Go version of the rule, e.g. "[1.22.0,)" or "", it desginates the go
 	// version range of rule, all other go version will not be instrumented
 	GoVersion string `json:"GoVersion,omitempty"`
+	// Minimum and maximum go version of the rule, e.g. "1.22", both of them are
+	// inclusive. Rules that fall outside are skipped and reported rather than
+	// breaking the compilation, e.g. a hook relying on stdlib added in go1.22
+	MinGoVersion string `json:"MinGoVersion,omitempty"`
+	MaxGoVersion string `json:"MaxGoVersion,omitempty"`
 	// Import path of the rule, e.g. "github.com/gin-gonic/gin", it desginates
 	// the import path of rule, all other import path will not be instrumented
 	ImportPath string `json:"ImportPath,omitempty"`
+	// Priority of the rule, higher wins if it conflicts with other rules that
+	// target the same function, struct field or file
+	Priority int `json:"Priority,omitempty"`
+	// Extra modules required by the hook code, e.g. "github.com/google/uuid@v1.6.0",
+	// they are added to go.mod of the project unless it requires a higher one
+	Requires []string `json:"Requires,omitempty"`
+	// Modules that must be in the build for the rule to apply, or must not be
+	// if prefixed by "!", optionally with a version range after "@", e.g.
+	// ["go.uber.org/zap@[1.20.0,)", "!github.com/sirupsen/logrus"]
+	WhenModules []string `json:"WhenModules,omitempty"`
+	// Scope of the rule, i.e. which files of the package it targets. It is
+	// either "source"(default) for non-test files, "test" for _test.go files
+	// that are compiled by "go test" only, or "all" for both of them
+	Scope string `json:"Scope,omitempty"`
+	// Deprecation of the rule, the build warns when the rule is applied
+	Deprecated *RuleDeprecation `json:"Deprecated,omitempty"`
+	// Source of the rule, e.g. "custom.json:12:5", it designates where the
+	// rule is defined and is only used for error reporting
+	Source string `json:"-"`
+}
+
+func (rule *InstBaseRule) GetName() string {
+	return rule.Name
+}
+
+func (rule *InstBaseRule) GetPriority() int {
+	return rule.Priority
+}
+
+func (rule *InstBaseRule) GetRequires() []string {
+	return rule.Requires
+}
+
+func (rule *InstBaseRule) GetWhenModules() []string {
+	return rule.WhenModules
+}
+
+func (rule *InstBaseRule) GetScope() string {
+	return rule.Scope
+}
+
+func (rule *InstBaseRule) GetDeprecation() *RuleDeprecation {
+	return rule.Deprecated
 }
 
 func (rule *InstBaseRule) GetVersion() string {
@@ -68,6 +143,14 @@ func (rule *InstBaseRule) GetGoVersion() string {
 	return rule.GoVersion
 }
 
+func (rule *InstBaseRule) GetMinGoVersion() string {
+	return rule.MinGoVersion
+}
+
+func (rule *InstBaseRule) GetMaxGoVersion() string {
+	return rule.MaxGoVersion
+}
+
 func (rule *InstBaseRule) GetImportPath() string {
 	return rule.ImportPath
 }
@@ -80,6 +163,47 @@ func (rule *InstBaseRule) SetPath(path string) {
 	rule.Path = path
 }
 
+func (rule *InstBaseRule) GetSource() string {
+	return rule.Source
+}
+
+func (rule *InstBaseRule) SetSource(src string) {
+	rule.Source = src
+}
+
+// Which files of the package the rule targets
+const (
+	ScopeSource = "source" // Non-test files, the default
+	ScopeTest   = "test"   // The _test.go files, compiled by "go test" only
+	ScopeAll    = "all"    // Both of them
+)
+
+// InScope checks if the file is targeted by the rule of given scope
+func InScope(scope string, file string) bool {
+	isTest := strings.HasSuffix(file, "_test.go")
+	switch scope {
+	case ScopeTest:
+		return isTest
+	case ScopeAll:
+		return true
+	default:
+		return !isTest
+	}
+}
+
+// RuleDeprecation tells how to migrate away from the deprecated rule, all
+// fields are optional
+type RuleDeprecation struct {
+	// Why the rule is deprecated, e.g. "redigo v1 is no longer maintained"
+	Reason string `json:"Reason,omitempty"`
+	// Version of the instrumented module to upgrade to, e.g. "v1.9.0"
+	UpgradeTo string `json:"UpgradeTo,omitempty"`
+	// Name of the rule to switch to, e.g. "goredis.afterNewRedisClient"
+	ReplacedBy string `json:"ReplacedBy,omitempty"`
+	// Version of the tool that removes the rule, e.g. "v0.10.0"
+	RemovedIn string `json:"RemovedIn,omitempty"`
+}
+
 // InstFuncRule finds specific function call and instrument by adding new code
 type InstFuncRule struct {
 	InstBaseRule
@@ -95,6 +219,47 @@ type InstFuncRule struct {
 	OnEnter string `json:"OnEnter,omitempty"`
 	// OnExit callback, called after original function
 	OnExit string `json:"OnExit,omitempty"`
+	// Raw code snippets spliced into the function, only used with UseRaw
+	Inject []*RawInjection `json:"Inject,omitempty"`
+	// Packages imported by the raw code, keyed by the name that the code
+	// refers to, e.g. {"strconv": "strconv", "stdjson": "encoding/json"}
+	Imports map[string]string `json:"Imports,omitempty"`
+	// Metrics indicates whether to record the duration and calls of original
+	// function, it can be used with or without hooks
+	Metrics *FuncMetrics `json:"Metrics,omitempty"`
+}
+
+// Where the raw code snippet is spliced into the function
+const (
+	InjectAtEnter  = "enter"  // At the start of the function body
+	InjectAtReturn = "return" // Before every return of the function
+	InjectAtBefore = "before" // Before the statements matched by the pattern
+	InjectAtAfter  = "after"  // After the statements matched by the pattern
+)
+
+// RawInjection describes a raw code snippet spliced into the function
+type RawInjection struct {
+	// Where the code is spliced, one of "enter", "return", "before" and "after"
+	At string `json:"At,omitempty"`
+	// Regular expression matched against the source code of statements, e.g.
+	// "^conn\\.Close\\(\\)$", it's required by "before" and "after"
+	Pattern string `json:"Pattern,omitempty"`
+	// Code snippet, e.g. "println(strconv.Itoa(n))"
+	Code string `json:"Code,omitempty"`
+}
+
+// Instruments of function metrics
+const (
+	MetricsHistogram = "histogram" // Duration of calls
+	MetricsCounter   = "counter"   // Number of calls
+)
+
+// FuncMetrics describes the metrics recorded for the instrumented function
+type FuncMetrics struct {
+	// Static attributes attached to the metrics, e.g. {"team": "payment"}
+	Attributes map[string]string `json:"Attributes,omitempty"`
+	// Instruments to record, e.g. ["counter"], all of them by default
+	Instruments []string `json:"Instruments,omitempty"`
 }
 
 // InstStructRule finds specific struct type and instrument by adding new field
@@ -115,6 +280,45 @@ type InstFileRule struct {
 	FileName string `json:"FileName,omitempty"`
 	// Replace indicates whether to replace the original file
 	Replace bool `json:"Replace,omitempty"`
+	// Body of the init function generated into a new file of the package,
+	// e.g. "sql.Register(\"otel-mysql\", wrapDriver(&MySQLDriver{}))", the
+	// rule needs no hook file if it's set
+	Init string `json:"Init,omitempty"`
+	// Packages imported by the init function, keyed by the name that the code
+	// refers to, e.g. {"sql": "database/sql"}
+	Imports map[string]string `json:"Imports,omitempty"`
+	// Build constraint of the file, e.g. "linux && !cgo", the rule is skipped
+	// if the build does not satisfy it
+	BuildConstraint string `json:"BuildConstraint,omitempty"`
+}
+
+// InstExtendRule extends the rules of the given name by overriding some of their
+// fields, e.g. pinning a patched hook package for one version range, while all
+// other fields are inherited. It is resolved into rules of the same types as
+// its base rules once all rules are loaded, see ResolveExtends.
+type InstExtendRule struct {
+	InstBaseRule
+	// Name of the base rules, e.g. "redigo.onBeforeDialContext"
+	Extends string `json:"Extends,omitempty"`
+	// Where the rule is defined, its fields override those of the base rules
+	file string
+	node *yaml.Node
+}
+
+// InstSuppressRule suppresses other rules for the packages or functions it
+// matches, e.g. never instrument anything under example.com/app/internal/crypto.
+// It takes precedence over all instrumentation rules regardless of where they
+// are defined or in which order they are loaded.
+type InstSuppressRule struct {
+	InstBaseRule
+	// Names of the suppressed rules, they are glob patterns as path.Match,
+	// e.g. ["http.*"], or ["*"] for all rules
+	Suppress []string `json:"Suppress,omitempty"`
+	// Function name, e.g. "Encrypt", rules are suppressed for the whole package
+	// if it's empty, i.e. struct and file rules are suppressed as well
+	Function string `json:"Function,omitempty"`
+	// Receiver type name, e.g. "*Cipher"
+	ReceiverType string `json:"ReceiverType,omitempty"`
 }
 
 // String returns string representation of the rule
@@ -122,6 +326,34 @@ func (rule *InstFuncRule) String() string {
 	bs, _ := json.Marshal(rule)
 	return string(bs)
 }
+
+// GetSwitchName returns the name of the variable holding the runtime switch of
+// the hook in the instrumented package. It's derived from the rule name only,
+// so that the generated importer is able to link to it
+func (rule *InstFuncRule) GetSwitchName() string {
+	h := fnv.New32a()
+	h.Write([]byte(rule.GetName()))
+	return fmt.Sprintf("OtelHookSwitch%08x", h.Sum32())
+}
+
+// The type of the variable named by GetCounterName, see overhead.Counters
+const HookCounterType = "interface{ Start() int64; Record(int64, bool) }"
+
+// GetCounterName returns the name of the variable holding the overhead
+// counters of the hook in the instrumented package, like GetSwitchName
+func (rule *InstFuncRule) GetCounterName() string {
+	h := fnv.New32a()
+	h.Write([]byte(rule.GetName()))
+	return fmt.Sprintf("OtelHookCounter%08x", h.Sum32())
+}
+
+// IsMetricsOnly checks if the rule records metrics without any hooks, such
+// rules never create spans and are cheap enough for extremely hot functions
+func (rule *InstFuncRule) IsMetricsOnly() bool {
+	return rule.Metrics != nil && !rule.UseRaw &&
+		rule.OnEnter == "" && rule.OnExit == ""
+}
+
 func (rule *InstStructRule) String() string {
 	bs, _ := json.Marshal(rule)
 	return string(bs)
@@ -130,6 +362,54 @@ func (rule *InstFileRule) String() string {
 	bs, _ := json.Marshal(rule)
 	return string(bs)
 }
+func (rule *InstExtendRule) String() string {
+	bs, _ := json.Marshal(rule)
+	return string(bs)
+}
+func (rule *InstSuppressRule) String() string {
+	bs, _ := json.Marshal(rule)
+	return string(bs)
+}
+
+// MatchImportPath checks if the rule covers the package, the import path of
+// the rule may end with "/..." to cover all packages under it as well, e.g.
+// example.com/app/internal/crypto/... matches example.com/app/internal/crypto
+// and example.com/app/internal/crypto/aes
+func (rule *InstSuppressRule) MatchImportPath(importPath string) bool {
+	if prefix, ok := strings.CutSuffix(rule.ImportPath, "/..."); ok {
+		if MatchImportPath(prefix, importPath) {
+			return true
+		}
+		for dir := path.Dir(importPath); dir != "." && dir != "/"; dir = path.Dir(dir) {
+			if MatchImportPath(prefix, dir) {
+				return true
+			}
+		}
+		return false
+	}
+	return MatchImportPath(rule.ImportPath, importPath)
+}
+
+// Suppresses checks if the rule suppresses the rule of the given name
+func (rule *InstSuppressRule) Suppresses(name string) bool {
+	for _, pattern := range rule.Suppress {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// SuppressesFunc checks if the rule suppresses the rule of the given name for
+// the function, i.e. the rule is suppressed for the whole package or for the
+// function in particular
+func (rule *InstSuppressRule) SuppressesFunc(name string, decl *dst.FuncDecl) bool {
+	if !rule.Suppresses(name) {
+		return false
+	}
+	return rule.Function == "" ||
+		util.MatchFuncDecl(decl, rule.Function, rule.ReceiverType)
+}
 
 // Verify checks the rule is valid
 func verifyRule(rule *InstBaseRule, checkPath bool) error {
@@ -142,13 +422,49 @@ func verifyRule(rule *InstBaseRule, checkPath bool) error {
 	if rule.ImportPath == "" {
 		return errc.New(errc.ErrInvalidRule, "import path is empty")
 	}
-	// If version is specified, it should be in the format of [start,end)
+	if IsImportPathPattern(rule.ImportPath) {
+		if _, err := path.Match(rule.ImportPath, ""); err != nil {
+			return errc.New(errc.ErrInvalidRule,
+				"bad import path pattern "+rule.ImportPath)
+		}
+	}
+	// If version is specified, it should be either in the format of
+	// [start,end) or a semver range expression
 	for _, v := range []string{rule.Version, rule.GoVersion} {
 		if v != "" {
-			if !strings.Contains(v, "[") ||
-				!strings.Contains(v, ")") ||
-				!strings.Contains(v, ",") ||
-				strings.Contains(v, "v") {
+			if _, err := util.ParseVersionConstraint(v); err != nil {
+				return errc.New(errc.ErrInvalidRule, "bad version "+v)
+			}
+		}
+	}
+	if rule.MinGoVersion != "" {
+		if _, err := util.ParseVersionBound(">=", rule.MinGoVersion); err != nil {
+			return errc.New(errc.ErrInvalidRule, "bad version "+rule.MinGoVersion)
+		}
+	}
+	if rule.MaxGoVersion != "" {
+		if _, err := util.ParseVersionBound("<=", rule.MaxGoVersion); err != nil {
+			return errc.New(errc.ErrInvalidRule, "bad version "+rule.MaxGoVersion)
+		}
+	}
+	for _, req := range rule.Requires {
+		if _, _, err := ParseRequirement(req); err != nil {
+			return err
+		}
+	}
+	for _, cond := range rule.WhenModules {
+		if _, _, _, err := ParseModuleCondition(cond); err != nil {
+			return err
+		}
+	}
+	switch rule.Scope {
+	case "", ScopeSource, ScopeTest, ScopeAll:
+	default:
+		return errc.New(errc.ErrInvalidRule, "bad scope "+rule.Scope)
+	}
+	if d := rule.Deprecated; d != nil {
+		for _, v := range []string{d.UpgradeTo, d.RemovedIn} {
+			if v != "" && !semver.IsValid(v) {
 				return errc.New(errc.ErrInvalidRule, "bad version "+v)
 			}
 		}
@@ -156,15 +472,64 @@ func verifyRule(rule *InstBaseRule, checkPath bool) error {
 	return nil
 }
 
+// ParseRequirement splits the module requirement of the rule, e.g.
+// "github.com/google/uuid@v1.6.0", into the module path and the version
+func ParseRequirement(req string) (string, string, error) {
+	path, version, found := strings.Cut(req, "@")
+	if !found || module.Check(path, version) != nil {
+		return "", "", errc.New(errc.ErrInvalidRule, "bad requirement "+req)
+	}
+	return path, version, nil
+}
+
+// ParseModuleCondition splits the module condition of the rule, e.g.
+// "go.uber.org/zap@[1.20.0,)" or "!github.com/sirupsen/logrus", into the module
+// path, the optional version range and whether the module must be absent
+func ParseModuleCondition(cond string) (string, string, bool, error) {
+	path, absent := strings.CutPrefix(cond, "!")
+	path, version, _ := strings.Cut(path, "@")
+	if module.CheckImportPath(path) != nil {
+		return "", "", false, errc.New(errc.ErrInvalidRule, "bad module condition "+cond)
+	}
+	if version != "" {
+		if _, err := util.ParseVersionConstraint(version); err != nil {
+			return "", "", false, errc.New(errc.ErrInvalidRule, "bad module condition "+cond)
+		}
+	}
+	return path, version, absent, nil
+}
+
+// IsImportPathPattern checks if the import path of the rule is a glob pattern,
+// i.e. it contains any of the special characters *, ? or [
+func IsImportPathPattern(importPath string) bool {
+	return strings.ContainsAny(importPath, "*?[")
+}
+
+// MatchImportPath checks if the import path matches the pattern of the rule.
+// The pattern follows path.Match, so * matches exactly one path element, e.g.
+// github.com/mycorp/*/internal/handlers matches
+// github.com/mycorp/payments/internal/handlers but not
+// github.com/mycorp/payments/v2/internal/handlers
+func MatchImportPath(pattern, importPath string) bool {
+	if !IsImportPathPattern(pattern) {
+		return pattern == importPath
+	}
+	matched, err := path.Match(pattern, importPath)
+	return err == nil && matched
+}
+
 func verifyRuleBase(rule *InstBaseRule) error {
-	return verifyRule(rule, false)
+	return verifyRule(rule, true)
 }
 
 func verifyRuleBaseWithoutPath(rule *InstBaseRule) error {
-	return verifyRule(rule, true)
+	return verifyRule(rule, false)
 }
 
 func (rule *InstFileRule) Verify() error {
+	if rule.Init != "" {
+		return rule.verifyInit()
+	}
 	err := verifyRuleBase(&rule.InstBaseRule)
 	if err != nil {
 		return err
@@ -175,12 +540,60 @@ func (rule *InstFileRule) Verify() error {
 	if !util.IsGoFile(rule.FileName) {
 		return errc.New(errc.ErrInvalidRule, "not a go file")
 	}
+	if len(rule.Imports) > 0 {
+		return errc.New(errc.ErrInvalidRule, "imports require init code")
+	}
+	return verifyBuildConstraint(rule.BuildConstraint)
+}
+
+// verifyInit checks the rule that generates an init function, the file name
+// is optional as the generated file is always named by us
+func (rule *InstFileRule) verifyInit() error {
+	err := verifyRuleBaseWithoutPath(&rule.InstBaseRule)
+	if err != nil {
+		return err
+	}
+	if rule.FileName != "" && !util.IsGoFile(rule.FileName) {
+		return errc.New(errc.ErrInvalidRule, "not a go file")
+	}
+	if rule.Replace {
+		return errc.New(errc.ErrInvalidRule, "init code can not replace file")
+	}
+	if _, err = util.NewAstParser().ParseSnippet(rule.Init); err != nil {
+		return errc.New(errc.ErrInvalidRule, "bad init code "+rule.Init)
+	}
+	if err = verifyImports(rule.Imports); err != nil {
+		return err
+	}
+	return verifyBuildConstraint(rule.BuildConstraint)
+}
+
+func verifyBuildConstraint(expr string) error {
+	if expr == "" {
+		return nil
+	}
+	if _, err := constraint.Parse("//go:build " + expr); err != nil {
+		return errc.New(errc.ErrInvalidRule, "bad build constraint "+expr)
+	}
+	return nil
+}
+
+func verifyImports(imports map[string]string) error {
+	for name, path := range imports {
+		if !token.IsIdentifier(name) || name == "_" {
+			return errc.New(errc.ErrInvalidRule, "bad import name "+name)
+		}
+		if module.CheckImportPath(path) != nil {
+			return errc.New(errc.ErrInvalidRule, "bad import path "+path)
+		}
+	}
 	return nil
 }
 
 func (rule *InstFuncRule) Verify() error {
 	var err error
-	if rule.UseRaw {
+	// Raw code and metrics-only rules have no hook code
+	if rule.UseRaw || (rule.OnEnter == "" && rule.OnExit == "") {
 		err = verifyRuleBaseWithoutPath(&rule.InstBaseRule)
 	} else {
 		err = verifyRuleBase(&rule.InstBaseRule)
@@ -191,9 +604,57 @@ func (rule *InstFuncRule) Verify() error {
 	if rule.Function == "" {
 		return errc.New(errc.ErrInvalidRule, "empty function name")
 	}
-	if rule.OnEnter == "" && rule.OnExit == "" {
+	if rule.OnEnter == "" && rule.OnExit == "" && rule.Metrics == nil &&
+		len(rule.Inject) == 0 {
 		return errc.New(errc.ErrInvalidRule, "empty hook")
 	}
+	if rule.UseRaw && rule.Metrics != nil {
+		return errc.New(errc.ErrInvalidRule, "metrics can not be used with raw code")
+	}
+	if rule.Metrics != nil {
+		for _, instrument := range rule.Metrics.Instruments {
+			if instrument != MetricsHistogram && instrument != MetricsCounter {
+				return errc.New(errc.ErrInvalidRule,
+					"unknown metrics instrument "+instrument)
+			}
+		}
+	}
+	if !rule.UseRaw && (len(rule.Inject) > 0 || len(rule.Imports) > 0) {
+		return errc.New(errc.ErrInvalidRule, "injections require raw code")
+	}
+	for _, inject := range rule.Inject {
+		if err = verifyInjection(inject); err != nil {
+			return err
+		}
+	}
+	return verifyImports(rule.Imports)
+}
+
+func verifyInjection(inject *RawInjection) error {
+	if inject == nil || inject.Code == "" {
+		return errc.New(errc.ErrInvalidRule, "empty injection code")
+	}
+	switch inject.At {
+	case InjectAtEnter, InjectAtReturn:
+		if inject.Pattern != "" {
+			return errc.New(errc.ErrInvalidRule,
+				"pattern can not be used at "+inject.At)
+		}
+	case InjectAtBefore, InjectAtAfter:
+		if inject.Pattern == "" {
+			return errc.New(errc.ErrInvalidRule,
+				"empty pattern of injection at "+inject.At)
+		}
+		if _, err := regexp.Compile(inject.Pattern); err != nil {
+			return errc.New(errc.ErrInvalidRule,
+				"bad pattern "+inject.Pattern)
+		}
+	default:
+		return errc.New(errc.ErrInvalidRule, "bad injection point "+inject.At)
+	}
+	if _, err := util.NewAstParser().ParseSnippet(inject.Code); err != nil {
+		return errc.New(errc.ErrInvalidRule, "bad injection code "+inject.Code)
+	}
 	return nil
 }
 
@@ -210,3 +671,36 @@ func (rule *InstStructRule) Verify() error {
 	}
 	return nil
 }
+
+func (rule *InstSuppressRule) Verify() error {
+	err := verifyRuleBaseWithoutPath(&rule.InstBaseRule)
+	if err != nil {
+		return err
+	}
+	if len(rule.Suppress) == 0 {
+		return errc.New(errc.ErrInvalidRule, "empty suppressed rules")
+	}
+	for _, pattern := range rule.Suppress {
+		if _, err = path.Match(pattern, ""); err != nil {
+			return errc.New(errc.ErrInvalidRule, "bad suppressed rule "+pattern)
+		}
+	}
+	if rule.Function == "" && rule.ReceiverType != "" {
+		return errc.New(errc.ErrInvalidRule, "receiver type without function")
+	}
+	for _, re := range []string{rule.Function, rule.ReceiverType} {
+		if _, err = regexp.Compile(re); err != nil {
+			return errc.New(errc.ErrInvalidRule, "bad function pattern "+re)
+		}
+	}
+	return nil
+}
+
+// Verify checks the rule is valid, fields overriding the base rules are checked
+// when the rule is resolved
+func (rule *InstExtendRule) Verify() error {
+	if rule.Extends == "" {
+		return errc.New(errc.ErrInvalidRule, "empty base rule")
+	}
+	return nil
+}
@@ -0,0 +1,622 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"gopkg.in/yaml.v3"
+)
+
+// -----------------------------------------------------------------------------
+// Rule File
+//
+// Rule files are written in either JSON or YAML. Since YAML is a superset of
+// JSON, both of them are parsed as YAML, which keeps the position of every
+// rule and field, so that errors such as unknown fields, missing required keys
+// and malformed version ranges are reported with the exact line and column,
+// instead of silently ignoring the rule. A YAML rule file may consist of
+// multiple documents, each of which is either a list of rules, a single rule,
+// or a mapping whose "Rules" key holds the list of rules, in which case other
+// keys are free to hold anchors that rules can refer to, e.g.
+//
+//	Common: &common
+//	  ImportPath: net/http
+//	  Path: github.com/foo/bar/rules/http
+//	Rules:
+//	  - <<: *common
+//	    Function: Get
+//
+// Rules of several related packages, e.g. a framework along with its middleware
+// and binding subpackages, can be grouped into a composite rule, whose fields
+// such as Version and Path are shared by the member rules unless they override
+// them, and whose ImportPath is the base of relative import paths of members:
+//
+//	- ImportPath: github.com/gin-gonic/gin
+//	  Version: "[1.7.0,)"
+//	  Path: github.com/foo/bar/rules/gin
+//	  Rules:
+//	    - Function: New
+//	      OnExit: onExitNew
+//	    - ImportPath: ./binding
+//	      Function: Bind
+//	      OnEnter: onEnterBind
+
+// IsYAMLRuleFile checks if the rule file is written in YAML
+func IsYAMLRuleFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// positionOf returns the position of the node in format "file:line:column"
+func positionOf(name string, node *yaml.Node) string {
+	return fmt.Sprintf("%s:%d:%d", name, node.Line, node.Column)
+}
+
+func ruleError(name string, node *yaml.Node, format string, args ...any) error {
+	return errc.New(errc.ErrInvalidRule,
+		positionOf(name, node)+": "+fmt.Sprintf(format, args...))
+}
+
+// offsetToPosition converts the byte offset of the content to line and column
+func offsetToPosition(content string, offset int64) (int, int) {
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	before := content[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := len(before) - strings.LastIndex(before, "\n")
+	return line, column
+}
+
+// checkJSONSyntax checks the syntax of JSON rule file, YAML accepts something
+// that JSON does not, e.g. trailing commas, and reports syntax errors of JSON
+// at inaccurate lines.
+func checkJSONSyntax(name, content string) error {
+	var v interface{}
+	err := json.Unmarshal([]byte(content), &v)
+	if err == nil {
+		return nil
+	}
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		// Offset is right after the invalid character
+		line, column := offsetToPosition(content, max(syntaxErr.Offset-1, 0))
+		return errc.New(errc.ErrInvalidJSON,
+			fmt.Sprintf("%s:%d:%d: %v", name, line, column, err))
+	}
+	return errc.New(errc.ErrInvalidJSON, fmt.Sprintf("%s: %v", name, err))
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// isMergeKey checks if the key merges other mappings, i.e. "<<: *anchor"
+func isMergeKey(key *yaml.Node) bool {
+	return key.Kind == yaml.ScalarNode && key.Value == "<<" &&
+		(key.Tag == "!!merge" || key.Tag == "")
+}
+
+// mergedNodes returns the mappings merged by the value of merge key, which is
+// either an alias or a list of aliases
+func mergedNodes(value *yaml.Node) []*yaml.Node {
+	value = resolveAlias(value)
+	if value.Kind == yaml.SequenceNode {
+		return value.Content
+	}
+	return []*yaml.Node{value}
+}
+
+// findKey finds the key node of the field in the mapping, keys that are
+// defined directly take precedence over the merged ones
+func findKey(node *yaml.Node, field string) *yaml.Node {
+	node = resolveAlias(node)
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if !isMergeKey(node.Content[i]) && node.Content[i].Value == field {
+			return node.Content[i]
+		}
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if isMergeKey(node.Content[i]) {
+			for _, merged := range mergedNodes(node.Content[i+1]) {
+				if key := findKey(merged, field); key != nil {
+					return key
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the fields of the struct keyed by their JSON names,
+// fields of embedded structs are promoted
+func jsonFields(typ reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for k, v := range jsonFields(field.Type) {
+				fields[k] = v
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// checkFields checks that all fields of the mapping are known by the struct,
+// nested structs are checked recursively.
+func checkFields(name string, node *yaml.Node, typ reflect.Type) error {
+	node = resolveAlias(node)
+	if node.Kind != yaml.MappingNode {
+		return ruleError(name, node, "expect a mapping")
+	}
+	fields := jsonFields(typ)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if isMergeKey(key) {
+			for _, merged := range mergedNodes(value) {
+				if err := checkFields(name, merged, typ); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		fieldType, exist := fields[key.Value]
+		if !exist {
+			for field := range fields {
+				if strings.EqualFold(field, key.Value) {
+					return ruleError(name, key,
+						"unknown field %q, did you mean %q", key.Value, field)
+				}
+			}
+			return ruleError(name, key, "unknown field %q", key.Value)
+		}
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct &&
+			resolveAlias(value).Tag != "!!null" {
+			if err := checkFields(name, value, fieldType); err != nil {
+				return err
+			}
+		}
+		// Elements of struct slices, e.g. Inject, are checked as well
+		if fieldType.Kind() == reflect.Slice &&
+			resolveAlias(value).Kind == yaml.SequenceNode {
+			elemType := fieldType.Elem()
+			for elemType.Kind() == reflect.Pointer {
+				elemType = elemType.Elem()
+			}
+			if elemType.Kind() != reflect.Struct {
+				continue
+			}
+			for _, elem := range resolveAlias(value).Content {
+				if err := checkFields(name, elem, elemType); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// decodeNode decodes the rule through JSON, so that rules in YAML share the
+// same field names as rules in JSON
+func decodeNode(name string, node *yaml.Node, v any) error {
+	var raw interface{}
+	err := node.Decode(&raw)
+	if err != nil {
+		return ruleError(name, node, "%v", err)
+	}
+	bs, err := json.Marshal(raw)
+	if err != nil {
+		return ruleError(name, node, "%v", err)
+	}
+	err = json.Unmarshal(bs, v)
+	if err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			field := typeErr.Field
+			if i := strings.LastIndex(field, "."); i >= 0 {
+				field = field[i+1:]
+			}
+			if key := findKey(node, field); key != nil {
+				return ruleError(name, key,
+					"field %q expects %v, got %s", typeErr.Field, typeErr.Type,
+					typeErr.Value)
+			}
+		}
+		return ruleError(name, node, "%v", err)
+	}
+	return nil
+}
+
+// parseRule parses one rule from the node, the type of the rule is decided by
+// its characteristic field, i.e. Extends, Suppress, StructType, Function,
+// FileName or Init.
+func parseRule(name string, node *yaml.Node) (InstRule, error) {
+	node = resolveAlias(node)
+	if node.Kind != yaml.MappingNode {
+		return nil, ruleError(name, node, "expect a rule")
+	}
+	var rule InstRule
+	switch {
+	case findKey(node, "Extends") != nil:
+		return parseExtendRule(name, node)
+	case findKey(node, "Suppress") != nil:
+		rule = &InstSuppressRule{}
+	case findKey(node, "StructType") != nil:
+		rule = &InstStructRule{}
+	case findKey(node, "Function") != nil:
+		rule = &InstFuncRule{}
+	case findKey(node, "FileName") != nil, findKey(node, "Init") != nil:
+		rule = &InstFileRule{}
+	default:
+		return nil, ruleError(name, node,
+			"unknown rule type, one of Function, StructType, FileName, Init, Extends and Suppress is required")
+	}
+	return decodeRule(name, node, rule)
+}
+
+// decodeRule decodes the node into the rule, fields that are absent from the
+// node are left as they are
+func decodeRule(name string, node *yaml.Node, rule InstRule) (InstRule, error) {
+	err := checkFields(name, node, reflect.TypeOf(rule).Elem())
+	if err != nil {
+		return nil, err
+	}
+	err = decodeNode(name, node, rule)
+	if err != nil {
+		return nil, err
+	}
+	return validateRule(name, node, rule)
+}
+
+// validateRule checks the decoded rule, errors are reported at the position of
+// the offending field if possible
+func validateRule(name string, node *yaml.Node, rule InstRule) (InstRule, error) {
+	var err error
+	versions := map[string]string{
+		"Version":   rule.GetVersion(),
+		"GoVersion": rule.GetGoVersion(),
+	}
+	for field, version := range versions {
+		key := findKey(node, field)
+		if key == nil {
+			continue
+		}
+		if _, err = util.ParseVersionConstraint(version); err != nil {
+			return nil, ruleError(name, key, "malformed version range %q", version)
+		}
+	}
+	bounds := map[string]string{
+		"MinGoVersion": rule.GetMinGoVersion(),
+		"MaxGoVersion": rule.GetMaxGoVersion(),
+	}
+	for field, version := range bounds {
+		key := findKey(node, field)
+		if key == nil {
+			continue
+		}
+		if _, err = util.ParseVersionBound(">=", version); err != nil {
+			return nil, ruleError(name, key, "malformed version %q", version)
+		}
+	}
+	if err = rule.Verify(); err != nil {
+		var perr *errc.PlentifulError
+		if errors.As(err, &perr) {
+			return nil, ruleError(name, node, "%s", perr.Reason)
+		}
+		return nil, ruleError(name, node, "%v", err)
+	}
+	rule.SetSource(positionOf(name, node))
+	return rule, nil
+}
+
+// parseExtendRule parses the rule that extends others, its fields are checked
+// against the type of base rules once they are known, see ResolveExtends
+func parseExtendRule(name string, node *yaml.Node) (InstRule, error) {
+	rule := &InstExtendRule{file: name, node: node}
+	err := decodeNode(name, node, rule)
+	if err != nil {
+		return nil, err
+	}
+	return validateRule(name, node, rule)
+}
+
+// extend derives a rule from the base rule, fields defined by the extend rule
+// override those of the base rule, maps such as Imports are merged
+func (rule *InstExtendRule) extend(base InstRule) (InstRule, error) {
+	bs, err := json.Marshal(base)
+	if err != nil {
+		return nil, errc.New(errc.ErrInvalidRule, err.Error())
+	}
+	derived := reflect.New(reflect.TypeOf(base).Elem()).Interface().(InstRule)
+	if err = json.Unmarshal(bs, derived); err != nil {
+		return nil, errc.New(errc.ErrInvalidRule, err.Error())
+	}
+	// Extends is not a field of the derived rule
+	node := *rule.node
+	node.Content = make([]*yaml.Node, 0, len(rule.node.Content))
+	for i := 0; i+1 < len(rule.node.Content); i += 2 {
+		if rule.node.Content[i].Value != "Extends" {
+			node.Content = append(node.Content,
+				rule.node.Content[i], rule.node.Content[i+1])
+		}
+	}
+	return decodeRule(rule.file, &node, derived)
+}
+
+// ResolveExtends resolves extend rules into rules derived from their bases, i.e.
+// rules of the given name that are loaded before them, so default rules can be
+// extended by custom ones. The derived rule replaces its base rule, unless it
+// is renamed or its version range differs, in which case both of them are kept
+// and the most specific one is applied to each version of the module.
+func ResolveExtends(rules []InstRule) ([]InstRule, error) {
+	resolved := make([]InstRule, 0, len(rules))
+	for _, rule := range rules {
+		ext, ok := rule.(*InstExtendRule)
+		if !ok {
+			resolved = append(resolved, rule)
+			continue
+		}
+		found := false
+		next := make([]InstRule, 0, len(resolved)+1)
+		for _, base := range resolved {
+			if base.GetName() != ext.Extends {
+				next = append(next, base)
+				continue
+			}
+			found = true
+			derived, err := ext.extend(base)
+			if err != nil {
+				return nil, err
+			}
+			if derived.GetName() != base.GetName() ||
+				derived.GetVersion() != base.GetVersion() {
+				next = append(next, base)
+			}
+			// Placed right after the base rule, so that it wins the tie
+			next = append(next, derived)
+		}
+		if !found {
+			return nil, errc.New(errc.ErrInvalidRule,
+				fmt.Sprintf("%s: no rule named %s to extend",
+					ext.GetSource(), ext.Extends))
+		}
+		resolved = next
+	}
+	return resolved, nil
+}
+
+// compositeRule groups rules of related packages, fields other than Rules are
+// shared by the member rules
+type compositeRule struct {
+	InstBaseRule
+	Rules []any `json:"Rules"`
+}
+
+// isCompositeRule checks if the mapping is a composite rule, i.e. it holds the
+// member rules and declares any field that is shared by them. Mappings holding
+// nothing but the rules and anchors are not.
+func isCompositeRule(node *yaml.Node) bool {
+	node = resolveAlias(node)
+	if node.Kind != yaml.MappingNode || findKey(node, "Rules") == nil {
+		return false
+	}
+	shared := jsonFields(reflect.TypeOf(InstBaseRule{}))
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if _, exist := shared[node.Content[i].Value]; exist {
+			return true
+		}
+	}
+	return false
+}
+
+// compositeMembers expands the composite rule into its member rules, shared
+// fields are merged into each member as if it were "<<: *shared", so that the
+// fields of the member take precedence, and relative import paths of members,
+// e.g. "./binding", are resolved against the import path of the composite.
+func compositeMembers(name string, node *yaml.Node) ([]*yaml.Node, error) {
+	node = resolveAlias(node)
+	err := checkFields(name, node, reflect.TypeOf(compositeRule{}))
+	if err != nil {
+		return nil, err
+	}
+	if key := findKey(node, "Name"); key != nil {
+		return nil, ruleError(name, key, "Name can not be shared by rules")
+	}
+	composite := &compositeRule{}
+	err = decodeNode(name, node, composite)
+	if err != nil {
+		return nil, err
+	}
+	shared := &yaml.Node{
+		Kind:   yaml.MappingNode,
+		Tag:    "!!map",
+		Line:   node.Line,
+		Column: node.Column,
+	}
+	var members *yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == "Rules" {
+			members = resolveAlias(node.Content[i+1])
+			continue
+		}
+		shared.Content = append(shared.Content, node.Content[i], node.Content[i+1])
+	}
+	if members == nil || members.Kind != yaml.SequenceNode {
+		return nil, ruleError(name, node, "Rules must be a list of rules")
+	}
+	nodes := make([]*yaml.Node, 0, len(members.Content))
+	for _, member := range members.Content {
+		member = resolveAlias(member)
+		if member.Kind != yaml.MappingNode {
+			return nil, ruleError(name, member, "expect a rule")
+		}
+		if key := findKey(member, "Rules"); key != nil {
+			return nil, ruleError(name, key, "composite rules can not be nested")
+		}
+		merged := &yaml.Node{
+			Kind:   yaml.MappingNode,
+			Tag:    "!!map",
+			Line:   member.Line,
+			Column: member.Column,
+		}
+		// Mappings merged by the member take precedence over the shared one
+		merges := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for i := 0; i+1 < len(member.Content); i += 2 {
+			key, value := member.Content[i], member.Content[i+1]
+			if isMergeKey(key) {
+				merges.Content = append(merges.Content, mergedNodes(value)...)
+				continue
+			}
+			if key.Value == "ImportPath" {
+				path := resolveAlias(value).Value
+				if path == "." || strings.HasPrefix(path, "./") {
+					if composite.ImportPath == "" {
+						return nil, ruleError(name, key,
+							"relative import path %s without base", path)
+					}
+					resolved := *resolveAlias(value)
+					resolved.Value = strings.TrimSuffix(composite.ImportPath+
+						strings.TrimPrefix(path, "."), "/")
+					value = &resolved
+				}
+			}
+			merged.Content = append(merged.Content, key, value)
+		}
+		merges.Content = append(merges.Content, shared)
+		mergeKey := &yaml.Node{
+			Kind:   yaml.ScalarNode,
+			Tag:    "!!merge",
+			Value:  "<<",
+			Line:   node.Line,
+			Column: node.Column,
+		}
+		merged.Content = append(merged.Content, mergeKey, merges)
+		nodes = append(nodes, merged)
+	}
+	return nodes, nil
+}
+
+// ruleNodes returns the nodes of rules in the document
+func ruleNodes(name string, doc *yaml.Node) ([]*yaml.Node, error) {
+	if doc.Kind == yaml.DocumentNode {
+		if len(doc.Content) == 0 {
+			return nil, nil
+		}
+		doc = doc.Content[0]
+	}
+	doc = resolveAlias(doc)
+	switch doc.Kind {
+	case yaml.SequenceNode:
+		nodes := make([]*yaml.Node, 0, len(doc.Content))
+		for _, node := range doc.Content {
+			if findKey(node, "Rules") == nil {
+				nodes = append(nodes, node)
+				continue
+			}
+			members, err := compositeMembers(name, node)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, members...)
+		}
+		return nodes, nil
+	case yaml.MappingNode:
+		if isCompositeRule(doc) {
+			return compositeMembers(name, doc)
+		}
+		for i := 0; i+1 < len(doc.Content); i += 2 {
+			if doc.Content[i].Value != "Rules" {
+				continue
+			}
+			rules := resolveAlias(doc.Content[i+1])
+			if rules.Kind != yaml.SequenceNode {
+				return nil, ruleError(name, rules, "Rules must be a list of rules")
+			}
+			return rules.Content, nil
+		}
+		return []*yaml.Node{doc}, nil
+	case yaml.ScalarNode:
+		if doc.Tag == "!!null" {
+			return nil, nil
+		}
+	}
+	return nil, ruleError(name, doc, "expect a list of rules")
+}
+
+// ParseRules parses and validates all rules in the rule file, name is where the
+// content comes from, which is used to report errors.
+func ParseRules(name, content string) ([]InstRule, error) {
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		if err := checkJSONSyntax(name, content); err != nil {
+			return nil, err
+		}
+	}
+	rules := make([]InstRule, 0)
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	for {
+		var doc yaml.Node
+		err := decoder.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errc.New(errc.ErrInvalidYAML,
+				fmt.Sprintf("%s: %v", name, err))
+		}
+		nodes, err := ruleNodes(name, &doc)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			rule, err := parseRule(name, node)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
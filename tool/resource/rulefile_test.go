@@ -0,0 +1,472 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/data"
+)
+
+func TestParseRules(t *testing.T) {
+	content := `
+Common: &common
+  ImportPath: net/http
+  Path: github.com/foo/bar/rules/http
+Rules:
+  - <<: *common
+    Function: Get
+    OnEnter: getOnEnter
+    Version: ">=1.2.0 <2.0.0"
+  - ImportPath: net/http
+    StructType: Client
+    FieldName: Tracer
+    FieldType: interface{}
+---
+- ImportPath: fmt
+  Function: Println
+  UseRaw: true
+  OnEnter: println()
+- ImportPath: fmt
+  FileName: hook.go
+  Path: github.com/foo/bar/rules/fmt
+`
+	rules, err := ParseRules("rules.yaml", content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 4 {
+		t.Fatalf("expect 4 rules, got %d", len(rules))
+	}
+	get, ok := rules[0].(*InstFuncRule)
+	if !ok || get.ImportPath != "net/http" || get.OnEnter != "getOnEnter" {
+		t.Fatalf("unexpected rule %v", rules[0])
+	}
+	if get.GetSource() != "rules.yaml:6:5" {
+		t.Fatalf("unexpected source %s", get.GetSource())
+	}
+	if _, ok := rules[1].(*InstStructRule); !ok {
+		t.Fatalf("unexpected rule %v", rules[1])
+	}
+	if _, ok := rules[3].(*InstFileRule); !ok {
+		t.Fatalf("unexpected rule %v", rules[3])
+	}
+}
+
+func TestParseCompositeRules(t *testing.T) {
+	content := `
+- ImportPath: github.com/gin-gonic/gin
+  Version: "[1.7.0,)"
+  Priority: 3
+  Path: github.com/foo/bar/rules/gin
+  Rules:
+    - Function: New
+      OnExit: onExitNew
+    - ImportPath: ./binding
+      Function: Bind
+      OnEnter: onEnterBind
+      Version: "[1.9.0,)"
+    - ImportPath: github.com/gin-contrib/cors
+      FileName: cors.go
+`
+	rules, err := ParseRules("composite.yaml", content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 3 {
+		t.Fatalf("expect 3 rules, got %d", len(rules))
+	}
+	gin, ok := rules[0].(*InstFuncRule)
+	if !ok || gin.ImportPath != "github.com/gin-gonic/gin" ||
+		gin.Version != "[1.7.0,)" || gin.Path != "github.com/foo/bar/rules/gin" {
+		t.Fatalf("unexpected rule %v", rules[0])
+	}
+	if gin.GetSource() != "composite.yaml:7:7" {
+		t.Fatalf("unexpected source %s", gin.GetSource())
+	}
+	bind, ok := rules[1].(*InstFuncRule)
+	if !ok || bind.ImportPath != "github.com/gin-gonic/gin/binding" ||
+		bind.Version != "[1.9.0,)" || bind.Priority != 3 {
+		t.Fatalf("unexpected rule %v", rules[1])
+	}
+	cors, ok := rules[2].(*InstFileRule)
+	if !ok || cors.ImportPath != "github.com/gin-contrib/cors" ||
+		cors.Priority != 3 || cors.Version != "[1.7.0,)" {
+		t.Fatalf("unexpected rule %v", rules[2])
+	}
+
+	// A JSON rule file can be a single composite rule as well
+	content = `{
+  "ImportPath": "github.com/gin-gonic/gin",
+  "Path": "github.com/foo/bar/rules/gin",
+  "Rules": [
+    {"Function": "New", "OnExit": "onExitNew"},
+    {"ImportPath": ".", "StructType": "Engine", "FieldName": "Tracer", "FieldType": "any"}
+  ]
+}`
+	rules, err = ParseRules("composite.json", content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[1].GetImportPath() != "github.com/gin-gonic/gin" {
+		t.Fatalf("unexpected rules %v", rules)
+	}
+}
+
+func TestParseInvalidRules(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		expect  string
+	}{
+		{
+			"unknown.json",
+			"[\n  {\n    \"ImportPath\": \"fmt\",\n    \"Function\": \"Println\",\n    \"OnEnterr\": \"hook\"\n  }\n]",
+			`unknown.json:5:5: unknown field "OnEnterr"`,
+		},
+		{
+			"case.json",
+			`[{"importPath": "fmt", "Function": "Println", "UseRaw": true, "OnEnter": "x"}]`,
+			`case.json:1:3: unknown field "importPath", did you mean "ImportPath"`,
+		},
+		{
+			"nested.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  Metrics:\n    Attribute: {}\n",
+			`nested.yaml:4:5: unknown field "Attribute"`,
+		},
+		{
+			"instrument.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  Metrics:\n    Instruments: [gauge]\n",
+			"instrument.yaml:1:3: unknown metrics instrument gauge",
+		},
+		{
+			"type.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  Priority: high\n",
+			`type.yaml:5:3: field "Priority" expects int`,
+		},
+		{
+			"kind.yaml",
+			"- ImportPath: fmt\n  OnEnter: x\n",
+			"kind.yaml:1:3: unknown rule type",
+		},
+		{
+			"required.yaml",
+			"- Function: Println\n  UseRaw: true\n  OnEnter: x\n",
+			"required.yaml:1:3: import path is empty",
+		},
+		{
+			"path.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  OnEnter: x\n",
+			"path.yaml:1:3: local path is empty",
+		},
+		{
+			"version.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  Version: \">=1.a\"\n",
+			`version.yaml:5:3: malformed version range ">=1.a"`,
+		},
+		{
+			"goversion.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  MinGoVersion: \">=1.22\"\n",
+			`goversion.yaml:5:3: malformed version ">=1.22"`,
+		},
+		{
+			"glob.yaml",
+			"- ImportPath: github.com/mycorp/[a-\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n",
+			"glob.yaml:1:3: bad import path pattern github.com/mycorp/[a-",
+		},
+		{
+			"requires.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  Requires: [github.com/google/uuid]\n",
+			"requires.yaml:1:3: bad requirement github.com/google/uuid",
+		},
+		{
+			"when.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  WhenModules: [\"go.uber.org/zap@>=1.a\"]\n",
+			"when.yaml:1:3: bad module condition go.uber.org/zap@>=1.a",
+		},
+		{
+			"scope.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  Scope: tests\n",
+			"scope.yaml:1:3: bad scope tests",
+		},
+		{
+			"deprecated.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  Deprecated:\n    UpgradeTo: \"1.9\"\n",
+			"deprecated.yaml:1:3: bad version 1.9",
+		},
+		{
+			"composite.yaml",
+			"- ImportPath: fmt\n  Name: shared\n  Rules:\n    - Function: Println\n      UseRaw: true\n      OnEnter: x\n",
+			"composite.yaml:2:3: Name can not be shared by rules",
+		},
+		{
+			"nested.json",
+			`[{"ImportPath": "fmt", "Rules": [{"Function": "Println", "Rules": []}]}]`,
+			"nested.json:1:58: composite rules can not be nested",
+		},
+		{
+			"shared.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  Rules: []\n",
+			`shared.yaml:2:3: unknown field "Function"`,
+		},
+		{
+			"inject.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  Inject:\n    - At: before\n      Code: x()\n      pattern: y\n",
+			`inject.yaml:7:7: unknown field "pattern", did you mean "Pattern"`,
+		},
+		{
+			"pattern.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  Inject:\n    - At: after\n      Code: x()\n",
+			"pattern.yaml:1:3: empty pattern of injection at after",
+		},
+		{
+			"at.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  Inject:\n    - At: exit\n      Code: x()\n",
+			"at.yaml:1:3: bad injection point exit",
+		},
+		{
+			"imports.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n  Imports:\n    str-conv: strconv\n",
+			"imports.yaml:1:3: bad import name str-conv",
+		},
+		{
+			"raw.yaml",
+			"- ImportPath: fmt\n  Function: Println\n  Path: github.com/foo/bar\n  OnEnter: x\n  Imports:\n    strconv: strconv\n",
+			"raw.yaml:1:3: injections require raw code",
+		},
+		{
+			"constraint.yaml",
+			"- ImportPath: database/sql\n  Init: register()\n  BuildConstraint: linux &&\n",
+			"constraint.yaml:1:3: bad build constraint linux &&",
+		},
+		{
+			"init.yaml",
+			"- ImportPath: database/sql\n  FileName: sql.go\n  Init: register()\n  Replace: true\n",
+			"init.yaml:1:3: init code can not replace file",
+		},
+		{
+			"extends.yaml",
+			"- Extends: \"\"\n  OnEnter: x\n",
+			"extends.yaml:1:3: empty base rule",
+		},
+		{
+			"suppress.yaml",
+			"- ImportPath: fmt\n  Suppress: []\n",
+			"suppress.yaml:1:3: empty suppressed rules",
+		},
+		{
+			"suppressed.yaml",
+			"- ImportPath: fmt\n  Suppress: [\"http.[a-\"]\n",
+			"suppressed.yaml:1:3: bad suppressed rule http.[a-",
+		},
+		{
+			"receiver.yaml",
+			"- ImportPath: fmt\n  Suppress: [\"*\"]\n  ReceiverType: \\*pp\n",
+			"receiver.yaml:1:3: receiver type without function",
+		},
+		{
+			"syntax.json",
+			"[\n  {\n    \"ImportPath\": \"fmt\"\n    \"Function\": \"Println\"\n  }\n]",
+			"syntax.json:4:5: invalid character",
+		},
+		{
+			"syntax.yaml",
+			"- ImportPath: fmt\n\tFunction: Println\n",
+			"syntax.yaml: yaml: line 2",
+		},
+		{
+			"list.yaml",
+			"Rules: fmt\n",
+			"list.yaml:1:8: Rules must be a list of rules",
+		},
+	}
+	for _, c := range cases {
+		_, err := ParseRules(c.name, c.content)
+		if err == nil || !strings.Contains(err.Error(), c.expect) {
+			t.Fatalf("%s: expect %q, got %v", c.name, c.expect, err)
+		}
+	}
+}
+
+func TestResolveExtends(t *testing.T) {
+	content := `
+- Name: redigo.dial
+  ImportPath: github.com/gomodule/redigo/redis
+  Function: DialContext
+  OnEnter: onBeforeDialContext
+  Path: github.com/foo/bar/rules/redigo
+  Version: "[1.8.0,)"
+- Extends: redigo.dial
+  Path: github.com/mycorp/patched/redigo
+- Extends: redigo.dial
+  Version: "[1.9.0,1.9.2)"
+  OnExit: onAfterDialContext
+`
+	rules, err := ParseRules("extends.yaml", content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err = ResolveExtends(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expect 2 rules, got %v", rules)
+	}
+	// The derived rule replaces its base and inherits other fields
+	patched, ok := rules[0].(*InstFuncRule)
+	if !ok || patched.Path != "github.com/mycorp/patched/redigo" ||
+		patched.Function != "DialContext" || patched.Version != "[1.8.0,)" ||
+		patched.GetSource() != "extends.yaml:8:3" {
+		t.Fatalf("unexpected rule %v", rules[0])
+	}
+	// The pinned one is kept along with its base
+	pinned := rules[1].(*InstFuncRule)
+	if pinned.Path != patched.Path || pinned.Version != "[1.9.0,1.9.2)" ||
+		pinned.OnEnter != "onBeforeDialContext" ||
+		pinned.OnExit != "onAfterDialContext" {
+		t.Fatalf("unexpected rule %v", rules[1])
+	}
+
+	cases := []struct {
+		name    string
+		content string
+		expect  string
+	}{
+		{
+			"missing.yaml",
+			"- Extends: redigo.dial\n  Path: github.com/foo/bar\n",
+			"missing.yaml:1:3: no rule named redigo.dial to extend",
+		},
+		{
+			"field.yaml",
+			"- Name: fmt.println\n  ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n" +
+				"- Extends: fmt.println\n  FileName: x.go\n",
+			`field.yaml:7:3: unknown field "FileName"`,
+		},
+		{
+			"verify.yaml",
+			"- Name: fmt.println\n  ImportPath: fmt\n  Function: Println\n  UseRaw: true\n  OnEnter: x\n" +
+				"- Extends: fmt.println\n  UseRaw: false\n",
+			"verify.yaml:6:3: local path is empty",
+		},
+	}
+	for _, c := range cases {
+		rules, err := ParseRules(c.name, c.content)
+		if err == nil {
+			_, err = ResolveExtends(rules)
+		}
+		if err == nil || !strings.Contains(err.Error(), c.expect) {
+			t.Fatalf("%s: expect %q, got %v", c.name, c.expect, err)
+		}
+	}
+}
+
+func TestMatchImportPath(t *testing.T) {
+	cases := []struct {
+		pattern    string
+		importPath string
+		expect     bool
+	}{
+		{"fmt", "fmt", true},
+		{"fmt", "fmt/x", false},
+		{"github.com/mycorp/*/internal/handlers", "github.com/mycorp/payments/internal/handlers", true},
+		{"github.com/mycorp/*/internal/handlers", "github.com/mycorp/payments/v2/internal/handlers", false},
+		{"github.com/mycorp/*/internal/handlers", "github.com/other/payments/internal/handlers", false},
+		{"github.com/mycorp/*/*/handlers", "github.com/mycorp/payments/api/handlers", true},
+		{"github.com/mycorp/svc-?", "github.com/mycorp/svc-a", true},
+		{"github.com/mycorp/svc-[ab]", "github.com/mycorp/svc-c", false},
+	}
+	for _, c := range cases {
+		if MatchImportPath(c.pattern, c.importPath) != c.expect {
+			t.Fatalf("%s against %s: expect %v", c.pattern, c.importPath, c.expect)
+		}
+	}
+}
+
+func TestParseSuppressRules(t *testing.T) {
+	content := `
+- ImportPath: example.com/app/internal/crypto/...
+  Suppress: ["*"]
+- ImportPath: example.com/app/cache
+  Function: Get
+  ReceiverType: \*Cache
+  Suppress: [funcmetrics.*, "http.client*"]
+`
+	rules, err := ParseRules("suppress.yaml", content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expect 2 rules, got %d", len(rules))
+	}
+	crypto, ok := rules[0].(*InstSuppressRule)
+	if !ok {
+		t.Fatalf("unexpected rule %v", rules[0])
+	}
+	for importPath, expect := range map[string]bool{
+		"example.com/app/internal/crypto":     true,
+		"example.com/app/internal/crypto/aes": true,
+		"example.com/app/internal/cryptox":    false,
+		"example.com/app/internal":            false,
+	} {
+		if crypto.MatchImportPath(importPath) != expect {
+			t.Fatalf("%s: expect %v", importPath, expect)
+		}
+	}
+	if !crypto.Suppresses("") || !crypto.Suppresses("http.clientOnEnter") {
+		t.Fatalf("expect all rules suppressed by %v", crypto)
+	}
+	cache, ok := rules[1].(*InstSuppressRule)
+	if !ok || cache.Function != "Get" || cache.ReceiverType != `\*Cache` {
+		t.Fatalf("unexpected rule %v", rules[1])
+	}
+	if !cache.Suppresses("http.clientOnEnter") || cache.Suppresses("http.serverOnEnter") {
+		t.Fatalf("unexpected suppression of %v", cache)
+	}
+}
+
+func TestParseDefaultRules(t *testing.T) {
+	files, err := data.ListRuleFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		content, err := data.ReadRuleFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = ParseRules(file, string(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Rule files used by tests are valid as well
+	tests, err := filepath.Glob(filepath.Join("..", "data", "test_*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range tests {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = ParseRules(file, string(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
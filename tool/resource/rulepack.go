@@ -0,0 +1,571 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// A rule pack is a set of rule files published remotely, so that they can be
+// shared across projects without copying files around. It is referenced by
+//
+//	https://example.com/rules/payments.yaml
+//	https://example.com/rules/payments.yaml#sha256:<hex>
+//	oci://registry.example.com/otel-rules/payments:v3
+//	oci://registry.example.com/otel-rules/payments@sha256:<hex>
+//
+// The HTTP rule pack is a single rule file, while the OCI rule pack is an
+// artifact whose layers are rule files, e.g. the one pushed by
+// "oras push registry.example.com/otel-rules/payments:v3 payments.yaml".
+// Digest pinned rule packs are verified against the digest, and they are
+// served from the local cache once fetched. Detached signatures of rule files
+// are fetched along with them if available, see signature.go. Rule packs are
+// fetched from the mirror instead if configured, see mirror.go.
+const (
+	RulePackOCIScheme   = "oci://"
+	RulePackHTTPScheme  = "http://"
+	RulePackHTTPSScheme = "https://"
+	rulePackCacheDir    = "opentelemetry-go-auto-instrumentation/rules"
+	rulePackMaxSize     = 32 << 20
+	digestAlgorithm     = "sha256:"
+	ociManifestType     = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestType  = "application/vnd.docker.distribution.manifest.v2+json"
+	ociTitleAnnotation  = "org.opencontainers.image.title"
+)
+
+var (
+	rulePackClient = &http.Client{Timeout: 60 * time.Second}
+	// registryScheme is the scheme used to talk to OCI registries, it is only
+	// changed by tests
+	registryScheme = "https"
+	// fetchedRulePacks memorizes the fetched rule packs, rules are loaded
+	// several times during preprocess
+	fetchedRulePacks = map[string][]*RuleFile{}
+	fetchedLock      sync.Mutex
+)
+
+// RuleFile is a rule file of the rule pack
+type RuleFile struct {
+	// Name of the rule file, i.e. where it comes from
+	Name string
+	// Content of the rule file
+	Content string
+	// Signature is the detached minisign signature, empty if not signed
+	Signature string
+}
+
+func IsRemoteRule(ref string) bool {
+	return strings.HasPrefix(ref, RulePackOCIScheme) ||
+		strings.HasPrefix(ref, RulePackHTTPScheme) ||
+		strings.HasPrefix(ref, RulePackHTTPSScheme)
+}
+
+// FetchRulePack returns rule files in the remote rule pack.
+func FetchRulePack(ref string) ([]*RuleFile, error) {
+	fetchedLock.Lock()
+	defer fetchedLock.Unlock()
+	if files, exist := fetchedRulePacks[ref]; exist {
+		return files, nil
+	}
+	var files []*RuleFile
+	var err error
+	if ruleMirror != nil {
+		files, err = fetchMirroredRulePack(ref)
+	} else if strings.HasPrefix(ref, RulePackOCIScheme) {
+		files, err = fetchOCIRulePack(ref)
+	} else {
+		files, err = fetchHTTPRulePack(ref)
+	}
+	if err != nil {
+		return nil, errc.Adhere(err, "rulePack", ref)
+	}
+	fetchedRulePacks[ref] = files
+	return files, nil
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return digestAlgorithm + hex.EncodeToString(sum[:])
+}
+
+func verifyDigest(digest string) error {
+	hexPart := strings.TrimPrefix(digest, digestAlgorithm)
+	if hexPart == digest || len(hexPart) != sha256.Size*2 {
+		return errc.New(errc.ErrFetchRule,
+			fmt.Sprintf("unsupported digest %q, expect sha256:<hex>", digest))
+	}
+	if _, err := hex.DecodeString(hexPart); err != nil {
+		return errc.New(errc.ErrFetchRule,
+			fmt.Sprintf("malformed digest %q", digest))
+	}
+	return nil
+}
+
+func getRulePackCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return util.GetTempBuildDirWith(filepath.Base(rulePackCacheDir))
+	}
+	return filepath.Join(dir, rulePackCacheDir)
+}
+
+// The cache consists of content-addressed blobs, and the references pointing
+// to the blob they are resolved to last time, which are used when the network
+// is not available.
+func blobCachePath(digest string) string {
+	return filepath.Join(getRulePackCacheDir(), "blobs",
+		strings.TrimPrefix(digest, digestAlgorithm))
+}
+
+func refCachePath(ref string) string {
+	return filepath.Join(getRulePackCacheDir(), "refs", refKey(ref))
+}
+
+func readCachedBlob(digest string) ([]byte, bool) {
+	data, err := os.ReadFile(blobCachePath(digest))
+	if err != nil || digestOf(data) != digest {
+		return nil, false
+	}
+	return data, true
+}
+
+func writeCache(path string, data []byte) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err == nil {
+		err = os.WriteFile(path, data, 0644)
+	}
+	if err != nil {
+		// Caching is best effort
+		util.Log("Failed to cache rule pack: %v", err)
+	}
+}
+
+func signatureCachePath(digest string) string {
+	return filepath.Join(getRulePackCacheDir(), "signatures",
+		strings.TrimPrefix(digest, digestAlgorithm))
+}
+
+func readCachedRef(ref string) (string, bool) {
+	data, err := os.ReadFile(refCachePath(ref))
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+func httpGet(req *http.Request) (*http.Response, []byte, error) {
+	resp, err := rulePackClient.Do(req)
+	if err != nil {
+		return nil, nil, errc.New(errc.ErrFetchRule, err.Error())
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, rulePackMaxSize+1))
+	if err != nil {
+		return nil, nil, errc.New(errc.ErrFetchRule, err.Error())
+	}
+	if len(data) > rulePackMaxSize {
+		return nil, nil, errc.New(errc.ErrFetchRule, "rule pack is too large")
+	}
+	return resp, data, nil
+}
+
+func expectOK(resp *http.Response, req *http.Request) error {
+	if resp.StatusCode != http.StatusOK {
+		err := errc.New(errc.ErrFetchRule, resp.Status)
+		return errc.Adhere(err, "url", req.URL.Redacted())
+	}
+	return nil
+}
+
+// fetchHTTPSignature fetches the signature of the HTTP rule pack, which is
+// published at "<url>.minisig". Signatures are cached by the digest of the
+// content they sign, the cached one is used if the network is not available
+// or preferred if the content itself is served from the cache.
+func fetchHTTPSignature(link, digest string, preferCache bool) string {
+	cached, err := os.ReadFile(signatureCachePath(digest))
+	if err == nil && preferCache {
+		return string(cached)
+	}
+	req, err := http.NewRequest(http.MethodGet, link+SignatureSuffix, nil)
+	if err == nil {
+		resp, data, err := httpGet(req)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			writeCache(signatureCachePath(digest), data)
+			return string(data)
+		}
+	}
+	return string(cached)
+}
+
+func fetchHTTPRulePack(ref string) ([]*RuleFile, error) {
+	link, digest, _ := strings.Cut(ref, "#")
+	ruleFile := func(data []byte, cached bool) []*RuleFile {
+		sig := fetchHTTPSignature(link, digestOf(data), cached)
+		return []*RuleFile{{Name: link, Content: string(data), Signature: sig}}
+	}
+	if digest != "" {
+		if err := verifyDigest(digest); err != nil {
+			return nil, err
+		}
+		if data, ok := readCachedBlob(digest); ok {
+			util.Log("Use cached rule pack %s", ref)
+			return ruleFile(data, true), nil
+		}
+	}
+	req, err := http.NewRequest(http.MethodGet, link, nil)
+	if err != nil {
+		return nil, errc.New(errc.ErrFetchRule, err.Error())
+	}
+	resp, data, err := httpGet(req)
+	if err == nil {
+		err = expectOK(resp, req)
+	}
+	if err != nil {
+		if digest == "" {
+			// Fall back to the last fetched one if possible
+			if last, ok := readCachedRef(ref); ok {
+				if data, ok := readCachedBlob(last); ok {
+					util.Log("Failed to fetch rule pack %s, use cached %s: %v",
+						ref, last, err)
+					return ruleFile(data, true), nil
+				}
+			}
+		}
+		return nil, err
+	}
+	actual := digestOf(data)
+	if digest != "" && actual != digest {
+		return nil, errc.New(errc.ErrFetchRule,
+			fmt.Sprintf("digest mismatch, expect %s but got %s", digest, actual))
+	}
+	writeCache(blobCachePath(actual), data)
+	writeCache(refCachePath(ref), []byte(actual))
+	util.Log("Fetched rule pack %s with digest %s", ref, actual)
+	return ruleFile(data, false), nil
+}
+
+type ociReference struct {
+	host   string
+	repo   string
+	tag    string
+	digest string
+}
+
+func parseOCIReference(ref string) (*ociReference, error) {
+	r := &ociReference{}
+	rest := strings.TrimPrefix(ref, RulePackOCIScheme)
+	rest, r.digest, _ = strings.Cut(rest, "@")
+	r.host, rest, _ = strings.Cut(rest, "/")
+	if r.host == "" || rest == "" {
+		return nil, errc.New(errc.ErrFetchRule,
+			fmt.Sprintf("malformed OCI reference %q", ref))
+	}
+	// The tag is after the last colon, colons are not allowed in repository
+	if i := strings.LastIndex(rest, ":"); i >= 0 {
+		rest, r.tag = rest[:i], rest[i+1:]
+	}
+	r.repo = rest
+	if r.digest != "" {
+		if err := verifyDigest(r.digest); err != nil {
+			return nil, err
+		}
+	} else if r.tag == "" {
+		r.tag = "latest"
+	}
+	return r, nil
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+func (d *ociDescriptor) title() string {
+	return d.Annotations[ociTitleAnnotation]
+}
+
+// ruleLayers returns layers that hold rule files, they are recognized by
+// either the file name or the media type.
+func (m *ociManifest) ruleLayers() []ociDescriptor {
+	layers := make([]ociDescriptor, 0)
+	for _, layer := range m.Layers {
+		title := strings.ToLower(layer.title())
+		switch {
+		case strings.HasSuffix(title, SignatureSuffix):
+			continue
+		case strings.HasSuffix(title, ".json"),
+			strings.HasSuffix(title, ".yaml"),
+			strings.HasSuffix(title, ".yml"),
+			strings.Contains(layer.MediaType, "json"),
+			strings.Contains(layer.MediaType, "yaml"):
+			layers = append(layers, layer)
+		}
+	}
+	return layers
+}
+
+// ruleFiles loads rule files and their signatures in the manifest, blobs are
+// loaded by the given function.
+func (m *ociManifest) ruleFiles(ref string,
+	load func(ociDescriptor) ([]byte, error)) ([]*RuleFile, error) {
+	signatures := map[string]ociDescriptor{}
+	for _, layer := range m.Layers {
+		if strings.HasSuffix(layer.title(), SignatureSuffix) {
+			signatures[layer.title()] = layer
+		}
+	}
+	files := make([]*RuleFile, 0)
+	for _, layer := range m.ruleLayers() {
+		blob, err := load(layer)
+		if err != nil {
+			return nil, err
+		}
+		name := layer.title()
+		if name == "" {
+			name = layer.Digest
+		}
+		file := &RuleFile{Name: ref + "/" + name, Content: string(blob)}
+		if sigLayer, exist := signatures[layer.title()+SignatureSuffix]; exist {
+			sig, err := load(sigLayer)
+			if err != nil {
+				return nil, err
+			}
+			file.Signature = string(sig)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+type ociClient struct {
+	ref   *ociReference
+	token string
+}
+
+func (c *ociClient) url(kind, reference string) string {
+	return fmt.Sprintf("%s://%s/v2/%s/%s/%s",
+		registryScheme, c.ref.host, c.ref.repo, kind, reference)
+}
+
+// get sends the request to the registry, it requests an anonymous bearer token
+// and retries if the registry asks for it.
+func (c *ociClient) get(link string, accept ...string) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(http.MethodGet, link, nil)
+		if err != nil {
+			return nil, errc.New(errc.ErrFetchRule, err.Error())
+		}
+		for _, a := range accept {
+			req.Header.Add("Accept", a)
+		}
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+		resp, data, err := httpGet(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			err = c.authorize(resp.Header.Get("WWW-Authenticate"))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err = expectOK(resp, req); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+func parseAuthParams(challenge string) map[string]string {
+	params := map[string]string{}
+	for _, part := range strings.Split(challenge, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok {
+			params[strings.ToLower(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return params
+}
+
+func (c *ociClient) authorize(challenge string) error {
+	const bearer = "Bearer "
+	if !strings.HasPrefix(challenge, bearer) {
+		return errc.New(errc.ErrFetchRule,
+			fmt.Sprintf("unsupported authentication %q", challenge))
+	}
+	params := parseAuthParams(strings.TrimPrefix(challenge, bearer))
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return errc.New(errc.ErrFetchRule,
+			fmt.Sprintf("malformed authentication %q", challenge))
+	}
+	query := realm.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + c.ref.repo + ":pull"
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return errc.New(errc.ErrFetchRule, err.Error())
+	}
+	resp, data, err := httpGet(req)
+	if err != nil {
+		return err
+	}
+	if err = expectOK(resp, req); err != nil {
+		return err
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err = json.Unmarshal(data, &token); err != nil {
+		return errc.New(errc.ErrInvalidJSON, err.Error())
+	}
+	c.token = token.Token
+	if c.token == "" {
+		c.token = token.AccessToken
+	}
+	return nil
+}
+
+func readCachedOCIRulePack(ref, manifestDigest string) ([]*RuleFile, bool) {
+	data, ok := readCachedBlob(manifestDigest)
+	if !ok {
+		return nil, false
+	}
+	manifest := &ociManifest{}
+	if json.Unmarshal(data, manifest) != nil {
+		return nil, false
+	}
+	files, err := manifest.ruleFiles(ref, func(layer ociDescriptor) ([]byte, error) {
+		data, ok := readCachedBlob(layer.Digest)
+		if !ok {
+			return nil, errc.New(errc.ErrFetchRule, "layer is not cached")
+		}
+		return data, nil
+	})
+	return files, err == nil && len(files) > 0
+}
+
+func fetchOCIRulePack(ref string) ([]*RuleFile, error) {
+	r, err := parseOCIReference(ref)
+	if err != nil {
+		return nil, err
+	}
+	if r.digest != "" {
+		if files, ok := readCachedOCIRulePack(ref, r.digest); ok {
+			util.Log("Use cached rule pack %s", ref)
+			return files, nil
+		}
+	}
+	files, digest, err := pullOCIRulePack(ref, r)
+	if err != nil {
+		if r.digest == "" {
+			// Fall back to the last pulled one if possible
+			if last, ok := readCachedRef(ref); ok {
+				if files, ok := readCachedOCIRulePack(ref, last); ok {
+					util.Log("Failed to pull rule pack %s, use cached %s: %v",
+						ref, last, err)
+					return files, nil
+				}
+			}
+		}
+		return nil, err
+	}
+	writeCache(refCachePath(ref), []byte(digest))
+	util.Log("Pulled rule pack %s with digest %s", ref, digest)
+	return files, nil
+}
+
+func pullOCIRulePack(ref string, r *ociReference) ([]*RuleFile, string, error) {
+	c := &ociClient{ref: r}
+	reference := r.digest
+	if reference == "" {
+		reference = r.tag
+	}
+	data, err := c.get(c.url("manifests", reference),
+		ociManifestType, dockerManifestType)
+	if err != nil {
+		return nil, "", err
+	}
+	digest := digestOf(data)
+	if r.digest != "" && digest != r.digest {
+		return nil, "", errc.New(errc.ErrFetchRule,
+			fmt.Sprintf("digest mismatch, expect %s but got %s", r.digest, digest))
+	}
+	manifest := &ociManifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, "", errc.New(errc.ErrInvalidJSON, err.Error())
+	}
+	if len(manifest.ruleLayers()) == 0 {
+		return nil, "", errc.New(errc.ErrFetchRule, "no rule file found")
+	}
+	files, err := manifest.ruleFiles(ref, func(layer ociDescriptor) ([]byte, error) {
+		if err := verifyDigest(layer.Digest); err != nil {
+			return nil, err
+		}
+		if blob, ok := readCachedBlob(layer.Digest); ok {
+			return blob, nil
+		}
+		blob, err := c.get(c.url("blobs", layer.Digest))
+		if err != nil {
+			return nil, err
+		}
+		if digestOf(blob) != layer.Digest {
+			return nil, errc.New(errc.ErrFetchRule,
+				fmt.Sprintf("digest mismatch of layer %s", layer.Digest))
+		}
+		writeCache(blobCachePath(layer.Digest), blob)
+		return blob, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	// Cache the manifest after all layers, so that a cached manifest always
+	// implies its layers are cached
+	writeCache(blobCachePath(digest), data)
+	return files, digest, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testRuleFile = `[{"ImportPath":"fmt","Function":"Println","UseRaw":true,"OnEnter":"println()"}]`
+
+func setupRulePackTest(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	fetchedRulePacks = map[string][]*RuleFile{}
+	t.Cleanup(func() { fetchedRulePacks = map[string][]*RuleFile{} })
+}
+
+func TestFetchHTTPRulePack(t *testing.T) {
+	setupRulePackTest(t)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/rules.json" {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write([]byte(testRuleFile))
+		}))
+	digest := digestOf([]byte(testRuleFile))
+	ref := server.URL + "/rules.json#" + digest
+
+	contents, err := FetchRulePack(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contents) != 1 || contents[0].Content != testRuleFile {
+		t.Fatalf("unexpected contents %v", contents)
+	}
+
+	// Pinned rule pack is served from cache once fetched
+	server.Close()
+	fetchedRulePacks = map[string][]*RuleFile{}
+	contents, err = FetchRulePack(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contents) != 1 || contents[0].Content != testRuleFile {
+		t.Fatalf("unexpected cached contents %v", contents)
+	}
+}
+
+func TestFetchHTTPRulePackDigestMismatch(t *testing.T) {
+	setupRulePackTest(t)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(testRuleFile))
+		}))
+	defer server.Close()
+
+	ref := server.URL + "/rules.json#" + digestOf([]byte("tampered"))
+	_, err := FetchRulePack(ref)
+	if err == nil || !strings.Contains(err.Error(), "digest mismatch") {
+		t.Fatalf("expect digest mismatch, got %v", err)
+	}
+	_, err = FetchRulePack(server.URL + "/rules.json#md5:1234")
+	if err == nil || !strings.Contains(err.Error(), "unsupported digest") {
+		t.Fatalf("expect unsupported digest, got %v", err)
+	}
+}
+
+func TestFetchOCIRulePack(t *testing.T) {
+	setupRulePackTest(t)
+	layerDigest := digestOf([]byte(testRuleFile))
+	sig := newTestSigner(t, 1).sign(testRuleFile, true)
+	sigDigest := digestOf([]byte(sig))
+	manifest, _ := json.Marshal(&ociManifest{
+		MediaType: ociManifestType,
+		Layers: []ociDescriptor{
+			{
+				MediaType: "application/vnd.oci.image.layer.v1.tar",
+				Digest:    digestOf([]byte("readme")),
+				Annotations: map[string]string{
+					ociTitleAnnotation: "README.md",
+				},
+			},
+			{
+				MediaType: "application/vnd.oci.image.layer.v1.tar",
+				Digest:    layerDigest,
+				Annotations: map[string]string{
+					ociTitleAnnotation: "payments.json",
+				},
+			},
+			{
+				MediaType: "application/vnd.oci.image.layer.v1.tar",
+				Digest:    sigDigest,
+				Annotations: map[string]string{
+					ociTitleAnnotation: "payments.json" + SignatureSuffix,
+				},
+			},
+		},
+	})
+	manifestDigest := digestOf(manifest)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/token" {
+				if r.URL.Query().Get("scope") != "repository:otel/payments:pull" {
+					t.Errorf("unexpected scope %s", r.URL.RawQuery)
+				}
+				_, _ = w.Write([]byte(`{"token":"secret"}`))
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.Header().Set("WWW-Authenticate",
+					`Bearer realm="`+server.URL+`/token",service="test"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch r.URL.Path {
+			case "/v2/otel/payments/manifests/v3",
+				"/v2/otel/payments/manifests/" + manifestDigest:
+				_, _ = w.Write(manifest)
+			case "/v2/otel/payments/blobs/" + layerDigest:
+				_, _ = w.Write([]byte(testRuleFile))
+			case "/v2/otel/payments/blobs/" + sigDigest:
+				_, _ = w.Write([]byte(sig))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+	registryScheme = "http"
+	defer func() { registryScheme = "https" }()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	contents, err := FetchRulePack(RulePackOCIScheme + host + "/otel/payments:v3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contents) != 1 || contents[0].Content != testRuleFile ||
+		contents[0].Signature != sig {
+		t.Fatalf("unexpected contents %v", contents)
+	}
+
+	// Tagged rule pack falls back to the last pulled one, pinned rule pack is
+	// served from cache directly
+	server.Close()
+	fetchedRulePacks = map[string][]*RuleFile{}
+	for _, ref := range []string{
+		RulePackOCIScheme + host + "/otel/payments:v3",
+		RulePackOCIScheme + host + "/otel/payments@" + manifestDigest,
+	} {
+		contents, err = FetchRulePack(ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(contents) != 1 || contents[0].Content != testRuleFile ||
+			contents[0].Signature != sig {
+			t.Fatalf("unexpected cached contents %v", contents)
+		}
+	}
+}
+
+func TestParseOCIReference(t *testing.T) {
+	digest := digestOf([]byte("manifest"))
+	cases := []struct {
+		ref    string
+		expect ociReference
+	}{
+		{"oci://example.com/rules", ociReference{"example.com", "rules", "latest", ""}},
+		{"oci://localhost:5000/a/b:v1", ociReference{"localhost:5000", "a/b", "v1", ""}},
+		{"oci://example.com/a/b:v1@" + digest, ociReference{"example.com", "a/b", "v1", digest}},
+		{"oci://example.com/a/b@" + digest, ociReference{"example.com", "a/b", "", digest}},
+	}
+	for _, c := range cases {
+		r, err := parseOCIReference(c.ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *r != c.expect {
+			t.Fatalf("%s: expect %+v, got %+v", c.ref, c.expect, *r)
+		}
+	}
+	if _, err := parseOCIReference("oci://example.com"); err == nil {
+		t.Fatal("expect error for reference without repository")
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Rule files can be signed with minisign, e.g. "minisign -Sm custom.json",
+// which produces the detached signature custom.json.minisig next to the rule
+// file. Once trusted public keys are configured, only rule files signed by one
+// of them are applied, so that a tampered rule file can not inject arbitrary
+// code into the build. Signatures of rule packs are published along with them,
+// i.e. "<url>.minisig" for HTTP(S) rule packs and the "<file>.minisig" layer
+// for OCI rule packs.
+const (
+	SignatureSuffix      = ".minisig"
+	sigAlgorithmPure     = "Ed"
+	sigAlgorithmHashed   = "ED"
+	untrustedCommentLine = "untrusted comment:"
+	trustedCommentLine   = "trusted comment: "
+)
+
+// PublicKey is the minisign public key of a trusted rule signer
+type PublicKey struct {
+	ID  [8]byte
+	Key ed25519.PublicKey
+}
+
+func (k *PublicKey) String() string {
+	// Key IDs are displayed in big endian by minisign
+	id := make([]byte, len(k.ID))
+	for i := range k.ID {
+		id[i] = k.ID[len(k.ID)-1-i]
+	}
+	return strings.ToUpper(hex.EncodeToString(id))
+}
+
+// commentFree returns the lines that are not untrusted comments
+func commentFree(text string) []string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, untrustedCommentLine) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// ParsePublicKey parses the minisign public key, either the content of the
+// public key file or the base64 encoded key itself.
+func ParsePublicKey(text string) (*PublicKey, error) {
+	lines := commentFree(text)
+	if len(lines) != 1 {
+		return nil, errc.New(errc.ErrInvalidRule, "malformed public key")
+	}
+	raw, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil || len(raw) != 2+8+ed25519.PublicKeySize ||
+		string(raw[:2]) != sigAlgorithmPure {
+		return nil, errc.New(errc.ErrInvalidRule, "malformed public key")
+	}
+	key := &PublicKey{Key: ed25519.PublicKey(raw[10:])}
+	copy(key.ID[:], raw[2:10])
+	return key, nil
+}
+
+// LoadTrustedKeys loads the trusted public keys separated by comma, each of
+// them is either a public key file or the key itself.
+func LoadTrustedKeys(keys string) ([]*PublicKey, error) {
+	trusted := make([]*PublicKey, 0)
+	for _, spec := range strings.Split(keys, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		text := spec
+		if util.PathExists(spec) {
+			data, err := os.ReadFile(spec)
+			if err != nil {
+				return nil, errc.New(errc.ErrOpenFile, err.Error())
+			}
+			text = string(data)
+		}
+		key, err := ParsePublicKey(text)
+		if err != nil {
+			return nil, errc.Adhere(err, "key", spec)
+		}
+		trusted = append(trusted, key)
+	}
+	return trusted, nil
+}
+
+// ReadRuleFile reads the local rule file along with its signature if any
+func ReadRuleFile(path string) (*RuleFile, error) {
+	content, err := util.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := &RuleFile{Name: path, Content: content}
+	if util.PathExists(path + SignatureSuffix) {
+		file.Signature, err = util.ReadFile(path + SignatureSuffix)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return file, nil
+}
+
+type signature struct {
+	algorithm      string
+	keyID          [8]byte
+	sig            []byte
+	trustedComment string
+	globalSig      []byte
+}
+
+func parseSignature(text string) (*signature, error) {
+	lines := commentFree(text)
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], trustedCommentLine) {
+		return nil, errc.New(errc.ErrInvalidRule, "malformed signature")
+	}
+	raw, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil || len(raw) != 2+8+ed25519.SignatureSize {
+		return nil, errc.New(errc.ErrInvalidRule, "malformed signature")
+	}
+	globalSig, err := base64.StdEncoding.DecodeString(lines[2])
+	if err != nil || len(globalSig) != ed25519.SignatureSize {
+		return nil, errc.New(errc.ErrInvalidRule, "malformed signature")
+	}
+	s := &signature{
+		algorithm:      string(raw[:2]),
+		sig:            raw[10:],
+		trustedComment: strings.TrimPrefix(lines[1], trustedCommentLine),
+		globalSig:      globalSig,
+	}
+	copy(s.keyID[:], raw[2:10])
+	return s, nil
+}
+
+// VerifySignature verifies that the content of the rule file is signed by one
+// of the trusted keys, name is where the content comes from.
+func VerifySignature(trusted []*PublicKey, name, content, sig string) error {
+	if sig == "" {
+		return errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("%s is not signed, signature %s not found",
+				name, name+SignatureSuffix))
+	}
+	s, err := parseSignature(sig)
+	if err != nil {
+		return errc.Adhere(err, "rule", name)
+	}
+	var key *PublicKey
+	for _, k := range trusted {
+		if k.ID == s.keyID {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		id := &PublicKey{ID: s.keyID}
+		return errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("%s is signed by untrusted key %s", name, id))
+	}
+	message := []byte(content)
+	switch s.algorithm {
+	case sigAlgorithmPure:
+	case sigAlgorithmHashed:
+		sum := blake2b.Sum512(message)
+		message = sum[:]
+	default:
+		return errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("%s is signed by unsupported algorithm %q", name,
+				s.algorithm))
+	}
+	if !ed25519.Verify(key.Key, message, s.sig) {
+		return errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("signature of %s does not match its content", name))
+	}
+	// The trusted comment is signed along with the signature
+	global := bytes.Join([][]byte{s.sig, []byte(s.trustedComment)}, nil)
+	if !ed25519.Verify(key.Key, global, s.globalSig) {
+		return errc.New(errc.ErrInvalidRule,
+			fmt.Sprintf("trusted comment of %s is tampered", name))
+	}
+	util.Log("Verified signature of %s by key %s, %s", name, key,
+		s.trustedComment)
+	return nil
+}
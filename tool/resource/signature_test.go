@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+type testSigner struct {
+	id   [8]byte
+	priv ed25519.PrivateKey
+	pub  ed25519.PublicKey
+}
+
+func newTestSigner(t *testing.T, id byte) *testSigner {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSigner{id: [8]byte{id, 1, 2, 3, 4, 5, 6, 7}, priv: priv, pub: pub}
+}
+
+// publicKey returns the public key in the format of minisign
+func (s *testSigner) publicKey() string {
+	raw := append([]byte(sigAlgorithmPure), s.id[:]...)
+	raw = append(raw, s.pub...)
+	return "untrusted comment: minisign public key\n" +
+		base64.StdEncoding.EncodeToString(raw) + "\n"
+}
+
+// sign signs the content in the same way as minisign
+func (s *testSigner) sign(content string, hashed bool) string {
+	algorithm, message := sigAlgorithmPure, []byte(content)
+	if hashed {
+		sum := blake2b.Sum512(message)
+		algorithm, message = sigAlgorithmHashed, sum[:]
+	}
+	sig := ed25519.Sign(s.priv, message)
+	raw := append([]byte(algorithm), s.id[:]...)
+	raw = append(raw, sig...)
+	comment := "timestamp:1760000000\tfile:rules.json"
+	global := ed25519.Sign(s.priv, append(sig, comment...))
+	return "untrusted comment: signature from minisign secret key\n" +
+		base64.StdEncoding.EncodeToString(raw) + "\n" +
+		trustedCommentLine + comment + "\n" +
+		base64.StdEncoding.EncodeToString(global) + "\n"
+}
+
+func TestVerifySignature(t *testing.T) {
+	signer := newTestSigner(t, 1)
+	other := newTestSigner(t, 2)
+	key, err := ParsePublicKey(signer.publicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	trusted := []*PublicKey{key}
+
+	for _, hashed := range []bool{true, false} {
+		sig := signer.sign(testRuleFile, hashed)
+		if err = VerifySignature(trusted, "rules.json", testRuleFile, sig); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tampered := strings.Replace(signer.sign(testRuleFile, true),
+		"file:rules.json", "file:other.json", 1)
+	cases := []struct {
+		content string
+		sig     string
+		expect  string
+	}{
+		{testRuleFile, "", "is not signed"},
+		{testRuleFile, "garbage", "malformed signature"},
+		{testRuleFile + " ", signer.sign(testRuleFile, true), "does not match"},
+		{testRuleFile, other.sign(testRuleFile, true), "untrusted key"},
+		{testRuleFile, tampered, "trusted comment"},
+	}
+	for _, c := range cases {
+		err = VerifySignature(trusted, "rules.json", c.content, c.sig)
+		if err == nil || !strings.Contains(err.Error(), c.expect) {
+			t.Fatalf("expect %q, got %v", c.expect, err)
+		}
+	}
+}
+
+func TestLoadTrustedKeys(t *testing.T) {
+	signer := newTestSigner(t, 1)
+	other := newTestSigner(t, 2)
+	file := filepath.Join(t.TempDir(), "platform.pub")
+	if err := os.WriteFile(file, []byte(signer.publicKey()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	inline := strings.Split(other.publicKey(), "\n")[1]
+	keys, err := LoadTrustedKeys(file + "," + inline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0].ID != signer.id || keys[1].ID != other.id {
+		t.Fatalf("unexpected keys %v", keys)
+	}
+	if _, err = LoadTrustedKeys("RWQnotakey"); err == nil {
+		t.Fatal("expect error for malformed key")
+	}
+}
+
+func TestFetchSignedRulePack(t *testing.T) {
+	setupRulePackTest(t)
+	signer := newTestSigner(t, 1)
+	sig := signer.sign(testRuleFile, true)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/rules.json":
+				_, _ = w.Write([]byte(testRuleFile))
+			case "/rules.json" + SignatureSuffix:
+				_, _ = w.Write([]byte(sig))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+	ref := server.URL + "/rules.json#" + digestOf([]byte(testRuleFile))
+
+	files, err := FetchRulePack(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Signature != sig {
+		t.Fatalf("unexpected rule files %v", files)
+	}
+
+	// Signature is cached along with the pinned rule pack
+	server.Close()
+	fetchedRulePacks = map[string][]*RuleFile{}
+	files, err = FetchRulePack(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Signature != sig {
+		t.Fatalf("unexpected cached rule files %v", files)
+	}
+}
@@ -0,0 +1,439 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffold
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+)
+
+// anyType is used for the parameters whose types can not be referred outside
+// of the target package, e.g. unexported types, the instrumentation rectifies
+// them accordingly
+const anyType = "interface{}"
+
+type targetFunc struct {
+	pkgName string
+	file    *ast.File
+	decl    *ast.FuncDecl
+}
+
+// parseSymbol parses the function symbol, i.e. Func, Type.Method, (Type).Method
+// or (*Type).Method, and returns the receiver type name and the function name
+func parseSymbol(symbol string) (string, string, error) {
+	symbol = strings.TrimSpace(symbol)
+	recv, fn := "", symbol
+	if i := strings.LastIndex(symbol, "."); i >= 0 {
+		recv, fn = symbol[:i], symbol[i+1:]
+		recv = strings.TrimSuffix(strings.TrimPrefix(recv, "("), ")")
+		recv = strings.TrimPrefix(recv, "*")
+	}
+	if !token.IsIdentifier(fn) || (recv != "" && !token.IsIdentifier(recv)) {
+		return "", "", errc.New(errc.ErrScaffold,
+			fmt.Sprintf("malformed function %q", symbol))
+	}
+	return recv, fn, nil
+}
+
+// receiverOf returns the type name of the receiver and whether it's generic,
+// the name is empty if the function has no receiver
+func receiverOf(decl *ast.FuncDecl) (string, bool) {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return "", false
+	}
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	generic := false
+	switch t := recv.(type) {
+	case *ast.IndexExpr:
+		recv, generic = t.X, true
+	case *ast.IndexListExpr:
+		recv, generic = t.X, true
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name, generic
+	}
+	return "", generic
+}
+
+// findTargetFunc finds the function designated by symbol in the package that
+// is built for the current platform
+func findTargetFunc(dir, symbol string) (*targetFunc, error) {
+	recv, fn, err := parseSymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+	pkg, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		return nil, errc.New(errc.ErrParseCode, err.Error())
+	}
+	fset := token.NewFileSet()
+	for _, name := range pkg.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil,
+			parser.SkipObjectResolution)
+		if err != nil {
+			return nil, errc.New(errc.ErrParseCode, err.Error())
+		}
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Name.Name != fn {
+				continue
+			}
+			name, generic := receiverOf(funcDecl)
+			if name != recv {
+				continue
+			}
+			if generic {
+				return nil, errc.New(errc.ErrScaffold,
+					"generic receiver is not supported")
+			}
+			if funcDecl.Body == nil {
+				return nil, errc.New(errc.ErrScaffold,
+					fmt.Sprintf("%s has no body", symbol))
+			}
+			if funcDecl.Type.TypeParams != nil {
+				return nil, errc.New(errc.ErrScaffold,
+					"generic function is not supported")
+			}
+			return &targetFunc{pkgName: file.Name.Name, file: file,
+				decl: funcDecl}, nil
+		}
+	}
+	return nil, errc.New(errc.ErrScaffold,
+		fmt.Sprintf("function %s not found in %s", symbol, dir))
+}
+
+// receiverPattern returns the ReceiverType of the rule
+func (t *targetFunc) receiverPattern() string {
+	name, _ := receiverOf(t.decl)
+	if name == "" {
+		return ""
+	}
+	if _, ok := t.decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+		return `\*` + name
+	}
+	return name
+}
+
+// importOf returns the import path of the package that is referred by name in
+// the file of target function
+func (t *targetFunc) importOf(name string) string {
+	for _, spec := range t.file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if spec.Name != nil {
+			if spec.Name.Name == name {
+				return path
+			}
+			continue
+		}
+		if guessPackageName(path) == name {
+			return path
+		}
+	}
+	return ""
+}
+
+// guessPackageName guesses the package name from the import path, i.e. the last
+// path element without the major version suffix, such as /v2 or .v3
+func guessPackageName(path string) string {
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && len(name) > 1 && name[0] == 'v' {
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			name = elems[len(elems)-2]
+		}
+	}
+	if i := strings.Index(name, ".v"); i > 0 {
+		name = name[:i]
+	}
+	return packageNameOf(strings.TrimPrefix(name, "go-"))
+}
+
+// packageNameOf turns the name into a valid package name
+func packageNameOf(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			sb.WriteRune(r)
+		}
+	}
+	pkg := sb.String()
+	if pkg == "" || !unicode.IsLetter(rune(pkg[0])) {
+		pkg = "hook" + pkg
+	}
+	return pkg
+}
+
+type hookGen struct {
+	importPath string
+	hookPkg    string
+	target     *targetFunc
+	// Imports used by the hook functions, name to import path
+	imports map[string]string
+	onEnter string
+	onExit  string
+}
+
+func newHookGen(importPath, hookPkg string, target *targetFunc) *hookGen {
+	base := target.decl.Name.Name
+	if recv, _ := receiverOf(target.decl); recv != "" {
+		base = recv + base
+	}
+	base = strings.ToUpper(base[:1]) + base[1:]
+	return &hookGen{
+		importPath: importPath,
+		hookPkg:    hookPkg,
+		target:     target,
+		imports:    map[string]string{},
+		onEnter:    "onEnter" + base,
+		onExit:     "onExit" + base,
+	}
+}
+
+// fileName returns the name of the hook file, e.g. client_do_setup.go
+func (g *hookGen) fileName() string {
+	name := strings.TrimPrefix(g.onEnter, "onEnter")
+	var sb strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && !unicode.IsUpper(rune(name[i-1])) {
+				sb.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String() + "_setup.go"
+}
+
+// typeOf renders the type expression of target function so that it can be
+// referred in the hook package, the imports it relies on are recorded in uses.
+// It returns false if the type can not be referred, e.g. it's unexported
+func (g *hookGen) typeOf(expr ast.Expr, uses map[string]string) (string, bool) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(t.Name) != nil {
+			return t.Name, true
+		}
+		if !ast.IsExported(t.Name) {
+			return "", false
+		}
+		uses[g.target.pkgName] = g.importPath
+		return g.target.pkgName + "." + t.Name, true
+	case *ast.SelectorExpr:
+		x, ok := t.X.(*ast.Ident)
+		if !ok || !ast.IsExported(t.Sel.Name) {
+			return "", false
+		}
+		path := g.target.importOf(x.Name)
+		if path == "" {
+			return "", false
+		}
+		uses[x.Name] = path
+		return x.Name + "." + t.Sel.Name, true
+	case *ast.StarExpr:
+		elem, ok := g.typeOf(t.X, uses)
+		return "*" + elem, ok
+	case *ast.Ellipsis:
+		elem, ok := g.typeOf(t.Elt, uses)
+		return "..." + elem, ok
+	case *ast.ParenExpr:
+		elem, ok := g.typeOf(t.X, uses)
+		return "(" + elem + ")", ok
+	case *ast.ArrayType:
+		elem, ok := g.typeOf(t.Elt, uses)
+		if t.Len == nil {
+			return "[]" + elem, ok
+		}
+		if lit, isLit := t.Len.(*ast.BasicLit); isLit {
+			return "[" + lit.Value + "]" + elem, ok
+		}
+		return "", false
+	case *ast.MapType:
+		key, ok1 := g.typeOf(t.Key, uses)
+		val, ok2 := g.typeOf(t.Value, uses)
+		return "map[" + key + "]" + val, ok1 && ok2
+	case *ast.ChanType:
+		elem, ok := g.typeOf(t.Value, uses)
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + elem, ok
+		case ast.RECV:
+			return "<-chan " + elem, ok
+		default:
+			return "chan " + elem, ok
+		}
+	case *ast.FuncType:
+		params, ok1 := g.fieldTypes(t.Params, uses)
+		results, ok2 := g.fieldTypes(t.Results, uses)
+		text := "func(" + strings.Join(params, ", ") + ")"
+		if len(results) == 1 {
+			text += " " + results[0]
+		} else if len(results) > 1 {
+			text += " (" + strings.Join(results, ", ") + ")"
+		}
+		return text, ok1 && ok2
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return anyType, true
+		}
+	case *ast.StructType:
+		if len(t.Fields.List) == 0 {
+			return "struct{}", true
+		}
+	case *ast.IndexExpr:
+		x, ok1 := g.typeOf(t.X, uses)
+		index, ok2 := g.typeOf(t.Index, uses)
+		return x + "[" + index + "]", ok1 && ok2
+	case *ast.IndexListExpr:
+		x, ok := g.typeOf(t.X, uses)
+		indices := make([]string, len(t.Indices))
+		for i, index := range t.Indices {
+			var indexOk bool
+			indices[i], indexOk = g.typeOf(index, uses)
+			ok = ok && indexOk
+		}
+		return x + "[" + strings.Join(indices, ", ") + "]", ok
+	}
+	return "", false
+}
+
+// fieldTypes renders the types of the field list, one for each name
+func (g *hookGen) fieldTypes(fields *ast.FieldList, uses map[string]string) ([]string, bool) {
+	if fields == nil {
+		return nil, true
+	}
+	texts := make([]string, 0, len(fields.List))
+	ok := true
+	for _, field := range fields.List {
+		text, fieldOk := g.typeOf(field.Type, uses)
+		ok = ok && fieldOk
+		for i := 0; i < max(len(field.Names), 1); i++ {
+			texts = append(texts, text)
+		}
+	}
+	return texts, ok
+}
+
+// hookParams renders the parameters of the hook function, one field for each
+// parameter as the instrumentation rectifies them one by one
+func (g *hookGen) hookParams(fields []*ast.Field, prefix string) []string {
+	params := []string{"call api.CallContext"}
+	for _, field := range fields {
+		uses := map[string]string{}
+		typ, ok := g.typeOf(field.Type, uses)
+		if ok {
+			for name, path := range uses {
+				g.imports[name] = path
+			}
+		} else {
+			typ = anyType
+		}
+		names := make([]string, 0, len(field.Names))
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+		if len(names) == 0 {
+			names = append(names, "")
+		}
+		for _, name := range names {
+			// Name the unnamed ones, e.g. results, after their positions
+			if name == "" || name == "call" {
+				name = fmt.Sprintf("%s%d", prefix, len(params)-1)
+			}
+			params = append(params, name+" "+typ)
+		}
+	}
+	return params
+}
+
+// generate generates the source of the hook file
+func (g *hookGen) generate() (string, error) {
+	decl := g.target.decl
+	enterFields := make([]*ast.Field, 0)
+	if decl.Recv != nil {
+		enterFields = append(enterFields, decl.Recv.List...)
+	}
+	enterFields = append(enterFields, decl.Type.Params.List...)
+	exitFields := make([]*ast.Field, 0)
+	if decl.Type.Results != nil {
+		exitFields = append(exitFields, decl.Type.Results.List...)
+	}
+	enterParams := g.hookParams(enterFields, "arg")
+	exitParams := g.hookParams(exitFields, "ret")
+
+	// Standard packages are grouped before the others
+	std := []string{"_ \"unsafe\""}
+	others := []string{strconv.Quote(apiImportPath)}
+	for name, imp := range g.imports {
+		spec := strconv.Quote(imp)
+		if path.Base(imp) != name {
+			spec = name + " " + spec
+		}
+		first, _, _ := strings.Cut(imp, "/")
+		if strings.Contains(first, ".") {
+			others = append(others, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std[1:])
+	sort.Strings(others)
+
+	content := "package " + g.hookPkg + "\n\n"
+	content += "import (\n"
+	for _, spec := range std {
+		content += "\t" + spec + "\n"
+	}
+	content += "\n"
+	for _, spec := range others {
+		content += "\t" + spec + "\n"
+	}
+	content += ")\n\n"
+	content += fmt.Sprintf("//go:linkname %s %s.%s\n", g.onEnter, g.importPath, g.onEnter)
+	content += fmt.Sprintf("func %s(%s) {\n", g.onEnter, strings.Join(enterParams, ", "))
+	content += "\t// Inspect or modify parameters by call.GetParam() and call.SetParam(),\n"
+	content += fmt.Sprintf("\t// pass data to %s by call.SetData()\n", g.onExit)
+	content += "}\n\n"
+	content += fmt.Sprintf("//go:linkname %s %s.%s\n", g.onExit, g.importPath, g.onExit)
+	content += fmt.Sprintf("func %s(%s) {\n", g.onExit, strings.Join(exitParams, ", "))
+	content += "\t// Inspect or modify return values by call.GetReturnVal() and\n"
+	content += fmt.Sprintf("\t// call.SetReturnVal(), data passed by %s is call.GetData()\n", g.onEnter)
+	content += "}\n"
+
+	source, err := format.Source([]byte(content))
+	if err != nil {
+		return "", errc.New(errc.ErrParseCode, err.Error())
+	}
+	return string(source), nil
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffold
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+// -----------------------------------------------------------------------------
+// Scaffold
+//
+// The scaffold package generates a new instrumentation rule for a function of
+// any package, i.e. "otel rule new". It downloads the module that provides the
+// package, finds the target function and generates the rule entry along with
+// the hook stubs whose parameters match the signature of the target function.
+
+const (
+	SubcommandNew = "new"
+	RuleFileName  = "rules.json"
+	apiImportPath = "github.com/alibaba/opentelemetry-go-auto-instrumentation/pkg/api"
+)
+
+var usage = `Usage: {} rule new [flags] <import path>[@version] <function>
+Example:
+	{} rule new github.com/gomodule/redigo/redis@v1.9.2 DialContext
+	{} rule new -output=hooks/redis github.com/redis/go-redis/v9 "(*Client).Process"
+	{} rule new net/http "(*Client).Do"
+
+Flags:
+`
+
+type options struct {
+	importPath string
+	version    string
+	symbol     string
+	output     string
+	hookPath   string
+	hookPkg    string
+}
+
+func printUsage(fs *flag.FlagSet) {
+	name, _ := util.GetToolName()
+	fmt.Print(strings.ReplaceAll(usage, "{}", name))
+	fs.PrintDefaults()
+}
+
+// Rule runs the "rule" command
+func Rule() error {
+	fs := flag.NewFlagSet("rule new", flag.ContinueOnError)
+	opts := &options{}
+	fs.StringVar(&opts.output, "output", "",
+		"Directory of the generated hook package, default to ./<package name>")
+	fs.StringVar(&opts.hookPath, "path", "",
+		"Import path of the hook package, inferred from go.mod if not set")
+	fs.StringVar(&opts.hookPkg, "package", "",
+		"Package name of the hook package, default to the name of output directory")
+	fs.Usage = func() { printUsage(fs) }
+
+	args := os.Args[2:]
+	if len(args) == 0 || args[0] != SubcommandNew {
+		printUsage(fs)
+		return nil
+	}
+	err := fs.Parse(args[1:])
+	if err == flag.ErrHelp {
+		return nil
+	}
+	if err != nil {
+		return errc.New(errc.ErrScaffold, err.Error())
+	}
+	if fs.NArg() != 2 {
+		printUsage(fs)
+		return errc.New(errc.ErrScaffold,
+			"expect an import path and a function")
+	}
+	opts.importPath, opts.version, _ = strings.Cut(fs.Arg(0), "@")
+	opts.symbol = fs.Arg(1)
+	return newRule(opts)
+}
+
+func newRule(opts *options) error {
+	dir, version, err := findPackageDir(opts.importPath, opts.version)
+	if err != nil {
+		return err
+	}
+	target, err := findTargetFunc(dir, opts.symbol)
+	if err != nil {
+		return errc.Adhere(err, "package", opts.importPath)
+	}
+
+	output := opts.output
+	if output == "" {
+		output = target.pkgName
+	}
+	output, err = filepath.Abs(output)
+	if err != nil {
+		return errc.New(errc.ErrAbsPath, err.Error())
+	}
+	hookPkg := opts.hookPkg
+	if hookPkg == "" {
+		hookPkg = packageNameOf(filepath.Base(output))
+	}
+	hookPath := opts.hookPath
+	if hookPath == "" {
+		hookPath, err = inferImportPath(output)
+		if err != nil {
+			return err
+		}
+	}
+
+	hook := newHookGen(opts.importPath, hookPkg, target)
+	source, err := hook.generate()
+	if err != nil {
+		return err
+	}
+	rule := &resource.InstFuncRule{
+		InstBaseRule: resource.InstBaseRule{
+			ImportPath: opts.importPath,
+			Path:       hookPath,
+			Version:    versionRangeOf(version),
+		},
+		Function:     target.decl.Name.Name,
+		ReceiverType: target.receiverPattern(),
+		OnEnter:      hook.onEnter,
+		OnExit:       hook.onExit,
+	}
+	err = rule.Verify()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(output, 0777)
+	if err != nil {
+		return errc.New(errc.ErrMkdirAll, err.Error())
+	}
+	hookFile := filepath.Join(output, hook.fileName())
+	if util.PathExists(hookFile) {
+		return errc.New(errc.ErrScaffold,
+			fmt.Sprintf("%s already exists", hookFile))
+	}
+	_, err = util.WriteFile(hookFile, source)
+	if err != nil {
+		return err
+	}
+	ruleFile := filepath.Join(output, RuleFileName)
+	err = appendRule(ruleFile, rule)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Generated hook %s\n", hookFile)
+	fmt.Printf("Added rule to %s\n", ruleFile)
+	return nil
+}
+
+type moduleInfo struct {
+	Path    string `json:"Path"`
+	Version string `json:"Version"`
+	Error   string `json:"Error"`
+	Dir     string `json:"Dir"`
+}
+
+// isStdPackage checks if the import path designates a standard library package,
+// whose first path element contains no dot
+func isStdPackage(importPath string) bool {
+	first, _, _ := strings.Cut(importPath, "/")
+	return !strings.Contains(first, ".")
+}
+
+// downloadModule downloads the module of given version and returns its info
+func downloadModule(modPath, version string) (*moduleInfo, error) {
+	cmd := exec.Command("go", "mod", "download", "-json",
+		modPath+"@"+version)
+	// Download outside of any module, otherwise the go.mod of current module
+	// may constrain the version
+	cmd.Dir = os.TempDir()
+	out, err := cmd.Output()
+	info := &moduleInfo{}
+	if jsonErr := json.Unmarshal(out, info); jsonErr != nil {
+		if err == nil {
+			err = jsonErr
+		}
+		return nil, errc.New(errc.ErrRunCmd, err.Error()).
+			With("command", cmd.String())
+	}
+	if info.Error != "" {
+		return nil, errc.New(errc.ErrScaffold, info.Error)
+	}
+	return info, nil
+}
+
+// findPackageDir downloads the module that provides the package and returns
+// the directory of the package and the resolved version of the module
+func findPackageDir(importPath, version string) (string, string, error) {
+	if isStdPackage(importPath) {
+		out, err := exec.Command("go", "env", "GOROOT").Output()
+		if err != nil {
+			return "", "", errc.New(errc.ErrRunCmd, err.Error())
+		}
+		dir := filepath.Join(strings.TrimSpace(string(out)), "src", importPath)
+		if util.PathNotExists(dir) {
+			return "", "", errc.New(errc.ErrNotExist,
+				fmt.Sprintf("no standard package %s", importPath))
+		}
+		return dir, "", nil
+	}
+	if version == "" {
+		version = "latest"
+	}
+	// The module path is unknown, try from the longest prefix of import path
+	var lastErr error
+	for modPath := importPath; modPath != "."; modPath = filepath.ToSlash(
+		filepath.Dir(modPath)) {
+		info, err := downloadModule(modPath, version)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		rel := strings.TrimPrefix(importPath, modPath)
+		dir := filepath.Join(info.Dir, filepath.FromSlash(rel))
+		if util.PathNotExists(dir) {
+			return "", "", errc.New(errc.ErrNotExist,
+				fmt.Sprintf("no package %s in %s@%s", importPath, modPath,
+					info.Version))
+		}
+		return dir, info.Version, nil
+	}
+	return "", "", errc.Adhere(lastErr, "package", importPath)
+}
+
+// versionRangeOf returns the version range of the rule, i.e. versions that are
+// compatible with the one the rule is generated from
+func versionRangeOf(version string) string {
+	switch {
+	case version == "":
+		return ""
+	case semver.Prerelease(version) != "" || semver.Build(version) != "":
+		return ">=" + strings.TrimPrefix(version, "v")
+	default:
+		return "^" + strings.TrimPrefix(version, "v")
+	}
+}
+
+// inferImportPath infers the import path of directory from the go.mod of the
+// module that contains it
+func inferImportPath(dir string) (string, error) {
+	for root := dir; ; root = filepath.Dir(root) {
+		gomod := filepath.Join(root, util.GoModFile)
+		if util.PathExists(gomod) {
+			content, err := os.ReadFile(gomod)
+			if err != nil {
+				return "", errc.New(errc.ErrOpenFile, err.Error())
+			}
+			modPath := modfile.ModulePath(content)
+			if modPath == "" {
+				break
+			}
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", errc.New(errc.ErrAbsPath, err.Error())
+			}
+			if rel == "." {
+				return modPath, nil
+			}
+			return modPath + "/" + filepath.ToSlash(rel), nil
+		}
+		if filepath.Dir(root) == root {
+			break
+		}
+	}
+	return "", errc.New(errc.ErrNotModularized,
+		fmt.Sprintf("can not infer import path of %s, specify it by -path", dir))
+}
+
+// appendRule appends the rule to the rule file, the file is created if it does
+// not exist yet
+func appendRule(ruleFile string, rule resource.InstRule) error {
+	rules := make([]json.RawMessage, 0)
+	if util.PathExists(ruleFile) {
+		content, err := os.ReadFile(ruleFile)
+		if err != nil {
+			return errc.New(errc.ErrOpenFile, err.Error())
+		}
+		err = json.Unmarshal(content, &rules)
+		if err != nil {
+			return errc.New(errc.ErrInvalidJSON, err.Error()).
+				With("file", ruleFile)
+		}
+	}
+	bs, err := json.Marshal(rule)
+	if err != nil {
+		return errc.New(errc.ErrInvalidJSON, err.Error())
+	}
+	rules = append(rules, bs)
+	bs, err = json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return errc.New(errc.ErrInvalidJSON, err.Error())
+	}
+	_, err = util.WriteFile(ruleFile, string(bs)+"\n")
+	return err
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffold
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+)
+
+const testImportPath = "example.com/target"
+
+func TestParseSymbol(t *testing.T) {
+	cases := []struct {
+		symbol, recv, fn string
+	}{
+		{"Do", "", "Do"},
+		{"Client.Do", "Client", "Do"},
+		{"(Client).Do", "Client", "Do"},
+		{"(*Client).Do", "Client", "Do"},
+	}
+	for _, c := range cases {
+		recv, fn, err := parseSymbol(c.symbol)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recv != c.recv || fn != c.fn {
+			t.Fatalf("%s: expect %s.%s, got %s.%s", c.symbol, c.recv, c.fn,
+				recv, fn)
+		}
+	}
+	if _, _, err := parseSymbol("(*Client)."); err == nil {
+		t.Fatal("expect error for malformed symbol")
+	}
+}
+
+func TestGenerateHook(t *testing.T) {
+	dir := filepath.Join("testdata", "target")
+	cases := []struct {
+		symbol   string
+		receiver string
+		expects  []string
+	}{
+		{
+			"(*Client).Do",
+			`\*Client`,
+			[]string{
+				`stdhttp "net/http"`,
+				`"example.com/target"`,
+				"//go:linkname onEnterClientDo example.com/target.onEnterClientDo",
+				"func onEnterClientDo(call api.CallContext, c *target.Client, ctx context.Context, req *stdhttp.Request, opts ...target.Option)",
+				"func onExitClientDo(call api.CallContext, ret0 *stdhttp.Response, ret1 error)",
+			},
+		},
+		{
+			"Client.Call",
+			"Client",
+			[]string{
+				// Unexported types are referred as interface{}
+				"func onEnterClientCall(call api.CallContext, c target.Client, arg1 string, o interface{}, handlers map[string]func(int) error)",
+				"func onExitClientCall(call api.CallContext, ret0 <-chan struct{})",
+			},
+		},
+	}
+	for _, c := range cases {
+		target, err := findTargetFunc(dir, c.symbol)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target.receiverPattern() != c.receiver {
+			t.Fatalf("%s: expect receiver %s, got %s", c.symbol, c.receiver,
+				target.receiverPattern())
+		}
+		source, err := newHookGen(testImportPath, "hooks", target).generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expect := range c.expects {
+			if !strings.Contains(source, expect) {
+				t.Fatalf("%s: expect %q in\n%s", c.symbol, expect, source)
+			}
+		}
+	}
+
+	for symbol, expect := range map[string]string{
+		"(*List).Push": "generic receiver is not supported",
+		"Map":          "generic function is not supported",
+		"Client.Nope":  "function Client.Nope not found",
+	} {
+		_, err := findTargetFunc(dir, symbol)
+		if err == nil || !strings.Contains(err.Error(), expect) {
+			t.Fatalf("%s: expect %q, got %v", symbol, expect, err)
+		}
+	}
+}
+
+func TestVersionRangeOf(t *testing.T) {
+	for version, expect := range map[string]string{
+		"":                                   "",
+		"v1.9.2":                             "^1.9.2",
+		"v2.0.0-rc.1":                        ">=2.0.0-rc.1",
+		"v0.0.0-20240101000000-abcdefabcdef": ">=0.0.0-20240101000000-abcdefabcdef",
+	} {
+		if got := versionRangeOf(version); got != expect {
+			t.Fatalf("%s: expect %s, got %s", version, expect, got)
+		}
+	}
+}
+
+func TestAppendRule(t *testing.T) {
+	root := t.TempDir()
+	err := os.WriteFile(filepath.Join(root, "go.mod"),
+		[]byte("module example.com/hooks\n\ngo 1.23\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "redis")
+	hookPath, err := inferImportPath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hookPath != "example.com/hooks/redis" {
+		t.Fatalf("unexpected import path %s", hookPath)
+	}
+
+	ruleFile := filepath.Join(root, RuleFileName)
+	for _, fn := range []string{"Do", "Call"} {
+		rule := &resource.InstFuncRule{
+			InstBaseRule: resource.InstBaseRule{
+				ImportPath: testImportPath,
+				Path:       hookPath,
+			},
+			Function: fn,
+			OnEnter:  "onEnter" + fn,
+		}
+		if err = appendRule(ruleFile, rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+	content, err := os.ReadFile(ruleFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err := resource.ParseRules(ruleFile, string(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 {
+		bs, _ := json.Marshal(rules)
+		t.Fatalf("expect 2 rules, got %s", bs)
+	}
+}
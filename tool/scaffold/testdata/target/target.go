@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package target
+
+import (
+	"context"
+	stdhttp "net/http"
+)
+
+type Client struct{}
+
+type options struct{}
+
+type Option func(*options)
+
+type List[T any] struct{}
+
+func (c *Client) Do(ctx context.Context, req *stdhttp.Request, opts ...Option) (*stdhttp.Response, error) {
+	return nil, nil
+}
+
+func (c Client) Call(call string, o *options, handlers map[string]func(int) error) <-chan struct{} {
+	return nil
+}
+
+func (l *List[T]) Push(v T) {}
+
+func Map[T any](v T) T { return v }
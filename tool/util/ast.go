@@ -15,12 +15,16 @@
 package util
 
 import (
+	"bytes"
 	"fmt"
+	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
 	"github.com/dave/dst"
@@ -386,6 +390,25 @@ func MatchFuncDecl(decl dst.Decl, function string, receiverType string) bool {
 	return true
 }
 
+// WhyNotHookable tells why the function declared in the given file can not be
+// hooked, or returns an empty string if it can. A function without body is
+// either implemented in assembly or pulled from elsewhere by //go:linkname,
+// there is no place for trampoline-jump-if in both cases.
+func WhyNotHookable(file string, decl *dst.FuncDecl) string {
+	if decl.Body != nil {
+		return ""
+	}
+	source, err := ReadFile(file)
+	if err == nil {
+		re := regexp.MustCompile(`(?m)^//go:linkname\s+` +
+			regexp.QuoteMeta(decl.Name.Name) + `(\s|$)`)
+		if re.MatchString(source) {
+			return "function is declared by //go:linkname"
+		}
+	}
+	return "function is implemented in assembly"
+}
+
 func MatchStructDecl(decl dst.Decl, structType string) bool {
 	if genDecl, ok := decl.(*dst.GenDecl); ok {
 		if genDecl.Tok == token.TYPE {
@@ -429,6 +452,14 @@ func (ap *AstParser) FindPosition(node dst.Node) token.Position {
 	return ap.fset.Position(astNode.Pos())
 }
 
+func (ap *AstParser) FindEndPosition(node dst.Node) token.Position {
+	astNode := ap.dec.Ast.Nodes[node]
+	if astNode == nil {
+		return token.Position{Filename: "", Line: -1, Column: -1} // Invalid
+	}
+	return ap.fset.Position(astNode.End())
+}
+
 // ParseSnippet parses the AST from incomplete source code snippet.
 func (ap *AstParser) ParseSnippet(codeSnippnet string) ([]dst.Stmt, error) {
 	Assert(codeSnippnet != "", "empty code snippet")
@@ -467,6 +498,7 @@ func (ap *AstParser) ParseFile(filePath string, mode parser.Mode) (*dst.File, er
 	if err != nil {
 		return nil, errc.New(errc.ErrParseCode, err.Error())
 	}
+	tagLineDirectives(ap.fset, astFile)
 	ap.dec = decorator.NewDecorator(ap.fset)
 	dstFile, err := ap.dec.DecorateFile(astFile)
 	if err != nil {
@@ -502,9 +534,85 @@ func WriteAstToFile(astRoot *dst.File, filePath string) (string, error) {
 	}(file)
 
 	r := decorator.NewRestorer()
-	err = r.Fprint(file, astRoot)
+	buf := &bytes.Buffer{}
+	err = r.Fprint(buf, astRoot)
 	if err != nil {
 		return "", errc.New(errc.ErrParseCode, err.Error())
 	}
+	_, err = file.Write(alignLineDirectives(buf.Bytes()))
+	if err != nil {
+		return "", errc.New(errc.ErrWriteFile, err.Error())
+	}
 	return file.Name(), nil
 }
+
+// Directive-aware Printing
+// Compiler directives are comments, they are attached to the nodes that follow
+// them and the restorer prints them along with these nodes. This works well for
+// //go: directives, but a //line directive only takes effect when it starts at
+// the beginning of the line, while the restorer always indents comments as the
+// nodes they are attached to. So line directives, either generated by us or
+// present in the original source, are tagged in the AST, and the tagged ones
+// are moved to the beginning of the line when printing. Other comments, string
+// literals and indented //line comments, which are not directives at all in the
+// original source, are left untouched.
+
+const (
+	lineDirectivePrefix = "//line "
+	lineDirectiveTag    = "//otel:line "
+)
+
+// LineDirective returns a line directive for the given position, e.g.
+// "foo.go:12:2" or "<generated>:1", that takes effect once printed.
+func LineDirective(pos string) string {
+	return lineDirectiveTag + pos
+}
+
+func tagLineDirectives(fset *token.FileSet, file *ast.File) {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if !strings.HasPrefix(comment.Text, lineDirectivePrefix) {
+				continue
+			}
+			if fset.Position(comment.Slash).Column != 1 {
+				continue
+			}
+			comment.Text = LineDirective(
+				strings.TrimPrefix(comment.Text, lineDirectivePrefix))
+		}
+	}
+}
+
+func alignLineDirectives(source []byte) []byte {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(source))
+	s := scanner.Scanner{}
+	s.Init(file, source, nil, scanner.ScanComments)
+	result := make([]byte, 0, len(source))
+	last := 0
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok != token.COMMENT || !strings.HasPrefix(lit, lineDirectiveTag) {
+			continue
+		}
+		offset := file.Offset(pos)
+		lineStart := bytes.LastIndexByte(source[:offset], '\n') + 1
+		if len(bytes.TrimSpace(source[lineStart:offset])) == 0 {
+			// Drop the indentation
+			result = append(result, source[last:lineStart]...)
+		} else {
+			// Something precedes the directive, break the line. A line
+			// comment always ends its line, so the line break does not
+			// change where semicolons are inserted
+			result = append(result,
+				bytes.TrimRight(source[last:offset], " \t")...)
+			result = append(result, '\n')
+		}
+		result = append(result, lineDirectivePrefix...)
+		last = offset + len(lineDirectiveTag)
+	}
+	return append(result, source[last:]...)
+}
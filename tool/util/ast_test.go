@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// scanTokens returns the tokens of the source along with the semicolons
+// inserted by the scanner, comments are skipped.
+func scanTokens(t *testing.T, source string) []string {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(source))
+	s := scanner.Scanner{}
+	s.Init(file, []byte(source), func(pos token.Position, msg string) {
+		t.Fatalf("%v: %s", pos, msg)
+	}, 0)
+	tokens := make([]string, 0)
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			return tokens
+		}
+		tokens = append(tokens, tok.String()+" "+lit)
+	}
+}
+
+func TestAlignLineDirectives(t *testing.T) {
+	source := `package main
+
+func f(x int) int {
+	` + LineDirective("a.go:4") + `
+	for {
+		break ` + LineDirective("a.go:6") + `
+	}
+	g() ` + LineDirective("a.go:8") + `
+	return x ` + LineDirective("a.go:9") + `
+}
+`
+	aligned := string(alignLineDirectives([]byte(source)))
+	for _, line := range []string{
+		"\n//line a.go:4\n\tfor {\n",
+		"\t\tbreak\n//line a.go:6\n",
+		"\tg()\n//line a.go:8\n",
+		"\treturn x\n//line a.go:9\n}\n",
+	} {
+		if !strings.Contains(aligned, line) {
+			t.Fatalf("expect %q in aligned source\n%s", line, aligned)
+		}
+	}
+	if strings.Contains(aligned, lineDirectiveTag) {
+		t.Fatalf("unexpected tagged directive in aligned source\n%s", aligned)
+	}
+	// Breaking the line after return, break and ) must not insert
+	// semicolons that were not there
+	if !reflect.DeepEqual(scanTokens(t, source), scanTokens(t, aligned)) {
+		t.Fatalf("tokens changed by aligned directives\n%s", aligned)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", aligned, 0); err != nil {
+		t.Fatal(err)
+	}
+}
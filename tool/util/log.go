@@ -48,3 +48,12 @@ func LogFatal(format string, args ...interface{}) {
 	}
 	os.Exit(1)
 }
+
+// LogWarning logs the warning and tells the user as well, so that it does not
+// go unnoticed in the debug log, e.g. a deprecated rule is applied
+func LogWarning(format string, args ...interface{}) {
+	Log(format, args...)
+	if InPreprocess() {
+		fmt.Fprintf(os.Stderr, "\033[33mWarning: "+format+"\033[0m\n", args...)
+	}
+}
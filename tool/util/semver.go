@@ -0,0 +1,398 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"golang.org/x/mod/semver"
+)
+
+// VersionConstraint is the version range of a rule, it is either in the legacy
+// format "[start,end)", or a semver range expression such as
+//
+//	>=1.4.0 <2.0.0      comparators are ANDed together
+//	1.2.3 - 1.4         hyphen range, i.e. >=1.2.3 <1.5.0-0
+//	^1.2.3, ~1.2, 1.x   caret, tilde and wildcard ranges
+//	<1.0.0 || >=2.1.0   ranges are ORed together
+//
+// Versions are ordered by semver, so pseudo-versions such as
+// v1.2.4-0.20191109021931-daa7c04131f5 sit between v1.2.3 and v1.2.4. Upper
+// bounds derived from partial versions exclude pre-releases of the bound, i.e.
+// ^1.2.3 does not match v2.0.0-rc.1, while the explicit <2.0.0 does.
+type VersionConstraint struct {
+	sets [][]comparator
+}
+
+type comparator struct {
+	op      string
+	version string
+}
+
+func (c comparator) match(version string) bool {
+	r := semver.Compare(version, c.version)
+	switch c.op {
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	case "!=":
+		return r != 0
+	default:
+		return r == 0
+	}
+}
+
+// ParseVersionConstraint parses the version range of a rule.
+func ParseVersionConstraint(constraint string) (*VersionConstraint, error) {
+	trimmed := strings.TrimSpace(constraint)
+	if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "(") ||
+		strings.HasSuffix(trimmed, "]") || strings.HasSuffix(trimmed, ")") {
+		return parseLegacyRange(trimmed)
+	}
+	vc := &VersionConstraint{}
+	for _, set := range strings.Split(trimmed, "||") {
+		cs, err := parseComparatorSet(set)
+		if err != nil {
+			return nil, errc.Adhere(err, "constraint", constraint)
+		}
+		vc.sets = append(vc.sets, cs)
+	}
+	return vc, nil
+}
+
+// parseLegacyRange parses the version range in format [start,end), where start
+// is inclusive and end is exclusive, either of them can be omitted.
+func parseLegacyRange(vr string) (*VersionConstraint, error) {
+	vr = strings.ReplaceAll(vr, " ", "")
+	if !strings.HasPrefix(vr, "[") || !strings.HasSuffix(vr, ")") ||
+		strings.Count(vr, ",") != 1 || strings.Contains(vr, "v") {
+		return nil, errc.New(errc.ErrMatchRule,
+			fmt.Sprintf("invalid rule version %v", vr))
+	}
+	start, end, _ := strings.Cut(vr[1:len(vr)-1], ",")
+	if start == "" && end == "" {
+		return nil, errc.New(errc.ErrMatchRule,
+			fmt.Sprintf("invalid rule version range %v", vr))
+	}
+	set := make([]comparator, 0, 2)
+	if start != "" {
+		set = append(set, comparator{">=", "v" + start})
+	}
+	if end != "" {
+		set = append(set, comparator{"<", "v" + end})
+	}
+	for _, c := range set {
+		if !semver.IsValid(c.version) {
+			return nil, errc.New(errc.ErrMatchRule,
+				fmt.Sprintf("invalid rule version %v", vr))
+		}
+	}
+	return &VersionConstraint{sets: [][]comparator{set}}, nil
+}
+
+var versionOperators = []string{">=", "<=", "!=", "==", ">", "<", "=", "^", "~"}
+
+func splitOperator(term string) (string, string) {
+	for _, op := range versionOperators {
+		if strings.HasPrefix(term, op) {
+			return op, strings.TrimSpace(term[len(op):])
+		}
+	}
+	return "", term
+}
+
+func parseComparatorSet(set string) ([]comparator, error) {
+	fields := strings.Fields(strings.ReplaceAll(set, ",", " "))
+	if len(fields) == 0 {
+		return nil, errc.New(errc.ErrMatchRule, "empty version range")
+	}
+	// Glue operators separated from their versions, e.g. ">= 1.2.0"
+	terms := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		op, rest := splitOperator(fields[i])
+		if op != "" && rest == "" && i+1 < len(fields) {
+			terms = append(terms, op+fields[i+1])
+			i++
+			continue
+		}
+		terms = append(terms, fields[i])
+	}
+	cs := make([]comparator, 0)
+	for i := 0; i < len(terms); i++ {
+		if i+2 < len(terms) && terms[i+1] == "-" {
+			// Hyphen range
+			lo, err := parsePartialVersion(terms[i])
+			if err != nil {
+				return nil, err
+			}
+			hi, err := parsePartialVersion(terms[i+2])
+			if err != nil {
+				return nil, err
+			}
+			cs = append(cs, lo.lowerBound())
+			cs = append(cs, hi.upperBound(hi.exclusiveEnd())...)
+			i += 2
+			continue
+		}
+		c, err := parseComparator(terms[i])
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c...)
+	}
+	return cs, nil
+}
+
+// partialVersion is a version whose trailing parts may be omitted or
+// wildcards, e.g. 1.2 or 1.2.x, n is the number of specified parts
+type partialVersion struct {
+	parts [3]int
+	n     int
+	// pre-release and build suffix, only valid if all parts are specified
+	suffix string
+}
+
+func parsePartialVersion(s string) (*partialVersion, error) {
+	pv := &partialVersion{}
+	s = strings.TrimPrefix(s, "v")
+	core := s
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		core, pv.suffix = s[:i], s[i:]
+	}
+	for _, part := range strings.Split(core, ".") {
+		if part == "x" || part == "X" || part == "*" {
+			break
+		}
+		if pv.n == 3 {
+			return nil, errc.New(errc.ErrMatchRule,
+				fmt.Sprintf("invalid version %v", s))
+		}
+		num, err := strconv.Atoi(part)
+		if err != nil || num < 0 {
+			return nil, errc.New(errc.ErrMatchRule,
+				fmt.Sprintf("invalid version %v", s))
+		}
+		pv.parts[pv.n] = num
+		pv.n++
+	}
+	if pv.suffix != "" && pv.n != 3 {
+		return nil, errc.New(errc.ErrMatchRule,
+			fmt.Sprintf("invalid version %v", s))
+	}
+	if !semver.IsValid(pv.String()) {
+		return nil, errc.New(errc.ErrMatchRule,
+			fmt.Sprintf("invalid version %v", s))
+	}
+	return pv, nil
+}
+
+func (pv *partialVersion) String() string {
+	return fmt.Sprintf("v%d.%d.%d%s",
+		pv.parts[0], pv.parts[1], pv.parts[2], pv.suffix)
+}
+
+// bump returns the smallest version that is greater than all versions whose
+// first i+1 parts are the same as pv, e.g. bump(1) of 1.2.3 is 1.3.0
+func (pv *partialVersion) bump(i int) string {
+	parts := pv.parts
+	parts[i]++
+	for j := i + 1; j < 3; j++ {
+		parts[j] = 0
+	}
+	// Pre-releases of the bound are excluded as well
+	return fmt.Sprintf("v%d.%d.%d-0", parts[0], parts[1], parts[2])
+}
+
+// exclusiveEnd returns the smallest version that is greater than all versions
+// pv designates, or empty if pv designates the exact version
+func (pv *partialVersion) exclusiveEnd() string {
+	if pv.n == 3 {
+		return ""
+	}
+	if pv.n == 0 {
+		return "*"
+	}
+	return pv.bump(pv.n - 1)
+}
+
+func (pv *partialVersion) lowerBound() comparator {
+	return comparator{">=", pv.String()}
+}
+
+// upperBound returns the comparators that match versions up to pv inclusively
+func (pv *partialVersion) upperBound(end string) []comparator {
+	switch end {
+	case "*":
+		return nil
+	case "":
+		return []comparator{{"<=", pv.String()}}
+	default:
+		return []comparator{{"<", end}}
+	}
+}
+
+func parseComparator(term string) ([]comparator, error) {
+	op, rest := splitOperator(term)
+	pv, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, err
+	}
+	end := pv.exclusiveEnd()
+	if end == "*" {
+		// Wildcard matches any version
+		switch op {
+		case "", "=", "==", ">=", "<=", "^", "~":
+			return nil, nil
+		default:
+			return nil, errc.New(errc.ErrMatchRule,
+				fmt.Sprintf("invalid version range %v", term))
+		}
+	}
+	switch op {
+	case "", "=", "==":
+		if end == "" {
+			return []comparator{{"=", pv.String()}}, nil
+		}
+		return []comparator{pv.lowerBound(), {"<", end}}, nil
+	case "!=":
+		if end != "" {
+			return nil, errc.New(errc.ErrMatchRule,
+				fmt.Sprintf("%v requires a full version", term))
+		}
+		return []comparator{{"!=", pv.String()}}, nil
+	case ">":
+		if end == "" {
+			return []comparator{{">", pv.String()}}, nil
+		}
+		return []comparator{{">=", end}}, nil
+	case ">=":
+		return []comparator{pv.lowerBound()}, nil
+	case "<":
+		return []comparator{{"<", pv.String()}}, nil
+	case "<=":
+		return pv.upperBound(end), nil
+	case "^":
+		// Bump the leftmost non-zero part, or the last specified part if all
+		// of them are zero, e.g. ^1.2.3 := <2.0.0, ^0.2.3 := <0.3.0
+		i := 0
+		for i < pv.n-1 && pv.parts[i] == 0 {
+			i++
+		}
+		return []comparator{pv.lowerBound(), {"<", pv.bump(i)}}, nil
+	case "~":
+		// Bump the minor part if specified, e.g. ~1.2.3 := <1.3.0, ~1 := <2.0.0
+		i := 1
+		if pv.n < 2 {
+			i = 0
+		}
+		return []comparator{pv.lowerBound(), {"<", pv.bump(i)}}, nil
+	}
+	return nil, errc.New(errc.ErrMatchRule,
+		fmt.Sprintf("invalid version range %v", term))
+}
+
+// ParseVersionBound parses the minimum or maximum version of a rule, which is
+// a single full or partial version with an optional "v" or "go" prefix, e.g.
+// 1.22 or go1.22.3. op is either ">=" or "<=", note that a partial maximum
+// version is inclusive, i.e. <=1.22 matches 1.22.9 as well.
+func ParseVersionBound(op, version string) (*VersionConstraint, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(version), "go")
+	pv, err := parsePartialVersion(v)
+	if err != nil {
+		return nil, err
+	}
+	if pv.n == 0 || strings.ContainsAny(v, " ,|") {
+		return nil, errc.New(errc.ErrMatchRule,
+			fmt.Sprintf("invalid version %v", version))
+	}
+	cs, err := parseComparator(op + v)
+	if err != nil {
+		return nil, err
+	}
+	return &VersionConstraint{sets: [][]comparator{cs}}, nil
+}
+
+// matchedSet returns the first comparator set that matches the version, note
+// that the empty set matches any version
+func (vc *VersionConstraint) matchedSet(version string) []comparator {
+	for _, set := range vc.sets {
+		matched := true
+		for _, c := range set {
+			if !c.match(version) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			if set == nil {
+				return []comparator{}
+			}
+			return set
+		}
+	}
+	return nil
+}
+
+// Match checks if the version is within the version range
+func (vc *VersionConstraint) Match(version string) bool {
+	return vc.matchedSet(version) != nil
+}
+
+// Bounds returns the lower and upper bound of the range that the version falls
+// into, empty bound means the range is open-ended
+func (vc *VersionConstraint) Bounds(version string) (string, string) {
+	lo, hi := "", ""
+	for _, c := range vc.matchedSet(version) {
+		switch c.op {
+		case ">", ">=", "=":
+			if lo == "" || semver.Compare(c.version, lo) > 0 {
+				lo = c.version
+			}
+		}
+		switch c.op {
+		case "<", "<=", "=":
+			if hi == "" || semver.Compare(c.version, hi) < 0 {
+				hi = c.version
+			}
+		}
+	}
+	return lo, hi
+}
+
+// MatchVersion checks if the version string matches the version range in the
+// rule. If the rule version string is empty, it always matches.
+func MatchVersion(version string, ruleVersion string) (bool, error) {
+	// Fast path, always match if the rule version is not specified
+	if ruleVersion == "" {
+		return true, nil
+	}
+	if !semver.IsValid(version) {
+		return false, errc.New(errc.ErrMatchRule,
+			fmt.Sprintf("invalid version %v", version))
+	}
+	vc, err := ParseVersionConstraint(ruleVersion)
+	if err != nil {
+		return false, err
+	}
+	return vc.Match(version), nil
+}
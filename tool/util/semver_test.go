@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import "testing"
+
+func TestMatchVersionExpression(t *testing.T) {
+	tests := []struct {
+		ruleVersion string
+		version     string
+		want        bool
+	}{
+		{">=1.4.0 <2.0.0", "v1.4.0", true},
+		{">=1.4.0 <2.0.0", "v1.9.9", true},
+		{">=1.4.0 <2.0.0", "v2.0.0", false},
+		{">=1.4.0 <2.0.0", "v1.3.9", false},
+		{">= 1.4.0, < 2.0.0", "v1.5.0", true},
+		{">=1.4.0 <2.0.0", "v2.0.0-rc.1", true},
+		{">=1.4.0", "v1.4.0-rc.1", false},
+		{">=1.4.0-rc.1", "v1.4.0-rc.2", true},
+		// Pseudo-versions are ordered after the tagged version they base on
+		{">=1.2.4", "v1.2.4-0.20191109021931-daa7c04131f5", false},
+		{">1.2.3", "v1.2.4-0.20191109021931-daa7c04131f5", true},
+		{"<1.2.4", "v1.2.4-0.20191109021931-daa7c04131f5", true},
+		{">=1.2.0 <1.3.0", "v1.2.5+incompatible", true},
+		{"^1.2.3", "v1.9.0", true},
+		{"^1.2.3", "v1.2.2", false},
+		{"^1.2.3", "v2.0.0", false},
+		{"^1.2.3", "v2.0.0-rc.1", false},
+		{"^0.2.3", "v0.2.9", true},
+		{"^0.2.3", "v0.3.0", false},
+		{"^0.0.3", "v0.0.4", false},
+		{"~1.2.3", "v1.2.9", true},
+		{"~1.2.3", "v1.3.0", false},
+		{"~1", "v1.9.0", true},
+		{"1.x", "v1.9.0", true},
+		{"1.x", "v2.0.0", false},
+		{"1.2", "v1.2.7", true},
+		{"1.2", "v1.3.0", false},
+		{"1.2.3", "v1.2.3", true},
+		{"=1.2.3", "v1.2.4", false},
+		{"!=1.2.3", "v1.2.4", true},
+		{"*", "v0.0.1", true},
+		{">1.2", "v1.2.9", false},
+		{">1.2", "v1.3.0", true},
+		{"<=1.2", "v1.2.9", true},
+		{"<=1.2", "v1.3.0", false},
+		{"1.2.3 - 1.4", "v1.4.9", true},
+		{"1.2.3 - 1.4", "v1.5.0", false},
+		{"1.2.3 - 1.4.0", "v1.4.0", true},
+		{"<1.0.0 || >=2.1.0", "v0.9.0", true},
+		{"<1.0.0 || >=2.1.0", "v2.0.0", false},
+		{"<1.0.0 || >=2.1.0", "v2.1.0", true},
+		{">=1.21", "v1.22", true},
+	}
+	for _, tt := range tests {
+		got, err := MatchVersion(tt.version, tt.ruleVersion)
+		if err != nil {
+			t.Errorf("MatchVersion(%q, %q) error = %v", tt.version, tt.ruleVersion, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("MatchVersion(%q, %q) = %v, want %v",
+				tt.version, tt.ruleVersion, got, tt.want)
+		}
+	}
+}
+
+func TestParseVersionConstraintError(t *testing.T) {
+	for _, rv := range []string{
+		">=", ">=1.2.3.4", "1.2-rc.1", "!=1.2", ">*", "abc", ">=1.0.0 ||", "[,)",
+	} {
+		if _, err := ParseVersionConstraint(rv); err == nil {
+			t.Errorf("ParseVersionConstraint(%q) expect error", rv)
+		}
+	}
+}
+
+func TestVersionConstraintBounds(t *testing.T) {
+	tests := []struct {
+		ruleVersion string
+		version     string
+		lo, hi      string
+	}{
+		{"[1.4.0,2.0.0)", "v1.5.0", "v1.4.0", "v2.0.0"},
+		{">=1.0.0", "v1.5.0", "v1.0.0", ""},
+		{"^1.4", "v1.5.0", "v1.4.0", "v2.0.0-0"},
+		{"<1.0.0 || >=1.2.0 <1.8.0", "v1.5.0", "v1.2.0", "v1.8.0"},
+	}
+	for _, tt := range tests {
+		vc, err := ParseVersionConstraint(tt.ruleVersion)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lo, hi := vc.Bounds(tt.version)
+		if lo != tt.lo || hi != tt.hi {
+			t.Errorf("Bounds(%q, %q) = %q, %q, want %q, %q",
+				tt.ruleVersion, tt.version, lo, hi, tt.lo, tt.hi)
+		}
+	}
+}
+
+func TestParseVersionBound(t *testing.T) {
+	tests := []struct {
+		op, bound, version string
+		expect             bool
+	}{
+		{">=", "1.22", "v1.22.0", true},
+		{">=", "go1.22", "v1.21.9", false},
+		{">=", "1.22.3", "v1.22.2", false},
+		{"<=", "1.22", "v1.22.9", true},
+		{"<=", "v1.22", "v1.23.0", false},
+		{"<=", "1.22.3", "v1.22.3", true},
+	}
+	for _, tt := range tests {
+		vc, err := ParseVersionBound(tt.op, tt.bound)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vc.Match(tt.version) != tt.expect {
+			t.Errorf("%s%s matches %s, want %v", tt.op, tt.bound, tt.version,
+				tt.expect)
+		}
+	}
+	for _, bound := range []string{"", "*", ">=1.22", "1.22 || 1.23", "1.a"} {
+		if _, err := ParseVersionBound(">=", bound); err == nil {
+			t.Errorf("ParseVersionBound(%q) expect error", bound)
+		}
+	}
+}
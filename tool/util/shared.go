@@ -51,11 +51,17 @@ func AssertGoBuild(args []string) {
 	if !strings.Contains(args[0], "go") {
 		Assert(false, "invalid go build command %v", args)
 	}
-	if args[1] != "build" && args[1] != "install" {
+	if args[1] != "build" && args[1] != "install" && args[1] != "test" {
 		Assert(false, "invalid go build command %v", args)
 	}
 }
 
+// IsGoTestCmd checks if the go build command is "go test", which compiles the
+// packages along with their test files and runs the tests
+func IsGoTestCmd(args []string) bool {
+	return len(args) > 1 && args[1] == "test"
+}
+
 func IsCompileCommand(line string) bool {
 	check := []string{"-o", "-p", "-buildid"}
 	if IsWindows() {
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Alibaba Group Holding Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"debug/buildinfo"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/errc"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/resource"
+	"github.com/alibaba/opentelemetry-go-auto-instrumentation/tool/util"
+)
+
+// -----------------------------------------------------------------------------
+// Verify
+//
+// The verify package tells what instrumentation exactly is inside a binary,
+// i.e. "otel verify", by reading the manifest that is embedded into the binary
+// at build time.
+
+var usage = `Usage: {} verify [-json] <binary>
+Example:
+	{} verify ./app
+	{} verify -json ./app
+
+Flags:
+`
+
+func printUsage(fs *flag.FlagSet) {
+	name, _ := util.GetToolName()
+	fmt.Print(strings.ReplaceAll(usage, "{}", name))
+	fs.PrintDefaults()
+}
+
+// Verify runs the "verify" command
+func Verify() error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "Print the manifest as JSON")
+	fs.Usage = func() { printUsage(fs) }
+	err := fs.Parse(os.Args[2:])
+	if err == flag.ErrHelp {
+		return nil
+	}
+	if err != nil {
+		return errc.New(errc.ErrVerify, err.Error())
+	}
+	if fs.NArg() != 1 {
+		printUsage(fs)
+		return errc.New(errc.ErrVerify, "expect a binary")
+	}
+	binary := fs.Arg(0)
+
+	content, err := os.ReadFile(binary)
+	if err != nil {
+		return errc.New(errc.ErrOpenFile, err.Error())
+	}
+	m, err := resource.ExtractManifest(content)
+	if err != nil {
+		return errc.Adhere(err, "binary", binary)
+	}
+	if *asJSON {
+		bs, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return errc.New(errc.ErrInvalidJSON, err.Error())
+		}
+		fmt.Println(string(bs))
+		return nil
+	}
+	printManifest(binary, m)
+	return nil
+}
+
+func printManifest(binary string, m *resource.Manifest) {
+	goVersion := ""
+	if info, err := buildinfo.ReadFile(binary); err == nil {
+		goVersion = info.GoVersion
+	}
+	fmt.Printf("%s is instrumented by otel %s", binary, m.ToolVersion)
+	if goVersion != "" {
+		fmt.Printf(", built with %s", goVersion)
+	}
+	fmt.Printf(", %d rules applied\n", len(m.Entries))
+	if len(m.Entries) == 0 {
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "RULE\tKIND\tTARGET\tVERSION")
+	for _, e := range m.Entries {
+		rule := e.Rule
+		if rule == "" {
+			rule = "-"
+		}
+		version := e.Version
+		if version == "" {
+			version = "-"
+			// Packages of the standard library come with the toolchain
+			first, _, _ := strings.Cut(e.Package, "/")
+			if goVersion != "" && !strings.Contains(first, ".") &&
+				e.Package != "main" {
+				version = goVersion
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rule, e.Kind, e.Target, version)
+	}
+	_ = w.Flush()
+}